@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var cacheTypeValue = newCacheTypeValue()
+	command := &cobra.Command{
+		Use:   "delete CACHE KEY...",
+		Short: "delete entries of a memoization cache",
+		Example: `# Delete an entry of a ConfigMap cache, so the next workflow to use it will run the step again:
+  argo cache delete my-cache my-key
+
+# Delete entries of a SQL cache:
+  argo cache delete my-cache my-key my-other-key --type sql
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewCacheServiceClient(ctx)
+			if err != nil {
+				return err
+			}
+			for _, key := range args[1:] {
+				_, err := serviceClient.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{
+					Namespace: client.Namespace(ctx),
+					Name:      args[0],
+					Type:      cacheType(cacheTypeValue),
+					Key:       key,
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Cache entry %s deleted\n", key)
+			}
+			return nil
+		},
+	}
+	command.Flags().Var(&cacheTypeValue, "type", "Type of cache. "+cacheTypeValue.Usage())
+	return command
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		cacheTypeValue = newCacheTypeValue()
+		output         = newOutputValue()
+	)
+	command := &cobra.Command{
+		Use:   "get CACHE KEY...",
+		Short: "display entries of a memoization cache",
+		Example: `# Get an entry of a ConfigMap cache:
+  argo cache get my-cache my-key
+
+# Get an entry of a SQL cache, including its outputs:
+  argo cache get my-cache my-key --type sql -o yaml
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewCacheServiceClient(ctx)
+			if err != nil {
+				return err
+			}
+			var entries []*cachepkg.CacheEntry
+			for _, key := range args[1:] {
+				entry, err := serviceClient.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{
+					Namespace: client.Namespace(ctx),
+					Name:      args[0],
+					Type:      cacheType(cacheTypeValue),
+					Key:       key,
+				})
+				if err != nil {
+					return err
+				}
+				entries = append(entries, entry)
+			}
+			return printCacheEntries(entries, output.String())
+		},
+	}
+	command.Flags().Var(&cacheTypeValue, "type", "Type of cache. "+cacheTypeValue.Usage())
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheTypeValue = newCacheTypeValue()
+		output         = newOutputValue()
+	)
+	command := &cobra.Command{
+		Use:   "list CACHE",
+		Short: "list the entries of a memoization cache",
+		Example: `# List the entries of a ConfigMap cache:
+  argo cache list my-cache
+
+# List the entries of a SQL cache:
+  argo cache list my-cache --type sql
+
+# List the keys of the entries:
+  argo cache list my-cache -o name
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewCacheServiceClient(ctx)
+			if err != nil {
+				return err
+			}
+			list, err := serviceClient.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{
+				Namespace: client.Namespace(ctx),
+				Name:      args[0],
+				Type:      cacheType(cacheTypeValue),
+			})
+			if err != nil {
+				return err
+			}
+			return printCacheEntries(list.Items, output.String())
+		},
+	}
+	command.Flags().Var(&cacheTypeValue, "type", "Type of cache. "+cacheTypeValue.Usage())
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	"github.com/argoproj/argo-workflows/v3/util/errors"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var (
+		cacheTypeValue = newCacheTypeValue()
+		notHitFor      string
+	)
+	command := &cobra.Command{
+		Use:   "prune CACHE",
+		Short: "delete the entries of a memoization cache that have not been hit recently",
+		Example: `# Delete the entries of a ConfigMap cache that have not been hit for a day:
+  argo cache prune my-cache --not-hit-for 24h
+
+# Delete all the entries of a SQL cache:
+  argo cache prune my-cache --type sql --not-hit-for 0s
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewCacheServiceClient(ctx)
+			if err != nil {
+				return err
+			}
+			resp, err := serviceClient.PruneCache(ctx, &cachepkg.PruneCacheRequest{
+				Namespace: client.Namespace(ctx),
+				Name:      args[0],
+				Type:      cacheType(cacheTypeValue),
+				NotHitFor: notHitFor,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d cache entries pruned\n", resp.Deleted)
+			return nil
+		},
+	}
+	command.Flags().Var(&cacheTypeValue, "type", "Type of cache. "+cacheTypeValue.Usage())
+	command.Flags().StringVar(&notHitFor, "not-hit-for", "", "Delete entries that have not been hit for at least this duration, e.g. 24h")
+	errors.CheckError(command.Context(), command.MarkFlagRequired("not-hit-for"))
+	return command
+}
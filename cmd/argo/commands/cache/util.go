@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	"github.com/argoproj/argo-workflows/v3/util/humanize"
+)
+
+func newCacheTypeValue() common.EnumFlagValue {
+	return common.EnumFlagValue{
+		AllowedValues: []string{"configmap", "sql"},
+		Value:         "configmap",
+	}
+}
+
+func cacheType(value common.EnumFlagValue) cachepkg.CacheType {
+	return cachepkg.CacheType(cachepkg.CacheType_value[strings.ToUpper(value.String())])
+}
+
+func newOutputValue() common.EnumFlagValue {
+	return common.EnumFlagValue{AllowedValues: []string{"name", "json", "yaml", "wide"}}
+}
+
+func printCacheEntries(entries []*cachepkg.CacheEntry, output string) error {
+	switch output {
+	case "name":
+		for _, entry := range entries {
+			fmt.Println(entry.Key)
+		}
+	case "json":
+		outBytes, err := json.MarshalIndent(entries, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(outBytes))
+	case "yaml":
+		outBytes, err := yaml.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(outBytes))
+	case "wide", "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprint(w, "KEY\tNODE ID\tAGE\tLAST HIT\n")
+		for _, entry := range entries {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Key, entry.NodeID, relativeDuration(entry.CreationTimestamp.Time), relativeDuration(entry.LastHitTimestamp.Time))
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+	return nil
+}
+
+func relativeDuration(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return humanize.RelativeDurationShort(t, time.Now())
+}
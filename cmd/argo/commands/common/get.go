@@ -237,7 +237,7 @@ func isNonBoundaryParentNode(node wfv1.NodeType) bool {
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypePlugin)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypePlugin) || (node == wfv1.NodeTypeChildWorkflow)
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
@@ -495,6 +495,8 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, wfName, nodePrefix str
 	if node.Type == wfv1.NodeTypePod {
 		podName := util.GeneratePodName(wfName, nodeName, templateName, node.ID, podNameVersion)
 		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, podName, duration, node.Message, ""}
+	} else if node.Type == wfv1.NodeTypeChildWorkflow {
+		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, node.ChildWorkflow, duration, node.Message, ""}
 	} else {
 		args = []interface{}{nodePrefix, fmtNodeName, fmtTemplateName, "", "", node.Message, ""}
 	}
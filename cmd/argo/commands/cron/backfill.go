@@ -12,6 +12,7 @@ import (
 
 	cron "github.com/robfig/cron/v3"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
@@ -31,6 +32,9 @@ type backfillOpts struct {
 	argName          string
 	dateFormat       string
 	maxWorkflowCount int
+	serverSide       bool
+	parallelism      int32
+	rerunFailed      bool
 }
 
 func NewBackfillCommand() *cobra.Command {
@@ -45,6 +49,9 @@ func NewBackfillCommand() *cobra.Command {
 				cmd.HelpFunc()(cmd, args)
 				os.Exit(0)
 			}
+			if cliOps.rerunFailed && cliOps.name == "" {
+				return fmt.Errorf("--rerun-failed requires the --name of the backfill")
+			}
 			if cliOps.name == "" {
 				name, err := rand.RandString(5)
 				if err != nil {
@@ -64,11 +71,20 @@ func NewBackfillCommand() *cobra.Command {
 	command.Flags().StringVar(&cliOps.argName, "argname", "cronScheduleTime", "Schedule time argument name for workflow")
 	command.Flags().StringVar(&cliOps.dateFormat, "format", time.RFC1123, "Date format for Schedule time value")
 	command.Flags().IntVar(&cliOps.maxWorkflowCount, "maxworkflowcount", 1000, "Maximum number of generated backfill workflows")
+	command.Flags().BoolVar(&cliOps.serverSide, "server-side", false, "Record the backfill on the CronWorkflow, so the controller runs it and tracks the outcome of each scheduled time")
+	command.Flags().Int32Var(&cliOps.parallelism, "parallelism", 1, "Maximum number of backfill workflows running at once, with --server-side")
+	command.Flags().BoolVar(&cliOps.rerunFailed, "rerun-failed", false, "Rerun the failed scheduled times of an existing backfill, with --server-side")
 
 	return command
 }
 
 func backfillCronWorkflow(ctx context.Context, cronWFName string, cliOps backfillOpts) error {
+	if cliOps.serverSide {
+		return backfillCronWorkflowServerSide(ctx, cronWFName, cliOps)
+	}
+	if cliOps.rerunFailed {
+		return fmt.Errorf("--rerun-failed requires --server-side")
+	}
 	if cliOps.startDate == "" {
 		return fmt.Errorf("start date should not be empty")
 	}
@@ -150,6 +166,99 @@ func backfillCronWorkflow(ctx context.Context, cronWFName string, cliOps backfil
 	return nil
 }
 
+// backfillCronWorkflowServerSide adds the backfill to the CronWorkflow, or reruns the failed scheduled times of an
+// existing one, for the controller to run
+func backfillCronWorkflowServerSide(ctx context.Context, cronWFName string, cliOps backfillOpts) error {
+	ctx, apiClient, err := client.NewAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+	cronClient, err := apiClient.NewCronWorkflowServiceClient()
+	if err != nil {
+		return err
+	}
+	namespace := client.Namespace(ctx)
+	cronWF, err := cronClient.GetCronWorkflow(ctx, &cronworkflow.GetCronWorkflowRequest{Name: cronWFName, Namespace: namespace})
+	if err != nil {
+		return err
+	}
+
+	var backfill *v1alpha1.CronWorkflowBackfill
+	var scheduledTimes []time.Time
+	for i := range cronWF.Spec.Backfills {
+		if cronWF.Spec.Backfills[i].Name == cliOps.name {
+			backfill = &cronWF.Spec.Backfills[i]
+		}
+	}
+	if cliOps.rerunFailed {
+		if backfill == nil {
+			return fmt.Errorf("CronWorkflow %s has no backfill named %q", cronWFName, cliOps.name)
+		}
+		backfill.RerunFailed++
+	} else {
+		if backfill != nil {
+			return fmt.Errorf("CronWorkflow %s already has a backfill named %q", cronWFName, cliOps.name)
+		}
+		newBackfill, err := newServerSideBackfill(cliOps)
+		if err != nil {
+			return err
+		}
+		scheduledTimes, err = common.GetBackfillScheduledTimes(&cronWF.Spec, *newBackfill)
+		if err != nil {
+			return err
+		}
+		if len(scheduledTimes) == 0 {
+			fmt.Print("There is no suitable scheduling time.")
+			return nil
+		}
+		cronWF.Spec.Backfills = append(cronWF.Spec.Backfills, *newBackfill)
+		backfill = newBackfill
+	}
+
+	_, err = cronClient.UpdateCronWorkflow(ctx, &cronworkflow.UpdateCronWorkflowRequest{Name: cronWFName, Namespace: namespace, CronWorkflow: cronWF})
+	if err != nil {
+		return err
+	}
+	if cliOps.rerunFailed {
+		fmt.Printf("Rerunning the failed scheduled times of backfill %s of Cronworkflow %s \n", backfill.Name, cronWFName)
+	} else {
+		fmt.Printf("Added %s Backfill to Cronworkflow %s \n", backfill.Name, cronWFName)
+		fmt.Printf("Start Time : %s \n", backfill.Start.Format(cliOps.dateFormat))
+		fmt.Printf("  End Time : %s \n", backfill.End.Format(cliOps.dateFormat))
+		fmt.Printf("Total Backfill Schedule: %d \n", len(scheduledTimes))
+	}
+	fmt.Printf("Run `argo cron get %s` to see its progress\n", cronWFName)
+	return nil
+}
+
+func newServerSideBackfill(cliOps backfillOpts) (*v1alpha1.CronWorkflowBackfill, error) {
+	if cliOps.startDate == "" {
+		return nil, fmt.Errorf("start date should not be empty")
+	}
+	startTime, err := time.Parse(cliOps.dateFormat, cliOps.startDate)
+	if err != nil {
+		return nil, err
+	}
+	endTime := time.Now()
+	if cliOps.endDate != "" {
+		endTime, err = time.Parse(cliOps.dateFormat, cliOps.endDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	parallelism := cliOps.parallelism
+	if cliOps.parallel {
+		parallelism = common.MaxBackfillScheduledTimes
+	}
+	return &v1alpha1.CronWorkflowBackfill{
+		Name:        cliOps.name,
+		Start:       metav1.NewTime(startTime),
+		End:         metav1.NewTime(endTime),
+		Parallelism: &parallelism,
+		Parameter:   cliOps.argName,
+	}, nil
+}
+
 const backfillWf = `{
    "apiVersion": "argoproj.io/v1alpha1",
    "kind": "Workflow",
@@ -126,6 +126,21 @@ func getCronWorkflowGet(ctx context.Context, cwf *v1alpha1.CronWorkflow) string
 		}
 		out += fmt.Sprintf(fmtStr, "Active Workflows:", strings.Join(activeWfNames, ", "))
 	}
+	if len(cwf.Status.Backfills) > 0 {
+		out += fmt.Sprintf(fmtStr, "Backfills:", "")
+		for _, backfill := range cwf.Status.Backfills {
+			fulfilled, failed := 0, 0
+			for _, interval := range backfill.Intervals {
+				if interval.Fulfilled() {
+					fulfilled++
+				}
+				if interval.Phase == v1alpha1.WorkflowFailed || interval.Phase == v1alpha1.WorkflowError {
+					failed++
+				}
+			}
+			out += fmt.Sprintf(fmtStr, "  "+backfill.Name+":", fmt.Sprintf("%s (%d/%d completed, %d failed)", backfill.Phase, fulfilled, len(backfill.Intervals), failed))
+		}
+	}
 	if len(cwf.Status.Conditions) > 0 {
 		out += cwf.Status.Conditions.DisplayString(fmtStr, map[v1alpha1.ConditionType]string{v1alpha1.ConditionTypeSubmissionError: "✖"})
 	}
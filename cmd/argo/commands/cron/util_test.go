@@ -104,3 +104,19 @@ func TestNextRuntimeWithMultipleSchedules(t *testing.T) {
 	assert.LessOrEqual(t, next.Unix(), time.Now().Add(1*time.Minute).Unix())
 	assert.Greater(t, next.Unix(), time.Now().Unix())
 }
+
+func TestPrintCronWorkflowBackfills(t *testing.T) {
+	cronWf := v1alpha1.MustUnmarshalCronWorkflow(invalidCwf)
+	cronWf.Status.Backfills = []v1alpha1.CronWorkflowBackfillStatus{{
+		Name:  "october",
+		Phase: v1alpha1.WorkflowRunning,
+		Intervals: []v1alpha1.CronWorkflowBackfillInterval{
+			{Phase: v1alpha1.WorkflowSucceeded},
+			{Phase: v1alpha1.WorkflowFailed},
+			{Phase: v1alpha1.WorkflowRunning},
+		},
+	}}
+	out := getCronWorkflowGet(logging.TestContext(t.Context()), cronWf)
+	assert.Contains(t, out, "Backfills:")
+	assert.Contains(t, out, "Running (2/3 completed, 1 failed)")
+}
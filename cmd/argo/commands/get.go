@@ -16,7 +16,7 @@ import (
 func NewGetCommand() *cobra.Command {
 	var getArgs = common.GetFlags{
 		Output: common.EnumFlagValue{
-			AllowedValues: []string{"name", "json", "yaml", "short", "wide"},
+			AllowedValues: []string{"name", "json", "yaml", "short", "wide", "outputs"},
 		},
 	}
 
@@ -29,6 +29,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Get the outputs of a workflow as JSON:
+  argo get my-wf -o outputs
 `,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,6 +76,9 @@ func printWorkflow(wf *wfv1.Workflow, getArgs common.GetFlags) error {
 	case "yaml":
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
+	case "outputs":
+		outBytes, _ := json.MarshalIndent(getWorkflowOutputs(wf), "", "    ")
+		fmt.Println(string(outBytes))
 	case "short", "wide", "":
 		fmt.Print(common.PrintWorkflowHelper(wf, getArgs))
 	default:
@@ -80,3 +86,28 @@ func printWorkflow(wf *wfv1.Workflow, getArgs common.GetFlags) error {
 	}
 	return nil
 }
+
+type workflowOutputs struct {
+	Parameters map[string]string        `json:"parameters"`
+	Artifacts  map[string]wfv1.Artifact `json:"artifacts"`
+}
+
+// getWorkflowOutputs returns the outputs declared by the workflow's spec by name, leaving out the other global outputs
+func getWorkflowOutputs(wf *wfv1.Workflow) workflowOutputs {
+	outputs := workflowOutputs{Parameters: map[string]string{}, Artifacts: map[string]wfv1.Artifact{}}
+	declared := wf.GetExecSpec().Outputs
+	if wf.Status.Outputs == nil {
+		return outputs
+	}
+	for _, param := range wf.Status.Outputs.Parameters {
+		if param.HasValue() && declared.GetParameterByName(param.Name) != nil {
+			outputs.Parameters[param.Name] = param.GetValue()
+		}
+	}
+	for _, art := range wf.Status.Outputs.Artifacts {
+		if declared.GetArtifactByName(art.Name) != nil {
+			outputs.Artifacts[art.Name] = art
+		}
+	}
+	return outputs
+}
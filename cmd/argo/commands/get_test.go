@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_getWorkflowOutputs(t *testing.T) {
+	assert.Equal(t, workflowOutputs{Parameters: map[string]string{}, Artifacts: map[string]wfv1.Artifact{}}, getWorkflowOutputs(&wfv1.Workflow{}))
+
+	art := wfv1.Artifact{Name: "binary", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "binary.tgz"}}}
+	wf := &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{Outputs: &wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{Name: "version"}, {Name: "unset"}},
+			Artifacts:  []wfv1.Artifact{{Name: "binary"}},
+		}},
+		Status: wfv1.WorkflowStatus{Outputs: &wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{Name: "version", Value: wfv1.AnyStringPtr("3")}, {Name: "unset"}, {Name: "exported", Value: wfv1.AnyStringPtr("global")}},
+			Artifacts:  []wfv1.Artifact{art, {Name: "exported-art"}},
+		}},
+	}
+	assert.Equal(t, workflowOutputs{Parameters: map[string]string{"version": "3"}, Artifacts: map[string]wfv1.Artifact{"binary": art}}, getWorkflowOutputs(wf))
+}
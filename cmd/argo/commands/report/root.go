@@ -0,0 +1,19 @@
+package report
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewReportCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "report",
+		Short: "report on workflows",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewUsageCommand())
+
+	return command
+}
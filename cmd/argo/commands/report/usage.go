@@ -0,0 +1,169 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	argotime "github.com/argoproj/pkg/time"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+)
+
+type usageFlags struct {
+	allNamespaces bool
+	label         string
+	labelSelector string
+	since         string
+	startedAfter  string
+	startedBefore string
+}
+
+func NewUsageCommand() *cobra.Command {
+	var (
+		flags   usageFlags
+		groupBy = common.EnumFlagValue{
+			AllowedValues: []string{"namespace", "label", "workflowTemplate", "template"},
+			Value:         "namespace",
+		}
+		output = common.EnumFlagValue{AllowedValues: []string{"wide", "json", "csv"}}
+	)
+	command := &cobra.Command{
+		Use:   "usage",
+		Short: "report the resource usage of live and archived workflows",
+		Example: `# Report the usage of each namespace over the last 30 days:
+  argo report usage -A --since 30d
+
+# Report the usage of each team, using the "team" label, as CSV:
+  argo report usage -A --group-by label --label team -o csv
+
+# Report the usage of each template of the workflows submitted from a WorkflowTemplate in January:
+  argo report usage --group-by template -l workflows.argoproj.io/workflow-template=my-wftmpl \
+    --started-after 2025-01-01T00:00:00Z --started-before 2025-02-01T00:00:00Z
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if groupBy.String() == "label" && flags.label == "" {
+				return fmt.Errorf("--label is required when grouping by label")
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewReportServiceClient(ctx)
+			if err != nil {
+				return err
+			}
+			req := &reportpkg.UsageReportRequest{
+				GroupBy:       groupBy.String(),
+				Label:         flags.label,
+				LabelSelector: flags.labelSelector,
+				StartedAfter:  flags.startedAfter,
+				StartedBefore: flags.startedBefore,
+			}
+			if !flags.allNamespaces {
+				req.Namespace = client.Namespace(ctx)
+			}
+			if flags.since != "" {
+				t, err := argotime.ParseSince(flags.since)
+				if err != nil {
+					return err
+				}
+				req.StartedAfter = t.Format(time.RFC3339)
+			}
+			report, err := serviceClient.GetUsageReport(ctx, req)
+			if err != nil {
+				return err
+			}
+			return printUsageReport(os.Stdout, report.Items, output.String())
+		},
+	}
+	command.Flags().BoolVarP(&flags.allNamespaces, "all-namespaces", "A", false, "Report on workflows from all namespaces")
+	command.Flags().Var(&groupBy, "group-by", "Group the usage by. "+groupBy.Usage())
+	command.Flags().StringVar(&flags.label, "label", "", "The key of the label to group by when grouping by label")
+	command.Flags().StringVarP(&flags.labelSelector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&flags.since, "since", "", "Report only on workflows started after a relative duration, e.g. 30d")
+	command.Flags().StringVar(&flags.startedAfter, "started-after", "", "Report only on workflows started at or after this RFC3339 time")
+	command.Flags().StringVar(&flags.startedBefore, "started-before", "", "Report only on workflows started before this RFC3339 time")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	command.MarkFlagsMutuallyExclusive("since", "started-after")
+	return command
+}
+
+// resourceNames returns the sorted names of all the resources in the items, so every row has the same columns
+func resourceNames(items []*reportpkg.UsageReportItem) []string {
+	var names []string
+	for _, item := range items {
+		for name := range item.ResourcesDuration {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func printUsageReport(w io.Writer, items []*reportpkg.UsageReportItem, output string) error {
+	names := resourceNames(items)
+	switch output {
+	case "json":
+		outBytes, err := json.MarshalIndent(items, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(outBytes))
+		return err
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write(append([]string{"group", "count", "wallTimeSeconds"}, names...)); err != nil {
+			return err
+		}
+		for _, item := range items {
+			row := []string{item.Group, strconv.FormatInt(item.Count, 10), strconv.FormatInt(item.WallTime, 10)}
+			for _, name := range names {
+				row = append(row, strconv.FormatInt(item.ResourcesDuration[name], 10))
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case "wide", "":
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		header := []string{"GROUP", "COUNT", "WALL TIME"}
+		for _, name := range names {
+			header = append(header, strings.ToUpper(name))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, item := range items {
+			group := item.Group
+			if group == "" {
+				group = "<none>"
+			}
+			row := []string{group, strconv.FormatInt(item.Count, 10), seconds(item.WallTime)}
+			for _, name := range names {
+				row = append(row, seconds(item.ResourcesDuration[name]))
+			}
+			_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+}
+
+func seconds(s int64) string {
+	return (time.Duration(s) * time.Second).String()
+}
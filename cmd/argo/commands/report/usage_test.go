@@ -0,0 +1,34 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+)
+
+func Test_printUsageReport(t *testing.T) {
+	items := []*reportpkg.UsageReportItem{
+		{Group: "", Count: 1, WallTime: 60, ResourcesDuration: map[string]int64{"cpu": 30}},
+		{Group: "red", Count: 2, WallTime: 90, ResourcesDuration: map[string]int64{"memory": 120, "cpu": 45}},
+	}
+	t.Run("CSV", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printUsageReport(&out, items, "csv"))
+		assert.Equal(t, "group,count,wallTimeSeconds,cpu,memory\n,1,60,30,0\nred,2,90,45,120\n", out.String())
+	})
+	t.Run("Wide", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printUsageReport(&out, items, "wide"))
+		assert.Equal(t, `GROUP    COUNT   WALL TIME   CPU   MEMORY
+<none>   1       1m0s        30s   0s
+red      2       1m30s       45s   2m0s
+`, out.String())
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		require.Error(t, printUsageReport(&bytes.Buffer{}, items, "yaml"))
+	})
+}
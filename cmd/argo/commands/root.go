@@ -12,10 +12,12 @@ import (
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/archive"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cache"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/executorplugin"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/report"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/sync"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/template"
 
@@ -121,6 +123,8 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	command.AddCommand(clustertemplate.NewClusterTemplateCommand())
 	command.AddCommand(executorplugin.NewRootCommand())
 	command.AddCommand(sync.NewSyncCommand())
+	command.AddCommand(cache.NewCacheCommand())
+	command.AddCommand(report.NewReportCommand())
 
 	client.AddKubectlFlagsToCmd(command)
 	client.AddAPIClientFlagsToCmd(command)
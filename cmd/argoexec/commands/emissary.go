@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/util/retry"
 
+	argoexec "github.com/argoproj/argo-workflows/v3/cmd/argoexec/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/emissary"
 
@@ -138,6 +139,9 @@ func NewEmissaryCommand() *cobra.Command {
 				return fmt.Errorf("failed to get retry strategy: %w", err)
 			}
 
+			ctx, endTracing := argoexec.InitTracing(ctx, containerName)
+			defer endTracing()
+
 			cmdErr := retry.OnError(backoff, func(error) bool { return true }, func() error {
 
 				command, closer, err := startCommand(ctx, name, args, template)
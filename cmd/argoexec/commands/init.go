@@ -26,6 +26,8 @@ func NewInitCommand() *cobra.Command {
 }
 
 func loadArtifacts(ctx context.Context) error {
+	ctx, endTracing := executor.InitTracing(ctx, "init")
+	defer endTracing()
 	wfExecutor := executor.Init(ctx, clientConfig, varRunArgo)
 	defer wfExecutor.HandleError(ctx)
 	defer stats.LogStats()
@@ -14,7 +14,7 @@ import (
 
 func NewResourceCommand() *cobra.Command {
 	command := cobra.Command{
-		Use:   "resource (get|create|apply|delete) MANIFEST",
+		Use:   "resource (get|create|apply|replace|patch|delete) MANIFEST",
 		Short: "update a resource and wait for resource conditions",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -63,7 +63,7 @@ func execResource(ctx context.Context, action string) error {
 			}
 		}
 	}
-	resourceNamespace, resourceName, selfLink, err := wfExecutor.ExecResource(ctx,
+	obj, err := wfExecutor.ExecResource(ctx,
 		action, manifestPath, wfExecutor.Template.Resource.Flags,
 	)
 	if err != nil {
@@ -71,12 +71,12 @@ func execResource(ctx context.Context, action string) error {
 		return err
 	}
 	if !isDelete {
-		err = wfExecutor.WaitResource(ctx, resourceNamespace, resourceName, selfLink)
+		err = wfExecutor.WaitResource(ctx, obj)
 		if err != nil {
 			wfExecutor.AddError(ctx, err)
 			return err
 		}
-		err = wfExecutor.SaveResourceParameters(ctx, resourceNamespace, resourceName)
+		err = wfExecutor.SaveResourceParameters(ctx, obj)
 		if err != nil {
 			wfExecutor.AddError(ctx, err)
 			return err
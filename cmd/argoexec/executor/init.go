@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-workflows/v3"
@@ -94,6 +97,15 @@ func Init(ctx context.Context, clientConfig clientcmd.ClientConfig, varRunArgo s
 		annotationPatchTickDuration,
 		progressFileTickDuration,
 	)
+	if tmpl.Resource != nil {
+		wfExecutor.DynamicClient, err = dynamic.NewForConfig(config)
+		CheckErr(err)
+		// the short names of resources, e.g. `cm`, may name the resources of flags, like they do with kubectl
+		discovery := memory.NewMemCacheClient(clientset.Discovery())
+		wfExecutor.RESTMapper = restmapper.NewShortcutExpander(restmapper.NewDeferredDiscoveryRESTMapper(discovery), discovery, func(warning string) {
+			logger.Warn(ctx, warning)
+		})
+	}
 
 	logger.
 		WithFields(version.Fields()).
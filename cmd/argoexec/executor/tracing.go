@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// InitTracing sets up the global trace provider for this executor process, and starts a span with the given name
+// parented to the span of the node this pod runs. The returned func ends the span and flushes it, so must be called before exiting.
+func InitTracing(ctx context.Context, name string) (context.Context, func()) {
+	tracing, err := telemetry.NewTracing(ctx, "argoexec")
+	if err != nil {
+		// tracing is best-effort, it must never fail the pod
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to initialize tracing")
+		return ctx, func() {}
+	}
+	ctx, span := tracing.Tracer().Start(telemetry.ContextWithTraceparent(ctx, os.Getenv(common.EnvVarTraceparent)), name)
+	return ctx, func() {
+		span.End()
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		tracing.Shutdown(shutdownCtx)
+	}
+}
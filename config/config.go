@@ -71,6 +71,10 @@ type Config struct {
 	// NamespaceParallelism limits the max workflows that can execute at the same time in a namespace
 	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
 
+	// FairShare admits workflows limited by Parallelism or NamespaceParallelism by weighted fair-share between tenants,
+	// rather than purely by priority
+	FairShare *FairShareConfig `json:"fairShare,omitempty"`
+
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
@@ -118,11 +122,63 @@ type Config struct {
 	// SSO in settings for single-sign on
 	SSO SSOConfig `json:"sso,omitempty"`
 
+	// EventSources are message brokers the Argo Server consumes events from
+	EventSources []EventSource `json:"eventSources,omitempty"`
+
 	// Synchronization via databases config
 	Synchronization *SyncConfig `json:"synchronization,omitempty"`
 
 	// ArtifactDrivers lists artifact driver plugins we can use
 	ArtifactDrivers []ArtifactDriver `json:"artifactDrivers,omitempty"`
+
+	// Estimation configures how the durations of workflows and nodes are estimated
+	Estimation *EstimationConfig `json:"estimation,omitempty"`
+}
+
+// EstimationConfig configures how the durations of workflows and nodes are estimated from the previous
+// successful runs of the same WorkflowTemplate, ClusterWorkflowTemplate or CronWorkflow
+type EstimationConfig struct {
+	// Runs is the number of the most recent successful archived runs to estimate from. The estimate is
+	// the median (p50) duration of each node, and the 90th percentile (p90) is also recorded.
+	// This requires the workflow archive. Defaults to 1, which uses the single most recent successful run.
+	Runs int `json:"runs,omitempty"`
+}
+
+func (e *EstimationConfig) GetRuns() int {
+	if e == nil || e.Runs < 1 {
+		return 1
+	}
+	return e.Runs
+}
+
+// FairShareConfig configures weighted fair-share admission of workflows. When a running-workflow slot frees up,
+// it is given to the pending workflow of the tenant using the smallest share of its weight, and only then by priority
+type FairShareConfig struct {
+	// Enabled enables fair-share admission
+	Enabled bool `json:"enabled,omitempty"`
+	// TenantLabel is the workflow label whose value is the tenant of the workflow. If not set, or a workflow does not
+	// have the label, the tenant is the namespace of the workflow
+	TenantLabel string `json:"tenantLabel,omitempty"`
+	// DefaultWeight is the weight of tenants not listed in Weights, defaults to 1
+	DefaultWeight int `json:"defaultWeight,omitempty"`
+	// Weights of tenants, keyed by namespace or tenant label value. A tenant with twice the weight of another is
+	// entitled to twice as many running workflows
+	Weights map[string]int `json:"weights,omitempty"`
+}
+
+func (f *FairShareConfig) IsEnabled() bool {
+	return f != nil && f.Enabled
+}
+
+// GetWeight returns the weight of the tenant
+func (f *FairShareConfig) GetWeight(tenant string) int {
+	if weight := f.Weights[tenant]; weight > 0 {
+		return weight
+	}
+	if f.DefaultWeight > 0 {
+		return f.DefaultWeight
+	}
+	return 1
 }
 
 // ArtifactDriver is a plugin for an artifact driver
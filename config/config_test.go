@@ -32,3 +32,15 @@ func TestSanitize(t *testing.T) {
 		}
 	}
 }
+
+func TestFairShareConfig(t *testing.T) {
+	var nilConfig *FairShareConfig
+	assert.False(t, nilConfig.IsEnabled())
+	assert.False(t, (&FairShareConfig{}).IsEnabled())
+	c := &FairShareConfig{Enabled: true, Weights: map[string]int{"a": 3}}
+	assert.True(t, c.IsEnabled())
+	assert.Equal(t, 3, c.GetWeight("a"))
+	assert.Equal(t, 1, c.GetWeight("b"))
+	c.DefaultWeight = 2
+	assert.Equal(t, 2, c.GetWeight("b"))
+}
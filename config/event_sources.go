@@ -0,0 +1,74 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// EventSource configures the Argo Server to consume events from a message broker, as well as from the events API.
+// Each message is an event, dispatched to the WorkflowEventBindings of the namespace
+type EventSource struct {
+	// Name of the event source, used as the discriminator of its events unless Discriminator is set
+	Name string `json:"name"`
+	// Namespace whose WorkflowEventBindings the events are dispatched to
+	Namespace string `json:"namespace"`
+	// ServiceAccountName is the service account of the namespace the events are dispatched as, in the same way as the
+	// service accounts of the webhook clients
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Discriminator of the events, defaults to the name
+	Discriminator string `json:"discriminator,omitempty"`
+	// MaxDeliveries is how many times a message that fails to dispatch is delivered before it is dropped, defaults to 5
+	MaxDeliveries int `json:"maxDeliveries,omitempty"`
+	// NATS consumes events from a NATS JetStream stream
+	NATS *NATSEventSource `json:"nats,omitempty"`
+	// Kafka consumes events from a Kafka topic
+	Kafka *KafkaEventSource `json:"kafka,omitempty"`
+}
+
+func (e EventSource) GetDiscriminator() string {
+	if e.Discriminator != "" {
+		return e.Discriminator
+	}
+	return e.Name
+}
+
+func (e EventSource) GetMaxDeliveries() int {
+	if e.MaxDeliveries > 0 {
+		return e.MaxDeliveries
+	}
+	return 5
+}
+
+// NATSEventSource consumes events from a NATS JetStream stream with a durable consumer
+type NATSEventSource struct {
+	// URL of the NATS server, e.g. nats://nats:4222, or tls://nats:4222
+	URL string `json:"url"`
+	// Stream to consume
+	Stream string `json:"stream"`
+	// Subject filters the messages of the stream, e.g. orders.>, optional
+	Subject string `json:"subject,omitempty"`
+	// Consumer is the name of the durable consumer, created if it does not exist, defaults to argo-workflows-<name>
+	Consumer string `json:"consumer,omitempty"`
+	// UsernameSecret references a secret containing the username
+	UsernameSecret *apiv1.SecretKeySelector `json:"userNameSecret,omitempty"`
+	// PasswordSecret references a secret containing the password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
+
+// KafkaEventSource consumes events from a Kafka topic as a member of a consumer group
+type KafkaEventSource struct {
+	// Brokers to bootstrap from, e.g. kafka:9092
+	Brokers []string `json:"brokers"`
+	// Topic to consume
+	Topic string `json:"topic"`
+	// ConsumerGroup the Argo Server joins, and commits offsets to, defaults to argo-workflows-<name>
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
+	// StartOffset is where to start consuming a partition without a committed offset, either "newest" (the default)
+	// or "oldest"
+	StartOffset string `json:"startOffset,omitempty"`
+	// TLS connects to the brokers with TLS
+	TLS bool `json:"tls,omitempty"`
+	// UsernameSecret references a secret containing the SASL/PLAIN username
+	UsernameSecret *apiv1.SecretKeySelector `json:"userNameSecret,omitempty"`
+	// PasswordSecret references a secret containing the SASL/PLAIN password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+}
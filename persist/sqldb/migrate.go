@@ -226,5 +226,33 @@ func Migrate(ctx context.Context, session db.Session, clusterName, tableName str
 		}),
 		// add index on creationtimestamp column
 		sqldb.AnsiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (creationtimestamp)`),
+		// add table for SQL memoization caches
+		sqldb.AnsiSQLChange(`create table if not exists argo_memoization_cache (
+    clustername varchar(64) not null,
+    namespace varchar(256) not null,
+    name varchar(128) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs text not null,
+    createdat timestamp not null default CURRENT_TIMESTAMP,
+    lasthitat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, namespace, name, cachekey)
+)`),
+		// MySQL can only store 64k in a TEXT field
+		sqldb.ByType(dbType, sqldb.TypedChanges{
+			sqldb.MySQL: sqldb.AnsiSQLChange(`alter table argo_memoization_cache modify column outputs longtext not null`),
+		}),
+		// index to find entries that have not been hit for garbage collection
+		sqldb.AnsiSQLChange(`create index argo_memoization_cache_i1 on argo_memoization_cache (clustername,namespace,lasthitat)`),
+		// The argo_archived_workflows_artifact_digests records the digests of the artifacts of archived workflows, so that
+		// artifact GC can find whether a content-addressed artifact is referenced without a free-text query on `workflow`.
+		sqldb.AnsiSQLChange(`create table if not exists argo_archived_workflows_artifact_digests (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    digest varchar(128) not null,
+    primary key (clustername, uid, digest),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		sqldb.AnsiSQLChange(`create index argo_archived_workflows_artifact_digests_i1 on argo_archived_workflows_artifact_digests (digest)`),
 	})
 }
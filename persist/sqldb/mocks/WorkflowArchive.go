@@ -498,6 +498,78 @@ func (_c *WorkflowArchive_HasMoreWorkflows_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// IsArtifactDigestReferenced provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) IsArtifactDigestReferenced(ctx context.Context, digest string, excludeUID string) (bool, error) {
+	ret := _mock.Called(ctx, digest, excludeUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsArtifactDigestReferenced")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, digest, excludeUID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, digest, excludeUID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, digest, excludeUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_IsArtifactDigestReferenced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsArtifactDigestReferenced'
+type WorkflowArchive_IsArtifactDigestReferenced_Call struct {
+	*mock.Call
+}
+
+// IsArtifactDigestReferenced is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - excludeUID string
+func (_e *WorkflowArchive_Expecter) IsArtifactDigestReferenced(ctx interface{}, digest interface{}, excludeUID interface{}) *WorkflowArchive_IsArtifactDigestReferenced_Call {
+	return &WorkflowArchive_IsArtifactDigestReferenced_Call{Call: _e.mock.On("IsArtifactDigestReferenced", ctx, digest, excludeUID)}
+}
+
+func (_c *WorkflowArchive_IsArtifactDigestReferenced_Call) Run(run func(ctx context.Context, digest string, excludeUID string)) *WorkflowArchive_IsArtifactDigestReferenced_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_IsArtifactDigestReferenced_Call) Return(b bool, err error) *WorkflowArchive_IsArtifactDigestReferenced_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_IsArtifactDigestReferenced_Call) RunAndReturn(run func(ctx context.Context, digest string, excludeUID string) (bool, error)) *WorkflowArchive_IsArtifactDigestReferenced_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEnabled provides a mock function for the type WorkflowArchive
 func (_mock *WorkflowArchive) IsEnabled() bool {
 	ret := _mock.Called()
@@ -610,6 +682,86 @@ func (_c *WorkflowArchive_ListWorkflows_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// ListWorkflowsForEstimator provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (v1alpha1.Workflows, error) {
+	ret := _mock.Called(ctx, namespace, requirements, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowsForEstimator")
+	}
+
+	var r0 v1alpha1.Workflows
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []labels.Requirement, int) (v1alpha1.Workflows, error)); ok {
+		return returnFunc(ctx, namespace, requirements, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []labels.Requirement, int) v1alpha1.Workflows); ok {
+		r0 = returnFunc(ctx, namespace, requirements, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1alpha1.Workflows)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []labels.Requirement, int) error); ok {
+		r1 = returnFunc(ctx, namespace, requirements, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_ListWorkflowsForEstimator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowsForEstimator'
+type WorkflowArchive_ListWorkflowsForEstimator_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowsForEstimator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - namespace string
+//   - requirements []labels.Requirement
+//   - limit int
+func (_e *WorkflowArchive_Expecter) ListWorkflowsForEstimator(ctx interface{}, namespace interface{}, requirements interface{}, limit interface{}) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	return &WorkflowArchive_ListWorkflowsForEstimator_Call{Call: _e.mock.On("ListWorkflowsForEstimator", ctx, namespace, requirements, limit)}
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) Run(run func(ctx context.Context, namespace string, requirements []labels.Requirement, limit int)) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []labels.Requirement
+		if args[2] != nil {
+			arg2 = args[2].([]labels.Requirement)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) Return(workflows v1alpha1.Workflows, err error) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Return(workflows, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsForEstimator_Call) RunAndReturn(run func(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (v1alpha1.Workflows, error)) *WorkflowArchive_ListWorkflowsForEstimator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowsLabelKeys provides a mock function for the type WorkflowArchive
 func (_mock *WorkflowArchive) ListWorkflowsLabelKeys(ctx context.Context) (*v1alpha1.LabelKeys, error) {
 	ret := _mock.Called(ctx)
@@ -739,3 +891,71 @@ func (_c *WorkflowArchive_ListWorkflowsLabelValues_Call) RunAndReturn(run func(c
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListWorkflowsNodes provides a mock function for the type WorkflowArchive
+func (_mock *WorkflowArchive) ListWorkflowsNodes(ctx context.Context, uids []string) (map[string]v1alpha1.Nodes, error) {
+	ret := _mock.Called(ctx, uids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowsNodes")
+	}
+
+	var r0 map[string]v1alpha1.Nodes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string]v1alpha1.Nodes, error)); ok {
+		return returnFunc(ctx, uids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string]v1alpha1.Nodes); ok {
+		r0 = returnFunc(ctx, uids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]v1alpha1.Nodes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, uids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowArchive_ListWorkflowsNodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkflowsNodes'
+type WorkflowArchive_ListWorkflowsNodes_Call struct {
+	*mock.Call
+}
+
+// ListWorkflowsNodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uids []string
+func (_e *WorkflowArchive_Expecter) ListWorkflowsNodes(ctx interface{}, uids interface{}) *WorkflowArchive_ListWorkflowsNodes_Call {
+	return &WorkflowArchive_ListWorkflowsNodes_Call{Call: _e.mock.On("ListWorkflowsNodes", ctx, uids)}
+}
+
+func (_c *WorkflowArchive_ListWorkflowsNodes_Call) Run(run func(ctx context.Context, uids []string)) *WorkflowArchive_ListWorkflowsNodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsNodes_Call) Return(stringToNodes map[string]v1alpha1.Nodes, err error) *WorkflowArchive_ListWorkflowsNodes_Call {
+	_c.Call.Return(stringToNodes, err)
+	return _c
+}
+
+func (_c *WorkflowArchive_ListWorkflowsNodes_Call) RunAndReturn(run func(ctx context.Context, uids []string) (map[string]v1alpha1.Nodes, error)) *WorkflowArchive_ListWorkflowsNodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
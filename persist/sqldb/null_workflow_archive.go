@@ -43,6 +43,18 @@ func (r *nullWorkflowArchive) GetWorkflowForEstimator(ctx context.Context, names
 	return nil, fmt.Errorf("getting archived workflow for estimator not supported")
 }
 
+func (r *nullWorkflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error) {
+	return nil, fmt.Errorf("listing archived workflows for estimator not supported")
+}
+
+func (r *nullWorkflowArchive) ListWorkflowsNodes(ctx context.Context, uids []string) (map[string]wfv1.Nodes, error) {
+	return map[string]wfv1.Nodes{}, nil
+}
+
+func (r *nullWorkflowArchive) IsArtifactDigestReferenced(ctx context.Context, digest string, excludeUID string) (bool, error) {
+	return false, nil
+}
+
 func (r *nullWorkflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
 	return fmt.Errorf("deleting archived workflows not supported")
 }
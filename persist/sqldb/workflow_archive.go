@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -24,9 +25,12 @@ import (
 )
 
 const (
-	archiveTableName        = "argo_archived_workflows"
-	archiveLabelsTableName  = archiveTableName + "_labels"
-	postgresNullReplacement = "ARGO_POSTGRES_NULL_REPLACEMENT"
+	archiveTableName       = "argo_archived_workflows"
+	archiveLabelsTableName = archiveTableName + "_labels"
+	// archiveArtifactDigestsTableName records the digests of the artifacts of archived workflows, so that artifact GC
+	// can find whether a content-addressed artifact is referenced using an index
+	archiveArtifactDigestsTableName = archiveTableName + "_artifact_digests"
+	postgresNullReplacement         = "ARGO_POSTGRES_NULL_REPLACEMENT"
 )
 
 type archivedWorkflowMetadata struct {
@@ -63,6 +67,12 @@ type archivedWorkflowLabelRecord struct {
 	Value string `db:"value"`
 }
 
+type archivedWorkflowArtifactDigestRecord struct {
+	ClusterName string `db:"clustername"`
+	UID         string `db:"uid"`
+	Digest      string `db:"digest"`
+}
+
 type archivedWorkflowCount struct {
 	Total uint64 `db:"total,omitempty" json:"total"`
 }
@@ -77,6 +87,14 @@ type WorkflowArchive interface {
 	HasMoreWorkflows(ctx context.Context, options sutils.ListOptions) (bool, error)
 	GetWorkflow(ctx context.Context, uid string, namespace string, name string) (*wfv1.Workflow, error)
 	GetWorkflowForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error)
+	// ListWorkflowsForEstimator returns up to limit of the most recently started succeeded workflows, with only their
+	// name, and the phase and timestamps of the workflow and its nodes
+	ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error)
+	// ListWorkflowsNodes returns the nodes of the archived workflows with the UIDs, keyed by UID
+	ListWorkflowsNodes(ctx context.Context, uids []string) (map[string]wfv1.Nodes, error)
+	// IsArtifactDigestReferenced returns whether an archived workflow, other than the one with the excluded UID, has an
+	// artifact with the digest
+	IsArtifactDigestReferenced(ctx context.Context, digest string, excludeUID string) (bool, error)
 	DeleteWorkflow(ctx context.Context, uid string) error
 	DeleteExpiredWorkflows(ctx context.Context, ttl time.Duration) error
 	IsEnabled() bool
@@ -161,10 +179,44 @@ func (r *workflowArchive) ArchiveWorkflow(ctx context.Context, wf *wfv1.Workflow
 				return err
 			}
 		}
+
+		_, err = sess.SQL().
+			DeleteFrom(archiveArtifactDigestsTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		// insert the digests of the artifacts
+		for _, digest := range artifactDigests(wf) {
+			_, err := sess.Collection(archiveArtifactDigestsTableName).
+				Insert(&archivedWorkflowArtifactDigestRecord{
+					ClusterName: r.clusterName,
+					UID:         string(wf.UID),
+					Digest:      digest,
+				})
+			if err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
 
+// artifactDigests returns the unique digests of the output artifacts of the workflow that have not been deleted
+func artifactDigests(wf *wfv1.Workflow) []string {
+	var digests []string
+	for _, node := range wf.Status.Nodes {
+		for _, a := range node.GetOutputs().GetArtifacts() {
+			if a.Digest != "" && !a.Deleted && !slices.Contains(digests, a.Digest) {
+				digests = append(digests, a.Digest)
+			}
+		}
+	}
+	return digests
+}
+
 func (r *workflowArchive) ListWorkflows(ctx context.Context, options sutils.ListOptions) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
 	var baseSelector = r.session.SQL().Select("name", "namespace", "uid", "phase", "startedat", "finishedat", "creationtimestamp")
@@ -611,6 +663,141 @@ func (r *workflowArchive) GetWorkflowForEstimator(ctx context.Context, namespace
 
 }
 
+// archivedEstimatorRecord is the part of an archived workflow the estimator needs
+type archivedEstimatorRecord struct {
+	Name       string    `db:"name"`
+	StartedAt  time.Time `db:"startedat"`
+	FinishedAt time.Time `db:"finishedat"`
+	Nodes      string    `db:"nodes"`
+}
+
+// estimatorNode is the part of a node the estimator needs, so the rest of it is not decoded
+type estimatorNode struct {
+	Name       string         `json:"name"`
+	Phase      wfv1.NodePhase `json:"phase"`
+	StartedAt  v1.Time        `json:"startedAt"`
+	FinishedAt v1.Time        `json:"finishedAt"`
+}
+
+// nodesColumn selects only the nodes of the archived workflow as the "nodes" column
+func (r *workflowArchive) nodesColumn() (*db.RawExpr, error) {
+	switch r.dbType {
+	case sqldb.MySQL:
+		return db.Raw("coalesce(workflow->'$.status.nodes', '{}') as nodes"), nil
+	case sqldb.Postgres:
+		return db.Raw("coalesce(workflow->'status'->'nodes', '{}') as nodes"), nil
+	default:
+		return nil, fmt.Errorf("unsupported db type %s", r.dbType)
+	}
+}
+
+func (r *workflowArchive) ListWorkflowsForEstimator(ctx context.Context, namespace string, requirements []labels.Requirement, limit int) (wfv1.Workflows, error) {
+	nodesColumn, err := r.nodesColumn()
+	if err != nil {
+		return nil, err
+	}
+	selector := r.session.SQL().
+		Select("name", "startedat", "finishedat", nodesColumn).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(phaseEqual(string(wfv1.NodeSucceeded)))
+
+	selector, err = BuildArchivedWorkflowSelector(selector, archiveTableName, archiveLabelsTableName, r.dbType, sutils.ListOptions{
+		Namespace:         namespace,
+		LabelRequirements: requirements,
+		Limit:             limit,
+		Offset:            0,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []archivedEstimatorRecord
+	err = selector.All(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	wfs := make(wfv1.Workflows, 0, len(records))
+	for _, record := range records {
+		if r.dbType == sqldb.Postgres {
+			record.Nodes = strings.ReplaceAll(record.Nodes, postgresNullReplacement, "\\u0000")
+		}
+		var nodes map[string]estimatorNode
+		err = json.Unmarshal([]byte(record.Nodes), &nodes)
+		if err != nil {
+			return nil, err
+		}
+		wf := wfv1.Workflow{
+			ObjectMeta: v1.ObjectMeta{Name: record.Name},
+			Status: wfv1.WorkflowStatus{
+				StartedAt:  v1.Time{Time: record.StartedAt},
+				FinishedAt: v1.Time{Time: record.FinishedAt},
+				Nodes:      make(wfv1.Nodes, len(nodes)),
+			},
+		}
+		for id, node := range nodes {
+			wf.Status.Nodes[id] = wfv1.NodeStatus{ID: id, Name: node.Name, Phase: node.Phase, StartedAt: node.StartedAt, FinishedAt: node.FinishedAt}
+		}
+		wfs = append(wfs, wf)
+	}
+	return wfs, nil
+}
+
+type archivedNodesRecord struct {
+	UID   string `db:"uid"`
+	Nodes string `db:"nodes"`
+}
+
+func (r *workflowArchive) ListWorkflowsNodes(ctx context.Context, uids []string) (map[string]wfv1.Nodes, error) {
+	res := make(map[string]wfv1.Nodes, len(uids))
+	if len(uids) == 0 {
+		return res, nil
+	}
+	nodesColumn, err := r.nodesColumn()
+	if err != nil {
+		return nil, err
+	}
+	var records []archivedNodesRecord
+	err = r.session.SQL().
+		Select("uid", nodesColumn).
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(db.Cond{"uid IN": uids}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if r.dbType == sqldb.Postgres {
+			record.Nodes = strings.ReplaceAll(record.Nodes, postgresNullReplacement, "\\u0000")
+		}
+		var nodes wfv1.Nodes
+		err = json.Unmarshal([]byte(record.Nodes), &nodes)
+		if err != nil {
+			return nil, err
+		}
+		res[record.UID] = nodes
+	}
+	return res, nil
+}
+
+func (r *workflowArchive) IsArtifactDigestReferenced(ctx context.Context, digest string, excludeUID string) (bool, error) {
+	// artifacts may be shared by the workflows of any cluster or instance using the same repository, so we match them all
+	var result []struct{ UID string }
+	err := r.session.SQL().
+		Select("uid").
+		From(archiveArtifactDigestsTableName).
+		Where(db.Cond{"digest": digest}).
+		And(db.Cond{"uid <>": excludeUID}).
+		Limit(1).
+		All(&result)
+	if err != nil {
+		return false, err
+	}
+	return len(result) > 0, nil
+}
+
 func (r *workflowArchive) DeleteWorkflow(ctx context.Context, uid string) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	rs, err := r.session.SQL().
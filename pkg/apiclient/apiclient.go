@@ -6,9 +6,11 @@ import (
 
 	"k8s.io/client-go/tools/clientcmd"
 
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
 	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -25,6 +27,8 @@ type Client interface {
 	NewClusterWorkflowTemplateServiceClient() (clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient, error)
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
 	NewSyncServiceClient(ctx context.Context) (syncpkg.SyncServiceClient, error)
+	NewCacheServiceClient(ctx context.Context) (cachepkg.CacheServiceClient, error)
+	NewReportServiceClient(ctx context.Context) (reportpkg.ReportServiceClient, error)
 }
 
 type Opts struct {
@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+type argoKubeCacheServiceClient struct {
+	delegate cachepkg.CacheServiceServer
+}
+
+var _ cachepkg.CacheServiceClient = &argoKubeCacheServiceClient{}
+
+func (a *argoKubeCacheServiceClient) ListCacheEntries(ctx context.Context, in *cachepkg.ListCacheEntriesRequest, opts ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	return a.delegate.ListCacheEntries(ctx, in)
+}
+
+func (a *argoKubeCacheServiceClient) GetCacheEntry(ctx context.Context, in *cachepkg.GetCacheEntryRequest, opts ...grpc.CallOption) (*cachepkg.CacheEntry, error) {
+	return a.delegate.GetCacheEntry(ctx, in)
+}
+
+func (a *argoKubeCacheServiceClient) DeleteCacheEntry(ctx context.Context, in *cachepkg.DeleteCacheEntryRequest, opts ...grpc.CallOption) (*cachepkg.DeleteCacheEntryResponse, error) {
+	return a.delegate.DeleteCacheEntry(ctx, in)
+}
+
+func (a *argoKubeCacheServiceClient) PruneCache(ctx context.Context, in *cachepkg.PruneCacheRequest, opts ...grpc.CallOption) (*cachepkg.PruneCacheResponse, error) {
+	return a.delegate.PruneCache(ctx, in)
+}
@@ -12,9 +12,11 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
 	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -23,6 +25,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	clusterworkflowtmplserver "github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/argoproj/argo-workflows/v3/server/cronworkflow"
+	memoizationserver "github.com/argoproj/argo-workflows/v3/server/memoization"
+	reportserver "github.com/argoproj/argo-workflows/v3/server/report"
 	syncserver "github.com/argoproj/argo-workflows/v3/server/sync"
 	"github.com/argoproj/argo-workflows/v3/server/types"
 	workflowserver "github.com/argoproj/argo-workflows/v3/server/workflow"
@@ -207,3 +211,11 @@ func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() (clusterworkf
 func (a *argoKubeClient) NewSyncServiceClient(ctx context.Context) (syncpkg.SyncServiceClient, error) {
 	return &errorTranslatingArgoKubeSyncServiceClient{&argoKubeSyncServiceClient{syncserver.NewSyncServer(ctx, nil, "", nil)}}, nil
 }
+
+func (a *argoKubeClient) NewCacheServiceClient(_ context.Context) (cachepkg.CacheServiceClient, error) {
+	return &errorTranslatingArgoKubeCacheServiceClient{&argoKubeCacheServiceClient{memoizationserver.NewCacheServer(nil, "")}}, nil
+}
+
+func (a *argoKubeClient) NewReportServiceClient(_ context.Context) (reportpkg.ReportServiceClient, error) {
+	return &errorTranslatingArgoKubeReportServiceClient{&argoKubeReportServiceClient{reportserver.NewReportServer(sqldb.NullWorkflowArchive, argoKubeOffloadNodeStatusRepo)}}, nil
+}
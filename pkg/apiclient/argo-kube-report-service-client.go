@@ -0,0 +1,19 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+)
+
+type argoKubeReportServiceClient struct {
+	delegate reportpkg.ReportServiceServer
+}
+
+var _ reportpkg.ReportServiceClient = &argoKubeReportServiceClient{}
+
+func (a *argoKubeReportServiceClient) GetUsageReport(ctx context.Context, in *reportpkg.UsageReportRequest, opts ...grpc.CallOption) (*reportpkg.UsageReport, error) {
+	return a.delegate.GetUsageReport(ctx, in)
+}
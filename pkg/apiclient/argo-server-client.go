@@ -9,9 +9,11 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
 	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -67,6 +69,14 @@ func (a *argoServerClient) NewSyncServiceClient(_ context.Context) (syncpkg.Sync
 	return syncpkg.NewSyncServiceClient(a.ClientConn), nil
 }
 
+func (a *argoServerClient) NewCacheServiceClient(_ context.Context) (cachepkg.CacheServiceClient, error) {
+	return cachepkg.NewCacheServiceClient(a.ClientConn), nil
+}
+
+func (a *argoServerClient) NewReportServiceClient(_ context.Context) (reportpkg.ReportServiceClient, error) {
+	return reportpkg.NewReportServiceClient(a.ClientConn), nil
+}
+
 func newClientConn(opts ArgoServerOpts) (*grpc.ClientConn, error) {
 	creds := grpc.WithTransportCredentials(insecure.NewCredentials())
 	if opts.Secure {
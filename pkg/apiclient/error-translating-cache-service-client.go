@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingArgoKubeCacheServiceClient struct {
+	delegate cachepkg.CacheServiceClient
+}
+
+var _ cachepkg.CacheServiceClient = &errorTranslatingArgoKubeCacheServiceClient{}
+
+func (e *errorTranslatingArgoKubeCacheServiceClient) ListCacheEntries(ctx context.Context, in *cachepkg.ListCacheEntriesRequest, opts ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	entries, err := e.delegate.ListCacheEntries(ctx, in, opts...)
+	return entries, grpcutil.TranslateError(err)
+}
+
+func (e *errorTranslatingArgoKubeCacheServiceClient) GetCacheEntry(ctx context.Context, in *cachepkg.GetCacheEntryRequest, opts ...grpc.CallOption) (*cachepkg.CacheEntry, error) {
+	entry, err := e.delegate.GetCacheEntry(ctx, in, opts...)
+	return entry, grpcutil.TranslateError(err)
+}
+
+func (e *errorTranslatingArgoKubeCacheServiceClient) DeleteCacheEntry(ctx context.Context, in *cachepkg.DeleteCacheEntryRequest, opts ...grpc.CallOption) (*cachepkg.DeleteCacheEntryResponse, error) {
+	deleteResp, err := e.delegate.DeleteCacheEntry(ctx, in, opts...)
+	return deleteResp, grpcutil.TranslateError(err)
+}
+
+func (e *errorTranslatingArgoKubeCacheServiceClient) PruneCache(ctx context.Context, in *cachepkg.PruneCacheRequest, opts ...grpc.CallOption) (*cachepkg.PruneCacheResponse, error) {
+	pruneResp, err := e.delegate.PruneCache(ctx, in, opts...)
+	return pruneResp, grpcutil.TranslateError(err)
+}
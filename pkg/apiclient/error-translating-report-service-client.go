@@ -0,0 +1,21 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+)
+
+type errorTranslatingArgoKubeReportServiceClient struct {
+	delegate reportpkg.ReportServiceClient
+}
+
+var _ reportpkg.ReportServiceClient = &errorTranslatingArgoKubeReportServiceClient{}
+
+func (e *errorTranslatingArgoKubeReportServiceClient) GetUsageReport(ctx context.Context, in *reportpkg.UsageReportRequest, opts ...grpc.CallOption) (*reportpkg.UsageReport, error) {
+	report, err := e.delegate.GetUsageReport(ctx, in, opts...)
+	return report, grpcutil.TranslateError(err)
+}
@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+type CacheServiceClient = Facade
+
+func (h CacheServiceClient) ListCacheEntries(ctx context.Context, in *cachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	out := &cachepkg.CacheEntryList{}
+	return out, h.Get(ctx, in, out, "/api/v1/caches/{namespace}/{name}/entries")
+}
+
+func (h CacheServiceClient) GetCacheEntry(ctx context.Context, in *cachepkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntry, error) {
+	out := &cachepkg.CacheEntry{}
+	return out, h.Get(ctx, in, out, "/api/v1/caches/{namespace}/{name}/entries/{key}")
+}
+
+func (h CacheServiceClient) DeleteCacheEntry(ctx context.Context, in *cachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.DeleteCacheEntryResponse, error) {
+	out := &cachepkg.DeleteCacheEntryResponse{}
+	return out, h.Delete(ctx, in, out, "/api/v1/caches/{namespace}/{name}/entries/{key}")
+}
+
+func (h CacheServiceClient) PruneCache(ctx context.Context, in *cachepkg.PruneCacheRequest, _ ...grpc.CallOption) (*cachepkg.PruneCacheResponse, error) {
+	out := &cachepkg.PruneCacheResponse{}
+	return out, h.Post(ctx, in, out, "/api/v1/caches/{namespace}/{name}/prune")
+}
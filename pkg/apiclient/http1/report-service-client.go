@@ -0,0 +1,16 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+)
+
+type ReportServiceClient = Facade
+
+func (h ReportServiceClient) GetUsageReport(ctx context.Context, in *reportpkg.UsageReportRequest, _ ...grpc.CallOption) (*reportpkg.UsageReport, error) {
+	out := &reportpkg.UsageReport{}
+	return out, h.Get(ctx, in, out, "/api/v1/reports/usage")
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
 	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -140,6 +142,14 @@ func (c *offlineClient) NewSyncServiceClient(_ context.Context) (syncpkg.SyncSer
 	return nil, ErrNoArgoServer
 }
 
+func (c *offlineClient) NewCacheServiceClient(_ context.Context) (cachepkg.CacheServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
+func (c *offlineClient) NewReportServiceClient(_ context.Context) (reportpkg.ReportServiceClient, error) {
+	return nil, ErrNoArgoServer
+}
+
 type offlineWorkflowTemplateNamespacedGetter struct {
 	namespace         string
 	workflowTemplates map[string]*wfv1.WorkflowTemplate
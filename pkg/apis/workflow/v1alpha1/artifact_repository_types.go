@@ -29,12 +29,21 @@ type ArtifactRepository struct {
 	Azure *AzureArtifactRepository `json:"azure,omitempty" protobuf:"bytes,7,opt,name=azure"`
 	// Plugin stores artifact in a plugin-specific artifact repository
 	Plugin *PluginArtifactRepository `json:"plugin,omitempty" protobuf:"bytes,8,opt,name=plugin"`
+	// ContentAddressed stores file artifacts at a key derived from their digest, rather than the key format, so
+	// byte-identical artifacts are only uploaded and stored once, and shared between workflows
+	ContentAddressed *bool `json:"contentAddressed,omitempty" protobuf:"varint,9,opt,name=contentAddressed"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// ContentAddressedKey returns the key prefix of artifacts with the digest in a content-addressed repository,
+// e.g. "cas/sha256/<hex>"
+func ContentAddressedKey(digest string) string {
+	return path.Join("cas", strings.Replace(digest, ":", "/", 1))
+}
+
 type ArtifactRepositoryType interface {
 	IntoArtifactLocation(l *ArtifactLocation)
 }
@@ -66,7 +75,7 @@ func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
 	if a == nil {
 		return nil
 	}
-	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs}
+	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs, ContentAddressed: a.ContentAddressed}
 	v := a.Get()
 	if v != nil {
 		v.IntoArtifactLocation(l)
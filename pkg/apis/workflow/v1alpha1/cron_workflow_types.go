@@ -38,6 +38,21 @@ const (
 	ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
+// CatchUpPolicy is which of the scheduled times missed while the controller was not running are run
+type CatchUpPolicy string
+
+const (
+	// CatchUpNone runs none of the missed scheduled times
+	CatchUpNone CatchUpPolicy = "None"
+	// CatchUpLatest runs the most recent missed scheduled time, if it is within the startingDeadlineSeconds
+	CatchUpLatest CatchUpPolicy = "Latest"
+	// CatchUpAll runs every missed scheduled time, up to the catchUpLimit most recent
+	CatchUpAll CatchUpPolicy = "All"
+)
+
+// DefaultCatchUpLimit is the default maximum number of missed scheduled times run with the All catch-up policy
+const DefaultCatchUpLimit = 100
+
 const annotationKeyLatestSchedule = workflow.CronWorkflowFullName + "/last-used-schedule"
 
 // CronWorkflowSpec is the specification of a CronWorkflow
@@ -65,6 +80,62 @@ type CronWorkflowSpec struct {
 	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,11,opt,name=schedules"`
 	// v3.6 and after: When is an expression that determines if a run should be scheduled.
 	When string `json:"when,omitempty" protobuf:"bytes,12,opt,name=when"`
+	// v3.8 and after: Backfills run the workflow for the times in the past that match the schedules. The controller
+	// submits a workflow for each scheduled time, and records the outcome in the status.
+	Backfills []CronWorkflowBackfill `json:"backfills,omitempty" protobuf:"bytes,13,rep,name=backfills"`
+	// v3.8 and after: CatchUpPolicy is which of the scheduled times missed while the controller was not running are run,
+	// one of None, Latest, or All. Defaults to Latest. StartingDeadlineSeconds limits how long ago a missed scheduled
+	// time can be, and is required for Latest.
+	CatchUpPolicy CatchUpPolicy `json:"catchUpPolicy,omitempty" protobuf:"bytes,14,opt,name=catchUpPolicy,casttype=CatchUpPolicy"`
+	// v3.8 and after: CatchUpLimit is the maximum number of missed scheduled times run with the All catch-up policy,
+	// the most recent ones are run. Defaults to 100.
+	CatchUpLimit *int32 `json:"catchUpLimit,omitempty" protobuf:"varint,15,opt,name=catchUpLimit"`
+	// v3.8 and after: Dependencies are other CronWorkflows whose run for the same scheduled time must succeed before
+	// a run is submitted
+	Dependencies *CronWorkflowDependencies `json:"dependencies,omitempty" protobuf:"bytes,16,opt,name=dependencies"`
+}
+
+// CronWorkflowDependencies holds runs until the runs of other CronWorkflows for the same scheduled time succeed.
+// The run of another CronWorkflow for a scheduled time is its run for the most recent of its own scheduled times at or
+// before it. v3.8 and after
+type CronWorkflowDependencies struct {
+	// CronWorkflows are the names of the CronWorkflows, in the same namespace, to depend on
+	CronWorkflows []string `json:"cronWorkflows" protobuf:"bytes,1,rep,name=cronWorkflows"`
+	// DeadlineSeconds is how long after its scheduled time a run waits for its dependencies before it is skipped.
+	// Zero means runs are skipped unless their dependencies have already succeeded. Defaults to waiting until the
+	// next scheduled time.
+	DeadlineSeconds *int64 `json:"deadlineSeconds,omitempty" protobuf:"varint,2,opt,name=deadlineSeconds"`
+}
+
+func (c *CronWorkflowSpec) GetCatchUpLimit() int {
+	if c.CatchUpLimit == nil {
+		return DefaultCatchUpLimit
+	}
+	return int(*c.CatchUpLimit)
+}
+
+// CronWorkflowBackfill runs the workflow for each time in a range that matches the schedules. v3.8 and after
+type CronWorkflowBackfill struct {
+	// Name of the backfill, unique within the CronWorkflow
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Start is the earliest scheduled time to run the workflow for
+	Start metav1.Time `json:"start" protobuf:"bytes,2,opt,name=start"`
+	// End is the latest scheduled time to run the workflow for
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+	// Parallelism is the maximum number of workflows of the backfill running at once, defaults to 1
+	Parallelism *int32 `json:"parallelism,omitempty" protobuf:"varint,4,opt,name=parallelism"`
+	// Parameter is the name of a workflow argument to set to the scheduled time, formatted in RFC 3339, in addition to
+	// the `workflow.scheduledTime` variable
+	Parameter string `json:"parameter,omitempty" protobuf:"bytes,5,opt,name=parameter"`
+	// RerunFailed is incremented to run the workflow again for the scheduled times whose workflow failed
+	RerunFailed int32 `json:"rerunFailed,omitempty" protobuf:"varint,6,opt,name=rerunFailed"`
+}
+
+func (b *CronWorkflowBackfill) GetParallelism() int {
+	if b.Parallelism == nil {
+		return 1
+	}
+	return int(*b.Parallelism)
 }
 
 // StopStrategy defines if the CronWorkflow should stop scheduling based on an expression. v3.6 and after
@@ -94,6 +165,50 @@ type CronWorkflowStatus struct {
 	// v3.6 and after: Phase is an enum of Active or Stopped. It changes to Stopped when stopStrategy.expression is true
 	// +optional
 	Phase CronWorkflowPhase `json:"phase" protobuf:"varint,6,rep,name=phase"`
+	// v3.8 and after: Backfills is the status of each backfill
+	// +optional
+	Backfills []CronWorkflowBackfillStatus `json:"backfills,omitempty" protobuf:"bytes,7,rep,name=backfills"`
+	// v3.8 and after: WaitingScheduledTimes are the scheduled times of the runs waiting for their dependencies
+	// +optional
+	WaitingScheduledTimes []metav1.Time `json:"waitingScheduledTimes,omitempty" protobuf:"bytes,8,rep,name=waitingScheduledTimes"`
+}
+
+// CronWorkflowBackfillStatus is the status of a backfill
+type CronWorkflowBackfillStatus struct {
+	// Name of the backfill
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is Running until every scheduled time has a fulfilled workflow, then Succeeded, or Failed if any failed
+	Phase WorkflowPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=WorkflowPhase"`
+	// RerunFailed is the value of the backfill's rerunFailed that the failed scheduled times were last rerun for
+	RerunFailed int32 `json:"rerunFailed,omitempty" protobuf:"varint,3,opt,name=rerunFailed"`
+	// Intervals are the scheduled times of the backfill, and the outcome of each
+	Intervals []CronWorkflowBackfillInterval `json:"intervals,omitempty" protobuf:"bytes,4,rep,name=intervals"`
+}
+
+// CronWorkflowBackfillInterval is a scheduled time of a backfill, and the outcome of its workflow
+type CronWorkflowBackfillInterval struct {
+	// ScheduledTime is the scheduled time the workflow is run for
+	ScheduledTime metav1.Time `json:"scheduledTime" protobuf:"bytes,1,opt,name=scheduledTime"`
+	// Workflow is the name of the latest workflow run for the scheduled time, if any
+	Workflow string `json:"workflow,omitempty" protobuf:"bytes,2,opt,name=workflow"`
+	// Phase is the phase of the workflow, empty until it is submitted
+	Phase WorkflowPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=WorkflowPhase"`
+	// Message is the reason the workflow could not be submitted or was not found
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// Fulfilled returns whether the scheduled time's workflow has completed, or could not be run
+func (i CronWorkflowBackfillInterval) Fulfilled() bool {
+	return i.Phase.Completed()
+}
+
+func (c *CronWorkflowStatus) GetBackfill(name string) *CronWorkflowBackfillStatus {
+	for i := range c.Backfills {
+		if c.Backfills[i].Name == name {
+			return &c.Backfills[i]
+		}
+	}
+	return nil
 }
 
 type CronWorkflowPhase string
@@ -204,4 +319,6 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeDependenciesNotMet signifies that a run is waiting for, or was skipped because of, its dependencies
+	ConditionTypeDependenciesNotMet ConditionType = "DependenciesNotMet"
 )
@@ -29,6 +29,28 @@ type WorkflowEventBindingSpec struct {
 	Event Event `json:"event" protobuf:"bytes,1,opt,name=event"`
 	// Submit is the workflow template to submit
 	Submit *Submit `json:"submit,omitempty" protobuf:"bytes,2,opt,name=submit"`
+	// Deduplicate suppresses the submission of a workflow for an event with the same key as a workflow already submitted,
+	// e.g. a retried delivery of a webhook
+	Deduplicate *EventDeduplication `json:"deduplicate,omitempty" protobuf:"bytes,3,opt,name=deduplicate"`
+	// RateLimit limits how many workflows are submitted per interval, e.g. during an alert storm
+	RateLimit *EventRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,4,opt,name=rateLimit"`
+}
+
+// EventDeduplication suppresses the submission of workflows for duplicate events
+type EventDeduplication struct {
+	// Key (https://github.com/expr-lang/expr) is the idempotency key of the event, and must evaluate to a string. E.g. `metadata["x-github-delivery"][0]`
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Window (e.g. "10m", "24h") is how long after a workflow is submitted that events with the same key are
+	// suppressed. Defaults to as long as the workflow exists.
+	Window string `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+}
+
+// EventRateLimit limits how many workflows are submitted per interval
+type EventRateLimit struct {
+	// MaxSubmissions is the maximum number of workflows submitted per interval
+	MaxSubmissions int32 `json:"maxSubmissions" protobuf:"varint,1,opt,name=maxSubmissions"`
+	// Interval (e.g. "1m", "1h")
+	Interval string `json:"interval" protobuf:"bytes,2,opt,name=interval"`
 }
 
 type Event struct {
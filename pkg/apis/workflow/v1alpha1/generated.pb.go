@@ -12,10 +12,10 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/policy/v1"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -64,10 +64,38 @@ func (m *Amount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Amount proto.InternalMessageInfo
 
+func (m *ApprovalDecision) Reset()      { *m = ApprovalDecision{} }
+func (*ApprovalDecision) ProtoMessage() {}
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{1}
+}
+func (m *ApprovalDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApprovalDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalDecision.Merge(m, src)
+}
+func (m *ApprovalDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalDecision proto.InternalMessageInfo
+
 func (m *ArchiveStrategy) Reset()      { *m = ArchiveStrategy{} }
 func (*ArchiveStrategy) ProtoMessage() {}
 func (*ArchiveStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{1}
+	return fileDescriptor_724696e352c3df5f, []int{2}
 }
 func (m *ArchiveStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +123,7 @@ var xxx_messageInfo_ArchiveStrategy proto.InternalMessageInfo
 func (m *Arguments) Reset()      { *m = Arguments{} }
 func (*Arguments) ProtoMessage() {}
 func (*Arguments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{2}
+	return fileDescriptor_724696e352c3df5f, []int{3}
 }
 func (m *Arguments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +151,7 @@ var xxx_messageInfo_Arguments proto.InternalMessageInfo
 func (m *ArtGCStatus) Reset()      { *m = ArtGCStatus{} }
 func (*ArtGCStatus) ProtoMessage() {}
 func (*ArtGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{3}
+	return fileDescriptor_724696e352c3df5f, []int{4}
 }
 func (m *ArtGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +179,7 @@ var xxx_messageInfo_ArtGCStatus proto.InternalMessageInfo
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +207,7 @@ var xxx_messageInfo_Artifact proto.InternalMessageInfo
 func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
 func (*ArtifactGC) ProtoMessage() {}
 func (*ArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +235,7 @@ var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
 func (m *ArtifactGCSpec) Reset()      { *m = ArtifactGCSpec{} }
 func (*ArtifactGCSpec) ProtoMessage() {}
 func (*ArtifactGCSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactGCSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +263,7 @@ var xxx_messageInfo_ArtifactGCSpec proto.InternalMessageInfo
 func (m *ArtifactGCStatus) Reset()      { *m = ArtifactGCStatus{} }
 func (*ArtifactGCStatus) ProtoMessage() {}
 func (*ArtifactGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +291,7 @@ var xxx_messageInfo_ArtifactGCStatus proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +319,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactNodeSpec) Reset()      { *m = ArtifactNodeSpec{} }
 func (*ArtifactNodeSpec) ProtoMessage() {}
 func (*ArtifactNodeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactNodeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +347,7 @@ var xxx_messageInfo_ArtifactNodeSpec proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +375,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +403,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +431,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +459,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactResult) Reset()      { *m = ArtifactResult{} }
 func (*ArtifactResult) ProtoMessage() {}
 func (*ArtifactResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +487,7 @@ var xxx_messageInfo_ArtifactResult proto.InternalMessageInfo
 func (m *ArtifactResultNodeStatus) Reset()      { *m = ArtifactResultNodeStatus{} }
 func (*ArtifactResultNodeStatus) ProtoMessage() {}
 func (*ArtifactResultNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *ArtifactResultNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +515,7 @@ var xxx_messageInfo_ArtifactResultNodeStatus proto.InternalMessageInfo
 func (m *ArtifactSearchQuery) Reset()      { *m = ArtifactSearchQuery{} }
 func (*ArtifactSearchQuery) ProtoMessage() {}
 func (*ArtifactSearchQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *ArtifactSearchQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +543,7 @@ var xxx_messageInfo_ArtifactSearchQuery proto.InternalMessageInfo
 func (m *ArtifactSearchResult) Reset()      { *m = ArtifactSearchResult{} }
 func (*ArtifactSearchResult) ProtoMessage() {}
 func (*ArtifactSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *ArtifactSearchResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +571,7 @@ var xxx_messageInfo_ArtifactSearchResult proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +599,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +627,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +655,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
 func (*AzureArtifact) ProtoMessage() {}
 func (*AzureArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +683,7 @@ var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
 func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
 func (*AzureArtifactRepository) ProtoMessage() {}
 func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +711,7 @@ var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
 func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
 func (*AzureBlobContainer) ProtoMessage() {}
 func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +739,7 @@ var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +767,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +795,7 @@ var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,10 +820,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChildWorkflowTemplate) Reset()      { *m = ChildWorkflowTemplate{} }
+func (*ChildWorkflowTemplate) ProtoMessage() {}
+func (*ChildWorkflowTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{28}
+}
+func (m *ChildWorkflowTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflowTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowTemplate.Merge(m, src)
+}
+func (m *ChildWorkflowTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowTemplate proto.InternalMessageInfo
+
 func (m *ClientCertAuth) Reset()      { *m = ClientCertAuth{} }
 func (*ClientCertAuth) ProtoMessage() {}
 func (*ClientCertAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *ClientCertAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +879,7 @@ var xxx_messageInfo_ClientCertAuth proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +907,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +935,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Column) Reset()      { *m = Column{} }
 func (*Column) ProtoMessage() {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +963,7 @@ var xxx_messageInfo_Column proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +991,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1019,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1047,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1075,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1103,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1131,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1159,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,10 +1184,122 @@ func (m *CronWorkflow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 
+func (m *CronWorkflowBackfill) Reset()      { *m = CronWorkflowBackfill{} }
+func (*CronWorkflowBackfill) ProtoMessage() {}
+func (*CronWorkflowBackfill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{41}
+}
+func (m *CronWorkflowBackfill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfill.Merge(m, src)
+}
+func (m *CronWorkflowBackfill) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfill) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfill proto.InternalMessageInfo
+
+func (m *CronWorkflowBackfillInterval) Reset()      { *m = CronWorkflowBackfillInterval{} }
+func (*CronWorkflowBackfillInterval) ProtoMessage() {}
+func (*CronWorkflowBackfillInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{42}
+}
+func (m *CronWorkflowBackfillInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfillInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfillInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfillInterval.Merge(m, src)
+}
+func (m *CronWorkflowBackfillInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfillInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfillInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfillInterval proto.InternalMessageInfo
+
+func (m *CronWorkflowBackfillStatus) Reset()      { *m = CronWorkflowBackfillStatus{} }
+func (*CronWorkflowBackfillStatus) ProtoMessage() {}
+func (*CronWorkflowBackfillStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{43}
+}
+func (m *CronWorkflowBackfillStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfillStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfillStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfillStatus.Merge(m, src)
+}
+func (m *CronWorkflowBackfillStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfillStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfillStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfillStatus proto.InternalMessageInfo
+
+func (m *CronWorkflowDependencies) Reset()      { *m = CronWorkflowDependencies{} }
+func (*CronWorkflowDependencies) ProtoMessage() {}
+func (*CronWorkflowDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{44}
+}
+func (m *CronWorkflowDependencies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowDependencies.Merge(m, src)
+}
+func (m *CronWorkflowDependencies) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowDependencies proto.InternalMessageInfo
+
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1327,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1355,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,10 +1380,38 @@ func (m *CronWorkflowStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 
+func (m *DAGExpansion) Reset()      { *m = DAGExpansion{} }
+func (*DAGExpansion) ProtoMessage() {}
+func (*DAGExpansion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{48}
+}
+func (m *DAGExpansion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAGExpansion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DAGExpansion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAGExpansion.Merge(m, src)
+}
+func (m *DAGExpansion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAGExpansion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAGExpansion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAGExpansion proto.InternalMessageInfo
+
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1439,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1467,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1495,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1523,7 @@ var xxx_messageInfo_DataSource proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,10 +1548,66 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventDeduplication) Reset()      { *m = EventDeduplication{} }
+func (*EventDeduplication) ProtoMessage() {}
+func (*EventDeduplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{54}
+}
+func (m *EventDeduplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeduplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventDeduplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeduplication.Merge(m, src)
+}
+func (m *EventDeduplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeduplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeduplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeduplication proto.InternalMessageInfo
+
+func (m *EventRateLimit) Reset()      { *m = EventRateLimit{} }
+func (*EventRateLimit) ProtoMessage() {}
+func (*EventRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{55}
+}
+func (m *EventRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimit.Merge(m, src)
+}
+func (m *EventRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimit proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,10 +1632,66 @@ func (m *ExecutorConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 
+func (m *FanOutPolicy) Reset()      { *m = FanOutPolicy{} }
+func (*FanOutPolicy) ProtoMessage() {}
+func (*FanOutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{57}
+}
+func (m *FanOutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FanOutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FanOutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanOutPolicy.Merge(m, src)
+}
+func (m *FanOutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FanOutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanOutPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanOutPolicy proto.InternalMessageInfo
+
+func (m *FanOutStatus) Reset()      { *m = FanOutStatus{} }
+func (*FanOutStatus) ProtoMessage() {}
+func (*FanOutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{58}
+}
+func (m *FanOutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FanOutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FanOutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanOutStatus.Merge(m, src)
+}
+func (m *FanOutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *FanOutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanOutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanOutStatus proto.InternalMessageInfo
+
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1719,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1747,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1775,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1803,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1831,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1859,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1887,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1915,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1943,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1971,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1999,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
 func (*HTTPAuth) ProtoMessage() {}
 func (*HTTPAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2027,7 @@ var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
 func (m *HTTPBodySource) Reset()      { *m = HTTPBodySource{} }
 func (*HTTPBodySource) ProtoMessage() {}
 func (*HTTPBodySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *HTTPBodySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2055,7 @@ var xxx_messageInfo_HTTPBodySource proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2083,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,10 +2108,66 @@ func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 
+func (m *HTTPPagination) Reset()      { *m = HTTPPagination{} }
+func (*HTTPPagination) ProtoMessage() {}
+func (*HTTPPagination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{74}
+}
+func (m *HTTPPagination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPPagination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPPagination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPPagination.Merge(m, src)
+}
+func (m *HTTPPagination) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPPagination) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPPagination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPPagination proto.InternalMessageInfo
+
+func (m *HTTPRetry) Reset()      { *m = HTTPRetry{} }
+func (*HTTPRetry) ProtoMessage() {}
+func (*HTTPRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{75}
+}
+func (m *HTTPRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPRetry.Merge(m, src)
+}
+func (m *HTTPRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPRetry proto.InternalMessageInfo
+
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2195,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2223,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2251,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2279,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2307,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2335,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2363,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2391,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2419,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *ManifestFrom) Reset()      { *m = ManifestFrom{} }
 func (*ManifestFrom) ProtoMessage() {}
 func (*ManifestFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *ManifestFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2447,7 @@ var xxx_messageInfo_ManifestFrom proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2475,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2503,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2531,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2559,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2587,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2615,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2643,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2304,10 +2668,38 @@ func (m *MutexStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 
+func (m *NodeApproval) Reset()      { *m = NodeApproval{} }
+func (*NodeApproval) ProtoMessage() {}
+func (*NodeApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{94}
+}
+func (m *NodeApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeApproval.Merge(m, src)
+}
+func (m *NodeApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeApproval proto.InternalMessageInfo
+
 func (m *NodeFlag) Reset()      { *m = NodeFlag{} }
 func (*NodeFlag) ProtoMessage() {}
 func (*NodeFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *NodeFlag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2727,7 @@ var xxx_messageInfo_NodeFlag proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2755,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2783,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2811,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2839,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OAuth2Auth) Reset()      { *m = OAuth2Auth{} }
 func (*OAuth2Auth) ProtoMessage() {}
 func (*OAuth2Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *OAuth2Auth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2867,7 @@ var xxx_messageInfo_OAuth2Auth proto.InternalMessageInfo
 func (m *OAuth2EndpointParam) Reset()      { *m = OAuth2EndpointParam{} }
 func (*OAuth2EndpointParam) ProtoMessage() {}
 func (*OAuth2EndpointParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *OAuth2EndpointParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2895,7 @@ var xxx_messageInfo_OAuth2EndpointParam proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2923,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2951,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2979,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3007,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3035,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3063,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3091,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +3119,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3147,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PluginArtifact) Reset()      { *m = PluginArtifact{} }
 func (*PluginArtifact) ProtoMessage() {}
 func (*PluginArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *PluginArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3175,7 @@ var xxx_messageInfo_PluginArtifact proto.InternalMessageInfo
 func (m *PluginArtifactRepository) Reset()      { *m = PluginArtifactRepository{} }
 func (*PluginArtifactRepository) ProtoMessage() {}
 func (*PluginArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *PluginArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3203,7 @@ var xxx_messageInfo_PluginArtifactRepository proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2836,10 +3228,66 @@ func (m *PodGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodGC proto.InternalMessageInfo
 
+func (m *Preemption) Reset()      { *m = Preemption{} }
+func (*Preemption) ProtoMessage() {}
+func (*Preemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{114}
+}
+func (m *Preemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Preemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preemption.Merge(m, src)
+}
+func (m *Preemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preemption proto.InternalMessageInfo
+
+func (m *PreemptionRecord) Reset()      { *m = PreemptionRecord{} }
+func (*PreemptionRecord) ProtoMessage() {}
+func (*PreemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{115}
+}
+func (m *PreemptionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PreemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreemptionRecord.Merge(m, src)
+}
+func (m *PreemptionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreemptionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreemptionRecord proto.InternalMessageInfo
+
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3315,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3343,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3371,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3399,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3427,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3455,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3483,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3511,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3539,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3116,10 +3564,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *SQLCache) Reset()      { *m = SQLCache{} }
+func (*SQLCache) ProtoMessage() {}
+func (*SQLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{126}
+}
+func (m *SQLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLCache.Merge(m, src)
+}
+func (m *SQLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLCache proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3623,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3651,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3679,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3707,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3735,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
 func (*StopStrategy) ProtoMessage() {}
 func (*StopStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3763,7 @@ var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3791,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3819,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3368,10 +3844,38 @@ func (m *SuppliedValueFrom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 
+func (m *SuspendApproval) Reset()      { *m = SuspendApproval{} }
+func (*SuspendApproval) ProtoMessage() {}
+func (*SuspendApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{136}
+}
+func (m *SuspendApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspendApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SuspendApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendApproval.Merge(m, src)
+}
+func (m *SuspendApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspendApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendApproval proto.InternalMessageInfo
+
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3903,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
 func (*SyncDatabaseRef) ProtoMessage() {}
 func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3931,7 @@ var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3959,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3987,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +4015,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +4043,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +4071,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +4099,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +4127,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +4155,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +4183,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +4211,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +4239,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +4267,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{151}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +4295,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3819,7 +4323,7 @@ var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{153}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3847,7 +4351,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{154}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4379,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{155}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +4407,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowLevelArtifactGC) Reset()      { *m = WorkflowLevelArtifactGC{} }
 func (*WorkflowLevelArtifactGC) ProtoMessage() {}
 func (*WorkflowLevelArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{156}
 }
 func (m *WorkflowLevelArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3931,7 +4435,7 @@ var xxx_messageInfo_WorkflowLevelArtifactGC proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{157}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +4463,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{158}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4491,7 @@ var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{159}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4519,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{160}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4547,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{161}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4575,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{162}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4603,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{163}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4631,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{164}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4155,7 +4659,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{147}
+	return fileDescriptor_724696e352c3df5f, []int{165}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +4687,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{148}
+	return fileDescriptor_724696e352c3df5f, []int{166}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4211,7 +4715,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{149}
+	return fileDescriptor_724696e352c3df5f, []int{167}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4239,7 +4743,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{150}
+	return fileDescriptor_724696e352c3df5f, []int{168}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4267,7 +4771,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{151}
+	return fileDescriptor_724696e352c3df5f, []int{169}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4295,7 +4799,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{152}
+	return fileDescriptor_724696e352c3df5f, []int{170}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4323,7 +4827,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{153}
+	return fileDescriptor_724696e352c3df5f, []int{171}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4350,6 +4854,7 @@ var xxx_messageInfo_ZipStrategy proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
+	proto.RegisterType((*ApprovalDecision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ApprovalDecision")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*ArtGCStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus")
@@ -4384,6 +4889,7 @@ func init() {
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChildWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ChildWorkflowTemplate")
 	proto.RegisterType((*ClientCertAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClientCertAuth")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
@@ -4396,16 +4902,25 @@ func init() {
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
+	proto.RegisterType((*CronWorkflowBackfill)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfill")
+	proto.RegisterType((*CronWorkflowBackfillInterval)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfillInterval")
+	proto.RegisterType((*CronWorkflowBackfillStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfillStatus")
+	proto.RegisterType((*CronWorkflowDependencies)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowDependencies")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
+	proto.RegisterType((*DAGExpansion)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGExpansion")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
+	proto.RegisterType((*EventDeduplication)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventDeduplication")
+	proto.RegisterType((*EventRateLimit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventRateLimit")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
+	proto.RegisterType((*FanOutPolicy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.FanOutPolicy")
+	proto.RegisterType((*FanOutStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.FanOutStatus")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifactRepository")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
@@ -4421,6 +4936,8 @@ func init() {
 	proto.RegisterType((*HTTPBodySource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPBodySource")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
+	proto.RegisterType((*HTTPPagination)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPPagination")
+	proto.RegisterType((*HTTPRetry)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPRetry")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Inputs")
@@ -4441,9 +4958,11 @@ func init() {
 	proto.RegisterType((*Mutex)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Mutex")
 	proto.RegisterType((*MutexHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MutexHolding")
 	proto.RegisterType((*MutexStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MutexStatus")
+	proto.RegisterType((*NodeApproval)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeApproval")
 	proto.RegisterType((*NodeFlag)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeFlag")
 	proto.RegisterType((*NodeResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus")
+	proto.RegisterMapType((map[string]FanOutStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.FanOutEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NoneStrategy")
@@ -4461,6 +4980,8 @@ func init() {
 	proto.RegisterType((*PluginArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PluginArtifact")
 	proto.RegisterType((*PluginArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PluginArtifactRepository")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
+	proto.RegisterType((*Preemption)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Preemption")
+	proto.RegisterType((*PreemptionRecord)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PreemptionRecord")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
@@ -4471,6 +4992,7 @@ func init() {
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*SQLCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SQLCache")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
@@ -4480,6 +5002,7 @@ func init() {
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
+	proto.RegisterType((*SuspendApproval)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuspendApproval")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuspendTemplate")
 	proto.RegisterType((*SyncDatabaseRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SyncDatabaseRef")
 	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Synchronization")
@@ -4536,717 +5059,807 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 11350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x70, 0x64, 0xc7,
-	0x75, 0x18, 0xef, 0x00, 0x83, 0xc7, 0x19, 0x00, 0x8b, 0xed, 0x7d, 0x0d, 0x41, 0x72, 0x41, 0x5f,
-	0x8a, 0x0c, 0x69, 0x53, 0x58, 0x73, 0x29, 0x39, 0x8c, 0x95, 0x48, 0xc2, 0x63, 0x81, 0x05, 0x01,
-	0x2c, 0xc0, 0x1e, 0x2c, 0xd7, 0xa4, 0x68, 0x49, 0x17, 0x33, 0x8d, 0x99, 0x4b, 0xcc, 0xdc, 0x3b,
-	0xbc, 0xf7, 0x0e, 0x76, 0xc1, 0x97, 0x64, 0xea, 0x45, 0xc5, 0xb2, 0x15, 0xcb, 0x94, 0x2c, 0xc9,
-	0x49, 0x4a, 0x51, 0xa4, 0x44, 0x25, 0xa7, 0x92, 0x92, 0xbf, 0x12, 0xfb, 0x27, 0x95, 0x0f, 0x97,
-	0x52, 0x4e, 0x25, 0x72, 0x45, 0x29, 0xeb, 0x23, 0x06, 0x23, 0x38, 0xd1, 0x47, 0x52, 0xfa, 0xb0,
-	0x2a, 0x4e, 0xe2, 0xcd, 0xa3, 0x52, 0xfd, 0xbc, 0xdd, 0x77, 0xee, 0x60, 0x01, 0x6c, 0x03, 0x54,
-	0xd9, 0x5f, 0xc0, 0x9c, 0x3e, 0x7d, 0x4e, 0x77, 0xdf, 0xee, 0xd3, 0xa7, 0xcf, 0x39, 0x7d, 0x1a,
-	0xd6, 0xea, 0x7e, 0xd2, 0xe8, 0x6c, 0x4c, 0x55, 0xc3, 0xd6, 0x25, 0x2f, 0xaa, 0x87, 0xed, 0x28,
-	0x7c, 0x91, 0xfd, 0xf3, 0xee, 0x9b, 0x61, 0xb4, 0xb5, 0xd9, 0x0c, 0x6f, 0xc6, 0x97, 0xb6, 0x9f,
-	0xbc, 0xd4, 0xde, 0xaa, 0x5f, 0xf2, 0xda, 0x7e, 0x7c, 0x49, 0x42, 0x2f, 0x6d, 0x3f, 0xe1, 0x35,
-	0xdb, 0x0d, 0xef, 0x89, 0x4b, 0x75, 0x12, 0x90, 0xc8, 0x4b, 0x48, 0x6d, 0xaa, 0x1d, 0x85, 0x49,
-	0x88, 0x3e, 0x98, 0x52, 0x9c, 0x92, 0x14, 0xd9, 0x3f, 0x1f, 0x51, 0x14, 0xa7, 0xb6, 0x9f, 0x9c,
-	0x6a, 0x6f, 0xd5, 0xa7, 0x28, 0xc5, 0x29, 0x09, 0x9d, 0x92, 0x14, 0x27, 0xde, 0xad, 0xb5, 0xa9,
-	0x1e, 0xd6, 0xc3, 0x4b, 0x8c, 0xf0, 0x46, 0x67, 0x93, 0xfd, 0x62, 0x3f, 0xd8, 0x7f, 0x9c, 0xe1,
-	0x84, 0xbb, 0xf5, 0x54, 0x3c, 0xe5, 0x87, 0xb4, 0x7d, 0x97, 0xaa, 0x61, 0x44, 0x2e, 0x6d, 0x77,
-	0x35, 0x6a, 0xe2, 0x5d, 0x1a, 0x4e, 0x3b, 0x6c, 0xfa, 0xd5, 0x9d, 0x3c, 0xac, 0xf7, 0xa4, 0x58,
-	0x2d, 0xaf, 0xda, 0xf0, 0x03, 0x12, 0xed, 0xa4, 0x5d, 0x6f, 0x91, 0xc4, 0xcb, 0xab, 0x75, 0xa9,
-	0x57, 0xad, 0xa8, 0x13, 0x24, 0x7e, 0x8b, 0x74, 0x55, 0xf8, 0x85, 0x3b, 0x55, 0x88, 0xab, 0x0d,
-	0xd2, 0xf2, 0xba, 0xea, 0x3d, 0xd9, 0xab, 0x5e, 0x27, 0xf1, 0x9b, 0x97, 0xfc, 0x20, 0x89, 0x93,
-	0x28, 0x5b, 0xc9, 0xbd, 0x02, 0x03, 0xd3, 0xad, 0xb0, 0x13, 0x24, 0xe8, 0x7d, 0x50, 0xdc, 0xf6,
-	0x9a, 0x1d, 0x52, 0x76, 0x1e, 0x74, 0x1e, 0x1d, 0x9e, 0x79, 0xf8, 0xbb, 0xbb, 0x93, 0xf7, 0xec,
-	0xed, 0x4e, 0x16, 0x9f, 0xa5, 0xc0, 0xdb, 0xbb, 0x93, 0x67, 0x49, 0x50, 0x0d, 0x6b, 0x7e, 0x50,
-	0xbf, 0xf4, 0x62, 0x1c, 0x06, 0x53, 0xd7, 0x3a, 0xad, 0x0d, 0x12, 0x61, 0x5e, 0xc7, 0xfd, 0xf7,
-	0x05, 0x38, 0x35, 0x1d, 0x55, 0x1b, 0xfe, 0x36, 0xa9, 0x24, 0x94, 0x7e, 0x7d, 0x07, 0x35, 0xa0,
-	0x2f, 0xf1, 0x22, 0x46, 0xae, 0x74, 0x79, 0x65, 0xea, 0x6e, 0xbf, 0xfb, 0xd4, 0xba, 0x17, 0x49,
-	0xda, 0x33, 0x83, 0x7b, 0xbb, 0x93, 0x7d, 0xeb, 0x5e, 0x84, 0x29, 0x0b, 0xd4, 0x84, 0xfe, 0x20,
-	0x0c, 0x48, 0xb9, 0xc0, 0x58, 0x5d, 0xbb, 0x7b, 0x56, 0xd7, 0xc2, 0x40, 0xf5, 0x63, 0x66, 0x68,
-	0x6f, 0x77, 0xb2, 0x9f, 0x42, 0x30, 0xe3, 0x42, 0xfb, 0xf5, 0xb2, 0xdf, 0x2e, 0xf7, 0xd9, 0xea,
-	0xd7, 0xf3, 0x7e, 0xdb, 0xec, 0xd7, 0xf3, 0x7e, 0x1b, 0x53, 0x16, 0xee, 0x67, 0x0b, 0x30, 0x3c,
-	0x1d, 0xd5, 0x3b, 0x2d, 0x12, 0x24, 0x31, 0xfa, 0x18, 0x40, 0xdb, 0x8b, 0xbc, 0x16, 0x49, 0x48,
-	0x14, 0x97, 0x9d, 0x07, 0xfb, 0x1e, 0x2d, 0x5d, 0x5e, 0xba, 0x7b, 0xf6, 0x6b, 0x92, 0xe6, 0x0c,
-	0x12, 0x9f, 0x1c, 0x14, 0x28, 0xc6, 0x1a, 0x4b, 0xf4, 0x0a, 0x0c, 0x7b, 0x51, 0xe2, 0x6f, 0x7a,
-	0xd5, 0x24, 0x2e, 0x17, 0x18, 0xff, 0xa7, 0xef, 0x9e, 0xff, 0xb4, 0x20, 0x39, 0x73, 0x5a, 0xb0,
-	0x1f, 0x96, 0x90, 0x18, 0xa7, 0xfc, 0xdc, 0xdf, 0xeb, 0x87, 0xd2, 0x74, 0x94, 0x2c, 0xcc, 0x56,
-	0x12, 0x2f, 0xe9, 0xc4, 0xe8, 0x0f, 0x1d, 0x38, 0x13, 0xf3, 0x61, 0xf3, 0x49, 0xbc, 0x16, 0x85,
-	0x55, 0x12, 0xc7, 0xa4, 0x26, 0xc6, 0x65, 0xd3, 0x4a, 0xbb, 0x24, 0xb3, 0xa9, 0x4a, 0x37, 0xa3,
-	0x2b, 0x41, 0x12, 0xed, 0xcc, 0x3c, 0x21, 0xda, 0x7c, 0x26, 0x07, 0xe3, 0x8d, 0xb7, 0x27, 0x91,
-	0xec, 0x0a, 0xa5, 0xc4, 0x3f, 0x31, 0xce, 0x6b, 0x35, 0xfa, 0x8a, 0x03, 0x23, 0xed, 0xb0, 0x16,
-	0x63, 0x52, 0x0d, 0x3b, 0x6d, 0x52, 0x13, 0xc3, 0xfb, 0x11, 0xbb, 0xdd, 0x58, 0xd3, 0x38, 0xf0,
-	0xf6, 0x9f, 0x15, 0xed, 0x1f, 0xd1, 0x8b, 0xb0, 0xd1, 0x14, 0xf4, 0x14, 0x8c, 0x04, 0x61, 0x52,
-	0x69, 0x93, 0xaa, 0xbf, 0xe9, 0x93, 0x1a, 0x9b, 0xf8, 0x43, 0x69, 0xcd, 0x6b, 0x5a, 0x19, 0x36,
-	0x30, 0x27, 0xe6, 0xa1, 0xdc, 0x6b, 0xe4, 0xd0, 0x38, 0xf4, 0x6d, 0x91, 0x1d, 0x2e, 0x6c, 0x30,
-	0xfd, 0x17, 0x9d, 0x95, 0x02, 0x88, 0x2e, 0xe3, 0x21, 0x21, 0x59, 0x7e, 0xb1, 0xf0, 0x94, 0x33,
-	0xf1, 0x01, 0x38, 0xdd, 0xd5, 0xf4, 0xc3, 0x10, 0x70, 0xbf, 0x37, 0x00, 0x43, 0xf2, 0x53, 0xa0,
-	0x07, 0xa1, 0x3f, 0xf0, 0x5a, 0x52, 0xce, 0x8d, 0x88, 0x7e, 0xf4, 0x5f, 0xf3, 0x5a, 0x74, 0x85,
-	0x7b, 0x2d, 0x42, 0x31, 0xda, 0x5e, 0xd2, 0x60, 0x74, 0x34, 0x8c, 0x35, 0x2f, 0x69, 0x60, 0x56,
-	0x82, 0xee, 0x87, 0xfe, 0x56, 0x58, 0x23, 0x6c, 0x2c, 0x8a, 0x5c, 0x42, 0xac, 0x84, 0x35, 0x82,
-	0x19, 0x94, 0xd6, 0xdf, 0x8c, 0xc2, 0x56, 0xb9, 0xdf, 0xac, 0x3f, 0x1f, 0x85, 0x2d, 0xcc, 0x4a,
-	0xd0, 0x97, 0x1d, 0x18, 0x97, 0x73, 0x7b, 0x39, 0xac, 0x7a, 0x89, 0x1f, 0x06, 0xe5, 0x22, 0x93,
-	0x28, 0xd8, 0xde, 0x92, 0x92, 0x94, 0x67, 0xca, 0xa2, 0x09, 0xe3, 0xd9, 0x12, 0xdc, 0xd5, 0x0a,
-	0x74, 0x19, 0xa0, 0xde, 0x0c, 0x37, 0xbc, 0x26, 0x1d, 0x90, 0xf2, 0x00, 0xeb, 0x82, 0x92, 0x0c,
-	0x0b, 0xaa, 0x04, 0x6b, 0x58, 0xe8, 0x16, 0x0c, 0x7a, 0x5c, 0xfa, 0x97, 0x07, 0x59, 0x27, 0x9e,
-	0xb1, 0xd1, 0x09, 0x63, 0x3b, 0x99, 0x29, 0xed, 0xed, 0x4e, 0x0e, 0x0a, 0x20, 0x96, 0xec, 0xd0,
-	0xe3, 0x30, 0x14, 0xb6, 0x69, 0xbb, 0xbd, 0x66, 0x79, 0x88, 0x4d, 0xcc, 0x71, 0xd1, 0xd6, 0xa1,
-	0x55, 0x01, 0xc7, 0x0a, 0x03, 0x3d, 0x06, 0x83, 0x71, 0x67, 0x83, 0x7e, 0xc7, 0xf2, 0x30, 0xeb,
-	0xd8, 0x29, 0x81, 0x3c, 0x58, 0xe1, 0x60, 0x2c, 0xcb, 0xd1, 0x7b, 0xa1, 0x14, 0x91, 0x6a, 0x27,
-	0x8a, 0x09, 0xfd, 0xb0, 0x65, 0x60, 0xb4, 0xcf, 0x08, 0xf4, 0x12, 0x4e, 0x8b, 0xb0, 0x8e, 0x87,
-	0xde, 0x0f, 0x63, 0xf4, 0x03, 0x5f, 0xb9, 0xd5, 0x8e, 0x48, 0x1c, 0xd3, 0xaf, 0x5a, 0x62, 0x8c,
-	0xce, 0x8b, 0x9a, 0x63, 0xf3, 0x46, 0x29, 0xce, 0x60, 0xa3, 0x57, 0x01, 0x3c, 0x25, 0x33, 0xca,
-	0x23, 0x6c, 0x30, 0x97, 0xed, 0xcd, 0x88, 0x85, 0xd9, 0x99, 0x31, 0xfa, 0x1d, 0xd3, 0xdf, 0x58,
-	0xe3, 0x47, 0xc7, 0xa7, 0x46, 0x9a, 0x24, 0x21, 0xb5, 0xf2, 0x28, 0xeb, 0xb0, 0x1a, 0x9f, 0x39,
-	0x0e, 0xc6, 0xb2, 0xdc, 0xfd, 0xed, 0x02, 0x68, 0x54, 0xd0, 0x0c, 0x0c, 0x09, 0xb9, 0x26, 0x96,
-	0xe4, 0xcc, 0x23, 0xf2, 0x3b, 0xc8, 0x2f, 0x78, 0x7b, 0x37, 0x57, 0x1e, 0xaa, 0x7a, 0xe8, 0x35,
-	0x28, 0xb5, 0xc3, 0xda, 0x0a, 0x49, 0xbc, 0x9a, 0x97, 0x78, 0x62, 0x37, 0xb7, 0xb0, 0xc3, 0x48,
-	0x8a, 0x33, 0xa7, 0xe8, 0xa7, 0x5b, 0x4b, 0x59, 0x60, 0x9d, 0x1f, 0x7a, 0x1a, 0x50, 0x4c, 0xa2,
-	0x6d, 0xbf, 0x4a, 0xa6, 0xab, 0x55, 0xaa, 0x12, 0xb1, 0x05, 0xd0, 0xc7, 0x3a, 0x33, 0x21, 0x3a,
-	0x83, 0x2a, 0x5d, 0x18, 0x38, 0xa7, 0x96, 0xfb, 0xfd, 0x02, 0x8c, 0x69, 0x7d, 0x6d, 0x93, 0x2a,
-	0xfa, 0x96, 0x03, 0xa7, 0xd4, 0x76, 0x36, 0xb3, 0x73, 0x8d, 0xce, 0x2a, 0xbe, 0x59, 0x11, 0x9b,
-	0xdf, 0x97, 0xf2, 0x52, 0x3f, 0x05, 0x1f, 0x2e, 0xeb, 0x2f, 0x88, 0x3e, 0x9c, 0xca, 0x94, 0xe2,
-	0x6c, 0xb3, 0x26, 0xbe, 0xe4, 0xc0, 0xd9, 0x3c, 0x12, 0x39, 0x32, 0xb7, 0xa1, 0xcb, 0x5c, 0xab,
-	0xc2, 0x8b, 0x72, 0xa5, 0x9d, 0xd1, 0xe5, 0xf8, 0xff, 0x2b, 0xc0, 0xb8, 0x3e, 0x85, 0x98, 0x26,
-	0xf0, 0xaf, 0x1c, 0x38, 0x27, 0x7b, 0x80, 0x49, 0xdc, 0x69, 0x66, 0x86, 0xb7, 0x65, 0x75, 0x78,
-	0xf9, 0x4e, 0x3a, 0x9d, 0xc7, 0x8f, 0x0f, 0xf3, 0x03, 0x62, 0x98, 0xcf, 0xe5, 0xe2, 0xe0, 0xfc,
-	0xa6, 0x4e, 0x7c, 0xc3, 0x81, 0x89, 0xde, 0x44, 0x73, 0x06, 0xbe, 0x6d, 0x0e, 0xfc, 0xf3, 0xf6,
-	0x3a, 0xc9, 0xd9, 0xb3, 0xe1, 0x67, 0x9d, 0xd5, 0x3f, 0xc0, 0xd7, 0x87, 0xa1, 0x6b, 0x0f, 0x41,
-	0x4f, 0x40, 0x49, 0x88, 0xe3, 0xe5, 0xb0, 0x1e, 0xb3, 0x46, 0x0e, 0xf1, 0xb5, 0x36, 0x9d, 0x82,
-	0xb1, 0x8e, 0x83, 0x6a, 0x50, 0x88, 0x9f, 0x14, 0x4d, 0xb7, 0x20, 0xde, 0x2a, 0x4f, 0x2a, 0x2d,
-	0x72, 0x60, 0x6f, 0x77, 0xb2, 0x50, 0x79, 0x12, 0x17, 0xe2, 0x27, 0xa9, 0xa6, 0x5e, 0xf7, 0x13,
-	0x7b, 0x9a, 0xfa, 0x82, 0x9f, 0x28, 0x3e, 0x4c, 0x53, 0x5f, 0xf0, 0x13, 0x4c, 0x59, 0xd0, 0x13,
-	0x48, 0x23, 0x49, 0xda, 0x6c, 0xc7, 0xb7, 0x72, 0x02, 0xb9, 0xba, 0xbe, 0xbe, 0xa6, 0x78, 0x31,
-	0xfd, 0x82, 0x42, 0x30, 0xe3, 0x82, 0xde, 0x74, 0xe8, 0x88, 0xf3, 0xc2, 0x30, 0xda, 0x11, 0x8a,
-	0xc3, 0x75, 0x7b, 0x53, 0x20, 0x8c, 0x76, 0x14, 0x73, 0xf1, 0x21, 0x55, 0x01, 0xd6, 0x59, 0xb3,
-	0x8e, 0xd7, 0x36, 0x63, 0xa6, 0x27, 0xd8, 0xe9, 0xf8, 0xdc, 0x7c, 0x25, 0xd3, 0xf1, 0xb9, 0xf9,
-	0x0a, 0x66, 0x5c, 0xe8, 0x07, 0x8d, 0xbc, 0x9b, 0x42, 0xc7, 0xb0, 0xf0, 0x41, 0xb1, 0x77, 0xd3,
-	0xfc, 0xa0, 0xd8, 0xbb, 0x89, 0x29, 0x0b, 0xca, 0x29, 0x8c, 0x63, 0xa6, 0x52, 0x58, 0xe1, 0xb4,
-	0x5a, 0xa9, 0x98, 0x9c, 0x56, 0x2b, 0x15, 0x4c, 0x59, 0xb0, 0x49, 0x5a, 0x8d, 0x99, 0x3e, 0x62,
-	0x67, 0x92, 0xce, 0x66, 0x38, 0x2d, 0xcc, 0x56, 0x30, 0x65, 0x41, 0x45, 0x86, 0xf7, 0x72, 0x27,
-	0xe2, 0xca, 0x4c, 0xe9, 0xf2, 0xaa, 0x85, 0xf9, 0x42, 0xc9, 0x29, 0x6e, 0xc3, 0x7b, 0xbb, 0x93,
-	0x45, 0x06, 0xc2, 0x9c, 0x11, 0x4a, 0x60, 0xa0, 0xdd, 0xec, 0xd4, 0x7d, 0xae, 0x05, 0x95, 0x2e,
-	0xaf, 0x59, 0x38, 0xae, 0x32, 0x7a, 0x8a, 0x27, 0xec, 0xed, 0x4e, 0x0e, 0x70, 0x18, 0x16, 0xbc,
-	0xdc, 0x3f, 0xe8, 0x4b, 0x85, 0x94, 0xdc, 0x45, 0xd0, 0x6f, 0xb0, 0xed, 0x57, 0x48, 0x20, 0xa1,
-	0x70, 0x3b, 0xc7, 0xa6, 0x70, 0x9f, 0xe1, 0xfb, 0xac, 0xc1, 0x0e, 0x67, 0xf9, 0xa3, 0x2f, 0x38,
-	0xdd, 0x27, 0x6a, 0xcf, 0xfe, 0x0e, 0x9a, 0xaa, 0x03, 0x7c, 0x87, 0xda, 0xf7, 0xa0, 0x3d, 0xf1,
-	0xa6, 0x93, 0xaa, 0x2e, 0x71, 0xaf, 0xdd, 0xe7, 0xa3, 0xe6, 0xee, 0x63, 0xd1, 0x0c, 0xa0, 0xef,
-	0x36, 0x9f, 0x75, 0x60, 0x54, 0xc2, 0xa9, 0x52, 0x1e, 0xa3, 0x5b, 0x30, 0x24, 0x5b, 0x2a, 0xbe,
-	0x9e, 0x4d, 0x0b, 0x84, 0x3a, 0x3a, 0xa8, 0xc6, 0x28, 0x6e, 0xee, 0xb7, 0x06, 0x01, 0xa5, 0x3b,
-	0x64, 0x3b, 0x8c, 0x7d, 0x26, 0xff, 0x8e, 0xb0, 0xf7, 0x05, 0xda, 0xde, 0xf7, 0xac, 0xcd, 0xbd,
-	0x2f, 0x6d, 0x96, 0xb1, 0x0b, 0x7e, 0x21, 0xb3, 0x5b, 0xf0, 0xed, 0xf0, 0x23, 0xc7, 0xb2, 0x5b,
-	0x68, 0x4d, 0xd8, 0x7f, 0xdf, 0xd8, 0x16, 0xfb, 0x06, 0xdf, 0x30, 0x7f, 0xc9, 0xee, 0xbe, 0xa1,
-	0xb5, 0x22, 0xbb, 0x83, 0x44, 0x5c, 0xae, 0xf3, 0x1d, 0xf3, 0x86, 0x55, 0xb9, 0xae, 0x71, 0x35,
-	0x25, 0x7c, 0xc4, 0x25, 0xfc, 0x80, 0x2d, 0x9e, 0x9a, 0x84, 0xcf, 0xf2, 0x54, 0xb2, 0xfe, 0x65,
-	0x29, 0xeb, 0xf9, 0x5e, 0xf9, 0x9c, 0x65, 0x59, 0xaf, 0xf1, 0xed, 0x96, 0xfa, 0xaf, 0x2b, 0xa9,
-	0x3f, 0x64, 0x4b, 0x37, 0x35, 0xa5, 0xbe, 0xc6, 0x3d, 0x4f, 0xfe, 0xbf, 0x04, 0xe7, 0xba, 0x31,
-	0x31, 0xd9, 0x44, 0x97, 0x60, 0xb8, 0x1a, 0x06, 0x9b, 0x7e, 0x7d, 0xc5, 0x6b, 0x8b, 0x53, 0xaa,
-	0x92, 0x85, 0xb3, 0xb2, 0x00, 0xa7, 0x38, 0xe8, 0x01, 0x2e, 0xf8, 0xb8, 0x1d, 0xa8, 0x24, 0x50,
-	0xfb, 0x96, 0xc8, 0x0e, 0x93, 0x82, 0xbf, 0x38, 0xf4, 0xe5, 0xaf, 0x4d, 0xde, 0xf3, 0xf1, 0xff,
-	0xf8, 0xe0, 0x3d, 0xee, 0x1f, 0xf5, 0xc1, 0x7d, 0xb9, 0x3c, 0xc5, 0x19, 0xe5, 0x9f, 0x18, 0x67,
-	0x14, 0xad, 0x5c, 0x48, 0xb1, 0x1b, 0x36, 0xd5, 0x77, 0x8d, 0x7c, 0xde, 0x69, 0x44, 0x2b, 0xc6,
-	0xf9, 0x8d, 0xa2, 0x03, 0x15, 0x78, 0x2d, 0x12, 0xb7, 0xbd, 0x2a, 0x11, 0xbd, 0x57, 0x03, 0x75,
-	0x4d, 0x16, 0xe0, 0x14, 0x87, 0x1b, 0x0e, 0x36, 0xbd, 0x4e, 0x33, 0x11, 0xe6, 0x41, 0xcd, 0x70,
-	0xc0, 0xc0, 0x58, 0x96, 0xa3, 0xbf, 0xeb, 0x00, 0xea, 0xe6, 0x2a, 0x04, 0xc1, 0xfa, 0x71, 0x8c,
-	0xc3, 0xcc, 0xf9, 0x3d, 0xcd, 0xf4, 0xa0, 0xf5, 0x34, 0xa7, 0x1d, 0xda, 0x37, 0x7d, 0x3d, 0xdd,
-	0x07, 0xf9, 0x91, 0xe8, 0x00, 0x96, 0x43, 0x66, 0x60, 0xaa, 0x56, 0x49, 0x1c, 0x73, 0x23, 0xa4,
-	0x6e, 0x60, 0x62, 0x60, 0x2c, 0xcb, 0xd1, 0x24, 0x14, 0x49, 0x14, 0x85, 0x91, 0xb0, 0x30, 0xb0,
-	0x65, 0x74, 0x85, 0x02, 0x30, 0x87, 0xbb, 0x3f, 0x2a, 0x40, 0xb9, 0xd7, 0x99, 0x0c, 0xfd, 0xae,
-	0x66, 0x4d, 0x10, 0xe7, 0x45, 0x71, 0xdc, 0x0d, 0x8f, 0xef, 0x24, 0x98, 0x3d, 0xf6, 0xf6, 0xb0,
-	0x2b, 0x88, 0x52, 0x9c, 0x6d, 0xe0, 0xc4, 0x5b, 0x9a, 0x5d, 0x41, 0x27, 0x91, 0xa3, 0x60, 0x6c,
-	0x9a, 0x0a, 0xc6, 0x9a, 0xed, 0x4e, 0xe9, 0x6a, 0xc6, 0x9f, 0x14, 0xe1, 0x8c, 0x2c, 0xad, 0x10,
-	0xba, 0x55, 0x3f, 0xd3, 0x21, 0xd1, 0x0e, 0xfa, 0x63, 0x07, 0xce, 0x7a, 0x59, 0x83, 0x95, 0x4f,
-	0x8e, 0x61, 0xa0, 0x35, 0xae, 0x53, 0xd3, 0x39, 0x1c, 0xf9, 0x40, 0x5f, 0x16, 0x03, 0x7d, 0x36,
-	0x0f, 0xa5, 0x87, 0xb7, 0x21, 0xb7, 0x03, 0xe8, 0x29, 0x18, 0x91, 0x70, 0x66, 0xe4, 0xe2, 0x4b,
-	0x5c, 0x99, 0xf4, 0xa7, 0xb5, 0x32, 0x6c, 0x60, 0xd2, 0x9a, 0x09, 0x69, 0xb5, 0x9b, 0x5e, 0x42,
-	0x34, 0xf3, 0x98, 0xaa, 0xb9, 0xae, 0x95, 0x61, 0x03, 0x13, 0x3d, 0x02, 0x03, 0x41, 0x58, 0x23,
-	0x8b, 0x35, 0x61, 0x16, 0x1f, 0x13, 0x75, 0x06, 0xae, 0x31, 0x28, 0x16, 0xa5, 0xe8, 0xe1, 0xd4,
-	0x06, 0x59, 0x64, 0x4b, 0xa8, 0x94, 0x67, 0x7f, 0x44, 0xff, 0xc0, 0x81, 0x61, 0x5a, 0x63, 0x7d,
-	0xa7, 0x4d, 0xe8, 0xde, 0x4a, 0xbf, 0x48, 0xed, 0x78, 0xbe, 0xc8, 0x35, 0xc9, 0xc6, 0x34, 0xf0,
-	0x0c, 0x2b, 0xf8, 0x1b, 0x6f, 0x4f, 0x0e, 0xc9, 0x1f, 0x38, 0x6d, 0xd5, 0xc4, 0x02, 0xdc, 0xdb,
-	0xf3, 0x6b, 0x1e, 0xca, 0x01, 0xf2, 0x37, 0x61, 0xcc, 0x6c, 0xc4, 0xa1, 0xbc, 0x1f, 0xff, 0x5c,
-	0x5b, 0x76, 0xbc, 0x5f, 0x42, 0x9e, 0xbd, 0x63, 0xda, 0xb4, 0x9a, 0x0c, 0x73, 0x62, 0xea, 0x99,
-	0x93, 0x61, 0x4e, 0x4c, 0x86, 0x39, 0xf7, 0x0f, 0x9d, 0x74, 0x69, 0x6a, 0x6a, 0x26, 0xdd, 0x98,
-	0x3b, 0x51, 0x53, 0x08, 0x62, 0xb5, 0x31, 0x5f, 0xc7, 0xcb, 0x98, 0xc2, 0xd1, 0x5b, 0x9a, 0x74,
-	0xa4, 0xd5, 0x3a, 0xc2, 0x99, 0x63, 0xc9, 0x31, 0x61, 0x10, 0xee, 0x96, 0x7f, 0xa2, 0x00, 0x67,
-	0x9b, 0xe0, 0x7e, 0xa1, 0x00, 0x0f, 0xec, 0xab, 0x34, 0xe7, 0x36, 0xdc, 0x79, 0xc7, 0x1b, 0x4e,
-	0xb7, 0xb5, 0x88, 0xb4, 0xc3, 0xeb, 0x78, 0x59, 0x7c, 0x2f, 0xb5, 0xad, 0x61, 0x0e, 0xc6, 0xb2,
-	0x9c, 0xaa, 0x0e, 0x5b, 0x64, 0x67, 0x3e, 0x8c, 0x5a, 0x5e, 0x22, 0xa4, 0x83, 0x52, 0x1d, 0x96,
-	0x64, 0x01, 0x4e, 0x71, 0xdc, 0x3f, 0x76, 0x20, 0xdb, 0x00, 0xe4, 0xc1, 0x58, 0x27, 0x26, 0x11,
-	0xdd, 0x52, 0x2b, 0xa4, 0x1a, 0x11, 0x39, 0x3d, 0x1f, 0x9e, 0xe2, 0x31, 0x0e, 0xb4, 0x87, 0x53,
-	0xd5, 0x30, 0x22, 0x53, 0xdb, 0x4f, 0x4c, 0x71, 0x8c, 0x25, 0xb2, 0x53, 0x21, 0x4d, 0x42, 0x69,
-	0xcc, 0xa0, 0xbd, 0xdd, 0xc9, 0xb1, 0xeb, 0x06, 0x01, 0x9c, 0x21, 0x48, 0x59, 0xb4, 0xbd, 0x38,
-	0xbe, 0x19, 0x46, 0x35, 0xc1, 0xa2, 0x70, 0x68, 0x16, 0x6b, 0x06, 0x01, 0x9c, 0x21, 0xe8, 0x7e,
-	0x9f, 0x1e, 0x5f, 0x75, 0xad, 0x19, 0x7d, 0x8d, 0xea, 0x3e, 0x14, 0x32, 0xd3, 0x0c, 0x37, 0x66,
-	0xc3, 0x20, 0xf1, 0xfc, 0x80, 0xc8, 0x10, 0x89, 0x75, 0x4b, 0x3a, 0xba, 0x41, 0x3b, 0xf5, 0x5c,
-	0x74, 0x97, 0xe1, 0x9c, 0xb6, 0x50, 0x1d, 0x67, 0xa3, 0x19, 0x6e, 0x64, 0x7d, 0x9f, 0x14, 0x09,
-	0xb3, 0x12, 0xf7, 0x27, 0x0e, 0x5c, 0xe8, 0x71, 0x18, 0x40, 0x5f, 0x72, 0x60, 0x74, 0xe3, 0xa7,
-	0xa2, 0x6f, 0x66, 0x33, 0xd0, 0xfb, 0x61, 0x8c, 0x02, 0xe8, 0x4e, 0x24, 0xe6, 0x66, 0xc1, 0xf4,
-	0xcb, 0xcd, 0x18, 0xa5, 0x38, 0x83, 0xed, 0xfe, 0x66, 0x01, 0x72, 0xb8, 0xa0, 0xc7, 0x61, 0x88,
-	0x04, 0xb5, 0x76, 0xe8, 0x07, 0x89, 0x10, 0x46, 0x4a, 0xea, 0x5d, 0x11, 0x70, 0xac, 0x30, 0xc4,
-	0xf9, 0x43, 0x0c, 0x4c, 0xa1, 0xeb, 0xfc, 0x21, 0x5a, 0x9e, 0xe2, 0xa0, 0x3a, 0x8c, 0x7b, 0xdc,
-	0xab, 0xc4, 0xe6, 0x1e, 0x9b, 0xa6, 0x7d, 0x87, 0x99, 0xa6, 0x67, 0x99, 0xd3, 0x37, 0x43, 0x02,
-	0x77, 0x11, 0x45, 0xef, 0x85, 0x52, 0x27, 0x26, 0x95, 0xb9, 0xa5, 0xd9, 0x88, 0xd4, 0xf8, 0xa9,
-	0x5c, 0xf3, 0x76, 0x5e, 0x4f, 0x8b, 0xb0, 0x8e, 0xe7, 0xfe, 0xa9, 0x03, 0x83, 0x33, 0x5e, 0x75,
-	0x2b, 0xdc, 0xdc, 0xa4, 0x43, 0x51, 0xeb, 0x44, 0xa9, 0x61, 0x4d, 0x1b, 0x8a, 0x39, 0x01, 0xc7,
-	0x0a, 0x03, 0xad, 0xc3, 0x00, 0x5f, 0xf0, 0x62, 0xd9, 0xfd, 0xbc, 0xd6, 0x1f, 0x15, 0xbd, 0xc4,
-	0xa6, 0x43, 0x27, 0xf1, 0x9b, 0x53, 0x3c, 0x7a, 0x69, 0x6a, 0x31, 0x48, 0x56, 0xa3, 0x4a, 0x12,
-	0xf9, 0x41, 0x9d, 0x9f, 0xfc, 0xe6, 0x19, 0x0d, 0x2c, 0x68, 0xd1, 0x6e, 0xb4, 0xbc, 0x5b, 0x92,
-	0x9d, 0x10, 0x3f, 0xaa, 0x1b, 0x2b, 0x69, 0x11, 0xd6, 0xf1, 0xe8, 0x6e, 0x52, 0xf5, 0xda, 0x42,
-	0x2f, 0x51, 0xbb, 0xc9, 0xac, 0xd7, 0xc6, 0x14, 0xee, 0xfe, 0x91, 0x03, 0xc3, 0x33, 0x5e, 0xec,
-	0x57, 0xff, 0x12, 0xc9, 0xa6, 0x0f, 0x43, 0x71, 0xd6, 0xab, 0x36, 0x08, 0xba, 0x9e, 0x3d, 0x13,
-	0x97, 0x2e, 0x3f, 0x9a, 0xc7, 0x66, 0x39, 0xac, 0x7a, 0xcd, 0xd5, 0x8d, 0x17, 0x09, 0x5d, 0xef,
-	0x9b, 0x24, 0x22, 0x41, 0x95, 0xcc, 0x8c, 0xf6, 0x3a, 0x39, 0xbb, 0x6f, 0x3b, 0x30, 0x36, 0xdb,
-	0xf4, 0x49, 0x90, 0xcc, 0x92, 0x28, 0x61, 0x03, 0x57, 0x87, 0xf1, 0xaa, 0x82, 0x1c, 0x65, 0xe8,
-	0xd8, 0x64, 0x9e, 0xcd, 0x90, 0xc0, 0x5d, 0x44, 0x51, 0x0d, 0x4e, 0x71, 0x58, 0xba, 0x68, 0x0e,
-	0x35, 0x7e, 0xcc, 0x78, 0x3b, 0x6b, 0x52, 0xc0, 0x59, 0x92, 0xee, 0x8f, 0x1d, 0xb8, 0x30, 0xdb,
-	0xec, 0xc4, 0x09, 0x89, 0x6e, 0x08, 0x61, 0x25, 0xb5, 0x5f, 0xf4, 0x51, 0x18, 0x6a, 0x49, 0x37,
-	0xb6, 0x73, 0x87, 0xf9, 0xcd, 0xc4, 0x1d, 0xc5, 0xa6, 0x8d, 0xe1, 0x03, 0xbc, 0x42, 0x12, 0x2f,
-	0x8d, 0xb9, 0x48, 0x61, 0x58, 0x51, 0x45, 0x6d, 0xe8, 0x8f, 0xdb, 0xa4, 0x6a, 0x2f, 0xe4, 0x4d,
-	0xf6, 0xa1, 0xd2, 0x26, 0xd5, 0x54, 0xec, 0x33, 0x07, 0x2c, 0xe3, 0xe4, 0xfe, 0x6f, 0x07, 0xee,
-	0xeb, 0xd1, 0xdf, 0x65, 0x3f, 0x4e, 0xd0, 0x0b, 0x5d, 0x7d, 0x9e, 0x3a, 0x58, 0x9f, 0x69, 0x6d,
-	0xd6, 0x63, 0x25, 0x2f, 0x24, 0x44, 0xeb, 0xef, 0xeb, 0x50, 0xf4, 0x13, 0xd2, 0x92, 0x56, 0x72,
-	0x0b, 0xf6, 0xac, 0x1e, 0x7d, 0x99, 0x19, 0x95, 0x81, 0x8f, 0x8b, 0x94, 0x1f, 0xe6, 0x6c, 0xdd,
-	0x2d, 0x18, 0x98, 0x0d, 0x9b, 0x9d, 0x56, 0x70, 0xb0, 0xf0, 0xa1, 0x64, 0xa7, 0x4d, 0xb2, 0x5b,
-	0x28, 0x3b, 0x1d, 0xb0, 0x12, 0x69, 0x57, 0xea, 0xcb, 0xb7, 0x2b, 0xb9, 0xff, 0xda, 0x01, 0xba,
-	0xaa, 0x6a, 0xbe, 0x70, 0xaf, 0x72, 0x72, 0x9c, 0xe1, 0x03, 0x3a, 0xb9, 0xdb, 0xbb, 0x93, 0xa3,
-	0x0a, 0x51, 0xa3, 0xff, 0x61, 0x18, 0x88, 0xd9, 0x89, 0x5d, 0xb4, 0x61, 0x5e, 0xaa, 0xd7, 0xfc,
-	0x1c, 0x7f, 0x7b, 0x77, 0xf2, 0x40, 0xb1, 0xac, 0x53, 0x8a, 0xb6, 0xf0, 0x04, 0x0b, 0xaa, 0x54,
-	0x1f, 0x6c, 0x91, 0x38, 0xf6, 0xea, 0xf2, 0x00, 0xa8, 0xf4, 0xc1, 0x15, 0x0e, 0xc6, 0xb2, 0xdc,
-	0xfd, 0xa2, 0x03, 0xa3, 0x6a, 0x6f, 0xa3, 0xda, 0x3d, 0xba, 0xa6, 0xef, 0x82, 0x7c, 0xa6, 0x3c,
-	0x90, 0xb7, 0x30, 0xd3, 0x7d, 0x7e, 0xff, 0x4d, 0xf2, 0x3d, 0x30, 0x52, 0x23, 0x6d, 0x12, 0xd4,
-	0x48, 0x50, 0xa5, 0xa7, 0x73, 0x3a, 0x43, 0x86, 0x67, 0xc6, 0xe9, 0x71, 0x74, 0x4e, 0x83, 0x63,
-	0x03, 0xcb, 0xfd, 0xba, 0x03, 0xf7, 0x2a, 0x72, 0x15, 0x92, 0x60, 0x92, 0x44, 0x3b, 0x2a, 0x76,
-	0xf5, 0x70, 0x9b, 0xd9, 0x0d, 0xaa, 0x1e, 0x27, 0x11, 0x67, 0x7e, 0xb4, 0xdd, 0xac, 0xc4, 0x95,
-	0x69, 0x46, 0x04, 0x4b, 0x6a, 0xee, 0xaf, 0xf7, 0xc1, 0x59, 0xbd, 0x91, 0x4a, 0xc0, 0x7c, 0xc2,
-	0x01, 0x50, 0x23, 0x40, 0xf7, 0xeb, 0x3e, 0x3b, 0x0e, 0x3d, 0xe3, 0x4b, 0xa5, 0x22, 0x48, 0x81,
-	0x63, 0xac, 0xb1, 0x45, 0xcf, 0xc1, 0xc8, 0x36, 0x5d, 0x14, 0x64, 0x85, 0x6a, 0x13, 0x71, 0xb9,
-	0x8f, 0x35, 0x63, 0x32, 0xef, 0x63, 0x3e, 0x9b, 0xe2, 0xa5, 0xd6, 0x02, 0x0d, 0x18, 0x63, 0x83,
-	0x14, 0x3d, 0x08, 0x8d, 0x46, 0xfa, 0x27, 0x11, 0x26, 0xfb, 0x0f, 0x59, 0xec, 0x63, 0xf6, 0xab,
-	0xcf, 0x9c, 0xde, 0xdb, 0x9d, 0x1c, 0x35, 0x40, 0xd8, 0x6c, 0x84, 0xfb, 0x1c, 0xb0, 0xb1, 0xf0,
-	0x83, 0x0e, 0x59, 0x0d, 0xd0, 0x43, 0xd2, 0x84, 0xc7, 0xdd, 0x3e, 0x4a, 0x72, 0xe8, 0x66, 0x3c,
-	0x7a, 0xd4, 0xdd, 0xf4, 0xfc, 0x26, 0x8b, 0xe9, 0xa4, 0x58, 0xea, 0xa8, 0x3b, 0xcf, 0xa0, 0x58,
-	0x94, 0xba, 0x53, 0x30, 0x38, 0x4b, 0xfb, 0x4e, 0x22, 0x4a, 0x57, 0x0f, 0xc5, 0x1e, 0x35, 0x42,
-	0xb1, 0x65, 0xc8, 0xf5, 0x3a, 0x9c, 0x9b, 0x8d, 0x88, 0x97, 0x90, 0xca, 0x93, 0x33, 0x9d, 0xea,
-	0x16, 0x49, 0x78, 0xbc, 0x5b, 0x8c, 0xde, 0x07, 0xa3, 0x21, 0xdb, 0x32, 0x96, 0xc3, 0xea, 0x96,
-	0x1f, 0xd4, 0x85, 0x45, 0xf6, 0x9c, 0xa0, 0x32, 0xba, 0xaa, 0x17, 0x62, 0x13, 0xd7, 0xfd, 0xcf,
-	0x05, 0x18, 0x99, 0x8d, 0xc2, 0x40, 0x8a, 0xc5, 0x13, 0xd8, 0xca, 0x12, 0x63, 0x2b, 0xb3, 0xe0,
-	0x8d, 0xd5, 0xdb, 0xdf, 0x6b, 0x3b, 0x43, 0xaf, 0x2a, 0x11, 0xd9, 0x67, 0xeb, 0x84, 0x62, 0xf0,
-	0x65, 0xb4, 0xd3, 0x8f, 0x6d, 0x0a, 0x50, 0xf7, 0xbf, 0x38, 0x30, 0xae, 0xa3, 0x9f, 0xc0, 0x0e,
-	0x1a, 0x9b, 0x3b, 0xe8, 0x35, 0xbb, 0xfd, 0xed, 0xb1, 0x6d, 0x7e, 0x67, 0xd0, 0xec, 0x27, 0x73,
-	0xc5, 0x7f, 0xd9, 0x81, 0x91, 0x9b, 0x1a, 0x40, 0x74, 0xd6, 0xb6, 0x12, 0xf3, 0x2e, 0x29, 0x66,
-	0x74, 0xe8, 0xed, 0xcc, 0x6f, 0x6c, 0xb4, 0x04, 0xbd, 0x00, 0xa7, 0xab, 0x61, 0x50, 0xed, 0x44,
-	0x54, 0xbf, 0xdd, 0x59, 0x63, 0x57, 0x41, 0xc4, 0x16, 0x37, 0x25, 0xc8, 0x9d, 0x9e, 0xcd, 0x22,
-	0xdc, 0xce, 0x03, 0xe2, 0x6e, 0x42, 0xdc, 0x3b, 0x10, 0xd3, 0x4d, 0x48, 0x9c, 0xb0, 0x34, 0xef,
-	0x00, 0x03, 0x63, 0x59, 0x8e, 0xae, 0xc3, 0x85, 0x38, 0xf1, 0xa2, 0xc4, 0x0f, 0xea, 0x73, 0xc4,
-	0xab, 0x35, 0xfd, 0x80, 0x1e, 0x0e, 0xc2, 0xa0, 0xc6, 0x7d, 0x97, 0x7d, 0x33, 0xf7, 0xed, 0xed,
-	0x4e, 0x5e, 0xa8, 0xe4, 0xa3, 0xe0, 0x5e, 0x75, 0xd1, 0x87, 0x61, 0x42, 0xf8, 0x1f, 0x36, 0x3b,
-	0xcd, 0xa7, 0xc3, 0x8d, 0xf8, 0xaa, 0x1f, 0xd3, 0x83, 0xfb, 0xb2, 0xdf, 0xf2, 0x13, 0xe6, 0xa1,
-	0x2c, 0xce, 0x5c, 0xdc, 0xdb, 0x9d, 0x9c, 0xa8, 0xf4, 0xc4, 0xc2, 0xfb, 0x50, 0x40, 0x18, 0xce,
-	0x73, 0x71, 0xd6, 0x45, 0x7b, 0x90, 0xd1, 0x9e, 0xd8, 0xdb, 0x9d, 0x3c, 0x3f, 0x9f, 0x8b, 0x81,
-	0x7b, 0xd4, 0xa4, 0x7b, 0x71, 0xe2, 0xb7, 0xc8, 0xcb, 0x61, 0x40, 0x98, 0x43, 0x51, 0xdb, 0x8b,
-	0xd7, 0x05, 0x1c, 0x2b, 0x0c, 0xf4, 0x62, 0x3a, 0xb7, 0xe8, 0x02, 0x10, 0x71, 0x35, 0x87, 0x97,
-	0x59, 0xec, 0xb0, 0x71, 0x43, 0xa3, 0xc4, 0x02, 0x46, 0x0d, 0xda, 0xe8, 0x93, 0x0e, 0x8c, 0xc4,
-	0x49, 0xa8, 0xae, 0x6f, 0x88, 0xc0, 0x1a, 0x0b, 0x13, 0xb9, 0xa2, 0x51, 0xe5, 0xaa, 0x8c, 0x0e,
-	0xc1, 0x06, 0x57, 0xf4, 0x73, 0x30, 0x1c, 0x57, 0x1b, 0xa4, 0xd6, 0x69, 0x92, 0xb8, 0x5c, 0x62,
-	0xda, 0x0f, 0x3b, 0x98, 0x55, 0x24, 0x10, 0xa7, 0xe5, 0x54, 0x39, 0xbd, 0xd9, 0x20, 0x01, 0x0b,
-	0x2d, 0xd6, 0x94, 0xd3, 0x1b, 0x0d, 0x12, 0x60, 0x56, 0xe2, 0xfe, 0xa8, 0x0f, 0x50, 0xb7, 0x28,
-	0x43, 0x4b, 0x30, 0xe0, 0x55, 0x13, 0x7f, 0x5b, 0x86, 0x55, 0x3e, 0x94, 0xb7, 0xcd, 0x67, 0x0f,
-	0x88, 0x4a, 0xfe, 0x4d, 0xb3, 0xaa, 0x58, 0x90, 0x40, 0x21, 0x9c, 0x6e, 0x7a, 0x71, 0x22, 0x5b,
-	0x58, 0xa3, 0x1f, 0x52, 0x6c, 0x00, 0x3f, 0x7b, 0xb0, 0x4f, 0x45, 0x6b, 0xcc, 0x9c, 0xa3, 0xeb,
-	0x71, 0x39, 0x4b, 0x08, 0x77, 0xd3, 0x46, 0x1f, 0x63, 0xfa, 0x12, 0x57, 0x66, 0xa5, 0xa2, 0xb2,
-	0x64, 0x45, 0x97, 0xe0, 0x34, 0x0d, 0x5d, 0x49, 0xb0, 0xc1, 0x1a, 0x4b, 0x74, 0x09, 0x86, 0xd9,
-	0xba, 0x21, 0x35, 0xc2, 0x57, 0x7f, 0x5f, 0xaa, 0xd6, 0x56, 0x64, 0x01, 0x4e, 0x71, 0x34, 0xbd,
-	0x81, 0x2f, 0xf8, 0x1e, 0x7a, 0x03, 0x7a, 0x0a, 0x8a, 0xed, 0x86, 0x17, 0xcb, 0x50, 0x7d, 0x57,
-	0xca, 0xe1, 0x35, 0x0a, 0x64, 0xa2, 0x49, 0xfb, 0x96, 0x0c, 0x88, 0x79, 0x05, 0xf7, 0xdf, 0x00,
-	0x0c, 0xce, 0x4d, 0x2f, 0xac, 0x7b, 0xf1, 0xd6, 0x01, 0x4e, 0x35, 0x74, 0x19, 0x0a, 0xf5, 0x53,
-	0x9c, 0x2a, 0xd2, 0x65, 0x28, 0xe0, 0x58, 0x61, 0xa0, 0x00, 0x06, 0xfc, 0x80, 0x4a, 0x9e, 0xf2,
-	0x98, 0x2d, 0xc7, 0x82, 0x3a, 0xa1, 0x31, 0xcb, 0xcf, 0x22, 0xa3, 0x8e, 0x05, 0x17, 0xf4, 0x2a,
-	0x0c, 0x7b, 0xf2, 0xa6, 0x94, 0xd8, 0xd1, 0x97, 0x6c, 0x58, 0xcc, 0x05, 0x49, 0x3d, 0x66, 0x4a,
-	0x80, 0x70, 0xca, 0x10, 0x7d, 0xdc, 0x81, 0x92, 0xec, 0x3a, 0x26, 0x9b, 0xc2, 0x99, 0xbd, 0x62,
-	0xaf, 0xcf, 0x98, 0x6c, 0xf2, 0x80, 0x1a, 0x0d, 0x80, 0x75, 0x96, 0x5d, 0xa7, 0xa0, 0xe2, 0x41,
-	0x4e, 0x41, 0xe8, 0x26, 0x0c, 0xdf, 0xf4, 0x93, 0x06, 0xdb, 0xb3, 0x85, 0x13, 0x6d, 0xfe, 0xee,
-	0x5b, 0x4d, 0xc9, 0xa5, 0x23, 0x76, 0x43, 0x32, 0xc0, 0x29, 0x2f, 0xba, 0x1c, 0xe8, 0x0f, 0x76,
-	0xd3, 0x8c, 0xed, 0x0d, 0xc3, 0x66, 0x05, 0x56, 0x80, 0x53, 0x1c, 0x3a, 0xc4, 0x23, 0xf4, 0x57,
-	0x85, 0xbc, 0xd4, 0xa1, 0xa2, 0x45, 0xc4, 0x96, 0x58, 0x98, 0x57, 0x92, 0x22, 0x1f, 0xac, 0x1b,
-	0x1a, 0x0f, 0x6c, 0x70, 0x54, 0xa2, 0x73, 0xb8, 0x97, 0xe8, 0x44, 0xaf, 0xf2, 0x53, 0x19, 0x3f,
-	0x1e, 0x88, 0xdd, 0x60, 0xd9, 0xce, 0x89, 0x85, 0xd3, 0xe4, 0xb7, 0x37, 0xd2, 0xdf, 0x58, 0xe3,
-	0x47, 0x25, 0x46, 0x18, 0x5c, 0xb9, 0xe5, 0x27, 0xe2, 0xce, 0x89, 0x92, 0x18, 0xab, 0x0c, 0x8a,
-	0x45, 0x29, 0x0f, 0xd6, 0xa0, 0x93, 0x20, 0x16, 0xbb, 0x80, 0x16, 0xac, 0xc1, 0xc0, 0x58, 0x96,
-	0xa3, 0xbf, 0xe7, 0x40, 0xb1, 0x11, 0x86, 0x5b, 0x71, 0x79, 0x94, 0x4d, 0x0e, 0x0b, 0x5a, 0xb2,
-	0x90, 0x38, 0x53, 0x57, 0x29, 0x59, 0xf3, 0x16, 0x5d, 0x91, 0xc1, 0x6e, 0xef, 0x4e, 0x8e, 0x2d,
-	0xfb, 0x9b, 0xa4, 0xba, 0x53, 0x6d, 0x12, 0x06, 0x79, 0xe3, 0x6d, 0x0d, 0x72, 0x65, 0x9b, 0x04,
-	0x09, 0xe6, 0xad, 0x9a, 0xf8, 0xac, 0x03, 0x90, 0x12, 0xca, 0xf1, 0x8a, 0x12, 0x33, 0x8e, 0xc0,
-	0xc2, 0x11, 0xd9, 0x68, 0x9a, 0xee, 0x66, 0xfd, 0x77, 0x0e, 0x94, 0x68, 0xe7, 0xa4, 0x08, 0x7c,
-	0x04, 0x06, 0x12, 0x2f, 0xaa, 0x13, 0xe9, 0x19, 0x50, 0x9f, 0x63, 0x9d, 0x41, 0xb1, 0x28, 0x45,
-	0x01, 0x14, 0x13, 0x2f, 0xde, 0x92, 0x8a, 0xf9, 0xa2, 0xb5, 0x21, 0x4e, 0x75, 0x72, 0xfa, 0x2b,
-	0xc6, 0x9c, 0x0d, 0x7a, 0x14, 0x86, 0xe8, 0xd6, 0x31, 0xef, 0xc5, 0x32, 0x58, 0x67, 0x84, 0x0a,
-	0xf1, 0x79, 0x01, 0xc3, 0xaa, 0xd4, 0xfd, 0xcd, 0x02, 0xf4, 0xcf, 0xf1, 0x23, 0xda, 0x40, 0x1c,
-	0x76, 0xa2, 0x2a, 0x11, 0xaa, 0xba, 0x85, 0x39, 0x4d, 0xe9, 0x56, 0x18, 0x4d, 0xed, 0x90, 0xc4,
-	0x7e, 0x63, 0xc1, 0x0b, 0xbd, 0xe5, 0xc0, 0x58, 0x12, 0x79, 0x41, 0xbc, 0xc9, 0x7c, 0x30, 0x7e,
-	0x18, 0x88, 0x21, 0xb2, 0x30, 0x0b, 0xd7, 0x0d, 0xba, 0x95, 0x84, 0xb4, 0x53, 0x57, 0x90, 0x59,
-	0x86, 0x33, 0x6d, 0x70, 0x7f, 0xcb, 0x01, 0x48, 0x5b, 0x8f, 0xde, 0x74, 0x60, 0xd4, 0xd3, 0x83,
-	0x54, 0xc5, 0x18, 0xad, 0xda, 0x73, 0xd8, 0x32, 0xb2, 0xdc, 0x3a, 0x61, 0x80, 0xb0, 0xc9, 0xd8,
-	0x7d, 0x2f, 0x14, 0xd9, 0xea, 0xa0, 0x7b, 0x75, 0x2c, 0xac, 0xd9, 0x59, 0xf3, 0x95, 0xb4, 0x72,
-	0x63, 0x85, 0xe1, 0xbe, 0x00, 0x63, 0x57, 0x6e, 0x91, 0x6a, 0x27, 0x09, 0x23, 0x6e, 0xcb, 0xef,
-	0x71, 0x15, 0xca, 0x39, 0xd2, 0x55, 0xa8, 0x6f, 0x3b, 0x50, 0xd2, 0x22, 0x16, 0xe9, 0x4e, 0x5d,
-	0x9f, 0xad, 0x70, 0x93, 0x85, 0x18, 0xaa, 0x25, 0x2b, 0x31, 0x91, 0x9c, 0x64, 0xba, 0x8d, 0x28,
-	0x10, 0x4e, 0x19, 0xde, 0x21, 0xa2, 0xcf, 0xfd, 0x03, 0x07, 0xce, 0xe5, 0x86, 0x57, 0xbe, 0xc3,
-	0xcd, 0x36, 0xbc, 0xea, 0x85, 0x03, 0x78, 0xd5, 0xbf, 0xe3, 0x40, 0x4a, 0x89, 0x8a, 0xa2, 0x8d,
-	0xb4, 0xe5, 0x9a, 0x28, 0x12, 0x9c, 0x44, 0x29, 0x7a, 0x15, 0x2e, 0x98, 0x5f, 0xf0, 0x88, 0x1e,
-	0x14, 0x7e, 0x38, 0xcd, 0xa7, 0x84, 0x7b, 0xb1, 0x70, 0xbf, 0xe2, 0x40, 0x71, 0xc1, 0xeb, 0xd4,
-	0xc9, 0x81, 0x0c, 0x60, 0x54, 0x8e, 0x45, 0xc4, 0x6b, 0x26, 0xf2, 0xe8, 0x20, 0xe4, 0x18, 0x16,
-	0x30, 0xac, 0x4a, 0xd1, 0x34, 0x0c, 0x87, 0x6d, 0x62, 0x38, 0x05, 0x1f, 0x92, 0xa3, 0xb7, 0x2a,
-	0x0b, 0xe8, 0xb6, 0xc3, 0xb8, 0x2b, 0x08, 0x4e, 0x6b, 0xb9, 0x5f, 0x1d, 0x80, 0x92, 0x76, 0xfd,
-	0x87, 0xea, 0x02, 0x11, 0x69, 0x87, 0x59, 0x7d, 0x99, 0x4e, 0x18, 0xcc, 0x4a, 0xe8, 0x1a, 0x8c,
-	0xc8, 0xb6, 0x1f, 0x73, 0xb1, 0x65, 0xac, 0x41, 0x2c, 0xe0, 0x58, 0x61, 0xa0, 0x49, 0x28, 0xd6,
-	0x48, 0x3b, 0x69, 0xb0, 0xe6, 0xf5, 0xf3, 0x68, 0xc0, 0x39, 0x0a, 0xc0, 0x1c, 0x4e, 0x11, 0x36,
-	0x49, 0x52, 0x6d, 0x30, 0x5b, 0xaf, 0x08, 0x17, 0x9c, 0xa7, 0x00, 0xcc, 0xe1, 0x39, 0x7e, 0xc9,
-	0xe2, 0xf1, 0xfb, 0x25, 0x07, 0x2c, 0xfb, 0x25, 0x51, 0x1b, 0xce, 0xc4, 0x71, 0x63, 0x2d, 0xf2,
-	0xb7, 0xbd, 0x84, 0xa4, 0xb3, 0x6f, 0xf0, 0x30, 0x7c, 0x2e, 0xb0, 0x0b, 0xf9, 0x95, 0xab, 0x59,
-	0x2a, 0x38, 0x8f, 0x34, 0xaa, 0xc0, 0x39, 0x3f, 0x88, 0x49, 0xb5, 0x13, 0x91, 0xc5, 0x7a, 0x10,
-	0x46, 0xe4, 0x6a, 0x18, 0x53, 0x72, 0xe2, 0x3a, 0xb1, 0x0a, 0xa0, 0x5d, 0xcc, 0x43, 0xc2, 0xf9,
-	0x75, 0xd1, 0x02, 0x9c, 0xae, 0xf9, 0xb1, 0xb7, 0xd1, 0x24, 0x95, 0xce, 0x46, 0x2b, 0xe4, 0x47,
-	0xf3, 0x61, 0x46, 0xf0, 0x5e, 0x69, 0x47, 0x9a, 0xcb, 0x22, 0xe0, 0xee, 0x3a, 0xe8, 0x29, 0x18,
-	0x89, 0xfd, 0xa0, 0xde, 0x24, 0x33, 0x91, 0x17, 0x54, 0x1b, 0xe2, 0x1e, 0xb2, 0xb2, 0xa0, 0x57,
-	0xb4, 0x32, 0x6c, 0x60, 0xb2, 0x35, 0xcf, 0xeb, 0x64, 0xb4, 0x41, 0x81, 0x2d, 0x4a, 0xd1, 0x34,
-	0x9c, 0x92, 0x7d, 0xa8, 0x6c, 0xf9, 0xed, 0xf5, 0xe5, 0x0a, 0xd3, 0x0a, 0x87, 0xd2, 0xf0, 0xa0,
-	0x45, 0xb3, 0x18, 0x67, 0xf1, 0xdd, 0x1f, 0x38, 0x30, 0xa2, 0xc7, 0xdf, 0x53, 0x65, 0x1d, 0x1a,
-	0x73, 0xf3, 0x15, 0xbe, 0x9d, 0xd8, 0x53, 0x1a, 0xae, 0x2a, 0x9a, 0xe9, 0x79, 0x3b, 0x85, 0x61,
-	0x8d, 0xe7, 0x01, 0xee, 0xf0, 0x3f, 0x04, 0xc5, 0xcd, 0x90, 0xea, 0x34, 0x7d, 0xa6, 0xf5, 0x7e,
-	0x9e, 0x02, 0x31, 0x2f, 0x73, 0xff, 0xbb, 0x03, 0xe7, 0xf3, 0xaf, 0x16, 0xfc, 0x34, 0x74, 0xf2,
-	0x32, 0x00, 0xed, 0x8a, 0xb1, 0x2f, 0x68, 0x59, 0x3c, 0x64, 0x09, 0xd6, 0xb0, 0x0e, 0xd6, 0xed,
-	0x7f, 0x5b, 0x00, 0x8d, 0x27, 0xfa, 0x9c, 0x03, 0xa3, 0x94, 0xed, 0x52, 0xb4, 0x61, 0xf4, 0x76,
-	0xd5, 0x4e, 0x6f, 0x15, 0xd9, 0xd4, 0x49, 0x61, 0x80, 0xb1, 0xc9, 0x1c, 0xfd, 0x1c, 0x0c, 0x7b,
-	0xb5, 0x5a, 0x44, 0xe2, 0x58, 0xb9, 0xfb, 0x98, 0xc1, 0x6b, 0x5a, 0x02, 0x71, 0x5a, 0x4e, 0xe5,
-	0x70, 0xa3, 0xb6, 0x19, 0x53, 0xd1, 0x26, 0x64, 0xbf, 0x92, 0xc3, 0x94, 0x09, 0x85, 0x63, 0x85,
-	0x81, 0x9e, 0x85, 0xf3, 0x35, 0x2f, 0xf1, 0xb8, 0x0a, 0x48, 0xa2, 0xb5, 0x28, 0x4c, 0x48, 0x95,
-	0xed, 0x1b, 0x3c, 0x3a, 0xe4, 0xa2, 0xa8, 0x7b, 0x7e, 0x2e, 0x17, 0x0b, 0xf7, 0xa8, 0xed, 0xfe,
-	0x5a, 0x3f, 0x98, 0x7d, 0x42, 0x35, 0x38, 0xb5, 0x15, 0x6d, 0xcc, 0xb2, 0x28, 0x8c, 0xa3, 0x44,
-	0x43, 0xb0, 0x28, 0x85, 0x25, 0x93, 0x02, 0xce, 0x92, 0x14, 0x5c, 0x96, 0xc8, 0x4e, 0xe2, 0x6d,
-	0x1c, 0x39, 0x16, 0x62, 0xc9, 0xa4, 0x80, 0xb3, 0x24, 0xd1, 0x7b, 0xa1, 0xb4, 0x15, 0x6d, 0xc8,
-	0xdd, 0x23, 0x1b, 0x77, 0xb3, 0x94, 0x16, 0x61, 0x1d, 0x8f, 0x7e, 0x9a, 0xad, 0x68, 0x83, 0x6e,
-	0xd8, 0x32, 0x57, 0x86, 0xfa, 0x34, 0x4b, 0x02, 0x8e, 0x15, 0x06, 0x6a, 0x03, 0xda, 0x92, 0xa3,
-	0xa7, 0x62, 0x4e, 0xc4, 0x26, 0xf7, 0x68, 0x0f, 0x07, 0x32, 0x47, 0xd2, 0x3b, 0xc4, 0xee, 0x02,
-	0x2c, 0x75, 0xd1, 0xc1, 0x39, 0xb4, 0xd1, 0x73, 0x70, 0x61, 0x2b, 0xda, 0x10, 0x7a, 0xcc, 0x5a,
-	0xe4, 0x07, 0x55, 0xbf, 0x6d, 0xe4, 0xc5, 0x98, 0x14, 0xcd, 0xbd, 0xb0, 0x94, 0x8f, 0x86, 0x7b,
-	0xd5, 0x77, 0x7f, 0xb7, 0x1f, 0xd8, 0x8d, 0x5e, 0x2a, 0xa6, 0x5b, 0x24, 0x69, 0x84, 0xb5, 0xac,
-	0x6a, 0xb6, 0xc2, 0xa0, 0x58, 0x94, 0xca, 0x88, 0xd7, 0x42, 0x8f, 0x88, 0xd7, 0x9b, 0x30, 0xd8,
-	0x20, 0x5e, 0x8d, 0x44, 0xd2, 0xb8, 0xb9, 0x6c, 0xe7, 0x0e, 0xf2, 0x55, 0x46, 0x34, 0xb5, 0x10,
-	0xf0, 0xdf, 0x31, 0x96, 0xdc, 0xd0, 0x2f, 0xc2, 0x18, 0xd5, 0xb1, 0xc2, 0x4e, 0x22, 0xfd, 0x13,
-	0xdc, 0xb8, 0xc9, 0x36, 0xfb, 0x75, 0xa3, 0x04, 0x67, 0x30, 0xd1, 0x1c, 0x8c, 0x0b, 0x5f, 0x82,
-	0x32, 0x9a, 0x8a, 0x81, 0x55, 0x09, 0x4b, 0x2a, 0x99, 0x72, 0xdc, 0x55, 0x83, 0x45, 0x2c, 0x86,
-	0x35, 0xee, 0x20, 0xd6, 0x23, 0x16, 0xc3, 0xda, 0x0e, 0x66, 0x25, 0xe8, 0x65, 0x18, 0xa2, 0x7f,
-	0xe7, 0xa3, 0xb0, 0x25, 0xcc, 0x46, 0x6b, 0x76, 0x46, 0x87, 0xf2, 0x10, 0x87, 0x58, 0xa6, 0x7b,
-	0xce, 0x08, 0x2e, 0x58, 0xf1, 0xa3, 0x47, 0x29, 0x7d, 0xbb, 0x7c, 0x96, 0x44, 0xfe, 0xe6, 0x0e,
-	0xd3, 0x67, 0x86, 0xd2, 0xa3, 0xd4, 0x62, 0x17, 0x06, 0xce, 0xa9, 0xe5, 0x7e, 0xae, 0x00, 0x23,
-	0xfa, 0xc5, 0xf0, 0x3b, 0x85, 0x41, 0xc7, 0xe9, 0xa4, 0xe0, 0x07, 0xe7, 0xab, 0x16, 0xba, 0x7d,
-	0xa7, 0x09, 0xd1, 0x80, 0x7e, 0xaf, 0x23, 0x14, 0x59, 0x2b, 0xf6, 0x39, 0xd6, 0xe3, 0x4e, 0xd2,
-	0xe0, 0x77, 0xf9, 0x58, 0x80, 0x32, 0xe3, 0xe0, 0x7e, 0xaa, 0x0f, 0x86, 0x64, 0x21, 0xfa, 0xa4,
-	0x03, 0x90, 0x46, 0x82, 0x09, 0x51, 0xba, 0x66, 0x23, 0x4c, 0x48, 0x0f, 0x62, 0xd3, 0xcc, 0xfc,
-	0x0a, 0x8e, 0x35, 0xbe, 0x28, 0x81, 0x81, 0x90, 0x36, 0xee, 0xb2, 0xbd, 0xe4, 0x06, 0xab, 0x94,
-	0xf1, 0x65, 0xc6, 0x3d, 0xb5, 0xe8, 0x31, 0x18, 0x16, 0xbc, 0xe8, 0xe1, 0x74, 0x43, 0x06, 0x28,
-	0xda, 0xb3, 0x7e, 0xab, 0x98, 0xc7, 0xf4, 0xac, 0xa9, 0x40, 0x38, 0x65, 0xe8, 0x3e, 0x01, 0x63,
-	0xe6, 0x62, 0xa0, 0x87, 0x95, 0x8d, 0x9d, 0x84, 0x70, 0x53, 0xc8, 0x08, 0x3f, 0xac, 0xcc, 0x50,
-	0x00, 0xe6, 0x70, 0xf7, 0xfb, 0x0e, 0x40, 0x2a, 0x5e, 0x0e, 0xe0, 0x7d, 0x78, 0x48, 0xb7, 0xe3,
-	0xf5, 0x3a, 0x11, 0x7e, 0x0c, 0x86, 0xd9, 0x3f, 0x6c, 0xa1, 0xf7, 0xd9, 0x0a, 0x27, 0x48, 0xdb,
-	0x29, 0x96, 0x3a, 0xd3, 0x35, 0x9e, 0x95, 0x8c, 0x70, 0xca, 0xd3, 0x0d, 0x61, 0x3c, 0x8b, 0x8d,
-	0x3e, 0x04, 0x23, 0xb1, 0xdc, 0x56, 0xd3, 0x0b, 0x7f, 0x07, 0xdc, 0x7e, 0xb9, 0xeb, 0x4f, 0xab,
-	0x8e, 0x0d, 0x62, 0xee, 0x2a, 0x0c, 0x58, 0x1d, 0x42, 0xf7, 0x9b, 0x0e, 0x0c, 0x33, 0xef, 0x6b,
-	0x3d, 0xf2, 0x5a, 0x69, 0x95, 0xbe, 0x7d, 0x46, 0x3d, 0x86, 0x41, 0x6e, 0x3e, 0x90, 0x71, 0x48,
-	0x16, 0xa4, 0x0c, 0xcf, 0x49, 0x98, 0x4a, 0x19, 0x6e, 0xa7, 0x88, 0xb1, 0xe4, 0xe4, 0x7e, 0xba,
-	0x00, 0x03, 0x8b, 0x41, 0xbb, 0xf3, 0x57, 0x3e, 0x2f, 0xde, 0x0a, 0xf4, 0x2f, 0x26, 0xa4, 0x65,
-	0xa6, 0x6f, 0x1c, 0x99, 0x79, 0x58, 0x4f, 0xdd, 0x58, 0x36, 0x53, 0x37, 0x62, 0xef, 0xa6, 0x0c,
-	0xd3, 0x13, 0xe6, 0xeb, 0xf4, 0xd2, 0xe3, 0xe3, 0x30, 0xbc, 0xec, 0x6d, 0x90, 0xe6, 0x12, 0xd9,
-	0x61, 0x57, 0x14, 0x79, 0xc8, 0x88, 0x93, 0xda, 0x1c, 0x8c, 0xf0, 0x8e, 0x39, 0x18, 0x63, 0xd8,
-	0x6a, 0x31, 0xd0, 0x13, 0x09, 0x49, 0x73, 0x5f, 0x39, 0xe6, 0x89, 0x44, 0xcb, 0x7b, 0xa5, 0x61,
-	0xb9, 0x53, 0x50, 0x4a, 0xa9, 0x1c, 0x80, 0xeb, 0x4f, 0x0a, 0x30, 0x6a, 0x58, 0xe1, 0x0d, 0xdf,
-	0xa4, 0x73, 0x47, 0xdf, 0xa4, 0xe1, 0x2b, 0x2c, 0xbc, 0xd3, 0xbe, 0xc2, 0xbe, 0x93, 0xf7, 0x15,
-	0x9a, 0x1f, 0xa9, 0xff, 0x40, 0x1f, 0xe9, 0x2d, 0x07, 0xfa, 0x97, 0xfd, 0x60, 0xeb, 0x60, 0x82,
-	0x26, 0xae, 0x86, 0xed, 0x2e, 0x41, 0x53, 0xa1, 0x40, 0xcc, 0xcb, 0xa4, 0xea, 0xd2, 0xd7, 0x43,
-	0x75, 0x49, 0x9d, 0x27, 0xfd, 0xfb, 0x39, 0x4f, 0xdc, 0x4f, 0x3a, 0x30, 0xb2, 0xe2, 0x05, 0xfe,
-	0x26, 0x89, 0x13, 0x36, 0x01, 0x93, 0x63, 0xbd, 0xd3, 0x36, 0xd2, 0x23, 0x3b, 0xc4, 0x1b, 0x0e,
-	0x9c, 0x5e, 0x21, 0xad, 0xd0, 0x7f, 0xd9, 0x4b, 0xc3, 0x65, 0x69, 0x1f, 0x1b, 0x7e, 0x22, 0xa2,
-	0x03, 0x55, 0x1f, 0xaf, 0xfa, 0x09, 0xa6, 0xf0, 0x3b, 0xd8, 0xa2, 0xd9, 0x6d, 0x11, 0x7a, 0x92,
-	0xd3, 0xee, 0x59, 0xa6, 0x81, 0xb0, 0xb2, 0x00, 0xa7, 0x38, 0xee, 0xef, 0x39, 0x30, 0xc8, 0x1b,
-	0xa1, 0x22, 0x8c, 0x9d, 0x1e, 0xb4, 0x1b, 0x50, 0x64, 0xf5, 0xc4, 0xf4, 0x5f, 0xb0, 0xa0, 0x27,
-	0x51, 0x72, 0x7c, 0xb1, 0xb2, 0x7f, 0x31, 0x67, 0xc0, 0xce, 0x37, 0xde, 0xad, 0x69, 0x15, 0x29,
-	0x9c, 0x9e, 0x6f, 0x18, 0x14, 0x8b, 0x52, 0xf7, 0xab, 0x7d, 0x30, 0xa4, 0x52, 0xb1, 0xb1, 0x94,
-	0x15, 0x41, 0x10, 0x26, 0x1e, 0x8f, 0xd7, 0xe0, 0x42, 0xfd, 0x43, 0xf6, 0x52, 0xc1, 0x4d, 0x4d,
-	0xa7, 0xd4, 0xb9, 0x0f, 0x52, 0x9d, 0x56, 0xb5, 0x12, 0xac, 0x37, 0x02, 0xbd, 0x0e, 0x03, 0x4d,
-	0x2a, 0xa6, 0xa4, 0x8c, 0x7f, 0xd6, 0x62, 0x73, 0x98, 0xfc, 0x13, 0x2d, 0x51, 0x23, 0xc4, 0x81,
-	0x58, 0x70, 0x9d, 0x78, 0x3f, 0x8c, 0x67, 0x5b, 0x7d, 0xa7, 0x6b, 0xa0, 0xc3, 0xfa, 0x25, 0xd2,
-	0xbf, 0x21, 0xc4, 0xec, 0xe1, 0xab, 0xba, 0xcf, 0x40, 0x69, 0x85, 0x24, 0x91, 0x5f, 0x65, 0x04,
-	0xee, 0x34, 0xb9, 0x0e, 0xa4, 0x68, 0x7c, 0x86, 0x4d, 0x56, 0x4a, 0x33, 0x46, 0xaf, 0x02, 0xb4,
-	0xa3, 0x90, 0x1e, 0x74, 0x49, 0x47, 0x7e, 0x6c, 0x0b, 0x8a, 0xf3, 0x9a, 0xa2, 0xc9, 0xdd, 0xe6,
-	0xe9, 0x6f, 0xac, 0xf1, 0x73, 0xdf, 0x74, 0xa0, 0xb8, 0xd2, 0x49, 0xc8, 0xad, 0x03, 0x88, 0xb6,
-	0x43, 0x27, 0x46, 0x78, 0x1c, 0x86, 0xe8, 0x07, 0xde, 0xf0, 0x62, 0x69, 0x70, 0x4b, 0x03, 0xc9,
-	0x05, 0x1c, 0x2b, 0x0c, 0xf7, 0x43, 0x30, 0xc2, 0x5a, 0x72, 0x35, 0x6c, 0xd2, 0xed, 0x9a, 0x8e,
-	0x64, 0x8b, 0xfe, 0xce, 0xfa, 0x41, 0x18, 0x12, 0xe6, 0x65, 0x74, 0x85, 0x35, 0xc2, 0x66, 0x4d,
-	0x5d, 0x29, 0x53, 0xf3, 0xe7, 0x2a, 0x83, 0x62, 0x51, 0xea, 0x7e, 0xa2, 0x00, 0x25, 0x56, 0x51,
-	0x48, 0xa7, 0x1d, 0x18, 0x6c, 0x70, 0x3e, 0x62, 0xc8, 0x2d, 0xc4, 0xad, 0xe9, 0xad, 0xd7, 0xce,
-	0x88, 0x1c, 0x80, 0x25, 0x3f, 0xca, 0xfa, 0xa6, 0xe7, 0x27, 0x94, 0x75, 0xe1, 0x78, 0x59, 0xdf,
-	0xe0, 0x6c, 0xb0, 0xe4, 0xe7, 0xfe, 0x32, 0xb0, 0xab, 0xda, 0xf3, 0x4d, 0xaf, 0xce, 0x47, 0x2e,
-	0xdc, 0x22, 0x35, 0x21, 0xa2, 0xb5, 0x91, 0xa3, 0x50, 0x2c, 0x4a, 0xf9, 0xf5, 0xd7, 0x24, 0xf2,
-	0x55, 0x0c, 0xb7, 0x76, 0xfd, 0x95, 0x81, 0x65, 0xc4, 0x7e, 0xcd, 0xfd, 0x62, 0x01, 0x80, 0xe5,
-	0xf9, 0xe3, 0x37, 0xac, 0x7f, 0x5e, 0x06, 0x67, 0x99, 0xbe, 0x53, 0x15, 0x9c, 0xc5, 0xee, 0x90,
-	0xeb, 0x41, 0x59, 0xfa, 0xd5, 0x8a, 0xc2, 0xfe, 0x57, 0x2b, 0x50, 0x1b, 0x06, 0xc3, 0x4e, 0x42,
-	0x75, 0x60, 0xa1, 0x44, 0x58, 0x08, 0x1d, 0x58, 0xe5, 0x04, 0xf9, 0x7d, 0x04, 0xf1, 0x03, 0x4b,
-	0x36, 0xe8, 0x29, 0x18, 0x6a, 0x47, 0x61, 0x9d, 0xea, 0x04, 0x62, 0x5f, 0xbe, 0x5f, 0xce, 0xe6,
-	0x35, 0x01, 0xbf, 0xad, 0xfd, 0x8f, 0x15, 0xb6, 0xfb, 0xf7, 0x4f, 0xf3, 0x71, 0x11, 0x73, 0x6f,
-	0x02, 0x0a, 0xbe, 0xb4, 0x78, 0x81, 0x20, 0x51, 0x58, 0x9c, 0xc3, 0x05, 0xbf, 0xa6, 0x56, 0x61,
-	0xa1, 0xe7, 0x2a, 0x7c, 0x2f, 0x94, 0x6a, 0x7e, 0xdc, 0x6e, 0x7a, 0x3b, 0xd7, 0x72, 0xcc, 0x8d,
-	0x73, 0x69, 0x11, 0xd6, 0xf1, 0xd0, 0xe3, 0xe2, 0x22, 0x4d, 0xbf, 0x61, 0x62, 0x92, 0x17, 0x69,
-	0xd2, 0x1b, 0xfc, 0xfc, 0x0e, 0x4d, 0x36, 0xd3, 0x41, 0xf1, 0xc0, 0x99, 0x0e, 0xb2, 0x1a, 0xde,
-	0xc0, 0xc9, 0x6b, 0x78, 0xef, 0x83, 0x51, 0xf9, 0x93, 0x69, 0x5d, 0xe5, 0xb3, 0xac, 0xf5, 0xca,
-	0xbc, 0xbe, 0xae, 0x17, 0x62, 0x13, 0x37, 0x9d, 0xb4, 0x83, 0x07, 0x9d, 0xb4, 0x97, 0x01, 0x36,
-	0xc2, 0x4e, 0x50, 0xf3, 0xa2, 0x9d, 0xc5, 0x39, 0x11, 0xa4, 0xab, 0x14, 0xca, 0x19, 0x55, 0x82,
-	0x35, 0x2c, 0x7d, 0xa2, 0x0f, 0xdf, 0x61, 0xa2, 0x7f, 0x08, 0x86, 0x59, 0x40, 0x33, 0xa9, 0x4d,
-	0x27, 0x22, 0xaa, 0xea, 0x30, 0x51, 0xa2, 0x69, 0x9c, 0xa5, 0x24, 0x82, 0x53, 0x7a, 0xe8, 0xc3,
-	0x00, 0x9b, 0x7e, 0xe0, 0xc7, 0x0d, 0x46, 0xbd, 0x74, 0x68, 0xea, 0xaa, 0x9f, 0xf3, 0x8a, 0x0a,
-	0xd6, 0x28, 0xa2, 0x17, 0xe0, 0x34, 0x89, 0x13, 0xbf, 0xe5, 0x25, 0xa4, 0xa6, 0x6e, 0xa6, 0x96,
-	0x99, 0x8d, 0x54, 0x85, 0x94, 0x5f, 0xc9, 0x22, 0xdc, 0xce, 0x03, 0xe2, 0x6e, 0x42, 0xc6, 0x8a,
-	0x9c, 0x38, 0xcc, 0x8a, 0x44, 0xff, 0xcb, 0x81, 0xd3, 0x11, 0xe1, 0xa1, 0x36, 0xb1, 0x6a, 0xd8,
-	0x39, 0x26, 0x8e, 0xab, 0x36, 0x52, 0xe8, 0xab, 0xac, 0x31, 0x38, 0xcb, 0x85, 0xeb, 0x39, 0x44,
-	0xf6, 0xbe, 0xab, 0xfc, 0x76, 0x1e, 0xf0, 0x8d, 0xb7, 0x27, 0x27, 0xbb, 0x9f, 0x72, 0x50, 0xc4,
-	0xe9, 0xca, 0xfb, 0xdb, 0x6f, 0x4f, 0x8e, 0xcb, 0xdf, 0xe9, 0xa0, 0x75, 0x75, 0x92, 0x6e, 0xab,
-	0xed, 0xb0, 0xb6, 0xb8, 0x26, 0xc2, 0xdf, 0xd4, 0xb6, 0xba, 0x46, 0x81, 0x98, 0x97, 0xa1, 0x47,
-	0xe9, 0xce, 0x4d, 0x5a, 0x61, 0xa0, 0x92, 0x21, 0x8f, 0xf0, 0x5d, 0x9b, 0xc3, 0xb0, 0x2a, 0xa5,
-	0x47, 0x8e, 0x40, 0x6c, 0x29, 0xe5, 0xfb, 0x6c, 0x1d, 0x39, 0xe4, 0x26, 0xc5, 0xb9, 0xca, 0x5f,
-	0x58, 0x71, 0x42, 0x4d, 0x18, 0xf0, 0x99, 0x01, 0x44, 0x44, 0xd8, 0x5a, 0xb0, 0xba, 0x70, 0x83,
-	0x8a, 0x8c, 0xaf, 0x65, 0xa2, 0x5f, 0xf0, 0xd0, 0xf7, 0x9a, 0x53, 0x27, 0xb3, 0xd7, 0x3c, 0x0a,
-	0x43, 0xd5, 0x86, 0xdf, 0xac, 0x45, 0x24, 0x28, 0x8f, 0x33, 0x4b, 0x00, 0x1b, 0x89, 0x59, 0x01,
-	0xc3, 0xaa, 0x14, 0xfd, 0x75, 0x18, 0x0d, 0x3b, 0x09, 0x13, 0x2d, 0x74, 0x9c, 0xe2, 0xf2, 0x69,
-	0x86, 0xce, 0xe2, 0xa5, 0x56, 0xf5, 0x02, 0x6c, 0xe2, 0x51, 0x11, 0xdf, 0x08, 0x63, 0x96, 0xe0,
-	0x88, 0x89, 0xf8, 0xf3, 0xa6, 0x88, 0xbf, 0xaa, 0x95, 0x61, 0x03, 0x13, 0x7d, 0xd9, 0x81, 0xd3,
-	0xad, 0xec, 0x79, 0xaf, 0x7c, 0x81, 0x8d, 0x4c, 0xc5, 0xc6, 0xb9, 0x20, 0x43, 0x9a, 0x47, 0xba,
-	0x77, 0x81, 0x71, 0x77, 0x23, 0x58, 0xaa, 0xb1, 0x78, 0x27, 0xa8, 0x36, 0xa2, 0x30, 0x30, 0x9b,
-	0x77, 0xaf, 0xad, 0x1b, 0x74, 0x6c, 0x6d, 0xe7, 0xb1, 0x98, 0xb9, 0x77, 0x6f, 0x77, 0xf2, 0x5c,
-	0x6e, 0x11, 0xce, 0x6f, 0x14, 0xfa, 0x20, 0x8c, 0x27, 0x5e, 0xbc, 0xc5, 0xf5, 0x25, 0x5a, 0x93,
-	0xd4, 0xca, 0xf7, 0xf3, 0x20, 0x87, 0xbd, 0xdd, 0xc9, 0xf1, 0xf5, 0x4c, 0x19, 0xee, 0xc2, 0x9e,
-	0x98, 0x83, 0xf3, 0xf9, 0x12, 0xe6, 0x4e, 0x47, 0x9c, 0x3e, 0xfd, 0x88, 0x33, 0x0f, 0xf7, 0xf6,
-	0xec, 0x16, 0xdd, 0xab, 0xa4, 0xbe, 0xea, 0x98, 0x7b, 0x55, 0x97, 0x7e, 0x39, 0x06, 0x23, 0xfa,
-	0xeb, 0x21, 0xee, 0xff, 0xed, 0x03, 0x48, 0x2d, 0xf8, 0xc8, 0x83, 0x31, 0xee, 0x2d, 0x58, 0x9c,
-	0x3b, 0x72, 0xf6, 0x80, 0x59, 0x83, 0x00, 0xce, 0x10, 0x44, 0x2d, 0x40, 0x1c, 0xc2, 0x7f, 0x1f,
-	0xc5, 0xeb, 0xcb, 0x9c, 0xa4, 0xb3, 0x5d, 0x44, 0x70, 0x0e, 0x61, 0xda, 0xa3, 0x24, 0xdc, 0x22,
-	0xc1, 0x75, 0xbc, 0x7c, 0x94, 0x0c, 0x15, 0xdc, 0x4f, 0x68, 0x10, 0xc0, 0x19, 0x82, 0xc8, 0x85,
-	0x01, 0x66, 0x34, 0x92, 0x51, 0xed, 0x4c, 0x40, 0x31, 0x5d, 0x25, 0xc6, 0xa2, 0x04, 0x7d, 0xd1,
-	0x81, 0x31, 0x99, 0x68, 0x83, 0xd9, 0x69, 0x65, 0x3c, 0xfb, 0x75, 0x5b, 0x1e, 0x98, 0x2b, 0x3a,
-	0xf5, 0x34, 0x5a, 0xd4, 0x00, 0xc7, 0x38, 0xd3, 0x08, 0xf7, 0x39, 0x38, 0x93, 0x53, 0xdd, 0xca,
-	0x11, 0xfa, 0xdb, 0x0e, 0x94, 0xb4, 0xfc, 0x93, 0xe8, 0x55, 0x18, 0x0e, 0x2b, 0xd6, 0x43, 0x14,
-	0x57, 0x2b, 0x5d, 0x21, 0x8a, 0x0a, 0x84, 0x53, 0x86, 0x07, 0x89, 0xac, 0xcc, 0x4d, 0x96, 0xf9,
-	0x0e, 0x37, 0xfb, 0xd0, 0x91, 0x95, 0xbf, 0x56, 0x84, 0x94, 0xd2, 0x21, 0x13, 0xc0, 0xa4, 0x71,
-	0x98, 0x85, 0x7d, 0xe3, 0x30, 0x6b, 0x70, 0xca, 0x63, 0x5e, 0xee, 0x23, 0xa6, 0x7d, 0xe1, 0xe9,
-	0x87, 0x4d, 0x0a, 0x38, 0x4b, 0x92, 0x72, 0x89, 0xd3, 0xaa, 0x8c, 0x4b, 0xff, 0xa1, 0xb9, 0x54,
-	0x4c, 0x0a, 0x38, 0x4b, 0x12, 0xbd, 0x00, 0xe5, 0x2a, 0xbb, 0xa7, 0xcc, 0xfb, 0xb8, 0xb8, 0x79,
-	0x2d, 0x4c, 0xd6, 0x22, 0x12, 0x93, 0x20, 0x11, 0x09, 0xde, 0x1e, 0x14, 0xa3, 0x50, 0x9e, 0xed,
-	0x81, 0x87, 0x7b, 0x52, 0xa0, 0x07, 0x1d, 0xe6, 0x26, 0xf7, 0x93, 0x1d, 0x26, 0x44, 0x44, 0xfc,
-	0x80, 0x3a, 0xe8, 0x54, 0xf4, 0x42, 0x6c, 0xe2, 0xa2, 0x5f, 0x75, 0x60, 0xb4, 0x29, 0x1d, 0x09,
-	0xb8, 0xd3, 0x94, 0xd9, 0x52, 0xb1, 0x95, 0xe9, 0xb7, 0xac, 0x53, 0xe6, 0xda, 0x88, 0x01, 0xc2,
-	0x26, 0xef, 0x6c, 0x0e, 0x9e, 0xa1, 0x03, 0xe6, 0xe0, 0xf9, 0xbe, 0x03, 0xe3, 0x59, 0x6e, 0x68,
-	0x0b, 0x1e, 0x68, 0x79, 0xd1, 0xd6, 0x62, 0xb0, 0x19, 0xb1, 0xdb, 0x2b, 0x09, 0x9f, 0x0c, 0xd3,
-	0x9b, 0x09, 0x89, 0xe6, 0xbc, 0x1d, 0xee, 0x98, 0x2d, 0xaa, 0x47, 0xbe, 0x1e, 0x58, 0xd9, 0x0f,
-	0x19, 0xef, 0x4f, 0x0b, 0x55, 0xe0, 0x1c, 0x45, 0x60, 0x29, 0xfa, 0xfc, 0x30, 0x48, 0x99, 0x14,
-	0x18, 0x13, 0x15, 0x41, 0xb9, 0x92, 0x87, 0x84, 0xf3, 0xeb, 0xba, 0x57, 0x60, 0x80, 0x5f, 0x26,
-	0xbc, 0x2b, 0xcf, 0x96, 0xfb, 0x1f, 0x0a, 0x20, 0x55, 0xcb, 0xbf, 0xda, 0x8e, 0x42, 0xba, 0x89,
-	0x46, 0x4c, 0x6d, 0x12, 0xf6, 0x12, 0xb6, 0x89, 0x8a, 0x64, 0x98, 0xa2, 0x84, 0xea, 0xdc, 0xe4,
-	0x96, 0x9f, 0xcc, 0x86, 0x35, 0x69, 0x25, 0x61, 0x3a, 0xf7, 0x15, 0x01, 0xc3, 0xaa, 0xd4, 0xfd,
-	0xa4, 0x03, 0xa3, 0xb4, 0x97, 0xcd, 0x26, 0x69, 0x56, 0x12, 0xd2, 0x8e, 0x51, 0x0c, 0xc5, 0x98,
-	0xfe, 0x63, 0xcf, 0x98, 0x98, 0x5e, 0x40, 0x25, 0x6d, 0xcd, 0x8b, 0x44, 0x99, 0x60, 0xce, 0xcb,
-	0xfd, 0x56, 0x1f, 0x0c, 0xab, 0xc1, 0x3e, 0x80, 0xfd, 0xf6, 0x72, 0x9a, 0xa7, 0x96, 0x4b, 0xe0,
-	0xb2, 0x96, 0xa3, 0xf6, 0x36, 0x1d, 0xba, 0x60, 0x87, 0x67, 0xe4, 0x48, 0x13, 0xd6, 0x3e, 0x6e,
-	0x3a, 0xc1, 0xcf, 0xeb, 0xf3, 0x4f, 0xc3, 0x17, 0xde, 0xf0, 0x5b, 0x7a, 0x0c, 0x42, 0xbf, 0xad,
-	0xdd, 0x4c, 0x39, 0x58, 0x7b, 0x07, 0x1f, 0x64, 0x1e, 0x6e, 0x2a, 0x1e, 0xe8, 0xe1, 0xa6, 0xc7,
-	0xa0, 0x9f, 0x04, 0x9d, 0x16, 0x53, 0x95, 0x86, 0xd9, 0x21, 0xa3, 0xff, 0x4a, 0xd0, 0x69, 0x99,
-	0x3d, 0x63, 0x28, 0xe8, 0xfd, 0x50, 0xaa, 0x91, 0xb8, 0x1a, 0xf9, 0x2c, 0xcd, 0x84, 0xb0, 0x0d,
-	0xdd, 0xcf, 0x0c, 0x6e, 0x29, 0xd8, 0xac, 0xa8, 0x57, 0x70, 0x5f, 0x06, 0x91, 0xa7, 0x19, 0xb5,
-	0x61, 0x80, 0x27, 0x9d, 0x10, 0xbb, 0xbd, 0x85, 0x93, 0x2b, 0x17, 0x15, 0x5a, 0x7c, 0x0c, 0xbf,
-	0x87, 0x2c, 0xf8, 0xb8, 0x6f, 0x14, 0x60, 0xcc, 0x4c, 0x21, 0x8d, 0x7e, 0xc1, 0x98, 0x2b, 0xae,
-	0x3e, 0x57, 0xf4, 0x87, 0x8a, 0x78, 0x2d, 0x6d, 0x06, 0xbd, 0x0f, 0x46, 0x79, 0x96, 0x2b, 0x69,
-	0x31, 0x29, 0x98, 0x1b, 0xce, 0xac, 0x5e, 0x88, 0x4d, 0x5c, 0xb6, 0x17, 0x86, 0x41, 0xc0, 0x63,
-	0x42, 0xcd, 0xe0, 0x38, 0xf1, 0x94, 0x58, 0xba, 0x17, 0xf6, 0xc0, 0xc3, 0x3d, 0x29, 0x48, 0x0d,
-	0xac, 0xbf, 0x87, 0x06, 0xf6, 0x2f, 0x1d, 0x28, 0xf7, 0xca, 0xa3, 0x7d, 0xe4, 0xe1, 0x38, 0xac,
-	0xfa, 0xd4, 0x3d, 0x7e, 0x7d, 0x07, 0x1f, 0x3f, 0xf7, 0x13, 0x05, 0x28, 0xae, 0x85, 0xb5, 0x85,
-	0x59, 0xf4, 0xb7, 0xba, 0xde, 0x9b, 0xfa, 0x99, 0x9c, 0xf7, 0xa6, 0x46, 0x19, 0x72, 0xce, 0x53,
-	0x53, 0x4d, 0x18, 0x65, 0x5e, 0x35, 0xa9, 0xcb, 0x88, 0xe3, 0xd1, 0x93, 0x07, 0xcc, 0xb7, 0xa1,
-	0x57, 0x15, 0x3b, 0xbb, 0x0e, 0xc2, 0x26, 0x71, 0xb4, 0x02, 0x67, 0x78, 0xda, 0xda, 0x39, 0xd2,
-	0xf4, 0x76, 0x32, 0xe9, 0xe9, 0xee, 0x93, 0x4f, 0x08, 0xce, 0x75, 0xa3, 0xe0, 0xbc, 0x7a, 0xee,
-	0xef, 0xf7, 0x83, 0xe6, 0xcb, 0x3a, 0x80, 0xd4, 0x7b, 0x29, 0xe3, 0xb9, 0x5c, 0xb1, 0xe2, 0xb9,
-	0x94, 0xee, 0x40, 0xbe, 0x93, 0x98, 0xce, 0x4a, 0xda, 0xa8, 0x06, 0x69, 0xb6, 0x45, 0x1f, 0x55,
-	0xa3, 0xae, 0x92, 0x66, 0x1b, 0xb3, 0x12, 0x75, 0x9b, 0xb6, 0xbf, 0xe7, 0x6d, 0xda, 0x06, 0x14,
-	0xeb, 0x5e, 0xa7, 0x4e, 0x44, 0x8c, 0xaf, 0x05, 0x27, 0x35, 0xbb, 0xdf, 0xc3, 0x9d, 0xd4, 0xec,
-	0x5f, 0xcc, 0x19, 0x50, 0xa1, 0xdd, 0x90, 0x41, 0x4f, 0xc2, 0x5c, 0x6f, 0x41, 0x68, 0xab, 0x38,
-	0x2a, 0x2e, 0xb4, 0xd5, 0x4f, 0x9c, 0x32, 0x43, 0x6d, 0x18, 0xac, 0xf2, 0xac, 0x3f, 0x42, 0xf7,
-	0x5c, 0xb4, 0x71, 0x5d, 0x98, 0x11, 0xe4, 0x76, 0x35, 0xf1, 0x03, 0x4b, 0x36, 0xee, 0x25, 0x28,
-	0x69, 0xcf, 0xde, 0xd0, 0xcf, 0xa0, 0x12, 0xce, 0x68, 0x9f, 0x61, 0xce, 0x4b, 0x3c, 0xcc, 0x4a,
-	0xdc, 0xaf, 0xf7, 0x83, 0xb2, 0xaa, 0xea, 0x97, 0x5b, 0xbd, 0xaa, 0x96, 0x1e, 0xcb, 0x48, 0xf4,
-	0x10, 0x06, 0x58, 0x94, 0xd2, 0xe5, 0xde, 0x22, 0x51, 0x5d, 0xd9, 0x43, 0xb2, 0xe2, 0x72, 0x45,
-	0x2f, 0xc4, 0x26, 0x2e, 0x3d, 0x5c, 0xb5, 0x44, 0x6c, 0x47, 0x36, 0x74, 0x5f, 0xc6, 0x7c, 0x60,
-	0x85, 0xc1, 0xb2, 0x71, 0xb4, 0xb4, 0x50, 0x10, 0x11, 0xea, 0x6b, 0xc3, 0xb5, 0xa8, 0x51, 0xe5,
-	0x21, 0x79, 0x3a, 0x04, 0x1b, 0x5c, 0xd1, 0x02, 0x9c, 0x8e, 0x49, 0xb2, 0x7a, 0x33, 0x20, 0x91,
-	0xca, 0x83, 0x21, 0xd2, 0xbd, 0xa8, 0xab, 0x3f, 0x95, 0x2c, 0x02, 0xee, 0xae, 0x93, 0x1b, 0x1d,
-	0x5d, 0x3c, 0x74, 0x74, 0xf4, 0x1c, 0x8c, 0x6f, 0x7a, 0x7e, 0xb3, 0x13, 0x91, 0x9e, 0x31, 0xd6,
-	0xf3, 0x99, 0x72, 0xdc, 0x55, 0x83, 0xdd, 0x3e, 0x6b, 0x7a, 0xf5, 0xb8, 0x3c, 0xa8, 0xdd, 0x3e,
-	0xa3, 0x00, 0xcc, 0xe1, 0xee, 0xef, 0x38, 0xc0, 0x33, 0x67, 0x4d, 0x6f, 0x6e, 0xfa, 0x81, 0x9f,
-	0xec, 0xa0, 0xaf, 0x38, 0x30, 0x1e, 0x84, 0x35, 0x32, 0x1d, 0x24, 0xbe, 0x04, 0xda, 0x7b, 0xed,
-	0x80, 0xf1, 0xba, 0x96, 0x21, 0xcf, 0x4d, 0x86, 0x59, 0x28, 0xee, 0x6a, 0x86, 0x7b, 0x01, 0xce,
-	0xe5, 0x12, 0x70, 0xbf, 0xdf, 0x07, 0x66, 0x02, 0x30, 0xf4, 0x0c, 0x14, 0x9b, 0x2c, 0x81, 0x8d,
-	0x73, 0xc4, 0xcc, 0x6e, 0x6c, 0xac, 0x78, 0x86, 0x1b, 0x4e, 0x09, 0xcd, 0x41, 0x89, 0x65, 0x15,
-	0x13, 0xe9, 0x85, 0x0a, 0xc6, 0x96, 0x5b, 0xc2, 0x69, 0xd1, 0x6d, 0xf3, 0x27, 0xd6, 0xab, 0xa1,
-	0x57, 0x60, 0x70, 0x83, 0xa7, 0x5e, 0xb5, 0xe7, 0xfd, 0x15, 0xb9, 0x5c, 0x99, 0x8e, 0x2b, 0x13,
-	0xbb, 0xde, 0x4e, 0xff, 0xc5, 0x92, 0x23, 0xda, 0x81, 0x21, 0x4f, 0x7e, 0xd3, 0x7e, 0x5b, 0x57,
-	0x81, 0x8c, 0xf9, 0x23, 0x42, 0xad, 0xe4, 0x37, 0x54, 0xec, 0x32, 0xc1, 0x6b, 0xc5, 0x03, 0x05,
-	0xaf, 0x7d, 0xd3, 0x01, 0x48, 0xdf, 0xc9, 0x41, 0xb7, 0x60, 0x28, 0x7e, 0xd2, 0x30, 0x38, 0xd9,
-	0x48, 0x23, 0x21, 0x28, 0x6a, 0x57, 0xad, 0x05, 0x04, 0x2b, 0x6e, 0x77, 0x32, 0x92, 0xfd, 0xc4,
-	0x81, 0xb3, 0x79, 0xef, 0xf9, 0xbc, 0x83, 0x2d, 0x3e, 0xb4, 0x82, 0xc7, 0x2b, 0xac, 0x45, 0x64,
-	0xd3, 0xbf, 0x95, 0x93, 0x00, 0x9c, 0x17, 0xe0, 0x14, 0xc7, 0xfd, 0xb3, 0x41, 0x50, 0x8c, 0x8f,
-	0xc9, 0x9e, 0xf6, 0x08, 0x3d, 0xfb, 0xd6, 0x53, 0x9d, 0x4b, 0xe1, 0x61, 0x06, 0xc5, 0xa2, 0x94,
-	0x9e, 0x7f, 0xe5, 0xb5, 0x0b, 0x21, 0xb2, 0xd9, 0x2c, 0x94, 0xd7, 0x33, 0xb0, 0x2a, 0xcd, 0xb3,
-	0xd0, 0x15, 0x4f, 0xc4, 0x42, 0x37, 0x60, 0xdf, 0x42, 0xd7, 0x02, 0x14, 0xf3, 0x85, 0xc2, 0xcc,
-	0x62, 0x82, 0xd1, 0xc8, 0xa1, 0x1d, 0x06, 0x95, 0x2e, 0x22, 0x38, 0x87, 0x30, 0x8b, 0xa6, 0x09,
-	0x9b, 0x64, 0x1a, 0x5f, 0x13, 0x87, 0xc8, 0x34, 0x9a, 0x86, 0x83, 0xb1, 0x2c, 0x3f, 0xa2, 0x49,
-	0x0c, 0x7d, 0xc7, 0xd9, 0xc7, 0xe6, 0x38, 0x6c, 0x6b, 0x0b, 0xca, 0xcd, 0xbe, 0xc8, 0x4e, 0xc4,
-	0x47, 0x31, 0x64, 0x7e, 0xd5, 0x81, 0xd3, 0x24, 0xa8, 0x46, 0x3b, 0x8c, 0x8e, 0xa0, 0x26, 0x82,
-	0x1d, 0xae, 0xdb, 0x58, 0xeb, 0x57, 0xb2, 0xc4, 0xb9, 0x4f, 0xb1, 0x0b, 0x8c, 0xbb, 0x9b, 0x81,
-	0x56, 0x61, 0xa8, 0xea, 0x89, 0x79, 0x51, 0x3a, 0xcc, 0xbc, 0xe0, 0x2e, 0xdb, 0x69, 0x31, 0x1b,
-	0x14, 0x11, 0xf7, 0x47, 0x05, 0x38, 0x93, 0xd3, 0x24, 0x76, 0x23, 0xb0, 0x45, 0x17, 0xc0, 0x62,
-	0x2d, 0xbb, 0xfc, 0x97, 0x04, 0x1c, 0x2b, 0x0c, 0xb4, 0x06, 0x67, 0xb7, 0x5a, 0x71, 0x4a, 0x65,
-	0x36, 0x0c, 0x12, 0x72, 0x4b, 0x0a, 0x03, 0x19, 0x08, 0x71, 0x76, 0x29, 0x07, 0x07, 0xe7, 0xd6,
-	0xa4, 0xda, 0x12, 0x09, 0xbc, 0x8d, 0x26, 0x49, 0x8b, 0x44, 0xd8, 0x9e, 0xd2, 0x96, 0xae, 0x64,
-	0xca, 0x71, 0x57, 0x0d, 0xf4, 0xa6, 0x03, 0xf7, 0xc5, 0x24, 0xda, 0x26, 0x51, 0xc5, 0xaf, 0x91,
-	0xd9, 0x4e, 0x9c, 0x84, 0x2d, 0x12, 0x1d, 0xd1, 0xca, 0x3e, 0xb9, 0xb7, 0x3b, 0x79, 0x5f, 0xa5,
-	0x37, 0x35, 0xbc, 0x1f, 0x2b, 0xf7, 0x4d, 0x07, 0xc6, 0x2a, 0xcc, 0x06, 0xa3, 0x54, 0x77, 0xdb,
-	0xf9, 0x77, 0x1f, 0x51, 0xc9, 0x61, 0x32, 0x42, 0xd8, 0x4c, 0xe7, 0xe2, 0xbe, 0x08, 0xe3, 0x15,
-	0xd2, 0xf2, 0xda, 0x0d, 0x76, 0x4f, 0x9e, 0x07, 0x02, 0x5e, 0x82, 0xe1, 0x58, 0xc2, 0xb2, 0x2f,
-	0x72, 0x29, 0x64, 0x9c, 0xe2, 0xa0, 0x87, 0x79, 0xd0, 0xa2, 0xbc, 0xd2, 0x36, 0xcc, 0x0f, 0x39,
-	0x3c, 0xd2, 0x31, 0xc6, 0xb2, 0xcc, 0xfd, 0x66, 0x01, 0x46, 0xd2, 0xfa, 0x64, 0x13, 0xd5, 0xe1,
-	0x54, 0x55, 0xbb, 0x0e, 0x9a, 0x5e, 0xc4, 0x39, 0xf8, 0xcd, 0x51, 0x9e, 0x16, 0xdc, 0x24, 0x82,
-	0xb3, 0x54, 0x0f, 0x1f, 0x21, 0xfa, 0x4a, 0x26, 0x42, 0xd4, 0xca, 0x53, 0x1f, 0x95, 0x9d, 0xa0,
-	0xaa, 0xe2, 0x4b, 0xc9, 0xa6, 0x0c, 0x5d, 0xe9, 0x0a, 0x38, 0xfd, 0x7c, 0x01, 0x4e, 0xa9, 0x71,
-	0x12, 0xce, 0xee, 0xd7, 0xb2, 0x71, 0xa1, 0xd8, 0x46, 0x8e, 0x2d, 0xf3, 0xc3, 0xef, 0x13, 0x1b,
-	0xfa, 0x5a, 0x36, 0x36, 0xf4, 0x58, 0xd9, 0x77, 0xf9, 0xef, 0xbf, 0x59, 0x80, 0x21, 0x95, 0xf1,
-	0xeb, 0x19, 0x28, 0xb2, 0x63, 0xf3, 0xdd, 0x29, 0xff, 0xec, 0x08, 0x8e, 0x39, 0x25, 0x4a, 0x92,
-	0xc5, 0x9e, 0x1d, 0x39, 0x53, 0xf4, 0x30, 0x37, 0x82, 0x7b, 0x51, 0x82, 0x39, 0x25, 0xb4, 0x04,
-	0x7d, 0x24, 0xa8, 0x89, 0xc9, 0x73, 0x78, 0x82, 0xec, 0xe1, 0xc0, 0x2b, 0x41, 0x0d, 0x53, 0x2a,
-	0x2c, 0xed, 0x20, 0x57, 0xf6, 0x32, 0x17, 0x2f, 0x84, 0xa6, 0x27, 0x4a, 0xdd, 0x19, 0x30, 0x52,
-	0x52, 0x1e, 0xe9, 0xe2, 0xcf, 0xaf, 0xf6, 0xc1, 0x40, 0xa5, 0xb3, 0x41, 0xcf, 0x44, 0xdf, 0x70,
-	0xe0, 0xcc, 0xcd, 0x4c, 0x2a, 0xf6, 0x74, 0x91, 0x5e, 0xb7, 0xe7, 0x4c, 0xd0, 0x63, 0x28, 0x95,
-	0xe9, 0x2d, 0xa7, 0x10, 0xe7, 0x35, 0xc7, 0xc8, 0x86, 0xdc, 0x77, 0x2c, 0xd9, 0x90, 0x6f, 0x1d,
-	0xf3, 0xe5, 0xa4, 0xd1, 0x5e, 0x17, 0x93, 0xdc, 0xdf, 0x2f, 0x02, 0xf0, 0xaf, 0xb1, 0xda, 0x4e,
-	0x0e, 0x62, 0x56, 0x7c, 0x0a, 0x46, 0xea, 0x24, 0x20, 0x91, 0x8c, 0x90, 0xcd, 0xbc, 0x22, 0xb6,
-	0xa0, 0x95, 0x61, 0x03, 0x93, 0x4d, 0x96, 0x20, 0x89, 0x76, 0xb8, 0x9e, 0x9f, 0xbd, 0x80, 0xa4,
-	0x4a, 0xb0, 0x86, 0x85, 0xa6, 0x0c, 0xef, 0x1d, 0x0f, 0x04, 0x19, 0xdb, 0xc7, 0xd9, 0xf6, 0x7e,
-	0x18, 0x33, 0x13, 0x0d, 0x09, 0x6d, 0x53, 0x05, 0x6e, 0x98, 0xf9, 0x89, 0x70, 0x06, 0x9b, 0x2e,
-	0x84, 0x5a, 0xb4, 0x83, 0x3b, 0x81, 0x50, 0x3b, 0xd5, 0x42, 0x98, 0x63, 0x50, 0x2c, 0x4a, 0x59,
-	0x86, 0x16, 0xb6, 0x01, 0x73, 0xb8, 0xc8, 0xf2, 0x92, 0x66, 0x68, 0xd1, 0xca, 0xb0, 0x81, 0x49,
-	0x39, 0x08, 0xb3, 0x2c, 0x98, 0x4b, 0x2d, 0x63, 0x4b, 0x6d, 0xc3, 0x58, 0x68, 0x9a, 0x93, 0xb8,
-	0x0e, 0xf6, 0x9e, 0x03, 0x4e, 0x3d, 0xa3, 0x2e, 0x0f, 0xb8, 0xc9, 0x58, 0x9f, 0x32, 0xf4, 0xa9,
-	0xde, 0xad, 0x5f, 0xbf, 0x19, 0x31, 0x03, 0xac, 0x7b, 0xde, 0x90, 0x59, 0x83, 0xb3, 0xed, 0xb0,
-	0xb6, 0x16, 0xf9, 0x61, 0xe4, 0x27, 0x3b, 0xb3, 0x4d, 0x2f, 0x8e, 0xd9, 0xc4, 0x18, 0x35, 0xf5,
-	0xb1, 0xb5, 0x1c, 0x1c, 0x9c, 0x5b, 0x93, 0x1e, 0xc8, 0xda, 0x02, 0xc8, 0xc2, 0x1c, 0x8b, 0x7c,
-	0x27, 0x93, 0x88, 0x58, 0x95, 0xba, 0x67, 0xe0, 0x74, 0xa5, 0xd3, 0x6e, 0x37, 0x7d, 0x52, 0x53,
-	0xde, 0x31, 0xf7, 0x03, 0x70, 0x4a, 0x64, 0x56, 0x56, 0xda, 0xcf, 0xa1, 0x32, 0xfb, 0xbb, 0x3f,
-	0x0f, 0xa7, 0x32, 0x5b, 0xe9, 0x1d, 0x22, 0x77, 0xdc, 0x5f, 0x29, 0xf0, 0x2a, 0x5a, 0x10, 0x19,
-	0x7a, 0x1d, 0x40, 0x69, 0x30, 0x32, 0x3f, 0xc3, 0x35, 0x8b, 0xbb, 0x1a, 0x95, 0x65, 0x6c, 0x29,
-	0x28, 0x48, 0x8c, 0x35, 0x8e, 0x28, 0x80, 0x41, 0x76, 0x55, 0x84, 0xc8, 0x1b, 0xba, 0x0b, 0x96,
-	0xae, 0x5b, 0x70, 0xed, 0x6b, 0x85, 0xd3, 0xc6, 0x92, 0x89, 0xfb, 0x99, 0x02, 0xe4, 0x07, 0x01,
-	0xa2, 0xd7, 0xb3, 0xfa, 0x9e, 0x1d, 0x6d, 0xc7, 0xd4, 0x60, 0x44, 0xf6, 0xe3, 0x3c, 0xf5, 0x31,
-	0x90, 0x17, 0x6a, 0x0a, 0xb6, 0x62, 0xf2, 0xb5, 0x1b, 0x35, 0x7c, 0x6b, 0xd6, 0xef, 0xe6, 0xb8,
-	0xff, 0xd3, 0x81, 0xd2, 0xfa, 0xfa, 0xb2, 0xda, 0x25, 0x31, 0x9c, 0x8f, 0xb9, 0xb7, 0x8e, 0x45,
-	0x3a, 0xcc, 0x86, 0xad, 0x36, 0x0f, 0x7c, 0x10, 0x01, 0x19, 0x2c, 0x3f, 0x76, 0x25, 0x17, 0x03,
-	0xf7, 0xa8, 0x89, 0x16, 0xe1, 0x8c, 0x5e, 0x52, 0xd1, 0xde, 0x1f, 0x2d, 0x8a, 0x5c, 0x58, 0xdd,
-	0xc5, 0x38, 0xaf, 0x4e, 0x96, 0x94, 0x30, 0x0c, 0x0b, 0xd7, 0x64, 0x17, 0x29, 0x51, 0x8c, 0xf3,
-	0xea, 0xb8, 0xab, 0x50, 0x5a, 0xf7, 0x22, 0xd5, 0xf1, 0x0f, 0xc2, 0x78, 0x35, 0x6c, 0xc9, 0x9d,
-	0x7f, 0x99, 0x6c, 0x93, 0xa6, 0xe8, 0x32, 0x7f, 0xd5, 0x27, 0x53, 0x86, 0xbb, 0xb0, 0xdd, 0xdf,
-	0x7e, 0x10, 0xd4, 0x65, 0xde, 0x03, 0x6c, 0x4e, 0x6d, 0x15, 0x1e, 0x5d, 0xb4, 0x1c, 0x1e, 0xad,
-	0xc4, 0x74, 0x26, 0x44, 0x3a, 0x49, 0x43, 0xa4, 0x07, 0x6c, 0x87, 0x48, 0x2b, 0x7d, 0xb5, 0x2b,
-	0x4c, 0xfa, 0x4b, 0x0e, 0x8c, 0x04, 0x61, 0x8d, 0x28, 0x4f, 0xe6, 0x20, 0x5b, 0xe1, 0x2f, 0xd8,
-	0xbb, 0x6d, 0xc2, 0xc3, 0x7d, 0x05, 0x79, 0x1e, 0xba, 0xaf, 0x76, 0x37, 0xbd, 0x08, 0x1b, 0xed,
-	0x40, 0xf3, 0x9a, 0x89, 0x98, 0x7b, 0x62, 0xee, 0xcf, 0x3b, 0x6a, 0xdd, 0xd1, 0xde, 0x7b, 0x4b,
-	0x53, 0xb9, 0x86, 0x6d, 0x99, 0x3e, 0xe5, 0xc5, 0x4b, 0xcd, 0xa1, 0x24, 0x53, 0xbc, 0xa7, 0xaa,
-	0x98, 0x0b, 0x03, 0x3c, 0xc6, 0x5f, 0x64, 0x5d, 0x63, 0x7e, 0x4e, 0x1e, 0xff, 0x8f, 0x45, 0x09,
-	0x4a, 0x64, 0xd4, 0x4b, 0xc9, 0xd6, 0x13, 0x2c, 0x46, 0x54, 0x4d, 0x7e, 0xd8, 0x0b, 0x7a, 0x5a,
-	0x3f, 0xc2, 0x8f, 0x1c, 0xe4, 0x08, 0x3f, 0xda, 0xf3, 0xf8, 0xfe, 0x39, 0x07, 0x46, 0xaa, 0xda,
-	0x93, 0x28, 0xe5, 0x47, 0x6d, 0xbd, 0x4c, 0x9f, 0xf7, 0x72, 0x0d, 0x77, 0x9f, 0x19, 0x4f, 0xb0,
-	0x18, 0xdc, 0x59, 0xaa, 0x59, 0x66, 0xaf, 0x60, 0x5a, 0x83, 0x95, 0x14, 0x2e, 0xa6, 0xfd, 0x43,
-	0x46, 0x0f, 0x53, 0x18, 0x16, 0xbc, 0xd0, 0xab, 0x30, 0x24, 0xaf, 0x89, 0x88, 0xeb, 0x14, 0xd8,
-	0x86, 0x3f, 0xc3, 0x74, 0x9a, 0xca, 0xfc, 0x94, 0x1c, 0x8a, 0x15, 0x47, 0xd4, 0x80, 0xbe, 0x9a,
-	0x57, 0x17, 0x17, 0x2b, 0x56, 0xec, 0xe4, 0xff, 0x95, 0x3c, 0xd9, 0xe9, 0x6e, 0x6e, 0x7a, 0x01,
-	0x53, 0x16, 0xe8, 0x56, 0xfa, 0x02, 0xc5, 0xb8, 0xb5, 0xdd, 0xd7, 0xd4, 0xb0, 0xb8, 0x4e, 0xd0,
-	0xf5, 0xa0, 0x45, 0x4d, 0xf8, 0x99, 0xff, 0x1a, 0x63, 0x3b, 0x6f, 0x27, 0x81, 0x30, 0x4f, 0x09,
-	0x94, 0xfa, 0xaa, 0x29, 0x97, 0x46, 0x92, 0xb4, 0xcb, 0x3f, 0x6b, 0x8b, 0x0b, 0x4b, 0x6c, 0xc3,
-	0xb8, 0xd0, 0xff, 0x30, 0xa3, 0x8e, 0x9a, 0xea, 0x81, 0xfb, 0x9f, 0xb3, 0xb5, 0xb7, 0xf0, 0xc0,
-	0x9a, 0xbc, 0xe7, 0xec, 0xd1, 0x15, 0x18, 0xe4, 0x4f, 0x23, 0xf1, 0x8b, 0x2d, 0xa5, 0xcb, 0x13,
-	0xbd, 0x1f, 0x58, 0x4a, 0x37, 0x0a, 0xfe, 0x3b, 0xc6, 0xb2, 0x2e, 0xfa, 0xbc, 0x03, 0x63, 0x54,
-	0xa2, 0xa6, 0x6f, 0x39, 0x95, 0x91, 0x2d, 0x99, 0x75, 0x3d, 0xa6, 0x1a, 0x89, 0x94, 0x35, 0xea,
-	0x84, 0xb5, 0x68, 0xb0, 0xc3, 0x19, 0xf6, 0xe8, 0x35, 0x18, 0x8a, 0xfd, 0x1a, 0xa9, 0x7a, 0x51,
-	0x5c, 0x3e, 0x73, 0x3c, 0x4d, 0x49, 0x3d, 0x5b, 0x82, 0x11, 0x56, 0x2c, 0xd1, 0x6f, 0xb0, 0xb7,
-	0x76, 0xab, 0x0d, 0x7f, 0x9b, 0x2c, 0x87, 0x55, 0x7e, 0x22, 0x38, 0x6b, 0x6b, 0xed, 0x4b, 0x1f,
-	0x9e, 0xa4, 0x2c, 0x1c, 0x3e, 0x26, 0x3b, 0x9c, 0xe5, 0x8f, 0x7e, 0xc5, 0x81, 0x73, 0xfc, 0x89,
-	0x8c, 0xec, 0xab, 0x2f, 0xe7, 0x8e, 0x68, 0xdd, 0x61, 0x37, 0x72, 0xa6, 0xf3, 0x48, 0xe2, 0x7c,
-	0x4e, 0x2c, 0xa1, 0xb5, 0xf9, 0xf4, 0xd6, 0x79, 0xab, 0x1e, 0xde, 0x83, 0x3f, 0xb7, 0x85, 0x9e,
-	0x80, 0x52, 0x5b, 0x6c, 0x87, 0x7e, 0xdc, 0x62, 0xf7, 0xab, 0xfa, 0xf8, 0xcd, 0xd7, 0xb5, 0x14,
-	0x8c, 0x75, 0x1c, 0x23, 0xbb, 0xf9, 0x63, 0xfb, 0x65, 0x37, 0x47, 0xd7, 0xa1, 0x94, 0x84, 0x4d,
-	0x91, 0xe0, 0x37, 0x2e, 0x97, 0xd9, 0x0c, 0xbc, 0x98, 0xb7, 0xb6, 0xd6, 0x15, 0x5a, 0x7a, 0x08,
-	0x4e, 0x61, 0x31, 0xd6, 0xe9, 0xb0, 0x88, 0x74, 0xf1, 0xf4, 0x48, 0xc4, 0x4e, 0xbf, 0xf7, 0x66,
-	0x22, 0xd2, 0xf5, 0x42, 0x6c, 0xe2, 0xa2, 0x05, 0x38, 0xdd, 0xee, 0x3a, 0x3e, 0xf3, 0x7b, 0x9d,
-	0x2a, 0x78, 0xa4, 0xfb, 0xec, 0xdc, 0x5d, 0xa7, 0x47, 0x06, 0xef, 0xfb, 0x8f, 0x92, 0xc1, 0x1b,
-	0xd5, 0xe0, 0x7e, 0xaf, 0x93, 0x84, 0x2c, 0x25, 0x93, 0x59, 0x85, 0x87, 0xdc, 0x3f, 0xc8, 0xa3,
-	0xf8, 0xf7, 0x76, 0x27, 0xef, 0x9f, 0xde, 0x07, 0x0f, 0xef, 0x4b, 0x05, 0xbd, 0x0c, 0x43, 0x44,
-	0x64, 0x21, 0x2f, 0xff, 0x8c, 0xad, 0xad, 0xdf, 0xcc, 0x6b, 0x2e, 0xa3, 0x99, 0x39, 0x0c, 0x2b,
-	0x7e, 0x68, 0x1d, 0x4a, 0x8d, 0x30, 0x4e, 0xa6, 0x9b, 0xbe, 0x17, 0x93, 0xb8, 0xfc, 0x00, 0x9b,
-	0x0a, 0xb9, 0x1a, 0xd5, 0x55, 0x89, 0x96, 0xce, 0x84, 0xab, 0x69, 0x4d, 0xac, 0x93, 0x41, 0x84,
-	0x79, 0x6f, 0xd9, 0x7d, 0x03, 0xe9, 0x99, 0xba, 0xc8, 0x3a, 0xf6, 0x48, 0x1e, 0xe5, 0xb5, 0xb0,
-	0x56, 0x31, 0xb1, 0x95, 0xfb, 0x56, 0x07, 0xe2, 0x2c, 0x4d, 0xf4, 0x14, 0x8c, 0xb4, 0xc3, 0x5a,
-	0xa5, 0x4d, 0xaa, 0x6b, 0x5e, 0x52, 0x6d, 0x94, 0x27, 0x4d, 0x33, 0xdc, 0x9a, 0x56, 0x86, 0x0d,
-	0x4c, 0xd4, 0x86, 0xc1, 0x16, 0x4f, 0xc1, 0x51, 0x7e, 0xc8, 0xd6, 0x89, 0x45, 0xe4, 0xf4, 0x10,
-	0x96, 0x01, 0xfe, 0x03, 0x4b, 0x36, 0xe8, 0x1f, 0x3a, 0x70, 0x2a, 0x73, 0x0f, 0xb0, 0xfc, 0x2e,
-	0x9b, 0x4e, 0x0f, 0x8d, 0xf0, 0xcc, 0x23, 0x6c, 0xf8, 0x4c, 0xe0, 0xed, 0x6e, 0x10, 0xce, 0xb6,
-	0x88, 0x8f, 0x0b, 0xcb, 0xa3, 0x53, 0x7e, 0xd8, 0xde, 0xb8, 0x30, 0x82, 0x72, 0x5c, 0xd8, 0x0f,
-	0x2c, 0xd9, 0xa0, 0xc7, 0x60, 0x50, 0xe4, 0xc6, 0x2c, 0x3f, 0x62, 0xfa, 0xc4, 0x45, 0x8c, 0x2f,
-	0x96, 0xe5, 0x5d, 0xb9, 0x71, 0x1e, 0xb7, 0x95, 0x1b, 0x47, 0x9d, 0xf7, 0x0e, 0x9f, 0x1b, 0x67,
-	0xe2, 0x03, 0x70, 0xba, 0xeb, 0x94, 0x78, 0xa8, 0xe4, 0x34, 0x77, 0x99, 0xdc, 0xc6, 0xfd, 0x2d,
-	0x07, 0xf4, 0x6c, 0x08, 0xd6, 0xdf, 0x33, 0x7a, 0x0a, 0x46, 0xaa, 0xfc, 0xc1, 0x58, 0x9e, 0x4f,
-	0xa1, 0xdf, 0xb4, 0xf2, 0xce, 0x6a, 0x65, 0xd8, 0xc0, 0x74, 0xaf, 0x02, 0xea, 0x7e, 0x6c, 0xe2,
-	0x48, 0xee, 0x92, 0x7f, 0xec, 0xc0, 0xa8, 0xa1, 0xde, 0x58, 0x77, 0xe5, 0xce, 0x03, 0x6a, 0xf9,
-	0x51, 0x14, 0x46, 0xfa, 0xcb, 0x9c, 0x22, 0xe7, 0x09, 0x0b, 0xf1, 0x58, 0xe9, 0x2a, 0xc5, 0x39,
-	0x35, 0xdc, 0x7f, 0xd6, 0x0f, 0xe9, 0x1d, 0x05, 0x95, 0x8a, 0xdb, 0xe9, 0x99, 0x8a, 0xfb, 0x71,
-	0x18, 0x7a, 0x31, 0x0e, 0x83, 0xb5, 0x34, 0x61, 0xb7, 0xfa, 0x16, 0x4f, 0x57, 0x56, 0xaf, 0x31,
-	0x4c, 0x85, 0xc1, 0xb0, 0x5f, 0x9a, 0xf7, 0x9b, 0x49, 0x77, 0x46, 0xe7, 0xa7, 0x9f, 0xe1, 0x70,
-	0xac, 0x30, 0xd8, 0x23, 0x9d, 0xdb, 0x44, 0x99, 0xff, 0xd3, 0x47, 0x3a, 0xf9, 0x3b, 0x32, 0xac,
-	0x0c, 0x5d, 0x82, 0x61, 0xe5, 0x3a, 0x10, 0xfe, 0x08, 0x35, 0x52, 0xca, 0xbf, 0x80, 0x53, 0x1c,
-	0xa6, 0xbb, 0x0a, 0x73, 0xb3, 0xb0, 0xf6, 0x54, 0x6c, 0x9c, 0xa4, 0x32, 0x06, 0x6c, 0xbe, 0x61,
-	0x49, 0x30, 0x56, 0x2c, 0xf3, 0xdc, 0xd9, 0xc3, 0xc7, 0xe2, 0xce, 0xd6, 0x2e, 0xcc, 0x14, 0x0f,
-	0x7a, 0x61, 0xc6, 0x9c, 0xdb, 0x43, 0x07, 0x9a, 0xdb, 0x9f, 0xea, 0x83, 0xc1, 0x67, 0x49, 0xc4,
-	0xde, 0x42, 0x78, 0x0c, 0x06, 0xb7, 0xf9, 0xbf, 0xd9, 0xdb, 0xd6, 0x02, 0x03, 0xcb, 0x72, 0xfa,
-	0xdd, 0x36, 0x3a, 0x7e, 0xb3, 0x36, 0x97, 0xae, 0xe2, 0x34, 0x57, 0xa9, 0x2c, 0xc0, 0x29, 0x0e,
-	0xad, 0x50, 0xa7, 0x87, 0x90, 0x56, 0xcb, 0x4f, 0xb2, 0xd1, 0x69, 0x0b, 0xb2, 0x00, 0xa7, 0x38,
-	0xe8, 0x11, 0x18, 0xa8, 0xfb, 0xc9, 0xba, 0x57, 0xcf, 0xfa, 0x43, 0x17, 0x18, 0x14, 0x8b, 0x52,
-	0xe6, 0x0c, 0xf3, 0x93, 0xf5, 0x88, 0x30, 0x23, 0x74, 0x57, 0xba, 0x98, 0x05, 0xad, 0x0c, 0x1b,
-	0x98, 0xac, 0x49, 0xa1, 0xe8, 0x99, 0x08, 0xcd, 0x4d, 0x9b, 0x24, 0x0b, 0x70, 0x8a, 0x43, 0xe7,
-	0x7f, 0x35, 0x6c, 0xb5, 0xfd, 0xa6, 0x08, 0x1a, 0xd7, 0xe6, 0xff, 0xac, 0x80, 0x63, 0x85, 0x41,
-	0xb1, 0xa9, 0x08, 0xa3, 0xe2, 0x27, 0xfb, 0x7c, 0xe2, 0x9a, 0x80, 0x63, 0x85, 0xe1, 0x3e, 0x0b,
-	0xa3, 0x7c, 0x25, 0xcf, 0x36, 0x3d, 0xbf, 0xb5, 0x30, 0x8b, 0xae, 0x74, 0x5d, 0xb4, 0x78, 0x2c,
-	0xe7, 0xa2, 0xc5, 0x39, 0xa3, 0x52, 0xf7, 0x85, 0x0b, 0xf7, 0x07, 0x05, 0x18, 0x3a, 0xc1, 0x37,
-	0x65, 0x4f, 0xfc, 0x79, 0x74, 0x74, 0x2b, 0xf3, 0x9e, 0xec, 0x9a, 0xcd, 0xfb, 0x6f, 0xfb, 0xbe,
-	0x25, 0xfb, 0x5f, 0x0b, 0x70, 0x5e, 0xa2, 0xca, 0x63, 0xe7, 0xc2, 0x2c, 0x7b, 0xd5, 0xef, 0xf8,
-	0x07, 0x3a, 0x32, 0x06, 0x7a, 0xcd, 0xde, 0xc1, 0x79, 0x61, 0xb6, 0xe7, 0x50, 0xbf, 0x9c, 0x19,
-	0x6a, 0x6c, 0x95, 0xeb, 0xfe, 0x83, 0xfd, 0x17, 0x0e, 0x4c, 0xe4, 0x0f, 0xf6, 0x09, 0x3c, 0xe1,
-	0xfb, 0x9a, 0xf9, 0x84, 0xef, 0x2f, 0xd9, 0x9b, 0x62, 0x66, 0x57, 0x7a, 0x3c, 0xe6, 0xfb, 0x3f,
-	0x1c, 0x38, 0x2b, 0x2b, 0xb0, 0xdd, 0x73, 0xc6, 0x0f, 0x58, 0xc8, 0xce, 0xf1, 0x4f, 0xb3, 0x57,
-	0x8d, 0x69, 0xf6, 0xbc, 0xbd, 0x8e, 0xeb, 0xfd, 0xe8, 0x35, 0xe1, 0xdc, 0x3f, 0x77, 0xa0, 0x9c,
-	0x57, 0xe1, 0x04, 0x3e, 0xf9, 0x2b, 0xe6, 0x27, 0x7f, 0xf6, 0x78, 0x7a, 0xde, 0xfb, 0x83, 0x97,
-	0x7b, 0x0d, 0x14, 0x6a, 0x4a, 0xbd, 0xca, 0xb1, 0x75, 0x3b, 0x8b, 0xb3, 0xc8, 0x57, 0xd0, 0x9a,
-	0x30, 0x10, 0xb3, 0xd8, 0x14, 0x31, 0x05, 0xae, 0xda, 0xd0, 0xb6, 0x28, 0x3d, 0xe1, 0x0e, 0x60,
-	0xff, 0x63, 0xc1, 0xc3, 0xfd, 0x9d, 0x02, 0x5c, 0x50, 0x4f, 0x73, 0x93, 0x6d, 0xd2, 0x4c, 0xd7,
-	0x07, 0x7b, 0xf6, 0xc5, 0x53, 0x3f, 0xed, 0x3d, 0xfb, 0x92, 0xb2, 0x48, 0xd7, 0x42, 0x0a, 0xc3,
-	0x1a, 0x4f, 0x54, 0x81, 0x73, 0xec, 0x99, 0x96, 0x79, 0x3f, 0xf0, 0x9a, 0xfe, 0xcb, 0x24, 0xc2,
-	0xa4, 0x15, 0x6e, 0x7b, 0x4d, 0xa1, 0xa9, 0xab, 0x0b, 0xf7, 0xf3, 0x79, 0x48, 0x38, 0xbf, 0x6e,
-	0x97, 0x19, 0xa1, 0xef, 0xa0, 0x66, 0x04, 0xf7, 0x4f, 0x1c, 0x18, 0x39, 0xc1, 0x87, 0xcc, 0x43,
-	0x73, 0x49, 0x3c, 0x6d, 0x6f, 0x49, 0xf4, 0x58, 0x06, 0xbb, 0x45, 0xe8, 0x7a, 0x09, 0x1a, 0x7d,
-	0xda, 0x51, 0xd1, 0x3b, 0x3c, 0x4a, 0xf2, 0xc3, 0xf6, 0xda, 0x71, 0x98, 0xb4, 0xb0, 0xe8, 0xab,
-	0x19, 0x7b, 0x40, 0xc1, 0x56, 0x06, 0xb7, 0xae, 0xd6, 0x1c, 0x21, 0x67, 0xee, 0x97, 0x1c, 0x00,
-	0xde, 0x4e, 0x91, 0x93, 0x9f, 0xb6, 0x6d, 0xe3, 0xd8, 0x46, 0x8a, 0x32, 0xe1, 0x4d, 0x53, 0x4b,
-	0x28, 0x2d, 0xc0, 0x5a, 0x4b, 0xee, 0x22, 0x19, 0xee, 0x5d, 0xe7, 0xe1, 0xfd, 0xbc, 0x03, 0xa7,
-	0x32, 0xcd, 0xcd, 0xa9, 0xbf, 0x69, 0x3e, 0x5c, 0x6a, 0x41, 0xb3, 0x32, 0x33, 0xb5, 0xeb, 0xc6,
-	0x93, 0x7f, 0xe1, 0x82, 0xf1, 0x28, 0x3e, 0x7a, 0x05, 0x86, 0xa5, 0xe5, 0x43, 0x4e, 0x6f, 0x9b,
-	0x0f, 0x38, 0xab, 0xe3, 0x8d, 0x84, 0xc4, 0x38, 0xe5, 0x97, 0x09, 0x0e, 0x2c, 0x1c, 0x28, 0x38,
-	0xf0, 0x9d, 0x7d, 0xfe, 0x39, 0xdf, 0xd8, 0xde, 0x7f, 0x2c, 0xc6, 0xf6, 0xfb, 0xad, 0x1b, 0xdb,
-	0x1f, 0x38, 0x61, 0x63, 0xbb, 0xe6, 0xcf, 0x2c, 0xde, 0x85, 0x3f, 0xf3, 0x15, 0x38, 0xbb, 0x9d,
-	0x1e, 0x3a, 0xd5, 0x4c, 0x12, 0x59, 0xbf, 0x1e, 0xcb, 0x35, 0xb1, 0xd3, 0x03, 0x74, 0x9c, 0x90,
-	0x20, 0xd1, 0x8e, 0xab, 0x69, 0x5c, 0xe2, 0xb3, 0x39, 0xe4, 0x70, 0x2e, 0x93, 0xac, 0x63, 0x6a,
-	0xf0, 0x00, 0x8e, 0xa9, 0x6f, 0x39, 0x70, 0xce, 0xeb, 0xba, 0xd9, 0x87, 0xc9, 0xa6, 0x88, 0x8e,
-	0xb9, 0x61, 0x4f, 0x85, 0x30, 0xc8, 0x0b, 0x0f, 0x60, 0x5e, 0x11, 0xce, 0x6f, 0x10, 0x7a, 0x38,
-	0x8d, 0x12, 0xe0, 0xd1, 0xac, 0xf9, 0x2e, 0xfd, 0xaf, 0x66, 0x43, 0x8f, 0x80, 0x0d, 0xfd, 0x47,
-	0xed, 0x9e, 0xb6, 0x2d, 0x84, 0x1f, 0x95, 0xee, 0x22, 0xfc, 0x28, 0xe3, 0x25, 0x1c, 0xb1, 0xe4,
-	0x25, 0x0c, 0x60, 0xdc, 0x6f, 0x79, 0x75, 0xb2, 0xd6, 0x69, 0x36, 0xf9, 0x55, 0x1d, 0xf9, 0xc4,
-	0x76, 0xae, 0x05, 0x6f, 0x39, 0xac, 0x7a, 0x4d, 0x91, 0xd4, 0x44, 0x45, 0xf2, 0xaa, 0x2b, 0x49,
-	0x8b, 0x19, 0x4a, 0xb8, 0x8b, 0x36, 0x9d, 0xb0, 0x2c, 0x81, 0x25, 0x49, 0xe8, 0x68, 0xb3, 0x18,
-	0x97, 0x21, 0x3e, 0x61, 0xaf, 0xa6, 0x60, 0xac, 0xe3, 0xa0, 0x25, 0x18, 0xae, 0x05, 0xb1, 0xb8,
-	0xa4, 0x7c, 0x8a, 0x09, 0xb3, 0x77, 0x53, 0x11, 0x38, 0x77, 0xad, 0xa2, 0xae, 0x27, 0xdf, 0x9f,
-	0x93, 0x91, 0x55, 0x95, 0xe3, 0xb4, 0x3e, 0x5a, 0x61, 0xc4, 0xc4, 0xe3, 0x81, 0x3c, 0xf4, 0xe4,
-	0xc1, 0x1e, 0x5e, 0xb0, 0xb9, 0x6b, 0xf2, 0xf9, 0xc3, 0x51, 0xc1, 0x4e, 0xbc, 0x02, 0x98, 0x52,
-	0xd0, 0x9e, 0x3a, 0x3f, 0xbd, 0xef, 0x53, 0xe7, 0x2c, 0x15, 0x73, 0xd2, 0x54, 0x9e, 0xec, 0x8b,
-	0xd6, 0x52, 0x31, 0xa7, 0x41, 0x9d, 0x22, 0x15, 0x73, 0x0a, 0xc0, 0x3a, 0x4b, 0xb4, 0xda, 0xcb,
-	0xa3, 0x7f, 0x86, 0x09, 0x8d, 0xc3, 0xfb, 0xe7, 0xf5, 0x98, 0xe8, 0xb3, 0xfb, 0xc5, 0x44, 0x77,
-	0xbb, 0xa2, 0xcf, 0x1d, 0xc2, 0x15, 0xdd, 0x60, 0x49, 0x72, 0x17, 0x66, 0x85, 0xf7, 0xdf, 0xc2,
-	0xf9, 0x8e, 0x25, 0x63, 0xe1, 0x41, 0xb2, 0xec, 0x5f, 0xcc, 0x19, 0xf4, 0x0c, 0x1b, 0xbf, 0x70,
-	0xe4, 0xb0, 0xf1, 0x8c, 0x3f, 0xf7, 0xde, 0x63, 0xf3, 0xe7, 0x4e, 0x9c, 0x80, 0x3f, 0xf7, 0xbe,
-	0x03, 0xfb, 0x73, 0x6f, 0xc1, 0x99, 0x76, 0x58, 0x9b, 0xf3, 0xe3, 0xa8, 0xc3, 0x2e, 0x22, 0xce,
-	0x74, 0x6a, 0x75, 0x92, 0x30, 0x87, 0x70, 0xe9, 0xf2, 0xbb, 0xf5, 0x46, 0xb6, 0xd9, 0xaa, 0x94,
-	0x0b, 0x2e, 0x53, 0x81, 0xd9, 0x41, 0x58, 0xb4, 0x6f, 0x4e, 0x21, 0xce, 0x63, 0xa1, 0x7b, 0x92,
-	0x1f, 0x3c, 0x19, 0x4f, 0xf2, 0x07, 0x61, 0x28, 0x6e, 0x74, 0x92, 0x5a, 0x78, 0x33, 0x60, 0xe1,
-	0x02, 0xc3, 0x33, 0xef, 0x52, 0x76, 0x69, 0x01, 0xbf, 0xbd, 0x3b, 0x39, 0x2e, 0xff, 0xd7, 0x4c,
-	0xd2, 0x02, 0x82, 0xbe, 0xd6, 0xe3, 0xca, 0x91, 0x7b, 0x9c, 0x57, 0x8e, 0x2e, 0x1c, 0xea, 0xba,
-	0x51, 0x9e, 0xbb, 0xfc, 0xa1, 0x9f, 0x3a, 0x77, 0xf9, 0x57, 0x1c, 0x18, 0xdd, 0xd6, 0xed, 0xff,
-	0xc2, 0xa5, 0x6f, 0x21, 0x60, 0xc8, 0x70, 0x2b, 0xcc, 0xb8, 0x54, 0x68, 0x19, 0xa0, 0xdb, 0x59,
-	0x00, 0x36, 0x5b, 0x92, 0x13, 0xcc, 0xf4, 0xf0, 0x3b, 0x15, 0xcc, 0xf4, 0x1a, 0x94, 0xda, 0x61,
-	0x4d, 0x9e, 0x58, 0x99, 0x9f, 0xdf, 0x6e, 0x2c, 0x33, 0xd7, 0x3f, 0x53, 0x16, 0x58, 0xe7, 0x87,
-	0x3e, 0xe7, 0xc0, 0xb8, 0x3c, 0x64, 0x09, 0xff, 0x5d, 0x2c, 0xa2, 0x31, 0x6d, 0x9e, 0xed, 0x78,
-	0xd6, 0xe6, 0x0c, 0x1f, 0xdc, 0xc5, 0x99, 0x2a, 0x24, 0x2a, 0xf8, 0xad, 0x1e, 0xb3, 0xa0, 0x63,
-	0xa1, 0x90, 0x4c, 0xa7, 0x60, 0xac, 0xe3, 0xa0, 0xaf, 0x3b, 0x50, 0x6c, 0x84, 0xe1, 0x56, 0x5c,
-	0x7e, 0x8c, 0x09, 0xf4, 0xe7, 0x2c, 0x2b, 0x9a, 0x57, 0x29, 0x6d, 0xae, 0x61, 0x3e, 0x21, 0x0d,
-	0x41, 0x0c, 0x76, 0x7b, 0x77, 0x72, 0xcc, 0x78, 0x70, 0x2c, 0x7e, 0xe3, 0x6d, 0x0d, 0x22, 0x0c,
-	0x95, 0xac, 0x69, 0xe8, 0x2d, 0x07, 0xc6, 0x6f, 0x66, 0xac, 0x13, 0x22, 0x1c, 0x15, 0xdb, 0xb7,
-	0x7b, 0xf0, 0xe1, 0xce, 0x42, 0x71, 0x57, 0x0b, 0xd0, 0x67, 0x4d, 0xab, 0x25, 0x8f, 0x5b, 0xb5,
-	0x38, 0x80, 0x19, 0x2b, 0x29, 0xbf, 0x8e, 0x94, 0x6f, 0xbe, 0xbc, 0xfb, 0x60, 0x11, 0xda, 0x99,
-	0xf4, 0x63, 0xe5, 0x54, 0x25, 0xa6, 0xf1, 0xc4, 0xc2, 0x62, 0x37, 0x3e, 0xbf, 0x6e, 0x3b, 0x79,
-	0xeb, 0x3c, 0x8c, 0x99, 0x8e, 0x3a, 0xf4, 0x1e, 0xf3, 0xd1, 0x97, 0x8b, 0xd9, 0xf7, 0x33, 0x46,
-	0x25, 0xbe, 0xf1, 0x86, 0x86, 0xf1, 0xc8, 0x45, 0xe1, 0x58, 0x1f, 0xb9, 0xe8, 0x3b, 0x99, 0x47,
-	0x2e, 0xc6, 0x8f, 0xe3, 0x91, 0x8b, 0xd3, 0x87, 0x7a, 0xe4, 0x42, 0x7b, 0x64, 0xa4, 0xff, 0x0e,
-	0x8f, 0x8c, 0x4c, 0xc3, 0x29, 0x79, 0xe7, 0x88, 0x88, 0x77, 0x04, 0xb8, 0x0f, 0x5f, 0xbd, 0x83,
-	0x3f, 0x6b, 0x16, 0xe3, 0x2c, 0x3e, 0x5d, 0x64, 0xc5, 0x80, 0xd5, 0x1c, 0xb0, 0x15, 0x94, 0x65,
-	0x4e, 0x2d, 0x76, 0x16, 0x16, 0x22, 0x4a, 0x46, 0x59, 0x17, 0x19, 0xec, 0xb6, 0xfc, 0x07, 0xf3,
-	0x16, 0xa0, 0x17, 0xa0, 0x1c, 0x6e, 0x6e, 0x36, 0x43, 0xaf, 0x96, 0xbe, 0xc4, 0x21, 0x83, 0x0c,
-	0xf8, 0x75, 0x53, 0x95, 0x6a, 0x72, 0xb5, 0x07, 0x1e, 0xee, 0x49, 0x01, 0x7d, 0x8b, 0x2a, 0x26,
-	0x49, 0x18, 0x91, 0x5a, 0x6a, 0x78, 0x19, 0x66, 0x7d, 0x26, 0xd6, 0xfb, 0x5c, 0x31, 0xf9, 0xf0,
-	0xde, 0xab, 0x8f, 0x92, 0x29, 0xc5, 0xd9, 0x66, 0xa1, 0x08, 0xce, 0xb7, 0xf3, 0xec, 0x3e, 0xb1,
-	0xb8, 0x29, 0xb5, 0x9f, 0xf5, 0x49, 0xbd, 0xf6, 0x9e, 0x6b, 0x39, 0x8a, 0x71, 0x0f, 0xca, 0xfa,
-	0x6b, 0x19, 0x43, 0x27, 0xf3, 0x5a, 0xc6, 0xc7, 0x00, 0xaa, 0x32, 0x5b, 0x9b, 0xb4, 0x24, 0x2c,
-	0x59, 0xb9, 0xc2, 0xc3, 0x69, 0x6a, 0x0f, 0x1f, 0x2b, 0x36, 0x58, 0x63, 0x89, 0xfe, 0x4f, 0xee,
-	0x73, 0x32, 0xdc, 0x5c, 0x52, 0xb7, 0x3e, 0x27, 0x7e, 0xea, 0x9e, 0x94, 0xf9, 0x47, 0x0e, 0x4c,
-	0xf0, 0x99, 0x97, 0x55, 0xee, 0xa9, 0x6a, 0x21, 0xee, 0x14, 0xd9, 0x8e, 0x43, 0xe1, 0x59, 0x97,
-	0x0c, 0xae, 0xcc, 0x6b, 0xbd, 0x4f, 0x4b, 0xd0, 0x97, 0x72, 0x8e, 0x14, 0xa7, 0x6c, 0x19, 0x20,
-	0xf3, 0x1f, 0x05, 0x39, 0xb3, 0x77, 0x90, 0x53, 0xc4, 0x3f, 0xed, 0x69, 0x1f, 0x45, 0xac, 0x79,
-	0xbf, 0x7c, 0x4c, 0xf6, 0x51, 0xfd, 0xe5, 0x92, 0x43, 0x59, 0x49, 0x3f, 0xef, 0xc0, 0xb8, 0x97,
-	0x89, 0x1b, 0x61, 0x46, 0x1d, 0x2b, 0x06, 0xa6, 0xe9, 0x28, 0x0d, 0x46, 0x61, 0x4a, 0x5e, 0x36,
-	0x44, 0x05, 0x77, 0x31, 0x47, 0x3f, 0x70, 0xe0, 0xbe, 0xf4, 0x79, 0x94, 0x38, 0xbd, 0x23, 0x2c,
-	0x1a, 0x77, 0x96, 0xad, 0xc6, 0x97, 0xac, 0xaf, 0xc6, 0xf5, 0xde, 0x3c, 0xf9, 0xba, 0x7c, 0x48,
-	0xac, 0xcb, 0xfb, 0xf6, 0xc1, 0xc4, 0xfb, 0x35, 0x7d, 0xe2, 0xd3, 0x0e, 0x7f, 0x3f, 0xae, 0xa7,
-	0xca, 0xb7, 0x61, 0xaa, 0x7c, 0xcb, 0x36, 0x5f, 0xb0, 0xd2, 0x75, 0xcf, 0x5f, 0x77, 0xe0, 0x6c,
-	0xde, 0x8e, 0x94, 0xd3, 0xa4, 0x8f, 0x9a, 0x4d, 0xb2, 0x78, 0xca, 0xd2, 0x1b, 0x64, 0xe5, 0xf9,
-	0x9b, 0x89, 0x6b, 0xf0, 0xe0, 0x9d, 0xbe, 0xe2, 0x9d, 0xe8, 0x0d, 0xe9, 0x6a, 0xf1, 0x9f, 0x0f,
-	0x6b, 0x2e, 0xc5, 0x84, 0xb4, 0xad, 0x07, 0x64, 0x07, 0x30, 0xe0, 0x07, 0x4d, 0x3f, 0x20, 0xe2,
-	0x9e, 0xa8, 0xcd, 0x33, 0xac, 0x78, 0x00, 0x8b, 0x52, 0xc7, 0x82, 0xcb, 0x3b, 0xec, 0x61, 0xcc,
-	0x3e, 0x29, 0xd8, 0x7f, 0xf2, 0x4f, 0x0a, 0xde, 0x84, 0xe1, 0x9b, 0x7e, 0xd2, 0x60, 0x91, 0x11,
-	0xc2, 0x71, 0x67, 0xe1, 0x7e, 0x25, 0x25, 0x97, 0xf6, 0xfd, 0x86, 0x64, 0x80, 0x53, 0x5e, 0xe8,
-	0x12, 0x67, 0xcc, 0xc2, 0xb0, 0xb3, 0xf1, 0xb1, 0x37, 0x64, 0x01, 0x4e, 0x71, 0xe8, 0x60, 0x8d,
-	0xd0, 0x5f, 0x32, 0x8d, 0x93, 0xc8, 0xac, 0x6c, 0x23, 0x63, 0xa6, 0xa0, 0xc8, 0x6f, 0x31, 0xdf,
-	0xd0, 0x78, 0x60, 0x83, 0xa3, 0x4a, 0x6e, 0x3d, 0xd4, 0x33, 0xb9, 0xf5, 0xab, 0x4c, 0x61, 0x4b,
-	0xfc, 0xa0, 0x43, 0x56, 0x03, 0x11, 0xbc, 0xbd, 0x6c, 0xe7, 0xce, 0x35, 0xa7, 0xc9, 0x8f, 0xe0,
-	0xe9, 0x6f, 0xac, 0xf1, 0xd3, 0xfc, 0x27, 0xa5, 0x7d, 0xfd, 0x27, 0xa9, 0xc9, 0x65, 0xc4, 0xba,
-	0xc9, 0x25, 0x21, 0x6d, 0x2b, 0x26, 0x97, 0x9f, 0x2a, 0x73, 0xc0, 0x5f, 0x38, 0x80, 0x94, 0xde,
-	0xa5, 0x04, 0xea, 0x09, 0x44, 0x48, 0x7e, 0xdc, 0x01, 0x08, 0xd4, 0xc3, 0xb3, 0x76, 0x77, 0x41,
-	0x4e, 0x33, 0x6d, 0x40, 0x0a, 0xc3, 0x1a, 0x4f, 0xf7, 0xcf, 0x9c, 0x34, 0x10, 0x39, 0xed, 0xfb,
-	0x09, 0x44, 0x84, 0xed, 0x98, 0x11, 0x61, 0xeb, 0x16, 0x4d, 0xf7, 0xaa, 0x1b, 0x3d, 0x62, 0xc3,
-	0x7e, 0x5c, 0x80, 0x53, 0x3a, 0x72, 0x85, 0x9c, 0xc4, 0xc7, 0xbe, 0x69, 0x84, 0xc3, 0x5e, 0xb7,
-	0xdb, 0xdf, 0x8a, 0xf0, 0x00, 0xe5, 0x85, 0x5e, 0x7f, 0x2c, 0x13, 0x7a, 0x7d, 0xc3, 0x3e, 0xeb,
-	0xfd, 0xe3, 0xaf, 0xff, 0x9b, 0x03, 0x67, 0x32, 0x35, 0x4e, 0x60, 0x82, 0x6d, 0x9b, 0x13, 0xec,
-	0x19, 0xeb, 0xbd, 0xee, 0x31, 0xbb, 0xbe, 0x51, 0xe8, 0xea, 0x2d, 0x3b, 0xc4, 0x7d, 0xca, 0x81,
-	0x22, 0xd5, 0x96, 0x65, 0x70, 0xd6, 0x47, 0x8f, 0x65, 0x06, 0x30, 0xbd, 0x5e, 0x48, 0x67, 0xd5,
-	0x3e, 0x06, 0xc3, 0x9c, 0xfb, 0xc4, 0x27, 0x1d, 0x80, 0x14, 0xe9, 0x9d, 0x52, 0x81, 0xdd, 0x6f,
-	0x17, 0xe0, 0x5c, 0xee, 0x34, 0x42, 0x9f, 0x51, 0x16, 0x39, 0xc7, 0x76, 0xe8, 0xa1, 0xc1, 0x48,
-	0x37, 0xcc, 0x8d, 0x1a, 0x86, 0x39, 0x61, 0x8f, 0x7b, 0xa7, 0x0e, 0x30, 0x42, 0x4c, 0x6b, 0x83,
-	0xf5, 0x23, 0x27, 0x8d, 0x66, 0x55, 0xf9, 0x94, 0xfe, 0x12, 0xde, 0xc8, 0x71, 0x7f, 0xac, 0x5d,
-	0x57, 0x90, 0x1d, 0x3d, 0x01, 0x59, 0x71, 0xd3, 0x94, 0x15, 0xd8, 0xbe, 0x1f, 0xb9, 0x87, 0xb0,
-	0x78, 0x09, 0xf2, 0x1c, 0xcb, 0x07, 0xcb, 0xe3, 0x68, 0xdc, 0x6d, 0x2d, 0x1c, 0xf8, 0x6e, 0xeb,
-	0x28, 0x94, 0x9e, 0xf7, 0x55, 0x0e, 0xd0, 0x99, 0xa9, 0xef, 0xfe, 0xf0, 0xe2, 0x3d, 0xdf, 0xfb,
-	0xe1, 0xc5, 0x7b, 0x7e, 0xf0, 0xc3, 0x8b, 0xf7, 0x7c, 0x7c, 0xef, 0xa2, 0xf3, 0xdd, 0xbd, 0x8b,
-	0xce, 0xf7, 0xf6, 0x2e, 0x3a, 0x3f, 0xd8, 0xbb, 0xe8, 0xfc, 0xa7, 0xbd, 0x8b, 0xce, 0xdf, 0xf9,
-	0xd3, 0x8b, 0xf7, 0x3c, 0x3f, 0x24, 0x3b, 0xf6, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x39,
-	0xf4, 0x08, 0x5e, 0xdc, 0x00, 0x00,
+	// 12786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x25, 0xc9,
+	0x59, 0xd8, 0xd6, 0x7b, 0xfd, 0xfa, 0xc8, 0x3e, 0xa7, 0xe6, 0xaa, 0xed, 0xdd, 0x9d, 0x1e, 0xd5,
+	0x6a, 0x97, 0x5d, 0x58, 0xf5, 0x48, 0xb3, 0x02, 0x2f, 0x92, 0x11, 0xea, 0x63, 0xba, 0x67, 0x76,
+	0xba, 0xa7, 0x7b, 0xbf, 0xd7, 0xb3, 0x63, 0x1d, 0x08, 0x55, 0xbf, 0x97, 0xdd, 0x5d, 0xea, 0xf7,
+	0xaa, 0xde, 0x56, 0xd5, 0x9b, 0x99, 0xde, 0x43, 0x02, 0x71, 0xca, 0x1c, 0x02, 0x21, 0x04, 0x92,
+	0xed, 0x08, 0xcc, 0x61, 0x13, 0xe0, 0xc0, 0xc6, 0x7f, 0xec, 0x00, 0x1c, 0xe1, 0x70, 0x84, 0x09,
+	0x1c, 0x44, 0xd8, 0x60, 0x44, 0xa0, 0x1f, 0x66, 0xd6, 0x1a, 0x30, 0x3f, 0x70, 0x10, 0x0e, 0x08,
+	0xdb, 0xc0, 0xe0, 0x2b, 0xbe, 0xbc, 0x2a, 0xb3, 0x5e, 0xbd, 0xbe, 0x36, 0x7b, 0x56, 0x86, 0x5f,
+	0xdd, 0xef, 0xcb, 0xaf, 0xbe, 0x2f, 0x33, 0x2b, 0x2b, 0xf3, 0xcb, 0xef, 0x24, 0xeb, 0xdb, 0x61,
+	0xb6, 0xd3, 0xdd, 0x9c, 0x6d, 0xc4, 0xed, 0x4b, 0x41, 0xb2, 0x1d, 0x77, 0x92, 0xf8, 0x13, 0xec,
+	0x9f, 0x77, 0xdd, 0x89, 0x93, 0xdd, 0xad, 0x56, 0x7c, 0x27, 0xbd, 0x74, 0xfb, 0xf9, 0x4b, 0x9d,
+	0xdd, 0xed, 0x4b, 0x41, 0x27, 0x4c, 0x2f, 0x49, 0xe8, 0xa5, 0xdb, 0xef, 0x09, 0x5a, 0x9d, 0x9d,
+	0xe0, 0x3d, 0x97, 0xb6, 0x69, 0x44, 0x93, 0x20, 0xa3, 0xcd, 0xd9, 0x4e, 0x12, 0x67, 0xb1, 0xfb,
+	0xc1, 0x9c, 0xe2, 0xac, 0xa4, 0xc8, 0xfe, 0xf9, 0x76, 0x45, 0x71, 0xf6, 0xf6, 0xf3, 0xb3, 0x9d,
+	0xdd, 0xed, 0x59, 0xa4, 0x38, 0x2b, 0xa1, 0xb3, 0x92, 0xe2, 0xf4, 0xbb, 0xb4, 0x3e, 0x6d, 0xc7,
+	0xdb, 0xf1, 0x25, 0x46, 0x78, 0xb3, 0xbb, 0xc5, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0xce, 0x70, 0xda,
+	0xdf, 0x7d, 0x21, 0x9d, 0x0d, 0x63, 0xec, 0xdf, 0xa5, 0x46, 0x9c, 0xd0, 0x4b, 0xb7, 0x7b, 0x3a,
+	0x35, 0xfd, 0x4e, 0x0d, 0xa7, 0x13, 0xb7, 0xc2, 0xc6, 0x5e, 0x19, 0xd6, 0x7b, 0x73, 0xac, 0x76,
+	0xd0, 0xd8, 0x09, 0x23, 0x9a, 0xec, 0xe5, 0x43, 0x6f, 0xd3, 0x2c, 0x28, 0x7b, 0xea, 0x52, 0xbf,
+	0xa7, 0x92, 0x6e, 0x94, 0x85, 0x6d, 0xda, 0xf3, 0xc0, 0x37, 0x1d, 0xf4, 0x40, 0xda, 0xd8, 0xa1,
+	0xed, 0xa0, 0xe7, 0xb9, 0xe7, 0xfb, 0x3d, 0xd7, 0xcd, 0xc2, 0xd6, 0xa5, 0x30, 0xca, 0xd2, 0x2c,
+	0x29, 0x3e, 0xe4, 0x5f, 0x21, 0x83, 0x73, 0xed, 0xb8, 0x1b, 0x65, 0xee, 0xfb, 0x49, 0xed, 0x76,
+	0xd0, 0xea, 0x52, 0xcf, 0xb9, 0xe8, 0x3c, 0x33, 0x32, 0xff, 0xd4, 0x6f, 0xdc, 0x9b, 0x79, 0xe4,
+	0xfe, 0xbd, 0x99, 0xda, 0xcb, 0x08, 0x7c, 0x70, 0x6f, 0xe6, 0x0c, 0x8d, 0x1a, 0x71, 0x33, 0x8c,
+	0xb6, 0x2f, 0x7d, 0x22, 0x8d, 0xa3, 0xd9, 0x1b, 0xdd, 0xf6, 0x26, 0x4d, 0x80, 0x3f, 0xe3, 0xff,
+	0x33, 0x87, 0x4c, 0xcd, 0x75, 0x3a, 0x49, 0x7c, 0x3b, 0x68, 0x2d, 0xd2, 0x46, 0x98, 0x86, 0x71,
+	0xe4, 0x5e, 0x24, 0x03, 0xdd, 0x94, 0x26, 0x82, 0xe0, 0x98, 0x20, 0x38, 0x70, 0x33, 0xa5, 0x09,
+	0xb0, 0x16, 0xf7, 0x39, 0x32, 0x1c, 0xb0, 0xa7, 0x68, 0xd3, 0xab, 0x5c, 0x74, 0x9e, 0x19, 0x9e,
+	0x9f, 0x12, 0x58, 0xc3, 0x73, 0x02, 0x0e, 0x0a, 0xc3, 0x5d, 0x21, 0x03, 0x38, 0x7e, 0xaf, 0x7a,
+	0xd1, 0x79, 0x66, 0xf4, 0xf2, 0xd7, 0xcf, 0xf2, 0xf1, 0xce, 0xea, 0xe3, 0xcd, 0xd7, 0x0d, 0xbe,
+	0x8e, 0xd9, 0xdb, 0xef, 0x99, 0xdd, 0x08, 0xdb, 0x34, 0xe7, 0x8d, 0xbf, 0x80, 0x51, 0xf1, 0x7f,
+	0xa7, 0x42, 0x26, 0xe7, 0x92, 0xc6, 0x4e, 0x78, 0x9b, 0xd6, 0x33, 0x9c, 0x92, 0xed, 0x3d, 0x77,
+	0x87, 0x54, 0xb3, 0x80, 0x77, 0x78, 0xf4, 0xf2, 0xea, 0xec, 0x5b, 0x5d, 0xaa, 0xb3, 0x1b, 0x41,
+	0x22, 0x69, 0xcf, 0x0f, 0xdd, 0xbf, 0x37, 0x53, 0xdd, 0x08, 0x12, 0x40, 0x16, 0x6e, 0x8b, 0x0c,
+	0x44, 0x71, 0x44, 0xd9, 0xa8, 0x47, 0x2f, 0xdf, 0x78, 0xeb, 0xac, 0x6e, 0xc4, 0x91, 0x1a, 0xc7,
+	0xfc, 0x30, 0x8e, 0x15, 0x21, 0xc0, 0xb8, 0xe0, 0xb8, 0x5e, 0x0d, 0x3b, 0x5e, 0xd5, 0xd6, 0xb8,
+	0x3e, 0x1c, 0x76, 0xcc, 0x71, 0x7d, 0x38, 0xec, 0x00, 0xb2, 0xf0, 0x3f, 0x53, 0x21, 0x23, 0x73,
+	0xc9, 0x76, 0xb7, 0x4d, 0xa3, 0x2c, 0x75, 0x3f, 0x45, 0x48, 0x27, 0x48, 0x82, 0x36, 0xcd, 0x68,
+	0x92, 0x7a, 0xce, 0xc5, 0xea, 0x33, 0xa3, 0x97, 0xaf, 0xbf, 0x75, 0xf6, 0xeb, 0x92, 0xe6, 0xbc,
+	0x2b, 0x5e, 0x2c, 0x51, 0xa0, 0x14, 0x34, 0x96, 0xee, 0x6b, 0x64, 0x24, 0x48, 0xb2, 0x70, 0x2b,
+	0x68, 0x64, 0xa9, 0x57, 0x61, 0xfc, 0x5f, 0x7c, 0xeb, 0xfc, 0xe7, 0x04, 0xc9, 0xf9, 0x53, 0x82,
+	0xfd, 0x88, 0x84, 0xa4, 0x90, 0xf3, 0xf3, 0x7f, 0x65, 0x80, 0x8c, 0xce, 0x25, 0xd9, 0xf2, 0x42,
+	0x3d, 0x0b, 0xb2, 0x6e, 0xea, 0xfe, 0xa6, 0x43, 0x4e, 0xa7, 0x7c, 0xda, 0x42, 0x9a, 0xae, 0x27,
+	0x71, 0x83, 0xa6, 0x29, 0x6d, 0x8a, 0x79, 0xd9, 0xb2, 0xd2, 0x2f, 0xc9, 0x6c, 0xb6, 0xde, 0xcb,
+	0xe8, 0x4a, 0x94, 0x25, 0x7b, 0xf3, 0xef, 0x11, 0x7d, 0x3e, 0x5d, 0x82, 0xf1, 0xe9, 0x37, 0x67,
+	0x5c, 0x39, 0x94, 0xe5, 0x05, 0x81, 0xb0, 0x07, 0x65, 0xbd, 0x76, 0xbf, 0xe8, 0x90, 0xb1, 0x4e,
+	0xdc, 0x4c, 0x81, 0x36, 0xe2, 0x6e, 0x87, 0x7d, 0xc0, 0x38, 0x8c, 0x6f, 0xb7, 0x3b, 0x8c, 0x75,
+	0x8d, 0x03, 0xef, 0xff, 0x19, 0xd1, 0xff, 0x31, 0xbd, 0x09, 0x8c, 0xae, 0xb8, 0x2f, 0x90, 0xb1,
+	0x28, 0xce, 0xea, 0x1d, 0xda, 0x08, 0xb7, 0x42, 0xda, 0x64, 0x0b, 0x7f, 0x38, 0x7f, 0xf2, 0x86,
+	0xd6, 0x06, 0x06, 0xe6, 0xf4, 0x12, 0xf1, 0xfa, 0xcd, 0x9c, 0x3b, 0x45, 0xaa, 0xbb, 0x74, 0x8f,
+	0x6f, 0x67, 0x80, 0xff, 0xba, 0x67, 0xe4, 0x9e, 0xc9, 0x36, 0x2f, 0xb1, 0x19, 0xbe, 0xaf, 0xf2,
+	0x82, 0x33, 0xfd, 0xad, 0xe4, 0x54, 0x4f, 0xd7, 0x8f, 0x42, 0xc0, 0xff, 0x8b, 0x41, 0x32, 0x2c,
+	0x5f, 0x05, 0xee, 0xa4, 0x51, 0xd0, 0xa6, 0xc5, 0x9d, 0xf4, 0x46, 0x80, 0xbb, 0x19, 0xb6, 0x20,
+	0x46, 0x27, 0xc8, 0x76, 0xbc, 0x8a, 0x89, 0xb1, 0x1e, 0x64, 0x3b, 0xc0, 0x5a, 0xdc, 0xc7, 0xc9,
+	0x40, 0x3b, 0x6e, 0xf2, 0xdd, 0xb3, 0xc6, 0x77, 0x88, 0xd5, 0xb8, 0x49, 0x81, 0x41, 0xf1, 0xf9,
+	0xad, 0x24, 0x6e, 0x7b, 0x03, 0xe6, 0xf3, 0x4b, 0x49, 0xdc, 0x06, 0xd6, 0xe2, 0xfe, 0xa4, 0x43,
+	0xa6, 0xe4, 0xda, 0x5e, 0x89, 0x1b, 0x41, 0x16, 0xc6, 0x91, 0x57, 0x63, 0x3b, 0x0a, 0xd8, 0xfb,
+	0xa4, 0x24, 0xe5, 0x79, 0x4f, 0x74, 0x61, 0xaa, 0xd8, 0x02, 0x3d, 0xbd, 0x70, 0x2f, 0x13, 0xb2,
+	0xdd, 0x8a, 0x37, 0x83, 0x16, 0x4e, 0x88, 0x37, 0xc8, 0x86, 0xa0, 0x76, 0x86, 0x65, 0xd5, 0x02,
+	0x1a, 0x96, 0x7b, 0x97, 0x0c, 0x05, 0x7c, 0xf7, 0xf7, 0x86, 0xd8, 0x20, 0x5e, 0xb2, 0x31, 0x08,
+	0xe3, 0x38, 0x99, 0x1f, 0xbd, 0x7f, 0x6f, 0x66, 0x48, 0x00, 0x41, 0xb2, 0xc3, 0x43, 0x2f, 0xee,
+	0x60, 0xbf, 0x83, 0x96, 0x37, 0x6c, 0x1e, 0x7a, 0x6b, 0x02, 0x0e, 0x0a, 0xc3, 0x7d, 0x96, 0x0c,
+	0xa5, 0xdd, 0x4d, 0x7c, 0x8f, 0xde, 0x08, 0x1b, 0xd8, 0xa4, 0x40, 0x1e, 0xaa, 0x73, 0x30, 0xc8,
+	0x76, 0xf7, 0x1b, 0xc9, 0x68, 0x42, 0x1b, 0xdd, 0x24, 0xa5, 0xf8, 0x62, 0x3d, 0xc2, 0x68, 0x9f,
+	0x16, 0xe8, 0xa3, 0x90, 0x37, 0x81, 0x8e, 0xe7, 0x7e, 0x80, 0x4c, 0xe0, 0x0b, 0xbe, 0x72, 0xb7,
+	0x93, 0xd0, 0x14, 0x0f, 0x6e, 0x6f, 0x94, 0x31, 0x3a, 0x27, 0x9e, 0x9c, 0x58, 0x32, 0x5a, 0xa1,
+	0x80, 0xed, 0xbe, 0x4e, 0x48, 0xa0, 0xf6, 0x0c, 0x6f, 0x8c, 0x4d, 0xe6, 0x8a, 0xbd, 0x15, 0xb1,
+	0xbc, 0x30, 0x3f, 0x81, 0xef, 0x31, 0xff, 0x0d, 0x1a, 0x3f, 0x9c, 0x9f, 0x26, 0x6d, 0xd1, 0x8c,
+	0x36, 0xbd, 0x71, 0x36, 0x60, 0x35, 0x3f, 0x8b, 0x1c, 0x0c, 0xb2, 0xdd, 0x7d, 0x9a, 0x0c, 0x36,
+	0xc3, 0x6d, 0x9a, 0x66, 0xde, 0x04, 0x1b, 0xe0, 0x84, 0xc0, 0x1c, 0x5c, 0x64, 0x50, 0x10, 0xad,
+	0xfe, 0xdf, 0xab, 0x10, 0x8d, 0x9b, 0x3b, 0x4f, 0x86, 0xc5, 0xfe, 0x27, 0x3e, 0xdd, 0xf9, 0xa7,
+	0xe5, 0xfb, 0x92, 0x6f, 0xfa, 0xc1, 0xbd, 0xd2, 0x7d, 0x53, 0x3d, 0xe7, 0xbe, 0x41, 0x46, 0x3b,
+	0x71, 0x73, 0x95, 0x66, 0x41, 0x33, 0xc8, 0x02, 0x71, 0xea, 0x5b, 0x38, 0x89, 0x24, 0xc5, 0xf9,
+	0x49, 0x7c, 0xc5, 0xeb, 0x39, 0x0b, 0xd0, 0xf9, 0xb9, 0x2f, 0x12, 0x37, 0xa5, 0xc9, 0xed, 0xb0,
+	0x41, 0xe7, 0x1a, 0x0d, 0x94, 0xf6, 0xd8, 0x87, 0x52, 0x65, 0x83, 0x99, 0x16, 0x83, 0x71, 0xeb,
+	0x3d, 0x18, 0x50, 0xf2, 0x94, 0xff, 0xe5, 0x0a, 0x99, 0xd0, 0xc6, 0xda, 0xa1, 0x0d, 0xf7, 0xe7,
+	0x1d, 0x32, 0xa9, 0x8e, 0xbd, 0xf9, 0xbd, 0x1b, 0xb8, 0xfa, 0xf8, 0xa1, 0x46, 0x6d, 0xae, 0x03,
+	0xe4, 0x35, 0x3b, 0x67, 0xf2, 0xe1, 0x67, 0xc2, 0x79, 0x31, 0x86, 0xc9, 0x42, 0x2b, 0x14, 0xbb,
+	0x35, 0xfd, 0x05, 0x87, 0x9c, 0x29, 0x23, 0x51, 0xb2, 0x37, 0xef, 0xe8, 0x7b, 0xb3, 0xd5, 0x4d,
+	0x0e, 0xb9, 0xe2, 0x60, 0xf4, 0xfd, 0xfe, 0xff, 0x54, 0xc8, 0x94, 0xbe, 0x84, 0x98, 0xc4, 0xf0,
+	0x6f, 0x1c, 0x72, 0x56, 0x8e, 0x00, 0x68, 0xda, 0x6d, 0x15, 0xa6, 0xb7, 0x6d, 0x75, 0x7a, 0x19,
+	0xcf, 0xd9, 0xb9, 0x32, 0x7e, 0x7c, 0x9a, 0x9f, 0x10, 0xd3, 0x7c, 0xb6, 0x14, 0x07, 0xca, 0xbb,
+	0x3a, 0xfd, 0xb3, 0x0e, 0x99, 0xee, 0x4f, 0xb4, 0x64, 0xe2, 0x3b, 0xe6, 0xc4, 0x7f, 0xd8, 0xde,
+	0x20, 0x39, 0x7b, 0x36, 0xfd, 0x6c, 0xb0, 0xfa, 0x0b, 0xf8, 0xc3, 0x11, 0xd2, 0x73, 0xd6, 0xb8,
+	0xef, 0x21, 0xa3, 0x62, 0xdb, 0x5e, 0x89, 0xb7, 0x53, 0xd6, 0xc9, 0x61, 0xfe, 0xad, 0xcd, 0xe5,
+	0x60, 0xd0, 0x71, 0xdc, 0x26, 0xa9, 0xa4, 0xcf, 0x7b, 0x15, 0x5b, 0xdb, 0x60, 0xfd, 0x79, 0x25,
+	0x6d, 0x0e, 0xde, 0xbf, 0x37, 0x53, 0xa9, 0x3f, 0x0f, 0x95, 0xf4, 0x79, 0x94, 0xe8, 0xb7, 0xc3,
+	0xcc, 0x9e, 0x44, 0xbf, 0x1c, 0x66, 0x8a, 0x0f, 0x93, 0xe8, 0x97, 0xc3, 0x0c, 0x90, 0x05, 0xde,
+	0x54, 0x76, 0xb2, 0xac, 0xe3, 0x0d, 0xd8, 0xba, 0xa9, 0x5c, 0xdd, 0xd8, 0x58, 0x57, 0xbc, 0x98,
+	0x1c, 0x82, 0x10, 0x60, 0x5c, 0xdc, 0xef, 0x77, 0x70, 0xc6, 0x79, 0x63, 0x9c, 0xec, 0x09, 0x01,
+	0xe3, 0xa6, 0xbd, 0x25, 0x10, 0x27, 0x7b, 0x8a, 0xb9, 0x78, 0x91, 0xaa, 0x01, 0x74, 0xd6, 0x6c,
+	0xe0, 0xcd, 0xad, 0xd4, 0x1b, 0xb4, 0x36, 0xf0, 0xc5, 0xa5, 0x7a, 0x61, 0xe0, 0x8b, 0x4b, 0x75,
+	0x60, 0x5c, 0xf0, 0x85, 0x26, 0xc1, 0x1d, 0x6f, 0xc8, 0xd6, 0x0b, 0x85, 0xe0, 0x8e, 0xf9, 0x42,
+	0x21, 0xb8, 0x03, 0xc8, 0x02, 0x39, 0xc5, 0x69, 0xea, 0x0d, 0xdb, 0xe2, 0xb4, 0x56, 0xaf, 0x9b,
+	0x9c, 0xd6, 0xea, 0x75, 0x40, 0x16, 0x6c, 0x91, 0x36, 0x52, 0x6f, 0xc4, 0x16, 0xa7, 0xe5, 0x85,
+	0x02, 0xa7, 0xe5, 0x85, 0x3a, 0x20, 0x0b, 0xdc, 0x32, 0x82, 0x57, 0xbb, 0x09, 0x17, 0x7a, 0x46,
+	0x2f, 0xaf, 0x59, 0x58, 0x2f, 0x48, 0x4e, 0x71, 0x1b, 0x41, 0x4d, 0x08, 0x03, 0x01, 0x67, 0xe4,
+	0x66, 0x64, 0xb0, 0xd3, 0xea, 0x6e, 0x87, 0x5c, 0x5a, 0x1a, 0xbd, 0xbc, 0x6e, 0xe1, 0x5a, 0xcb,
+	0xe8, 0x29, 0x9e, 0x04, 0x45, 0x13, 0x0e, 0x03, 0xc1, 0xcb, 0xfd, 0x20, 0x99, 0x6a, 0xc4, 0x51,
+	0x46, 0xa3, 0x6c, 0xae, 0xd9, 0x4c, 0xf8, 0xf5, 0x71, 0x8c, 0x5f, 0x6e, 0x50, 0x56, 0x5e, 0x28,
+	0xb4, 0x41, 0x0f, 0xb6, 0xff, 0xeb, 0xd5, 0x7c, 0x9b, 0x93, 0xe7, 0x90, 0xfb, 0xa3, 0xec, 0x00,
+	0x17, 0x7b, 0x98, 0x10, 0xed, 0x9d, 0x13, 0x13, 0xed, 0x4f, 0xf3, 0x93, 0xda, 0x60, 0x07, 0x45,
+	0xfe, 0xee, 0xe7, 0x9c, 0xde, 0xbb, 0x7b, 0x60, 0xff, 0x0c, 0x56, 0x80, 0x94, 0x9f, 0x71, 0xfb,
+	0x5e, 0xe9, 0xa7, 0xbf, 0xdf, 0x21, 0x13, 0xe6, 0x03, 0x25, 0xe7, 0xd7, 0xc7, 0xcd, 0xf3, 0xcb,
+	0xa2, 0xc2, 0x41, 0x3f, 0xaf, 0x3e, 0xe3, 0x90, 0x71, 0x09, 0x47, 0xf1, 0x3f, 0x75, 0xef, 0x92,
+	0x61, 0xd9, 0x53, 0xcf, 0xb1, 0xcd, 0x5a, 0xd3, 0xcc, 0xc9, 0xce, 0x28, 0x6e, 0xfe, 0x1f, 0x0f,
+	0x11, 0x25, 0xff, 0x02, 0xed, 0xc4, 0x69, 0xc8, 0x76, 0xd0, 0x63, 0x9c, 0x9e, 0x91, 0x76, 0x7a,
+	0xbe, 0x6c, 0xf3, 0xf4, 0xcc, 0xbb, 0x65, 0x9c, 0xa3, 0x9f, 0x2b, 0x9c, 0x37, 0xfc, 0x40, 0xfd,
+	0xf6, 0x13, 0x39, 0x6f, 0xb4, 0x2e, 0xec, 0x7f, 0xf2, 0xdc, 0x16, 0x27, 0x0f, 0x3f, 0x72, 0xff,
+	0x8e, 0xdd, 0x93, 0x47, 0xeb, 0x45, 0xf1, 0x0c, 0x4a, 0xf8, 0xc9, 0xc0, 0xcf, 0xdc, 0x5b, 0x56,
+	0x4f, 0x06, 0x8d, 0xab, 0x79, 0x46, 0x24, 0xfc, 0x8c, 0x18, 0xb4, 0xc5, 0x73, 0x79, 0xa1, 0x2f,
+	0x4f, 0x75, 0x5a, 0xbc, 0x2a, 0x4f, 0x0b, 0x7e, 0xda, 0x7e, 0xc8, 0xf2, 0x69, 0xa1, 0xf1, 0xed,
+	0x3d, 0x37, 0x3e, 0xa9, 0xce, 0x8d, 0x61, 0x5b, 0xd2, 0xad, 0x79, 0x6e, 0x68, 0xdc, 0x0f, 0x7b,
+	0x82, 0x8c, 0x1c, 0xe9, 0x04, 0x79, 0x85, 0x9c, 0xed, 0xe5, 0x05, 0x74, 0xcb, 0xbd, 0x44, 0x46,
+	0x1a, 0x71, 0xb4, 0x15, 0x6e, 0xaf, 0x06, 0x1d, 0x71, 0x53, 0x56, 0xbb, 0xe9, 0x82, 0x6c, 0x80,
+	0x1c, 0xc7, 0x7d, 0x82, 0x6f, 0x9d, 0x5c, 0x67, 0x35, 0x2a, 0x50, 0xab, 0xd7, 0xe9, 0x1e, 0xdb,
+	0x47, 0xdf, 0x37, 0xfc, 0x93, 0x3f, 0x35, 0xf3, 0xc8, 0x77, 0xfc, 0xa7, 0x8b, 0x8f, 0xf8, 0xbf,
+	0x5d, 0x25, 0x8f, 0x95, 0xf2, 0x14, 0xf7, 0xa4, 0x7f, 0x62, 0xdc, 0x93, 0xb4, 0x76, 0xcf, 0xb1,
+	0xb5, 0xae, 0x4a, 0xd9, 0x97, 0xdd, 0x88, 0xb4, 0x66, 0x38, 0x1b, 0xf4, 0x9b, 0x28, 0x54, 0xda,
+	0xa5, 0x9d, 0xa0, 0x41, 0xbd, 0x8a, 0x39, 0x51, 0x37, 0x64, 0x03, 0xe4, 0x38, 0x5c, 0xc9, 0xb1,
+	0x15, 0x74, 0x5b, 0x99, 0x57, 0x2d, 0x2a, 0x39, 0x18, 0x18, 0x64, 0xbb, 0xfb, 0xf7, 0x1d, 0xe2,
+	0xf6, 0x72, 0x15, 0x5b, 0xc9, 0xc6, 0x49, 0xcc, 0xc3, 0xfc, 0xb9, 0xfb, 0x9a, 0xfa, 0x43, 0x1b,
+	0x69, 0x49, 0x3f, 0xb4, 0x77, 0xfa, 0x49, 0x32, 0x61, 0x5e, 0xcb, 0x0e, 0xa1, 0xe5, 0x64, 0xca,
+	0xb0, 0x06, 0xea, 0x64, 0xbd, 0x8a, 0x39, 0x0f, 0x75, 0x0e, 0x06, 0xd9, 0xee, 0xce, 0x90, 0x1a,
+	0x4d, 0x92, 0x38, 0x11, 0x5a, 0x0e, 0xf6, 0x21, 0x5e, 0x41, 0x00, 0x70, 0xb8, 0xff, 0x47, 0x15,
+	0xe2, 0xf5, 0xbb, 0x17, 0xba, 0xff, 0x5c, 0xd3, 0x68, 0xf0, 0x46, 0x69, 0xbe, 0x88, 0x4f, 0xee,
+	0x36, 0x5a, 0x68, 0x48, 0xfb, 0xe8, 0x36, 0x44, 0x2b, 0x14, 0x3b, 0x38, 0xfd, 0x79, 0x4d, 0xb7,
+	0xa1, 0x93, 0x28, 0x11, 0x51, 0xb6, 0x4c, 0x11, 0x65, 0xdd, 0xf6, 0xa0, 0x74, 0x41, 0xe5, 0xf7,
+	0x6b, 0xe4, 0xb4, 0x6c, 0xad, 0x53, 0x3c, 0xec, 0x5f, 0xea, 0xd2, 0x64, 0xcf, 0xfd, 0x3d, 0x87,
+	0x9c, 0x09, 0x8a, 0x4a, 0xb3, 0x90, 0x9e, 0xc0, 0x44, 0x6b, 0x5c, 0x67, 0xe7, 0x4a, 0x38, 0xf2,
+	0x89, 0xbe, 0x2c, 0x26, 0xfa, 0x4c, 0x19, 0x4a, 0x1f, 0xcb, 0x48, 0xe9, 0x00, 0xd0, 0xfc, 0x20,
+	0xe1, 0x4c, 0xd1, 0xc6, 0x3f, 0x71, 0x65, 0x7e, 0x98, 0xd3, 0xda, 0xc0, 0xc0, 0xc4, 0x27, 0x33,
+	0xda, 0xee, 0xb4, 0x82, 0x8c, 0x6a, 0x2a, 0x3a, 0xf5, 0xe4, 0x86, 0xd6, 0x06, 0x06, 0x26, 0x2a,
+	0x37, 0xa3, 0xb8, 0x49, 0xaf, 0x35, 0xbd, 0x01, 0x53, 0xb9, 0x79, 0x83, 0x41, 0x41, 0xb4, 0xba,
+	0x4f, 0xe5, 0xfa, 0xd2, 0x1a, 0xfb, 0x84, 0x46, 0x4b, 0x75, 0xa5, 0xff, 0xd0, 0x21, 0x23, 0xf8,
+	0xc4, 0xc6, 0x5e, 0x87, 0xe2, 0xe9, 0x8c, 0x6f, 0xa4, 0x79, 0x32, 0x6f, 0xe4, 0x86, 0x64, 0x63,
+	0x2a, 0x99, 0x46, 0x14, 0xfc, 0xd3, 0x6f, 0xce, 0x0c, 0xcb, 0x1f, 0x90, 0xf7, 0x6a, 0x7a, 0x99,
+	0x3c, 0xda, 0xf7, 0x6d, 0x1e, 0xc9, 0x58, 0xf3, 0xb7, 0xc9, 0x84, 0xd9, 0x89, 0x23, 0x59, 0x6a,
+	0xfe, 0xa5, 0xf6, 0xd9, 0xf1, 0x71, 0x89, 0xfd, 0xec, 0x6d, 0x93, 0xc7, 0xd5, 0x62, 0x58, 0xf4,
+	0x2a, 0x25, 0x8b, 0x61, 0x51, 0x2c, 0x86, 0x45, 0x1f, 0x2d, 0x92, 0x25, 0x82, 0x2a, 0x1e, 0xcc,
+	0xdd, 0xa4, 0xe5, 0x39, 0xe6, 0xc1, 0x7c, 0x13, 0x56, 0x00, 0xe1, 0xee, 0xe7, 0xb5, 0xdd, 0x11,
+	0x1f, 0xeb, 0x0a, 0xc3, 0x93, 0x25, 0x23, 0x8a, 0x41, 0xb8, 0x77, 0xff, 0x13, 0x0d, 0x50, 0xec,
+	0x82, 0xff, 0xb9, 0x0a, 0x79, 0x62, 0x5f, 0xb1, 0xbb, 0xb4, 0xe3, 0xce, 0xdb, 0xde, 0x71, 0x3c,
+	0xd6, 0x12, 0xda, 0x89, 0x6f, 0xc2, 0x8a, 0x78, 0x5f, 0xea, 0x58, 0x03, 0x0e, 0x06, 0xd9, 0x8e,
+	0xa2, 0xc3, 0x2e, 0xdd, 0x5b, 0x8a, 0x93, 0x76, 0x90, 0x79, 0x55, 0x53, 0x74, 0xb8, 0x2e, 0x1b,
+	0x20, 0xc7, 0xf1, 0x7f, 0xcf, 0x21, 0xc5, 0x0e, 0xb8, 0x01, 0x99, 0xe8, 0xa6, 0x34, 0xc1, 0x23,
+	0xb5, 0x4e, 0x1b, 0x09, 0x95, 0xcb, 0xf3, 0x29, 0xcd, 0xa5, 0x62, 0xb6, 0x11, 0x27, 0x14, 0x1d,
+	0x28, 0x38, 0xc6, 0x75, 0xba, 0x57, 0xa7, 0x2d, 0x8a, 0x34, 0xe6, 0x5d, 0x34, 0x0a, 0xdd, 0x34,
+	0x08, 0x40, 0x81, 0x20, 0xb2, 0xe8, 0x04, 0x69, 0x7a, 0x27, 0x4e, 0x9a, 0x82, 0x45, 0xe5, 0xc8,
+	0x2c, 0xd6, 0x0d, 0x02, 0x50, 0x20, 0xe8, 0x7f, 0x19, 0x2f, 0xc0, 0xba, 0xdc, 0xed, 0xfe, 0x14,
+	0xca, 0x3e, 0x08, 0x99, 0x6f, 0xc5, 0x9b, 0x28, 0xc9, 0x06, 0x61, 0x44, 0xa5, 0x3b, 0xc7, 0x86,
+	0x25, 0x29, 0xdf, 0xa0, 0x9d, 0x5b, 0x4f, 0x7a, 0xdb, 0xa0, 0xa4, 0x2f, 0x28, 0xe3, 0x6c, 0xb6,
+	0xe2, 0xcd, 0xa2, 0x9d, 0x16, 0x91, 0x80, 0xb5, 0xf8, 0x7f, 0xe6, 0x90, 0xf3, 0x7d, 0xae, 0x13,
+	0xee, 0x17, 0x1c, 0x32, 0xbe, 0xf9, 0x35, 0x31, 0x36, 0xb3, 0x1b, 0x68, 0x43, 0x44, 0x00, 0x9e,
+	0x44, 0x62, 0x6d, 0x56, 0x4c, 0x1b, 0xe2, 0xbc, 0xd1, 0x0a, 0x05, 0x6c, 0xff, 0xc7, 0x2a, 0xa4,
+	0x84, 0x0b, 0x9a, 0x4a, 0x69, 0xd4, 0xec, 0xc4, 0x61, 0x94, 0x89, 0xcd, 0x48, 0xed, 0x7a, 0x57,
+	0x04, 0x1c, 0x14, 0x86, 0xb8, 0x7f, 0x88, 0x89, 0xa9, 0xf4, 0xdc, 0x3f, 0x44, 0xcf, 0x73, 0x1c,
+	0x77, 0x9b, 0x4c, 0x05, 0xdc, 0xb2, 0xc5, 0xd6, 0x1e, 0x5b, 0xa6, 0xd5, 0xa3, 0x2c, 0x53, 0x76,
+	0x65, 0x9a, 0x2b, 0x90, 0x80, 0x1e, 0xa2, 0x68, 0x99, 0xed, 0xa6, 0xb4, 0xbe, 0x78, 0x7d, 0x21,
+	0xa1, 0x4d, 0x7e, 0xaf, 0xd7, 0x2c, 0xb3, 0x37, 0xf3, 0x26, 0xd0, 0xf1, 0xfc, 0x3f, 0x70, 0xc8,
+	0xd0, 0x7c, 0xd0, 0xd8, 0x8d, 0xb7, 0xb6, 0x70, 0x2a, 0x9a, 0xdd, 0x24, 0x57, 0xcd, 0x69, 0x53,
+	0xb1, 0x28, 0xe0, 0xa0, 0x30, 0xdc, 0x0d, 0x32, 0xc8, 0x3f, 0x78, 0xf1, 0xd9, 0xbd, 0xbb, 0xaf,
+	0xb3, 0x14, 0x3a, 0x87, 0xcd, 0x72, 0xe7, 0xb0, 0xd9, 0x6b, 0x51, 0xb6, 0x86, 0x0e, 0x4b, 0x61,
+	0xb4, 0xcd, 0xef, 0x8e, 0x4b, 0x8c, 0x06, 0x08, 0x5a, 0x38, 0x8c, 0x76, 0x70, 0x57, 0xb2, 0x13,
+	0xdb, 0x8f, 0x1a, 0xc6, 0x6a, 0xde, 0x04, 0x3a, 0x1e, 0x9e, 0x26, 0x8d, 0xa0, 0xe3, 0x0d, 0x98,
+	0xa7, 0xc9, 0x42, 0xd0, 0x01, 0x84, 0xfb, 0xbf, 0xed, 0x90, 0x91, 0xf9, 0x20, 0x0d, 0x1b, 0x7f,
+	0x8d, 0xf6, 0xa6, 0x7f, 0xeb, 0x90, 0xda, 0x42, 0xd0, 0xd8, 0xa1, 0xee, 0xcd, 0xe2, 0xa5, 0x78,
+	0xf4, 0xf2, 0x33, 0x65, 0x7c, 0x50, 0xef, 0xd9, 0x5a, 0xdb, 0xfc, 0x04, 0xc5, 0x0f, 0x7e, 0x8b,
+	0x26, 0x34, 0x6a, 0xd0, 0xf9, 0xf1, 0xbe, 0x57, 0x67, 0x4a, 0xaa, 0xe9, 0x2b, 0x2d, 0x7b, 0x1a,
+	0xc6, 0xfa, 0x4b, 0x2b, 0xac, 0xbf, 0x5c, 0x53, 0x52, 0x7f, 0x69, 0x05, 0x90, 0xbe, 0xff, 0x6b,
+	0x15, 0x72, 0x76, 0x61, 0x27, 0x6c, 0x35, 0x6f, 0x89, 0x27, 0xa4, 0x04, 0xea, 0xfe, 0xac, 0x43,
+	0x4e, 0xdf, 0x29, 0x00, 0xf3, 0x0b, 0xb7, 0x05, 0x83, 0xcd, 0xad, 0x5e, 0xe2, 0xf3, 0x8f, 0x49,
+	0xdf, 0xa5, 0x92, 0x46, 0x28, 0xeb, 0x8e, 0xfb, 0x3a, 0x2a, 0x91, 0x85, 0x3b, 0x9a, 0x98, 0xad,
+	0xeb, 0x36, 0x8e, 0x7a, 0x41, 0x52, 0x57, 0x17, 0x0b, 0x10, 0xe4, 0x0c, 0xfd, 0x37, 0x1d, 0x32,
+	0xb1, 0xd0, 0x0a, 0x69, 0x94, 0x2d, 0xd0, 0x24, 0x63, 0xeb, 0x7b, 0x9b, 0x4c, 0x35, 0x14, 0xe4,
+	0x38, 0x2b, 0x9c, 0xab, 0x69, 0x0a, 0x24, 0xa0, 0x87, 0xa8, 0xdb, 0x24, 0x93, 0x1c, 0x96, 0xef,
+	0x6d, 0x47, 0x5a, 0xe6, 0x4c, 0x4b, 0xbf, 0x60, 0x52, 0x80, 0x22, 0x49, 0xff, 0x4f, 0x1c, 0x72,
+	0x7e, 0xa1, 0xd5, 0x4d, 0x33, 0x9a, 0xf4, 0x2c, 0x91, 0x8f, 0x93, 0xe1, 0xb6, 0xf4, 0x78, 0x70,
+	0x0e, 0xd8, 0x86, 0x0c, 0x9f, 0x4d, 0xfe, 0x19, 0xa0, 0xf7, 0x42, 0xee, 0xc6, 0x93, 0xc3, 0x40,
+	0x51, 0x75, 0x3b, 0x64, 0x20, 0xed, 0xd0, 0x86, 0x3d, 0x2f, 0x4a, 0x39, 0x06, 0xb4, 0x0c, 0xe4,
+	0xa7, 0x33, 0xfe, 0x02, 0xc6, 0xc9, 0xff, 0x2b, 0x87, 0x3c, 0xd6, 0x67, 0xbc, 0x2b, 0x61, 0x9a,
+	0xb9, 0x1f, 0xed, 0x19, 0xf3, 0xec, 0xe1, 0xc6, 0x8c, 0x4f, 0xb3, 0x11, 0xab, 0x6d, 0x5d, 0x42,
+	0xb4, 0xf1, 0x7e, 0x92, 0xd4, 0xc2, 0x8c, 0xb6, 0xa5, 0x39, 0xc4, 0x82, 0xe2, 0xb2, 0xcf, 0x58,
+	0xe6, 0xc7, 0xa5, 0xfb, 0xef, 0x35, 0xe4, 0x07, 0x9c, 0xad, 0xbf, 0x4b, 0x06, 0x17, 0xe2, 0x56,
+	0xb7, 0x1d, 0x1d, 0xce, 0x23, 0x2d, 0xdb, 0xeb, 0xd0, 0xa2, 0xa4, 0xc3, 0x2e, 0x71, 0xac, 0x45,
+	0xaa, 0xff, 0xaa, 0xe5, 0xea, 0x3f, 0xff, 0xdf, 0x39, 0x04, 0xf7, 0xbe, 0x66, 0x28, 0x2c, 0xf1,
+	0x9c, 0x1c, 0x67, 0xf8, 0x84, 0x4e, 0xee, 0xc1, 0xbd, 0x99, 0x71, 0x85, 0xa8, 0xd1, 0xff, 0x18,
+	0x19, 0x4c, 0x99, 0x62, 0x45, 0xf4, 0x61, 0x49, 0xde, 0x82, 0xb8, 0xba, 0xe5, 0xc1, 0xbd, 0x99,
+	0x43, 0x79, 0x74, 0xcf, 0x2a, 0xda, 0xfc, 0x39, 0x10, 0x54, 0x51, 0x6c, 0x6f, 0xd3, 0x34, 0x0d,
+	0xb6, 0xe5, 0x3d, 0x5d, 0x89, 0xed, 0xab, 0x1c, 0x0c, 0xb2, 0xdd, 0xff, 0x71, 0x87, 0x8c, 0x2b,
+	0x11, 0x04, 0x2f, 0x61, 0xee, 0x0d, 0x5d, 0x58, 0xe1, 0x2b, 0xe5, 0x89, 0xb2, 0x0f, 0x53, 0x3d,
+	0x75, 0x80, 0x2c, 0xf3, 0x5e, 0x32, 0xd6, 0xa4, 0x1d, 0x1a, 0x35, 0x69, 0xd4, 0x08, 0x29, 0x5f,
+	0x21, 0x23, 0xf3, 0x53, 0xa8, 0x35, 0x58, 0xd4, 0xe0, 0x60, 0x60, 0xf9, 0x3f, 0xed, 0x90, 0x47,
+	0x15, 0xb9, 0x3a, 0xcd, 0x80, 0x66, 0xc9, 0x9e, 0x72, 0x87, 0x3e, 0x9a, 0xcc, 0x71, 0x0b, 0x6f,
+	0x31, 0x59, 0xc2, 0x99, 0x1f, 0x4f, 0xe8, 0x18, 0xe5, 0x77, 0x1e, 0x46, 0x04, 0x24, 0x35, 0xff,
+	0x87, 0xab, 0xe4, 0x8c, 0xde, 0x49, 0xb5, 0xc1, 0x7c, 0x97, 0x43, 0x88, 0x9a, 0x01, 0x14, 0xab,
+	0xaa, 0x76, 0x6c, 0xbf, 0xc6, 0x9b, 0xca, 0xb7, 0x20, 0x05, 0x4e, 0x41, 0x63, 0xeb, 0x7e, 0x88,
+	0x8c, 0xdd, 0xc6, 0x8f, 0x82, 0xae, 0xa2, 0xd0, 0x97, 0x7a, 0x55, 0xd6, 0x8d, 0x99, 0xb2, 0x97,
+	0xf9, 0x72, 0x8e, 0x97, 0x2b, 0x75, 0x34, 0x60, 0x0a, 0x06, 0x29, 0xbc, 0xaf, 0x8e, 0x27, 0xfa,
+	0x2b, 0x11, 0xb6, 0x99, 0x8f, 0x58, 0x1c, 0x63, 0xf1, 0xad, 0xcf, 0x9f, 0xba, 0x7f, 0x6f, 0x66,
+	0xdc, 0x00, 0x81, 0xd9, 0x09, 0xff, 0x43, 0x84, 0xcd, 0x45, 0x18, 0x75, 0xe9, 0x5a, 0xe4, 0x3e,
+	0x29, 0x35, 0xad, 0xdc, 0xbe, 0xa7, 0x76, 0x0e, 0x5d, 0xdb, 0x8a, 0x1a, 0x89, 0xad, 0x20, 0x6c,
+	0x29, 0x3f, 0x7f, 0xa5, 0x91, 0x58, 0x62, 0x50, 0x10, 0xad, 0xfe, 0x2c, 0x19, 0x5a, 0xc0, 0xb1,
+	0xd3, 0x04, 0xe9, 0xea, 0x01, 0x09, 0xe3, 0x46, 0x40, 0x82, 0x0c, 0x3c, 0xd8, 0x20, 0x67, 0x17,
+	0x12, 0x1a, 0x64, 0xb4, 0xfe, 0xfc, 0x7c, 0xb7, 0xb1, 0x4b, 0x33, 0xee, 0x42, 0x99, 0xba, 0xef,
+	0x27, 0xe3, 0x31, 0x3b, 0x32, 0x56, 0xe2, 0xc6, 0x6e, 0x18, 0x6d, 0x0b, 0xc5, 0xf9, 0x59, 0x41,
+	0x65, 0x7c, 0x4d, 0x6f, 0x04, 0x13, 0xd7, 0xff, 0xc3, 0x0a, 0x19, 0x5b, 0x48, 0xe2, 0x48, 0x6e,
+	0x8b, 0x0f, 0xe1, 0x28, 0xcb, 0x8c, 0xa3, 0xcc, 0x82, 0xd9, 0x5d, 0xef, 0x7f, 0xbf, 0xe3, 0xcc,
+	0x7d, 0x5d, 0x6d, 0x91, 0x55, 0x5b, 0x17, 0x49, 0x83, 0x2f, 0xa3, 0x9d, 0xbf, 0x6c, 0x73, 0x03,
+	0xf5, 0xff, 0x5b, 0x85, 0x9c, 0xd1, 0xd1, 0xf1, 0xae, 0xb3, 0x15, 0xb6, 0x5a, 0x87, 0x38, 0x5d,
+	0xd6, 0x48, 0x2d, 0xcd, 0x82, 0x44, 0xca, 0x34, 0x47, 0x09, 0x06, 0x51, 0x0b, 0xa9, 0x8e, 0x04,
+	0x80, 0xd3, 0x71, 0xaf, 0x91, 0x2a, 0x8d, 0x9a, 0xc7, 0x88, 0x2d, 0x51, 0x07, 0xd7, 0x95, 0xa8,
+	0x09, 0x48, 0x03, 0xcd, 0xde, 0x9d, 0x20, 0x09, 0x5a, 0x2d, 0xda, 0x0a, 0x53, 0xee, 0x52, 0x5d,
+	0x13, 0x0e, 0x9a, 0x39, 0x18, 0x74, 0x1c, 0xbc, 0xba, 0xaa, 0xa8, 0x05, 0xaf, 0x66, 0x5e, 0x5d,
+	0x55, 0x68, 0x03, 0xe4, 0x38, 0xdc, 0xd7, 0x37, 0xe9, 0x46, 0xfc, 0xf3, 0x61, 0xe6, 0xd3, 0x9a,
+	0xee, 0xeb, 0xab, 0x9a, 0x40, 0xc7, 0xf3, 0xbf, 0x50, 0x21, 0x8f, 0x97, 0xcd, 0xf8, 0x35, 0xfc,
+	0xe2, 0x6e, 0x07, 0x2d, 0x77, 0x9b, 0x8c, 0x63, 0x78, 0x51, 0xb3, 0xdb, 0xa2, 0xcd, 0x8d, 0x50,
+	0xbc, 0x82, 0xa3, 0x4d, 0x88, 0xfa, 0xc4, 0xea, 0x3a, 0x21, 0x30, 0xe9, 0xe2, 0xd9, 0x22, 0xd7,
+	0x8e, 0x57, 0x31, 0xcf, 0x16, 0xd9, 0x39, 0x50, 0x18, 0xee, 0x7b, 0x49, 0xad, 0xb3, 0x13, 0xa4,
+	0xf2, 0xa0, 0xbd, 0x20, 0x5f, 0xe1, 0x3a, 0x02, 0xf1, 0xfc, 0x97, 0xcf, 0x30, 0x00, 0x70, 0x64,
+	0xfd, 0x80, 0x1e, 0x38, 0xe0, 0x80, 0xfe, 0x57, 0x15, 0x32, 0x5d, 0x36, 0x31, 0xc2, 0x1e, 0x74,
+	0xf0, 0x82, 0x54, 0x3d, 0xac, 0x1c, 0xa5, 0x87, 0x85, 0xd7, 0x58, 0x3d, 0xdc, 0x6b, 0x74, 0x3f,
+	0xeb, 0x90, 0x91, 0x50, 0xbc, 0x32, 0x79, 0xee, 0x7d, 0xcc, 0xee, 0xa7, 0x5b, 0x5c, 0x19, 0xf9,
+	0x7a, 0x94, 0x90, 0x14, 0xf2, 0x3e, 0xf8, 0x9f, 0x73, 0x88, 0xa7, 0x3f, 0xae, 0xcb, 0x1c, 0xee,
+	0xdf, 0x22, 0xe3, 0x0d, 0xad, 0x8d, 0x5b, 0x78, 0x46, 0xf8, 0x41, 0xa3, 0x3f, 0x94, 0x82, 0x89,
+	0xe7, 0x7e, 0x0b, 0x99, 0x6c, 0xd2, 0xa0, 0xd9, 0x0a, 0x23, 0xbc, 0xbb, 0xc7, 0x51, 0x93, 0x8b,
+	0x16, 0x55, 0x7e, 0x39, 0x59, 0x34, 0x9b, 0xa0, 0x88, 0xeb, 0xff, 0x17, 0x87, 0x4c, 0xe9, 0xf4,
+	0x1f, 0x82, 0x84, 0x9e, 0x9a, 0x12, 0xfa, 0x0d, 0xcb, 0x2f, 0xa5, 0x5c, 0x2c, 0xff, 0x8f, 0xc4,
+	0x1c, 0x27, 0xf3, 0xe9, 0xfa, 0x49, 0x87, 0x8c, 0xdd, 0xd1, 0x00, 0x62, 0xb0, 0xb6, 0x2f, 0x49,
+	0xef, 0x94, 0x62, 0x8c, 0x0e, 0x7d, 0x50, 0xf8, 0x0d, 0x46, 0x4f, 0xdc, 0x8f, 0x92, 0x53, 0x8d,
+	0x38, 0x6a, 0x74, 0x13, 0xd4, 0x72, 0xec, 0xad, 0xb3, 0x80, 0x4b, 0xf1, 0x65, 0xcf, 0x0a, 0x72,
+	0xa7, 0x16, 0x8a, 0x08, 0x0f, 0xca, 0x80, 0xd0, 0x4b, 0x88, 0x1b, 0x89, 0x53, 0x5c, 0x7e, 0x42,
+	0xd1, 0xa6, 0x19, 0x89, 0x19, 0x18, 0x64, 0xbb, 0x7b, 0x93, 0x9c, 0x67, 0xbb, 0x7f, 0x18, 0x6d,
+	0x17, 0x16, 0x13, 0xdb, 0x84, 0xab, 0xf3, 0x8f, 0xdd, 0xbf, 0x37, 0x73, 0xbe, 0x5e, 0x8e, 0x02,
+	0xfd, 0x9e, 0x75, 0x3f, 0x46, 0xa6, 0x85, 0x19, 0x7a, 0xab, 0xdb, 0x7a, 0x31, 0xde, 0x4c, 0xaf,
+	0x86, 0x29, 0xea, 0x6f, 0x57, 0xc2, 0x76, 0x98, 0x89, 0xbd, 0xfa, 0xc2, 0xfd, 0x7b, 0x33, 0xd3,
+	0xf5, 0xbe, 0x58, 0xb0, 0x0f, 0x05, 0x17, 0xc8, 0x39, 0x2e, 0x2e, 0xf5, 0xd0, 0x1e, 0x62, 0xb4,
+	0xa7, 0xef, 0xdf, 0x9b, 0x39, 0xb7, 0x54, 0x8a, 0x01, 0x7d, 0x9e, 0xc4, 0xfd, 0x18, 0xc3, 0x22,
+	0x5f, 0xc5, 0xa0, 0xc4, 0x61, 0x73, 0x3f, 0xde, 0x10, 0x70, 0x50, 0x18, 0xee, 0x27, 0xf2, 0xb5,
+	0x85, 0x1f, 0x80, 0x37, 0x72, 0x4c, 0x99, 0x88, 0x29, 0x33, 0x6e, 0x69, 0x94, 0x58, 0xec, 0x82,
+	0x41, 0xdb, 0xfd, 0x6e, 0x87, 0x8c, 0xa5, 0x59, 0xac, 0x22, 0x0e, 0x3d, 0x62, 0x6b, 0x21, 0xd7,
+	0x35, 0xaa, 0xfc, 0xaa, 0xa4, 0x43, 0xc0, 0xe0, 0xea, 0x7e, 0x03, 0x19, 0x91, 0x27, 0x58, 0xea,
+	0x8d, 0xb2, 0x0d, 0x8c, 0xa9, 0xe7, 0xe4, 0x29, 0x97, 0x42, 0xde, 0x8e, 0xe7, 0xc5, 0x9d, 0x1d,
+	0x1a, 0x79, 0x63, 0xe6, 0x79, 0x71, 0x6b, 0x87, 0x46, 0xc0, 0x5a, 0xdc, 0xef, 0x73, 0xc8, 0xc8,
+	0xa6, 0xd8, 0x63, 0x53, 0x6f, 0xfc, 0x62, 0xd5, 0x8e, 0xc3, 0x5b, 0xd9, 0x16, 0x9e, 0x6f, 0xdd,
+	0x12, 0x92, 0x42, 0xce, 0xdb, 0x5d, 0x21, 0xe3, 0x8d, 0x20, 0x6b, 0xec, 0xdc, 0xec, 0x88, 0x2f,
+	0x71, 0xc2, 0x08, 0x72, 0x19, 0x5f, 0xd0, 0x1b, 0x1f, 0x14, 0x01, 0x60, 0x3e, 0x8c, 0xf7, 0x50,
+	0x01, 0xe0, 0x2b, 0x72, 0x92, 0xad, 0x48, 0x36, 0xb9, 0x0b, 0x1a, 0x1c, 0x0c, 0x2c, 0xf7, 0x47,
+	0x9c, 0xc2, 0xf5, 0x75, 0xca, 0x96, 0x73, 0x54, 0xbf, 0x43, 0xe9, 0xc0, 0xab, 0xf1, 0x9f, 0xd7,
+	0x88, 0xdb, 0x2b, 0xcb, 0xba, 0xd7, 0xc9, 0x60, 0xd0, 0xc8, 0x30, 0x6c, 0x8c, 0xbb, 0x29, 0x3c,
+	0x59, 0x76, 0xcf, 0x2b, 0xea, 0x71, 0x95, 0x00, 0x3c, 0xc7, 0x1e, 0x05, 0x41, 0xc2, 0x8d, 0xc9,
+	0xa9, 0x56, 0x90, 0x66, 0x86, 0xa0, 0x74, 0x0c, 0x89, 0xf6, 0x2c, 0x6e, 0x98, 0x2b, 0x45, 0x42,
+	0xd0, 0x4b, 0x1b, 0x03, 0x72, 0x1b, 0x52, 0x9b, 0x21, 0x6f, 0xaa, 0xd7, 0xad, 0x5c, 0x26, 0x39,
+	0x4d, 0xe3, 0xb2, 0x2c, 0xd8, 0x80, 0xc6, 0x12, 0x05, 0x5d, 0xb6, 0xb1, 0xd1, 0x26, 0xe5, 0xdb,
+	0x73, 0x35, 0x5f, 0x9d, 0x75, 0xd9, 0x00, 0x39, 0x8e, 0x76, 0x71, 0xe4, 0x3b, 0x72, 0x9f, 0x8b,
+	0xa3, 0xfb, 0x82, 0x94, 0xbf, 0x78, 0xf8, 0x9f, 0x5f, 0x94, 0xbf, 0x4e, 0xe9, 0xef, 0xd2, 0x90,
+	0xc1, 0x7e, 0xc8, 0xf8, 0x12, 0x87, 0xd8, 0x9c, 0x7c, 0xf4, 0x64, 0xbe, 0x44, 0x71, 0x1f, 0xda,
+	0xff, 0x7b, 0xfc, 0x14, 0x39, 0x7b, 0x27, 0x08, 0xf1, 0x5c, 0x31, 0xde, 0x1d, 0x7a, 0xec, 0x57,
+	0x8f, 0xb8, 0x30, 0x94, 0x7b, 0xda, 0xad, 0x32, 0x82, 0x50, 0xce, 0xc7, 0xff, 0x56, 0x32, 0xb6,
+	0x38, 0xb7, 0x7c, 0xe5, 0x6e, 0x27, 0x88, 0x58, 0x80, 0x9f, 0x71, 0x39, 0x71, 0x0e, 0xbe, 0x9c,
+	0xf8, 0x5f, 0x1d, 0x23, 0x43, 0x8b, 0x73, 0xcb, 0x1b, 0x41, 0xba, 0x7b, 0x08, 0xc9, 0x19, 0x4f,
+	0x1e, 0xa1, 0xd1, 0x29, 0xde, 0x04, 0x94, 0x26, 0x5f, 0x61, 0xb8, 0x11, 0x19, 0x0c, 0x23, 0x3c,
+	0x6c, 0xbd, 0x09, 0x5b, 0xb6, 0x0f, 0xc9, 0x85, 0xdb, 0xbc, 0xae, 0x31, 0xea, 0x20, 0xb8, 0x98,
+	0x06, 0x84, 0xea, 0x43, 0x36, 0x20, 0xb8, 0xdf, 0xe1, 0x90, 0xd1, 0x4c, 0xb3, 0xae, 0x0c, 0x58,
+	0xcb, 0x4c, 0x90, 0x13, 0xe5, 0x57, 0x53, 0x0d, 0x00, 0x3a, 0xcb, 0x1e, 0xc5, 0x62, 0xed, 0x30,
+	0x8a, 0x45, 0xf7, 0x0e, 0x19, 0xb9, 0x13, 0x66, 0x3b, 0x4c, 0x4c, 0x15, 0xee, 0x43, 0x4b, 0x6f,
+	0xbd, 0xd7, 0x48, 0x2e, 0x9f, 0xb1, 0x5b, 0x92, 0x01, 0xe4, 0xbc, 0x70, 0xb1, 0xe2, 0x0f, 0xb6,
+	0x2e, 0xbd, 0x21, 0x73, 0xb1, 0xde, 0x92, 0x0d, 0x90, 0xe3, 0xe0, 0x14, 0x8f, 0xe1, 0xaf, 0x3a,
+	0x7d, 0xa5, 0x8b, 0x9b, 0xb5, 0x37, 0x6c, 0x6b, 0x5d, 0x49, 0x8a, 0x7c, 0xb2, 0x6e, 0x69, 0x3c,
+	0xc0, 0xe0, 0xa8, 0xa4, 0x85, 0x91, 0xbe, 0xd2, 0xc2, 0xeb, 0x5c, 0xd1, 0xc9, 0x35, 0x6e, 0x1e,
+	0xb1, 0x15, 0x5c, 0x96, 0x6b, 0xf1, 0x78, 0x8c, 0x6d, 0xfe, 0x1b, 0x34, 0x7e, 0xb8, 0x07, 0xc7,
+	0xd1, 0x95, 0xbb, 0x61, 0x26, 0x22, 0x83, 0xd5, 0x1e, 0xbc, 0xc6, 0xa0, 0x20, 0x5a, 0xb9, 0x9b,
+	0x2a, 0x2e, 0x82, 0x54, 0x08, 0x3e, 0x9a, 0x9b, 0x2a, 0x03, 0x83, 0x6c, 0x77, 0xff, 0x81, 0x43,
+	0x6a, 0x3b, 0x71, 0xbc, 0x2b, 0x45, 0x1f, 0x0b, 0x8a, 0x27, 0xb1, 0xe3, 0xcc, 0x5e, 0x45, 0xb2,
+	0x66, 0xae, 0x83, 0x1a, 0x83, 0x3d, 0xb8, 0x37, 0x33, 0xb1, 0x12, 0x6e, 0xd1, 0xc6, 0x5e, 0xa3,
+	0x45, 0x19, 0xe4, 0xd3, 0x6f, 0x6a, 0x90, 0x2b, 0xb7, 0x69, 0x94, 0x01, 0xef, 0x95, 0x9b, 0x90,
+	0x41, 0x8a, 0x1b, 0x60, 0xd3, 0x9b, 0xb4, 0x25, 0x6d, 0xea, 0x7b, 0x2a, 0xdf, 0x6a, 0xd8, 0xcf,
+	0x26, 0x08, 0x4e, 0xc8, 0x73, 0x2b, 0x88, 0xd6, 0xba, 0x99, 0x37, 0x65, 0x8b, 0xe7, 0x12, 0xa3,
+	0xc7, 0x45, 0x33, 0x69, 0xd2, 0x47, 0x08, 0x08, 0x4e, 0x28, 0xd5, 0x6e, 0xa2, 0x20, 0x56, 0x0f,
+	0x5f, 0xa5, 0xde, 0x29, 0x76, 0xc2, 0x8e, 0xf3, 0x93, 0x49, 0x00, 0x21, 0x6f, 0x9f, 0xfe, 0x8c,
+	0x43, 0x48, 0x3e, 0xbb, 0x25, 0x4e, 0x72, 0xd4, 0x74, 0x2b, 0xb5, 0xa0, 0x8a, 0x37, 0xde, 0x97,
+	0xee, 0x75, 0xf7, 0x1f, 0x1c, 0x32, 0x8a, 0x6f, 0x5c, 0x9e, 0x0b, 0x4f, 0x93, 0xc1, 0x2c, 0x48,
+	0xb6, 0xa9, 0x74, 0x14, 0x51, 0x6b, 0x74, 0x83, 0x41, 0x41, 0xb4, 0xba, 0x11, 0xa9, 0x65, 0x41,
+	0xba, 0x2b, 0x2f, 0xe8, 0xd7, 0xac, 0xad, 0xbb, 0xfc, 0x6e, 0x8e, 0xbf, 0x52, 0xe0, 0x6c, 0xdc,
+	0x67, 0xc8, 0x30, 0x4a, 0x28, 0x4b, 0x41, 0x2a, 0x7d, 0xb7, 0xc7, 0xf0, 0x64, 0x5b, 0x12, 0x30,
+	0x50, 0xad, 0xe8, 0x03, 0x33, 0xb0, 0xc8, 0x55, 0xc1, 0x83, 0x69, 0xdc, 0x4d, 0x1a, 0x52, 0xf9,
+	0x66, 0xe1, 0x43, 0x47, 0xba, 0x75, 0x46, 0x53, 0x53, 0xc6, 0xb2, 0xdf, 0x20, 0x78, 0xa1, 0xad,
+	0x61, 0x22, 0x4b, 0x82, 0x28, 0xdd, 0x62, 0x2e, 0x39, 0x68, 0xf3, 0xa9, 0xd8, 0xfa, 0x34, 0x37,
+	0x0c, 0xba, 0xf5, 0x8c, 0x76, 0x72, 0xcf, 0x20, 0xb3, 0x0d, 0x0a, 0x7d, 0xf0, 0x7f, 0xc2, 0x21,
+	0x24, 0xef, 0x3d, 0xc6, 0x87, 0x8e, 0x07, 0x7a, 0xd4, 0x93, 0xe7, 0xd8, 0x5a, 0x6a, 0x46, 0x30,
+	0x15, 0x57, 0x4e, 0x19, 0x20, 0x30, 0x19, 0xfb, 0xdf, 0x48, 0x6a, 0x6c, 0xcb, 0x40, 0x01, 0x26,
+	0x15, 0x56, 0xf3, 0xa2, 0x99, 0x4c, 0x5a, 0xd3, 0x41, 0x61, 0xf8, 0x1f, 0x21, 0x2e, 0x7b, 0x6c,
+	0x91, 0x36, 0xbb, 0x9d, 0x56, 0xd8, 0x50, 0x3e, 0x32, 0xea, 0x5b, 0xea, 0xb5, 0x85, 0xe2, 0xea,
+	0xbe, 0x13, 0x46, 0x4d, 0xa5, 0x2b, 0x55, 0x2f, 0xf1, 0x16, 0x83, 0x82, 0x68, 0x45, 0xa7, 0x7a,
+	0xbe, 0x8d, 0x31, 0x7b, 0x34, 0xde, 0xac, 0x3e, 0x40, 0x26, 0xda, 0xc1, 0xdd, 0x7a, 0x77, 0xb3,
+	0x1d, 0xb2, 0x74, 0x0d, 0x7c, 0xc2, 0x6a, 0xf9, 0xfc, 0xaf, 0x1a, 0xad, 0x50, 0xc0, 0xc6, 0xc1,
+	0x49, 0x2d, 0x5f, 0x51, 0x3a, 0x93, 0x8a, 0x40, 0x50, 0x18, 0xfe, 0x47, 0xc9, 0xc4, 0x95, 0xbb,
+	0xb4, 0xd1, 0xcd, 0xe2, 0x84, 0xbb, 0xad, 0xf4, 0x49, 0x3d, 0xe0, 0x1c, 0x2b, 0xf5, 0xc0, 0xef,
+	0x3a, 0x64, 0x4c, 0xdf, 0xd1, 0xdc, 0x2d, 0x32, 0xd6, 0x0e, 0x23, 0x75, 0x6f, 0xf0, 0x9c, 0x63,
+	0xda, 0x1d, 0xd9, 0x01, 0xbd, 0xaa, 0x51, 0x02, 0x83, 0xae, 0xfb, 0x6d, 0x64, 0xa4, 0x1d, 0xdc,
+	0x5d, 0xca, 0x0d, 0x58, 0xc7, 0x61, 0xc2, 0xf6, 0xd5, 0x55, 0x49, 0x06, 0x72, 0x8a, 0xfe, 0x0f,
+	0xaa, 0x71, 0x89, 0x4b, 0xe6, 0x93, 0xa4, 0x96, 0xc5, 0x59, 0xc0, 0x3d, 0x70, 0xab, 0xda, 0xce,
+	0x82, 0x40, 0xe0, 0x6d, 0xe6, 0x55, 0xaa, 0x72, 0xa4, 0xab, 0x54, 0x75, 0xbf, 0xab, 0x94, 0xff,
+	0x0b, 0x0e, 0x19, 0xd5, 0xc2, 0xa8, 0x50, 0x04, 0xde, 0x5e, 0xa8, 0x73, 0xf3, 0x9a, 0xe7, 0xd8,
+	0x12, 0x81, 0x97, 0x25, 0xc9, 0xbc, 0xd7, 0x0a, 0x04, 0x39, 0xc3, 0x03, 0x82, 0x84, 0xfc, 0x5f,
+	0x77, 0xc8, 0xd9, 0xd2, 0x98, 0xaf, 0xb7, 0xb9, 0xdb, 0x86, 0xa3, 0x6e, 0xe5, 0x10, 0x8e, 0xba,
+	0xbf, 0xec, 0x90, 0x9c, 0x12, 0xbe, 0xab, 0xcd, 0xbc, 0xe7, 0xda, 0x07, 0x2f, 0x38, 0x89, 0x56,
+	0xf7, 0x75, 0x72, 0xde, 0xfc, 0x50, 0x8e, 0xe9, 0xed, 0xc3, 0x15, 0x9d, 0xe5, 0x94, 0xa0, 0x1f,
+	0x0b, 0xff, 0x8b, 0x0e, 0xa9, 0x2d, 0x07, 0xdd, 0x6d, 0x7a, 0x28, 0x63, 0x2d, 0x9e, 0x85, 0x09,
+	0x0d, 0x5a, 0x99, 0xd4, 0x72, 0x88, 0xb3, 0x10, 0x04, 0x0c, 0x54, 0xab, 0x3b, 0x47, 0x46, 0xe2,
+	0x0e, 0x35, 0xfc, 0x0c, 0x9f, 0x94, 0xb3, 0xb7, 0x26, 0x1b, 0x50, 0x9e, 0x63, 0xdc, 0x15, 0x04,
+	0xf2, 0xa7, 0xfc, 0x2f, 0x0d, 0x92, 0x51, 0x2d, 0xab, 0x01, 0x0a, 0xd9, 0x09, 0xed, 0xc4, 0xc5,
+	0x8b, 0x28, 0x2e, 0x18, 0x60, 0x2d, 0xb8, 0xd5, 0x25, 0xf4, 0x36, 0xcb, 0x5d, 0x57, 0xdc, 0xea,
+	0x40, 0xc0, 0x41, 0x61, 0x60, 0x80, 0x51, 0x93, 0x76, 0xb2, 0x1d, 0xd6, 0xbd, 0x01, 0x1e, 0x60,
+	0xb4, 0x88, 0x00, 0xe0, 0x70, 0x44, 0xd8, 0xa2, 0x59, 0x63, 0x87, 0xd9, 0x67, 0x44, 0x04, 0xd2,
+	0x12, 0x02, 0x80, 0xc3, 0x4b, 0x5c, 0x1d, 0x6b, 0x27, 0xef, 0xea, 0x38, 0x68, 0xd9, 0xd5, 0xd1,
+	0xed, 0x90, 0xd3, 0x69, 0xba, 0xb3, 0x9e, 0x84, 0xb7, 0x83, 0x8c, 0xe6, 0xab, 0x6f, 0xe8, 0x28,
+	0x7c, 0xce, 0xb3, 0x7c, 0x64, 0xf5, 0xab, 0x45, 0x2a, 0x50, 0x46, 0xda, 0xad, 0x93, 0xb3, 0x61,
+	0x94, 0xd2, 0x46, 0x37, 0xa1, 0xd7, 0xb6, 0xa3, 0x38, 0xa1, 0x57, 0xe3, 0x14, 0xc9, 0x89, 0x6c,
+	0x4a, 0x4a, 0xe9, 0x71, 0xad, 0x0c, 0x09, 0xca, 0x9f, 0x75, 0x97, 0xc9, 0xa9, 0x66, 0x98, 0x06,
+	0x9b, 0x2d, 0x8a, 0xa7, 0x5f, 0xcc, 0xd5, 0xbc, 0x3c, 0x30, 0xf2, 0x51, 0x69, 0x93, 0x58, 0x2c,
+	0x22, 0x40, 0xef, 0x33, 0x18, 0xc2, 0x93, 0x86, 0xd1, 0x76, 0x8b, 0xce, 0x27, 0x41, 0xd4, 0xd8,
+	0x11, 0x69, 0x98, 0x94, 0xb7, 0x47, 0x5d, 0x6b, 0x03, 0x03, 0x93, 0x7d, 0xf3, 0xfc, 0x99, 0xc2,
+	0x35, 0x4b, 0x60, 0x8b, 0x56, 0x77, 0x8e, 0x4c, 0xca, 0x31, 0xd4, 0x77, 0xc3, 0xce, 0xc6, 0x4a,
+	0x5d, 0xe4, 0x00, 0x50, 0x11, 0x07, 0xd7, 0xcc, 0x66, 0x28, 0xe2, 0xfb, 0x5f, 0x71, 0xc8, 0x98,
+	0x1e, 0x14, 0x8c, 0xb7, 0x60, 0xb2, 0xb3, 0xb8, 0x54, 0xe7, 0xa7, 0xb6, 0x3d, 0xc1, 0xf3, 0xaa,
+	0xa2, 0x99, 0xab, 0x06, 0x73, 0x18, 0x68, 0x3c, 0x0f, 0x91, 0xc2, 0xec, 0x49, 0x52, 0xdb, 0x8a,
+	0x51, 0x2e, 0xae, 0x9a, 0x9e, 0x26, 0x4b, 0x08, 0x04, 0xde, 0xe6, 0xff, 0x77, 0x87, 0x9c, 0x2b,
+	0x8f, 0x77, 0xfe, 0x5a, 0x18, 0xe4, 0x65, 0xcc, 0x88, 0x98, 0xed, 0x18, 0xe7, 0x82, 0x96, 0xc4,
+	0x50, 0xb6, 0x80, 0x86, 0x75, 0xb8, 0x61, 0xff, 0xfb, 0x0a, 0xd1, 0x78, 0xba, 0x3f, 0xe8, 0x90,
+	0x71, 0x64, 0x7b, 0x3d, 0xd9, 0x34, 0x46, 0xbb, 0x66, 0x67, 0xb4, 0x8a, 0x6c, 0x6e, 0xed, 0x37,
+	0xc0, 0x60, 0x32, 0xc7, 0x6b, 0x66, 0x20, 0x02, 0x88, 0xa5, 0x6b, 0x1a, 0x13, 0x87, 0x64, 0x54,
+	0x31, 0x2a, 0xbd, 0xe4, 0xbf, 0xb8, 0x0f, 0x63, 0x38, 0x3a, 0x6e, 0x6d, 0x5e, 0xd5, 0xdc, 0x87,
+	0x91, 0x09, 0xc2, 0x41, 0x61, 0xb8, 0x2f, 0x93, 0x73, 0x68, 0x34, 0xe2, 0xd7, 0x08, 0x9a, 0xac,
+	0x27, 0x71, 0x46, 0x1b, 0xec, 0xdc, 0x18, 0x30, 0x2c, 0xf1, 0xe7, 0x16, 0x4b, 0xb1, 0xa0, 0xcf,
+	0xd3, 0xfe, 0x0f, 0x0d, 0x10, 0x73, 0x4c, 0xe8, 0x51, 0xbb, 0x9b, 0x6c, 0x2e, 0x30, 0x3f, 0xe9,
+	0xe3, 0x78, 0xee, 0x32, 0xa3, 0xf5, 0x75, 0x93, 0x02, 0x14, 0x49, 0x0a, 0x2e, 0xd7, 0xe9, 0x5e,
+	0x16, 0x6c, 0x1e, 0xdb, 0x6f, 0xf7, 0xba, 0x49, 0x01, 0x8a, 0x24, 0xd1, 0xf1, 0x60, 0x37, 0xd9,
+	0x94, 0xa7, 0x47, 0xd1, 0x95, 0xff, 0x7a, 0xde, 0x04, 0x3a, 0x1e, 0xbe, 0x9a, 0xdd, 0x64, 0x13,
+	0x0f, 0x6c, 0x99, 0x2a, 0x50, 0xbd, 0x9a, 0xeb, 0x02, 0x0e, 0x0a, 0xc3, 0xed, 0x10, 0x77, 0x57,
+	0xce, 0x9e, 0xf2, 0x62, 0xf7, 0x6a, 0xfd, 0x9d, 0xe0, 0x15, 0x92, 0x3e, 0x20, 0x16, 0x5e, 0x7c,
+	0xbd, 0x87, 0x0e, 0x94, 0xd0, 0x76, 0x3f, 0x44, 0xce, 0xef, 0x26, 0x9b, 0x42, 0x8e, 0x59, 0x4f,
+	0xc2, 0xa8, 0x11, 0x76, 0x8c, 0xb4, 0x80, 0x33, 0xa2, 0xbb, 0xe7, 0xaf, 0x97, 0xa3, 0x41, 0xbf,
+	0xe7, 0xfd, 0xdf, 0x19, 0x22, 0x2c, 0x51, 0x11, 0x6e, 0xd3, 0x6d, 0x9a, 0xed, 0xc4, 0xcd, 0xa2,
+	0x68, 0xb6, 0xca, 0xa0, 0x20, 0x5a, 0x65, 0x10, 0x5d, 0xa5, 0x4f, 0x10, 0xdd, 0x1d, 0x32, 0xb4,
+	0x43, 0x83, 0x26, 0x4d, 0xa4, 0x1d, 0x66, 0xc5, 0x4e, 0x6a, 0xa5, 0xab, 0x8c, 0x68, 0xae, 0x7a,
+	0xe3, 0xbf, 0x53, 0x90, 0xdc, 0xdc, 0xf7, 0x91, 0x09, 0x94, 0xb1, 0xe2, 0x6e, 0x26, 0x6d, 0xdd,
+	0xdc, 0x0e, 0xc3, 0x0e, 0xfb, 0x0d, 0xa3, 0x05, 0x0a, 0x98, 0xee, 0x22, 0x99, 0x12, 0x76, 0x69,
+	0x65, 0xdf, 0x11, 0x13, 0xab, 0xf2, 0x35, 0xd6, 0x0b, 0xed, 0xd0, 0xf3, 0x04, 0x0b, 0x82, 0x8a,
+	0x9b, 0x7b, 0x5e, 0xcd, 0xdc, 0xe9, 0xe7, 0xe3, 0xe6, 0x1e, 0xb0, 0x16, 0xf7, 0x55, 0x32, 0x8c,
+	0x7f, 0x31, 0xf3, 0xa0, 0x37, 0x6c, 0x2b, 0x44, 0x19, 0x67, 0x07, 0x79, 0x08, 0x45, 0x08, 0x93,
+	0x3d, 0xe7, 0x05, 0x17, 0x50, 0xfc, 0xf0, 0xc6, 0xaa, 0x1f, 0x97, 0x2f, 0xd3, 0x24, 0xdc, 0xda,
+	0x63, 0xf2, 0xcc, 0x70, 0x7e, 0x63, 0xbd, 0xd6, 0x83, 0x01, 0x25, 0x4f, 0xb9, 0x3b, 0x64, 0x20,
+	0xe8, 0x8a, 0xd4, 0x8d, 0x56, 0x74, 0xca, 0x38, 0x06, 0x16, 0x5d, 0xc8, 0x72, 0x77, 0xe0, 0x7f,
+	0xc0, 0x38, 0xb8, 0x2d, 0x52, 0x63, 0x4e, 0x9a, 0x1e, 0xb1, 0x75, 0xd3, 0x41, 0x56, 0xcc, 0x0d,
+	0x94, 0x8b, 0xae, 0xec, 0x5f, 0xe0, 0x4c, 0xd8, 0x49, 0xda, 0x09, 0xb6, 0xc3, 0x88, 0x4b, 0xe8,
+	0xa3, 0x36, 0x5f, 0xd1, 0xba, 0xa2, 0xcb, 0x95, 0xd2, 0xf9, 0x6f, 0xd0, 0x78, 0xe2, 0x52, 0x4c,
+	0x68, 0xda, 0x89, 0xa3, 0x54, 0x45, 0xca, 0x79, 0x63, 0xe6, 0x52, 0x84, 0x42, 0x3b, 0xf4, 0x3c,
+	0xe1, 0xff, 0x60, 0x85, 0x8c, 0xe9, 0x09, 0xc9, 0x0e, 0x0a, 0x7d, 0x4d, 0xf3, 0xaf, 0x96, 0x6b,
+	0xc7, 0xae, 0x5a, 0x18, 0xf4, 0x41, 0x5f, 0xac, 0x5c, 0x45, 0xd5, 0x93, 0x5e, 0x45, 0xfe, 0xf7,
+	0x54, 0xc9, 0xb0, 0x6c, 0x44, 0xc7, 0x0b, 0x92, 0x87, 0x95, 0x78, 0x8e, 0xad, 0x97, 0x6c, 0x46,
+	0xc4, 0x68, 0x26, 0x63, 0x05, 0x07, 0x8d, 0x2f, 0xaa, 0x43, 0x63, 0xec, 0xdc, 0x65, 0x7b, 0x49,
+	0xf5, 0xd6, 0x90, 0xf1, 0x65, 0xc6, 0x3d, 0xb7, 0x65, 0x30, 0x18, 0x08, 0x5e, 0xa8, 0x3d, 0xd8,
+	0x94, 0x41, 0x69, 0xf6, 0xec, 0x7e, 0x2a, 0xce, 0x4d, 0xb7, 0x01, 0x0b, 0x10, 0xe4, 0x0c, 0xfd,
+	0xf7, 0x90, 0x09, 0x73, 0xb7, 0xc2, 0xdb, 0xe4, 0xe6, 0x5e, 0x46, 0xb9, 0xfa, 0x6e, 0x8c, 0x7f,
+	0x92, 0xf3, 0x08, 0x00, 0x0e, 0xc7, 0x70, 0x58, 0x92, 0xef, 0xff, 0x87, 0xb0, 0xbb, 0x3e, 0xa9,
+	0x2b, 0xeb, 0xfb, 0x5d, 0xd9, 0x3f, 0x45, 0x46, 0xd8, 0x3f, 0x6c, 0x27, 0xae, 0xda, 0xf2, 0x4d,
+	0xce, 0xfb, 0x29, 0xf6, 0x62, 0x26, 0x0c, 0xbe, 0x2c, 0x19, 0x41, 0xce, 0xd3, 0x8f, 0xc9, 0x54,
+	0x11, 0xdb, 0xfd, 0x08, 0x19, 0x4b, 0xa5, 0xdc, 0x93, 0xc7, 0x9c, 0x1d, 0x52, 0x3e, 0xe2, 0x7e,
+	0x3e, 0xda, 0xe3, 0x60, 0x10, 0xf3, 0x3f, 0xce, 0xa7, 0x3e, 0xdf, 0x75, 0xd8, 0x54, 0xd2, 0xbb,
+	0x59, 0xcf, 0x54, 0xd2, 0xbb, 0x19, 0xb0, 0x16, 0x14, 0x8b, 0xda, 0xc1, 0xdd, 0xf5, 0x60, 0x5b,
+	0xc4, 0x3e, 0xd4, 0x72, 0xb1, 0x68, 0x55, 0xc0, 0x41, 0x61, 0x60, 0xd0, 0xc5, 0x88, 0xda, 0x5c,
+	0xf1, 0x35, 0xb4, 0x98, 0xa7, 0x0c, 0xd7, 0xcb, 0xaa, 0xd7, 0xc0, 0xdd, 0x64, 0x78, 0x9b, 0xdb,
+	0x21, 0x43, 0x9b, 0x3c, 0x10, 0x54, 0x7c, 0x04, 0xd7, 0x6c, 0xac, 0x45, 0x46, 0x90, 0x07, 0x5d,
+	0x88, 0x1f, 0x20, 0xd9, 0xf8, 0x6b, 0x64, 0xd0, 0xea, 0x4a, 0xf2, 0x7f, 0x0e, 0x47, 0x1d, 0xa6,
+	0x59, 0xbc, 0x8d, 0x56, 0x57, 0xf5, 0x48, 0x75, 0x9f, 0xc5, 0x97, 0x92, 0x21, 0xae, 0xe6, 0x92,
+	0x3e, 0xae, 0x16, 0x36, 0x5b, 0x5e, 0xed, 0x20, 0xdf, 0x6c, 0xb9, 0x3e, 0x2d, 0x05, 0xc9, 0xc9,
+	0xff, 0xde, 0x0a, 0x19, 0xbc, 0x16, 0x75, 0xba, 0x7f, 0xe3, 0xd3, 0xd7, 0xaf, 0x92, 0x01, 0x34,
+	0xa9, 0x9b, 0x85, 0x21, 0xc6, 0xe6, 0x9f, 0xd2, 0x8b, 0x42, 0x78, 0x66, 0x51, 0x08, 0x08, 0xee,
+	0x48, 0xcf, 0x6a, 0x61, 0xaa, 0xcb, 0xf3, 0xfd, 0x3c, 0x47, 0x46, 0x56, 0x82, 0x4d, 0xda, 0xba,
+	0x4e, 0xf7, 0x58, 0x76, 0x1e, 0xee, 0x26, 0xeb, 0xe4, 0xba, 0x31, 0xc3, 0xa5, 0x75, 0x91, 0x4c,
+	0x30, 0x6c, 0xb5, 0x27, 0xe0, 0xcd, 0x99, 0xe6, 0x29, 0xaa, 0x1d, 0xf3, 0xe6, 0xac, 0xa5, 0xa7,
+	0xd6, 0xb0, 0xfc, 0x59, 0x32, 0x9a, 0x53, 0x39, 0x04, 0xd7, 0x3f, 0xab, 0x90, 0x71, 0xc3, 0xe2,
+	0x68, 0x38, 0xa7, 0x38, 0x07, 0x3a, 0xa7, 0xbc, 0xad, 0xd1, 0xa6, 0x3d, 0xce, 0x22, 0xd5, 0x87,
+	0xef, 0x2c, 0x62, 0xbe, 0xa4, 0x81, 0x43, 0xbd, 0xa4, 0xcf, 0x3b, 0x64, 0x60, 0x25, 0x8c, 0x76,
+	0x0f, 0xb7, 0xd1, 0xa4, 0x8d, 0xb8, 0xd3, 0xb3, 0xd1, 0xd4, 0x11, 0x08, 0xbc, 0x4d, 0x4a, 0x70,
+	0xd5, 0x3e, 0x12, 0x5c, 0x6e, 0x28, 0x1e, 0xd8, 0xcf, 0x50, 0xec, 0xa3, 0xdb, 0xe9, 0x6a, 0x10,
+	0x85, 0x5b, 0x34, 0xcd, 0xd8, 0x02, 0xcc, 0x4e, 0x34, 0x9d, 0xcb, 0x58, 0x9f, 0xd4, 0x8a, 0xbf,
+	0xe9, 0x90, 0x53, 0xab, 0xb4, 0x1d, 0x87, 0xaf, 0x06, 0x79, 0x08, 0x22, 0x8e, 0x71, 0x47, 0x1c,
+	0x19, 0xc3, 0xf9, 0x18, 0xaf, 0x62, 0xce, 0xde, 0x9d, 0xf0, 0x20, 0x9b, 0x09, 0x4b, 0x94, 0x80,
+	0x1a, 0x07, 0x2d, 0xc5, 0x50, 0x1e, 0x5c, 0x28, 0x1b, 0x20, 0xc7, 0x71, 0x97, 0xc5, 0x03, 0x18,
+	0x5c, 0x29, 0xa6, 0xed, 0x59, 0xe3, 0x01, 0x11, 0x86, 0x79, 0x46, 0xeb, 0xa9, 0x82, 0x43, 0xfe,
+	0xac, 0xff, 0x2b, 0x0e, 0x19, 0xe2, 0x38, 0xf4, 0x20, 0x93, 0xe7, 0x0e, 0xa9, 0xb1, 0xe7, 0xc4,
+	0x77, 0xb4, 0x6c, 0x41, 0xee, 0x44, 0x72, 0xfc, 0xab, 0x67, 0xff, 0x02, 0x67, 0xc0, 0x2e, 0xf4,
+	0xc1, 0xdd, 0x39, 0x15, 0xc6, 0x99, 0x5f, 0xe8, 0x19, 0x14, 0x44, 0xab, 0xff, 0xa5, 0x2a, 0x19,
+	0x56, 0x29, 0xd5, 0x59, 0xe2, 0xc8, 0x28, 0x8a, 0xb3, 0x20, 0x13, 0x56, 0xd5, 0xaa, 0x9d, 0xc0,
+	0x3c, 0xc9, 0x61, 0x76, 0x2e, 0xa7, 0xce, 0xbd, 0x59, 0x94, 0x7a, 0x46, 0x6b, 0x01, 0xbd, 0x13,
+	0x98, 0x5c, 0xb0, 0x85, 0xfb, 0x9d, 0x3c, 0x2c, 0x5e, 0xb6, 0xd8, 0x1d, 0xb6, 0x91, 0x8a, 0x9e,
+	0xa8, 0x19, 0xe2, 0x40, 0x10, 0x5c, 0xa7, 0x3f, 0x40, 0xa6, 0x8a, 0xbd, 0x3e, 0x28, 0x95, 0xd2,
+	0x88, 0x9e, 0x88, 0xe9, 0x9b, 0xc5, 0x7e, 0x7d, 0xf4, 0x47, 0xfd, 0x97, 0xc8, 0xe8, 0x2a, 0xcd,
+	0x92, 0xb0, 0xc1, 0x08, 0x1c, 0xb4, 0xb8, 0x0e, 0x25, 0xb1, 0x7c, 0x1f, 0x5b, 0xac, 0x48, 0x33,
+	0x45, 0x07, 0xac, 0x4e, 0x12, 0xa3, 0x66, 0x87, 0x76, 0xe5, 0xcb, 0xb6, 0x70, 0x11, 0x59, 0x57,
+	0x34, 0xc5, 0x5d, 0x57, 0xfd, 0x06, 0x8d, 0x1f, 0xca, 0x24, 0xb5, 0xd5, 0x6e, 0x46, 0xef, 0x1e,
+	0x62, 0x8f, 0x3c, 0x72, 0x72, 0x41, 0x8c, 0xf2, 0x0d, 0xb2, 0x60, 0x53, 0x86, 0x57, 0x69, 0xf5,
+	0x28, 0x16, 0x05, 0x1c, 0x14, 0x06, 0x9f, 0x08, 0x4a, 0xdb, 0x1d, 0xa5, 0x62, 0xb5, 0x34, 0x11,
+	0x92, 0xa6, 0x9c, 0x08, 0xf9, 0x1b, 0x34, 0x7e, 0xfe, 0x47, 0xc8, 0x18, 0x9b, 0x87, 0xab, 0x71,
+	0x0b, 0xa5, 0x0e, 0x7c, 0x8f, 0x6d, 0xfc, 0x5d, 0x34, 0x3b, 0x32, 0x24, 0xe0, 0x6d, 0xf8, 0x7d,
+	0xef, 0xc4, 0xad, 0xa6, 0x4a, 0x0a, 0xa3, 0x56, 0xef, 0x55, 0x06, 0x05, 0xd1, 0xea, 0x7f, 0x57,
+	0x85, 0x8c, 0xb2, 0x07, 0xc5, 0x26, 0xbb, 0x47, 0x86, 0x76, 0x38, 0x1f, 0xf1, 0xc2, 0x2d, 0x38,
+	0x64, 0xe9, 0xbd, 0xd7, 0x6e, 0xfc, 0x1c, 0x00, 0x92, 0x1f, 0xb2, 0x16, 0xbe, 0xb9, 0x5e, 0xe5,
+	0x64, 0x59, 0x0b, 0xcf, 0x60, 0x90, 0xfc, 0xb0, 0x82, 0xd3, 0x18, 0xc6, 0x3d, 0xcb, 0x72, 0x5e,
+	0x6e, 0x4a, 0x06, 0xb6, 0xa5, 0xf8, 0x63, 0x25, 0x51, 0x96, 0x08, 0xc3, 0x91, 0x0c, 0xf2, 0x55,
+	0xbc, 0x8c, 0x52, 0xc4, 0xc0, 0xb6, 0x08, 0xed, 0x1e, 0x69, 0x8a, 0x42, 0x62, 0x72, 0x37, 0xb3,
+	0x91, 0x8b, 0xba, 0x50, 0xa3, 0x2c, 0xff, 0x34, 0x24, 0x24, 0x85, 0x9c, 0xaf, 0xff, 0x6d, 0x84,
+	0x25, 0x9e, 0x5b, 0x6a, 0x05, 0xdb, 0x7c, 0x15, 0xc5, 0xbb, 0xc2, 0xcb, 0x64, 0x58, 0x5f, 0x45,
+	0x08, 0x05, 0xd1, 0xca, 0x93, 0x79, 0x65, 0x49, 0xa8, 0x42, 0x9d, 0xb5, 0x64, 0x5e, 0x0c, 0x2c,
+	0x03, 0xdb, 0x9b, 0xfe, 0x8f, 0x57, 0x08, 0x41, 0xfa, 0x22, 0x5f, 0xdc, 0xbb, 0xa5, 0x0b, 0xbb,
+	0xe9, 0x1d, 0xa3, 0x5c, 0xd8, 0x59, 0x46, 0xbc, 0x7e, 0x01, 0x8e, 0x95, 0xfd, 0x03, 0x1c, 0xf1,
+	0x06, 0x19, 0x77, 0x33, 0xbc, 0xd6, 0x78, 0x55, 0x5b, 0x37, 0xc8, 0x35, 0x4e, 0x90, 0xdf, 0x20,
+	0xc5, 0x0f, 0x90, 0x6c, 0xdc, 0x17, 0xc8, 0x70, 0x27, 0x89, 0xb7, 0x51, 0xcc, 0x13, 0x32, 0xc3,
+	0xe3, 0x72, 0x5f, 0x59, 0x17, 0xf0, 0x07, 0xda, 0xff, 0xa0, 0xb0, 0xfd, 0xcf, 0x9f, 0xe3, 0xf3,
+	0x22, 0xbe, 0xc3, 0x69, 0x52, 0x09, 0xa5, 0xb2, 0x9d, 0x08, 0x12, 0x95, 0x6b, 0x8b, 0x50, 0x09,
+	0x9b, 0x6a, 0x3f, 0xac, 0xf4, 0xdd, 0x0f, 0xbf, 0x91, 0x8c, 0x36, 0xc3, 0xb4, 0xd3, 0x0a, 0xf6,
+	0x6e, 0x94, 0x58, 0x3a, 0x16, 0xf3, 0x26, 0xd0, 0xf1, 0xdc, 0xe7, 0x44, 0xbe, 0x89, 0x01, 0x43,
+	0xa5, 0x28, 0xf3, 0x4d, 0xe4, 0xf9, 0x08, 0x19, 0x56, 0x4f, 0xde, 0xc6, 0xda, 0xa1, 0xf3, 0x36,
+	0x16, 0x85, 0xf6, 0xc1, 0x87, 0x2f, 0xb4, 0xbf, 0x9f, 0x8c, 0xcb, 0x9f, 0x4c, 0x90, 0xf6, 0xce,
+	0xb0, 0xde, 0x2b, 0xcb, 0xde, 0x86, 0xde, 0x08, 0x26, 0x6e, 0xbe, 0x68, 0x87, 0x0e, 0xbb, 0x68,
+	0x2f, 0x13, 0xb2, 0x19, 0x77, 0xa3, 0x66, 0x90, 0xec, 0x5d, 0x5b, 0xf4, 0x86, 0xcd, 0x3b, 0xc2,
+	0xbc, 0x6a, 0x01, 0x0d, 0x4b, 0x5f, 0xe8, 0x23, 0x07, 0x2c, 0xf4, 0x8f, 0x90, 0x11, 0x16, 0x97,
+	0x47, 0x9b, 0x73, 0x99, 0x50, 0x86, 0x1f, 0x25, 0x64, 0x22, 0x77, 0xa1, 0x92, 0x44, 0x20, 0xa7,
+	0xe7, 0x7e, 0x8c, 0x90, 0xad, 0x30, 0x0a, 0xd3, 0x1d, 0x46, 0x7d, 0xf4, 0xc8, 0xd4, 0xd5, 0x38,
+	0x97, 0x14, 0x15, 0xd0, 0x28, 0x62, 0x64, 0x24, 0x4d, 0xb3, 0xb0, 0x1d, 0x64, 0xb4, 0xa9, 0xf2,
+	0x6c, 0x79, 0xcc, 0x3c, 0xa3, 0x22, 0x23, 0xaf, 0x14, 0x11, 0x1e, 0x94, 0x01, 0xa1, 0x97, 0x90,
+	0x4b, 0xc9, 0x99, 0x1e, 0xe0, 0xfa, 0x37, 0xbf, 0xdb, 0x7b, 0x82, 0x31, 0x90, 0xce, 0xd2, 0x67,
+	0xae, 0x94, 0xe0, 0x94, 0xf3, 0x28, 0x25, 0x67, 0x7c, 0xf8, 0xd3, 0x47, 0xf9, 0xf0, 0xd1, 0x09,
+	0x43, 0xfe, 0xaf, 0x98, 0x79, 0xef, 0x30, 0x9d, 0x30, 0xd6, 0x8b, 0x08, 0xd0, 0xfb, 0x8c, 0xfb,
+	0x17, 0x0e, 0x39, 0x95, 0x50, 0xee, 0xd9, 0x9a, 0xaa, 0x89, 0x3c, 0xcb, 0x8e, 0x91, 0x86, 0x8d,
+	0x62, 0x8b, 0x72, 0x73, 0x9a, 0x85, 0x22, 0x17, 0x2e, 0x21, 0x53, 0xd9, 0xdd, 0x9e, 0xf6, 0x07,
+	0x65, 0xc0, 0x4f, 0xbf, 0x39, 0x33, 0xd3, 0x5b, 0xa7, 0x54, 0x11, 0xc7, 0x9d, 0xe2, 0xef, 0xbe,
+	0x39, 0x33, 0x25, 0x7f, 0xe7, 0x2f, 0xb9, 0x67, 0x90, 0x28, 0x12, 0x75, 0xe2, 0xe6, 0xb5, 0x75,
+	0x6f, 0xcc, 0x14, 0x89, 0xd6, 0x11, 0x08, 0xbc, 0x0d, 0x3d, 0xb1, 0x9a, 0x01, 0x6d, 0xc7, 0x91,
+	0x2a, 0x9b, 0x35, 0xc6, 0xe5, 0x3d, 0x0e, 0x03, 0xd5, 0x8a, 0xb7, 0xde, 0x48, 0x1c, 0x81, 0xde,
+	0x63, 0xb6, 0x6e, 0xbd, 0xf2, 0x50, 0xe5, 0x5c, 0xe5, 0x2f, 0x50, 0x9c, 0xdc, 0x16, 0x46, 0xf9,
+	0xb0, 0xc3, 0x8a, 0x47, 0xf9, 0x58, 0x50, 0xfc, 0x71, 0x9d, 0x9e, 0x8c, 0xf1, 0xc1, 0xff, 0x41,
+	0xf0, 0xd0, 0xcf, 0xc6, 0xc9, 0x87, 0x73, 0x36, 0x3e, 0x43, 0x86, 0x1b, 0x98, 0x56, 0x2d, 0xa1,
+	0x91, 0x37, 0xc5, 0x94, 0x51, 0x6c, 0x26, 0x16, 0x04, 0x0c, 0x54, 0x2b, 0xc6, 0xce, 0xc7, 0xdd,
+	0x8c, 0x6d, 0x85, 0x38, 0x4f, 0xa9, 0x77, 0x2a, 0x8f, 0x9d, 0x5f, 0xd3, 0x1b, 0xc0, 0xc4, 0xc3,
+	0x23, 0x69, 0x27, 0x4e, 0x59, 0x7a, 0x69, 0x76, 0x24, 0x9d, 0x33, 0x8f, 0xa4, 0xab, 0x5a, 0x1b,
+	0x18, 0x98, 0x18, 0x39, 0x7e, 0xaa, 0x5d, 0x54, 0x39, 0x78, 0xe7, 0xd9, 0xcc, 0xd4, 0x6d, 0xdc,
+	0x28, 0x0b, 0xa4, 0x79, 0xfc, 0x62, 0x0f, 0x18, 0x7a, 0x3b, 0xc1, 0x12, 0xbd, 0xa7, 0x7b, 0x51,
+	0x63, 0x27, 0x89, 0x23, 0xb3, 0x7b, 0x8f, 0xda, 0x4a, 0x8c, 0xc3, 0xbe, 0xed, 0x32, 0x16, 0xf3,
+	0x8f, 0xa2, 0x53, 0x59, 0x69, 0x13, 0x94, 0x77, 0x0a, 0x93, 0xed, 0x63, 0x14, 0x00, 0x97, 0xef,
+	0xf0, 0x49, 0xda, 0xf4, 0x1e, 0xcf, 0x93, 0xed, 0x6f, 0x14, 0xda, 0xa0, 0x07, 0x9b, 0xe5, 0x10,
+	0x16, 0x32, 0xab, 0x77, 0xc1, 0x5e, 0xad, 0xd8, 0x5c, 0xbc, 0x17, 0x8a, 0x27, 0xf1, 0x0b, 0x14,
+	0x37, 0x94, 0x0a, 0x1a, 0x7a, 0xe6, 0x3f, 0x6f, 0xc6, 0x94, 0x0a, 0x8c, 0xb4, 0x80, 0x60, 0xe2,
+	0xa2, 0x54, 0x23, 0x63, 0x59, 0x2e, 0x5e, 0xac, 0xda, 0x29, 0x62, 0xa1, 0x6d, 0xba, 0xdc, 0x59,
+	0xba, 0xa0, 0x8b, 0x30, 0x23, 0x5b, 0xa6, 0x17, 0xc9, 0xb9, 0xf2, 0xbd, 0xf9, 0x20, 0xb5, 0x42,
+	0x55, 0xd7, 0x48, 0x7c, 0xc6, 0x21, 0xa3, 0x1a, 0xb7, 0x92, 0x67, 0x9b, 0x66, 0xcc, 0x8b, 0xb5,
+	0xa0, 0x9d, 0xde, 0x0a, 0x65, 0x4b, 0xe4, 0xd1, 0xbe, 0x8b, 0x13, 0x25, 0x24, 0x79, 0x63, 0x74,
+	0x4c, 0x09, 0xa9, 0xe7, 0x86, 0x37, 0x41, 0xc6, 0xf4, 0x6a, 0xc1, 0xfe, 0xff, 0xae, 0x12, 0x92,
+	0x5b, 0x44, 0xd1, 0x67, 0x94, 0x5b, 0x5f, 0xaf, 0x2d, 0x1e, 0x3b, 0x03, 0xe7, 0x82, 0x41, 0x00,
+	0x0a, 0x04, 0xdd, 0x36, 0x71, 0x39, 0x84, 0xff, 0x3e, 0x8e, 0x9b, 0x13, 0xf3, 0x0a, 0x5a, 0xe8,
+	0x21, 0x02, 0x25, 0x84, 0x71, 0x44, 0x59, 0xbc, 0x4b, 0xa3, 0x9b, 0xb0, 0x72, 0x9c, 0x2c, 0xaf,
+	0xdc, 0x31, 0xc6, 0x20, 0x00, 0x05, 0x82, 0xae, 0x4f, 0x06, 0x99, 0xf6, 0x59, 0xc6, 0x47, 0xb2,
+	0x63, 0x86, 0x49, 0xc8, 0x98, 0xee, 0x88, 0xfd, 0x75, 0x7f, 0xdc, 0x21, 0x13, 0x32, 0x59, 0x2d,
+	0x33, 0xf8, 0xc8, 0xc8, 0xc8, 0x9b, 0xb6, 0x2c, 0xda, 0x57, 0x74, 0xea, 0x79, 0x88, 0x87, 0x01,
+	0x4e, 0xa1, 0xd0, 0x09, 0xff, 0x43, 0xe4, 0x74, 0xc9, 0xe3, 0x56, 0x54, 0x68, 0x18, 0x4a, 0xa0,
+	0x55, 0x81, 0x41, 0x03, 0x49, 0x5c, 0xb7, 0xee, 0x93, 0xbf, 0x56, 0xef, 0xf1, 0xc9, 0x57, 0x20,
+	0xc8, 0x19, 0x1e, 0x26, 0x94, 0xa0, 0xb4, 0x64, 0xcd, 0xdb, 0xdc, 0xed, 0x23, 0x87, 0x12, 0xfc,
+	0x50, 0x8d, 0xe4, 0x94, 0x8e, 0x98, 0x44, 0x39, 0x0f, 0x3c, 0xa8, 0xec, 0x1b, 0x78, 0xd0, 0x24,
+	0x93, 0x01, 0x73, 0xeb, 0x3a, 0x66, 0xea, 0x64, 0x5e, 0x04, 0xcc, 0xa4, 0x00, 0x45, 0x92, 0xc8,
+	0x25, 0xcd, 0x1f, 0x65, 0x5c, 0x06, 0x8e, 0xcc, 0xa5, 0x6e, 0x52, 0x80, 0x22, 0x49, 0xf7, 0xa3,
+	0xc4, 0x6b, 0xb0, 0x24, 0x72, 0x7c, 0x8c, 0xd7, 0xb6, 0x6e, 0xc4, 0xd9, 0x7a, 0x42, 0x53, 0x1a,
+	0x65, 0xa2, 0x48, 0xc2, 0x45, 0x31, 0x0b, 0xde, 0x42, 0x1f, 0x3c, 0xe8, 0x4b, 0x01, 0x0f, 0x52,
+	0xe6, 0x17, 0x16, 0x66, 0x7b, 0x6c, 0x13, 0xf1, 0x06, 0xcd, 0x83, 0xb4, 0xae, 0x37, 0x82, 0x89,
+	0xeb, 0xfe, 0x80, 0x43, 0xc6, 0x5b, 0xd2, 0x22, 0x09, 0xdd, 0x96, 0xac, 0x59, 0x04, 0x56, 0x96,
+	0xdf, 0x8a, 0x4e, 0x99, 0xcb, 0x94, 0x06, 0x08, 0x4c, 0xde, 0xc5, 0x3c, 0xd6, 0xc3, 0x87, 0xcc,
+	0x63, 0xfd, 0x65, 0x87, 0x4c, 0x15, 0xb9, 0xb9, 0xbb, 0xe4, 0x89, 0x76, 0x90, 0xec, 0x5e, 0x8b,
+	0xb6, 0x12, 0x16, 0x07, 0x9d, 0xf1, 0xc5, 0x30, 0xb7, 0x95, 0xd1, 0x64, 0x31, 0xd8, 0x93, 0x71,
+	0x6a, 0x4f, 0x09, 0xea, 0x4f, 0xac, 0xee, 0x87, 0x0c, 0xfb, 0xd3, 0xc2, 0x90, 0x01, 0x44, 0x60,
+	0x65, 0x2e, 0xc2, 0x38, 0xca, 0x99, 0x70, 0x6f, 0x0d, 0x15, 0x32, 0xb0, 0x5a, 0x86, 0x04, 0xe5,
+	0xcf, 0xfa, 0x57, 0xc8, 0x20, 0x4f, 0xf4, 0xf1, 0x96, 0x4c, 0xe4, 0xfe, 0xef, 0x56, 0x88, 0xbc,
+	0x20, 0xfc, 0xcd, 0xf6, 0x38, 0xc0, 0x43, 0x34, 0x61, 0xc2, 0xaf, 0xd0, 0xd2, 0xb1, 0x43, 0x54,
+	0x14, 0x94, 0x11, 0x2d, 0x78, 0x73, 0xa2, 0x77, 0xc3, 0x6c, 0x01, 0x8b, 0xe0, 0x8a, 0x62, 0xe5,
+	0x6c, 0x27, 0x13, 0x30, 0x50, 0xad, 0x68, 0xc0, 0x1d, 0x97, 0x09, 0xf7, 0x30, 0xe4, 0x34, 0xc5,
+	0xe4, 0x5c, 0x29, 0xfe, 0x63, 0x4f, 0x9d, 0x9f, 0x27, 0x87, 0xa1, 0x1d, 0x3d, 0xb1, 0x20, 0xed,
+	0xa4, 0xc0, 0x79, 0xf9, 0xdf, 0x35, 0x40, 0xf2, 0x2c, 0x19, 0x87, 0xb0, 0xdf, 0x5c, 0xce, 0x6b,
+	0x3d, 0xf1, 0x1d, 0xd8, 0xd3, 0xea, 0x3c, 0xa1, 0x42, 0x6d, 0x2e, 0xda, 0xe3, 0x11, 0x85, 0x79,
+	0xd1, 0xa7, 0xe7, 0x4c, 0x6f, 0x9a, 0x73, 0xfa, 0xfa, 0xd3, 0xf0, 0x39, 0x92, 0x7b, 0x57, 0xf7,
+	0xe9, 0x1a, 0xb0, 0x75, 0x9a, 0x29, 0x4f, 0x8d, 0xfe, 0xce, 0x5c, 0x85, 0x42, 0xed, 0xb5, 0x43,
+	0x15, 0x6a, 0x7f, 0x96, 0x0c, 0xd0, 0xa8, 0xdb, 0x66, 0xa2, 0xd2, 0x08, 0xbb, 0x2a, 0x0e, 0x5c,
+	0x89, 0xba, 0x6d, 0x73, 0x64, 0x0c, 0xc5, 0xfd, 0x00, 0x19, 0x6d, 0xd2, 0xb4, 0x91, 0x84, 0xdc,
+	0x38, 0xc5, 0x35, 0x92, 0x8f, 0x33, 0x35, 0x6f, 0x0e, 0x36, 0x1f, 0xd4, 0x1f, 0x50, 0x39, 0x86,
+	0x87, 0xcb, 0x73, 0x0c, 0xab, 0xb7, 0xa8, 0x29, 0x7e, 0x9f, 0x46, 0xb9, 0x6f, 0x87, 0xb6, 0x03,
+	0x6f, 0xc4, 0x3c, 0x2e, 0xeb, 0x0c, 0x0a, 0xa2, 0xd5, 0x7f, 0x95, 0x88, 0x42, 0x6c, 0x6e, 0x87,
+	0x0c, 0xf2, 0x64, 0xa3, 0x9e, 0x63, 0x4b, 0xb5, 0xc1, 0x77, 0x21, 0xcd, 0x95, 0x91, 0xfd, 0x06,
+	0xc1, 0xc7, 0xff, 0x74, 0x85, 0x4c, 0x98, 0x35, 0xe2, 0xdc, 0x6f, 0x32, 0x96, 0xa1, 0xaf, 0x2f,
+	0x43, 0xbd, 0x96, 0x39, 0x7f, 0x4a, 0x5b, 0x9c, 0x78, 0x29, 0x64, 0xce, 0xf6, 0x52, 0xa5, 0x56,
+	0x29, 0x5c, 0x0a, 0xf5, 0x46, 0x30, 0x71, 0xd9, 0x31, 0x1b, 0x47, 0x11, 0x8f, 0xaf, 0x30, 0x1d,
+	0xcd, 0x45, 0xe6, 0xc3, 0xfc, 0x98, 0xed, 0x83, 0x07, 0x7d, 0x29, 0x48, 0xe1, 0x6e, 0xa0, 0x8f,
+	0x70, 0xf7, 0xaf, 0x1d, 0xe2, 0xf5, 0x2b, 0x94, 0x77, 0xec, 0xe9, 0x38, 0xaa, 0x64, 0xd6, 0x3b,
+	0x7f, 0xd5, 0xc3, 0xcf, 0x1f, 0xda, 0x27, 0x51, 0x8b, 0xb7, 0xbc, 0xe0, 0x7e, 0x4b, 0x4f, 0x49,
+	0xfa, 0x77, 0x94, 0x94, 0xa4, 0x1f, 0x67, 0xc8, 0x25, 0xd5, 0xe8, 0x5b, 0x64, 0x9c, 0x19, 0xec,
+	0xa5, 0x98, 0x24, 0x6e, 0x5e, 0xcf, 0x1f, 0x32, 0x0f, 0xa2, 0xfe, 0xa8, 0x10, 0x1a, 0x74, 0x10,
+	0x98, 0xc4, 0xdd, 0x55, 0x72, 0x9a, 0x57, 0x95, 0x5a, 0xa4, 0xad, 0x60, 0xaf, 0x50, 0x3d, 0x42,
+	0x65, 0xf4, 0x5f, 0xec, 0x45, 0x81, 0xb2, 0xe7, 0xfc, 0xd7, 0x88, 0x66, 0x1c, 0x66, 0xb9, 0x56,
+	0x93, 0x30, 0x46, 0x81, 0x69, 0x59, 0x14, 0x58, 0x90, 0xb9, 0x56, 0x73, 0x30, 0xe8, 0x38, 0xee,
+	0xfb, 0x78, 0x06, 0x2f, 0xb5, 0x78, 0x7d, 0x3d, 0x39, 0x17, 0xdb, 0x1f, 0xa6, 0x72, 0x06, 0x1c,
+	0x06, 0xe2, 0x09, 0xff, 0x27, 0x2a, 0x44, 0x6b, 0x04, 0xda, 0x88, 0x13, 0x66, 0x83, 0x6a, 0xc5,
+	0x8d, 0xdd, 0xe2, 0x9e, 0x8e, 0xc9, 0x84, 0x81, 0xb5, 0x1c, 0xd6, 0x02, 0x8d, 0xeb, 0x49, 0x18,
+	0xbb, 0x55, 0xe1, 0xba, 0x3c, 0xd3, 0x92, 0x6c, 0x80, 0x1c, 0x47, 0x1b, 0xcb, 0xc0, 0x51, 0xc7,
+	0xe2, 0xae, 0x90, 0x81, 0x2c, 0x14, 0xdb, 0xf0, 0xd1, 0xac, 0x18, 0x79, 0x32, 0x77, 0x8c, 0xda,
+	0x65, 0x54, 0xfc, 0x5f, 0x1d, 0x20, 0x9a, 0xf7, 0xc2, 0x21, 0xce, 0xb9, 0x57, 0x0a, 0xbe, 0x2a,
+	0xab, 0x56, 0x7c, 0x55, 0xa4, 0x03, 0x08, 0x97, 0x1d, 0x4c, 0xf7, 0x14, 0xec, 0xd4, 0x0e, 0x6d,
+	0x75, 0xbc, 0xaa, 0xd9, 0xa9, 0xab, 0xb4, 0xd5, 0x01, 0xd6, 0xa2, 0x32, 0xf1, 0x0c, 0xf4, 0xcd,
+	0xc4, 0xb3, 0x43, 0x6a, 0xdb, 0x18, 0x73, 0xec, 0xd5, 0x6c, 0xb9, 0x25, 0xb1, 0x10, 0x66, 0xee,
+	0x96, 0xc4, 0xfe, 0x05, 0xce, 0x00, 0x8f, 0xe9, 0x1d, 0xe9, 0x2f, 0xeb, 0x0d, 0xda, 0x3a, 0xa6,
+	0x95, 0x0b, 0x2e, 0x3f, 0xa6, 0xd5, 0x4f, 0xc8, 0x99, 0xa1, 0x3e, 0xbc, 0xc1, 0x93, 0x70, 0x7b,
+	0x43, 0xb6, 0xf4, 0xe1, 0x22, 0xab, 0x37, 0xd7, 0x87, 0x8b, 0x1f, 0x20, 0xd9, 0xf8, 0x97, 0xc8,
+	0xa8, 0x56, 0xb0, 0x1c, 0x5f, 0x83, 0xca, 0xcf, 0xaa, 0xbd, 0x06, 0x74, 0x47, 0x01, 0xd6, 0xe2,
+	0x7f, 0x77, 0x8d, 0x28, 0x6b, 0x88, 0x9e, 0x03, 0x46, 0x7c, 0x0d, 0x85, 0xc8, 0xac, 0xc2, 0xca,
+	0x7f, 0x3f, 0x19, 0x6f, 0xd3, 0x64, 0x5b, 0x69, 0xc0, 0x8a, 0xa7, 0xd8, 0xaa, 0xde, 0x08, 0x26,
+	0x2e, 0xf7, 0xf5, 0xe6, 0x6e, 0x81, 0xc5, 0xe8, 0x44, 0xe9, 0x2e, 0x08, 0x0a, 0x83, 0x25, 0xaf,
+	0x6c, 0x6b, 0x5e, 0x84, 0x22, 0x9a, 0xc9, 0x86, 0x3b, 0x87, 0x46, 0x55, 0x24, 0xb0, 0xd0, 0x20,
+	0x60, 0x70, 0x45, 0xc3, 0x5a, 0x4a, 0xb3, 0xb5, 0x3b, 0x11, 0x4d, 0x54, 0x56, 0x42, 0x6f, 0xc0,
+	0x34, 0xac, 0xd5, 0x8b, 0x08, 0xd0, 0xfb, 0x4c, 0x69, 0x00, 0x58, 0xed, 0xc8, 0x01, 0x60, 0x8b,
+	0x64, 0x6a, 0x2b, 0x08, 0x5b, 0xdd, 0x84, 0xf6, 0x0d, 0x23, 0x5b, 0x2a, 0xb4, 0x43, 0xcf, 0x13,
+	0x2c, 0xc0, 0xbe, 0x15, 0x6c, 0xf3, 0x9c, 0x7d, 0x32, 0xc0, 0x1e, 0x01, 0xc0, 0xe1, 0x68, 0x02,
+	0xd9, 0x0a, 0x69, 0xab, 0xb9, 0x1a, 0x44, 0xc1, 0x36, 0x4d, 0xbc, 0x11, 0xd3, 0x04, 0xb2, 0xa4,
+	0xb5, 0x81, 0x81, 0x89, 0x2b, 0xa9, 0x99, 0xec, 0x41, 0x37, 0x12, 0xe1, 0xdb, 0x6a, 0x25, 0x2d,
+	0x32, 0x28, 0x88, 0x56, 0xff, 0x17, 0x1d, 0xc2, 0x53, 0xe5, 0xcf, 0x6d, 0xa1, 0x15, 0x37, 0xdb,
+	0x73, 0xbf, 0xe8, 0x90, 0x29, 0x34, 0x63, 0xcd, 0x45, 0x59, 0x28, 0x81, 0xf6, 0xaa, 0xd0, 0x32,
+	0x5e, 0x37, 0x0a, 0xe4, 0xb9, 0x31, 0xa1, 0x08, 0x85, 0x9e, 0x6e, 0xf8, 0xe7, 0xc9, 0xd9, 0x52,
+	0x02, 0xfe, 0x97, 0xab, 0xc4, 0xcc, 0xf8, 0xef, 0xbe, 0xa4, 0x47, 0x25, 0x1c, 0x27, 0xdb, 0xc9,
+	0x48, 0x4f, 0x0c, 0xc3, 0x22, 0xe6, 0xba, 0xce, 0x12, 0x99, 0xef, 0xd7, 0x3c, 0x7c, 0x47, 0x21,
+	0x6f, 0x7a, 0x60, 0xfe, 0x04, 0xfd, 0x31, 0xf7, 0xb5, 0x3c, 0x12, 0xa2, 0x6a, 0x3b, 0x12, 0xe2,
+	0x9c, 0x16, 0x09, 0xf1, 0xa0, 0x24, 0x28, 0xc2, 0xdd, 0x23, 0xc3, 0x81, 0x7c, 0xa7, 0x03, 0xb6,
+	0xe2, 0xa9, 0x8d, 0xf5, 0x23, 0xcc, 0x31, 0xf2, 0x1d, 0x2a, 0x76, 0x05, 0xcf, 0xea, 0xda, 0xa1,
+	0x3c, 0xab, 0x7f, 0xce, 0x21, 0x24, 0xaf, 0x80, 0x8e, 0xb6, 0xa4, 0xf4, 0x79, 0x43, 0x89, 0x69,
+	0x23, 0xc9, 0x9d, 0xa0, 0xa8, 0xe5, 0x3c, 0x12, 0x10, 0x50, 0xdc, 0x0e, 0x52, 0xbc, 0xfe, 0x99,
+	0x43, 0xce, 0x94, 0x55, 0x6a, 0x7f, 0x1b, 0x7b, 0x7c, 0x64, 0xc9, 0x9e, 0x3f, 0xb0, 0x9e, 0xd0,
+	0xad, 0xf0, 0x6e, 0x49, 0x61, 0x46, 0xde, 0x00, 0x39, 0x8e, 0xff, 0xa7, 0x43, 0x44, 0x31, 0x3e,
+	0x21, 0x1d, 0xed, 0xd3, 0xa8, 0x4f, 0xd9, 0xce, 0x85, 0x6d, 0x85, 0x07, 0x0c, 0x0a, 0xa2, 0x15,
+	0x75, 0x2a, 0x32, 0x76, 0x55, 0x1c, 0x0a, 0x63, 0x3c, 0xbf, 0x13, 0x87, 0x81, 0x6a, 0x2d, 0xd3,
+	0xfa, 0xd6, 0x1e, 0x8a, 0xd6, 0x77, 0xd0, 0xbe, 0xd6, 0xb7, 0x8d, 0x99, 0xa9, 0xd8, 0x87, 0xc2,
+	0x54, 0xad, 0x82, 0xd1, 0xd8, 0x91, 0x8d, 0x50, 0xf5, 0x1e, 0x22, 0x50, 0x42, 0x98, 0xf9, 0x05,
+	0xc6, 0x2d, 0x3a, 0x07, 0x37, 0xbc, 0x21, 0xd3, 0x40, 0x07, 0x1c, 0x0c, 0xb2, 0xfd, 0x98, 0x6a,
+	0x56, 0xf7, 0x97, 0x9d, 0x7d, 0xf4, 0xd8, 0x23, 0xb6, 0x8e, 0xa0, 0xd2, 0x72, 0x2b, 0xf3, 0x8f,
+	0x1f, 0x53, 0x39, 0xfe, 0x25, 0x87, 0x9c, 0xa2, 0x51, 0x23, 0xd9, 0x63, 0x74, 0x04, 0x35, 0xe1,
+	0xb6, 0x75, 0xd3, 0xc6, 0xb7, 0x7e, 0xa5, 0x48, 0x9c, 0x7b, 0x1b, 0xf4, 0x80, 0xa1, 0xb7, 0x1b,
+	0xee, 0x1a, 0x19, 0x6e, 0x04, 0x62, 0x5d, 0x8c, 0x1e, 0x65, 0x5d, 0x70, 0x67, 0x8e, 0x39, 0xb1,
+	0x1a, 0x14, 0x11, 0xac, 0x39, 0x7e, 0xba, 0xa4, 0x4b, 0x2c, 0xad, 0x42, 0x1b, 0x3f, 0x80, 0x6b,
+	0xcd, 0xe2, 0xe7, 0x7f, 0x5d, 0xc0, 0x41, 0x61, 0xb8, 0xeb, 0xe4, 0xcc, 0x6e, 0x3b, 0xcd, 0xa9,
+	0xb0, 0x9a, 0xfd, 0x77, 0xe5, 0x66, 0x20, 0x7d, 0xad, 0xce, 0x5c, 0x2f, 0xc1, 0x81, 0xd2, 0x27,
+	0x51, 0x1e, 0xa3, 0x51, 0xb0, 0xd9, 0xa2, 0x79, 0x93, 0x70, 0x05, 0x57, 0xf2, 0xd8, 0x95, 0x42,
+	0x3b, 0xf4, 0x3c, 0x81, 0xb9, 0xf9, 0x1e, 0x4b, 0x69, 0x72, 0x9b, 0x26, 0xf5, 0xb0, 0x49, 0x17,
+	0xba, 0x69, 0x16, 0xb7, 0x69, 0x72, 0x4c, 0xcb, 0xcd, 0xcc, 0xfd, 0x7b, 0x33, 0x8f, 0xd5, 0xfb,
+	0x53, 0x83, 0xfd, 0x58, 0xf9, 0xcf, 0x91, 0x61, 0x59, 0xd1, 0xf0, 0xe0, 0xab, 0xa8, 0xff, 0xfd,
+	0x0e, 0x99, 0xa8, 0x33, 0x2d, 0xa0, 0xba, 0x4a, 0xd8, 0x2e, 0xcf, 0xf5, 0xb4, 0xca, 0xe9, 0x58,
+	0xd8, 0xb2, 0xcd, 0x2c, 0x8c, 0xfe, 0x27, 0xc8, 0x54, 0x9d, 0xb6, 0x83, 0xce, 0x0e, 0x4b, 0x4d,
+	0xc4, 0x9d, 0xc1, 0x31, 0xd1, 0x9b, 0x84, 0x15, 0xf3, 0x2f, 0x2b, 0x64, 0xc8, 0x71, 0xb0, 0xc6,
+	0x37, 0x57, 0x28, 0xc8, 0x5c, 0x2b, 0xa3, 0xd2, 0xc9, 0x9c, 0x87, 0x95, 0xf3, 0x7f, 0xfc, 0x9f,
+	0xa9, 0x92, 0xb1, 0xfc, 0x79, 0xba, 0xe5, 0x6e, 0x93, 0xc9, 0x86, 0x96, 0x81, 0x23, 0x0f, 0xad,
+	0x3d, 0x7c, 0xb2, 0x0e, 0x5e, 0x35, 0xd0, 0x24, 0x02, 0x45, 0xaa, 0x47, 0x8f, 0x51, 0x78, 0xad,
+	0x10, 0xa3, 0x60, 0xc7, 0x0f, 0x7d, 0x2f, 0x6a, 0xa8, 0x08, 0x07, 0xba, 0x25, 0x5d, 0xe0, 0xbe,
+	0xc6, 0x42, 0x1e, 0x3e, 0x5b, 0x21, 0x93, 0xea, 0x2d, 0x09, 0x67, 0x8f, 0x37, 0x8a, 0x91, 0x09,
+	0x16, 0xcc, 0x81, 0xc5, 0x65, 0xb7, 0x4f, 0x74, 0xc2, 0x1b, 0xc5, 0xe8, 0x84, 0x13, 0x65, 0xdf,
+	0xe3, 0xbf, 0xf2, 0x73, 0x15, 0x32, 0xac, 0x72, 0x27, 0xbf, 0x44, 0x6a, 0x4c, 0x89, 0xf0, 0xd6,
+	0x2e, 0x2a, 0x4c, 0x21, 0x01, 0x9c, 0x12, 0x92, 0xd4, 0x6b, 0x4b, 0x1d, 0x93, 0xa4, 0x51, 0x5d,
+	0xea, 0xba, 0x5e, 0x5d, 0xea, 0xe8, 0x04, 0x87, 0x8c, 0xfa, 0x52, 0x98, 0xc7, 0x91, 0x0b, 0xa6,
+	0x85, 0x08, 0x46, 0x21, 0x95, 0x8a, 0x56, 0x7f, 0x9e, 0x18, 0xf5, 0x2c, 0x8e, 0x15, 0x41, 0xfb,
+	0x03, 0x55, 0x32, 0xc8, 0xd2, 0x81, 0x66, 0xff, 0xbf, 0x54, 0x7c, 0xd5, 0x4b, 0xb5, 0x55, 0x4f,
+	0xa4, 0x54, 0xdb, 0xdd, 0x13, 0x8e, 0xf2, 0x1d, 0xef, 0x5b, 0x4f, 0xf6, 0x57, 0x6b, 0x84, 0xf0,
+	0xb7, 0xb1, 0xd6, 0xc9, 0x0e, 0xa3, 0x64, 0x7d, 0x81, 0x8c, 0x6d, 0xd3, 0x88, 0x26, 0x32, 0x2e,
+	0xa1, 0x62, 0x6a, 0x40, 0x96, 0xb5, 0x36, 0x30, 0x30, 0xd9, 0x62, 0x41, 0x8f, 0x37, 0x7e, 0x27,
+	0x29, 0x46, 0xf2, 0xaa, 0x16, 0xd0, 0xb0, 0xdc, 0x59, 0xc3, 0x7a, 0xcd, 0x1d, 0xa1, 0x26, 0xf6,
+	0x31, 0x36, 0x7f, 0x80, 0x4c, 0x98, 0x99, 0x25, 0x85, 0x64, 0xac, 0x1c, 0x97, 0xcc, 0x84, 0x94,
+	0x50, 0xc0, 0xd6, 0xb4, 0x34, 0xc3, 0xfb, 0x69, 0x69, 0x70, 0x16, 0xb8, 0xb0, 0xc0, 0xe1, 0x22,
+	0xad, 0x5f, 0x9e, 0x92, 0x4f, 0x6b, 0x03, 0x03, 0x13, 0x39, 0x08, 0x25, 0x35, 0x31, 0x3f, 0xb5,
+	0x82, 0x66, 0xb9, 0x43, 0x26, 0x62, 0x53, 0xb9, 0xc6, 0xe5, 0xc5, 0xf7, 0x1e, 0x72, 0xe9, 0x19,
+	0xcf, 0x72, 0x87, 0x33, 0x13, 0x06, 0x05, 0xfa, 0x78, 0x47, 0xd0, 0xc3, 0x4f, 0xc7, 0xcc, 0xb0,
+	0x96, 0xbe, 0x11, 0xa2, 0xeb, 0xe4, 0x4c, 0x27, 0x6e, 0x4a, 0xdb, 0xc8, 0x42, 0x2b, 0x48, 0x53,
+	0xb6, 0x30, 0xc6, 0x4d, 0xd9, 0x71, 0xbd, 0x04, 0x07, 0x4a, 0x9f, 0xc4, 0xcb, 0xa3, 0xb4, 0xae,
+	0x30, 0x67, 0xed, 0x1a, 0x3f, 0x47, 0x25, 0x22, 0xa8, 0x56, 0xff, 0x34, 0x39, 0x55, 0xef, 0x76,
+	0x3a, 0xad, 0x90, 0x36, 0x95, 0x75, 0xd8, 0xff, 0x17, 0x0e, 0x99, 0x2c, 0x04, 0x84, 0xa1, 0x62,
+	0xaf, 0x9b, 0x4a, 0x07, 0x08, 0xa1, 0xd8, 0xc3, 0x14, 0x64, 0x29, 0x70, 0x38, 0x3a, 0x0a, 0x6c,
+	0x27, 0x71, 0xb7, 0x23, 0xe5, 0x1b, 0xa6, 0xec, 0x5f, 0x66, 0x10, 0x10, 0x2d, 0x3c, 0xd9, 0xe8,
+	0x2b, 0xdd, 0x30, 0x51, 0x75, 0xd5, 0x44, 0xb2, 0x51, 0x0e, 0x03, 0xd5, 0xca, 0xa6, 0xb2, 0x85,
+	0x4e, 0xac, 0x94, 0x99, 0x65, 0x0b, 0xd5, 0xd9, 0xe7, 0xf2, 0x26, 0xd0, 0xf1, 0xfc, 0x5f, 0xcb,
+	0x7b, 0xae, 0xc4, 0xc6, 0xa3, 0x55, 0x4c, 0x7d, 0x4d, 0x73, 0xee, 0xad, 0x9c, 0x58, 0x74, 0x5d,
+	0xb9, 0x7f, 0xaf, 0xff, 0x6e, 0x32, 0x59, 0x10, 0x80, 0x0e, 0xf0, 0xf8, 0xf3, 0xbf, 0xb3, 0x42,
+	0x26, 0x0b, 0xce, 0xa7, 0xee, 0x27, 0x09, 0x51, 0x72, 0xa7, 0x4c, 0x64, 0x76, 0xc3, 0xa2, 0x34,
+	0x80, 0x67, 0x00, 0xdb, 0x42, 0x14, 0x24, 0x05, 0x8d, 0xa3, 0x1b, 0x91, 0x21, 0x16, 0xe4, 0x49,
+	0x65, 0x8a, 0x90, 0x65, 0x4b, 0x81, 0x92, 0x5c, 0x66, 0x5e, 0xe5, 0xb4, 0x41, 0x32, 0xf1, 0x7f,
+	0xac, 0x4a, 0xca, 0x5d, 0xc0, 0xdd, 0x4f, 0x16, 0xa5, 0x74, 0x3b, 0x6f, 0xd3, 0x94, 0xfc, 0x44,
+	0xc9, 0xa9, 0x32, 0xa1, 0x3f, 0x92, 0xa1, 0xb0, 0x15, 0x5b, 0x11, 0x64, 0x5a, 0x2c, 0x2c, 0xff,
+	0x06, 0x8d, 0xa8, 0x5a, 0xf4, 0x8c, 0xce, 0xc5, 0x54, 0xf9, 0xee, 0xc1, 0xa6, 0x5c, 0xcc, 0xed,
+	0xab, 0xf9, 0xa7, 0x98, 0xb7, 0xa4, 0xa0, 0xf3, 0xf6, 0xff, 0xdc, 0x21, 0xa3, 0x1b, 0x1b, 0x2b,
+	0x4a, 0xd2, 0x01, 0x72, 0x2e, 0xe5, 0x6e, 0x01, 0xcc, 0x5b, 0x6b, 0x21, 0x6e, 0x77, 0xb8, 0xf3,
+	0x96, 0xe7, 0xe4, 0x05, 0xd2, 0xea, 0xa5, 0x18, 0xd0, 0xe7, 0x49, 0xf7, 0x1a, 0x39, 0xad, 0xb7,
+	0x08, 0x2b, 0x87, 0x70, 0x20, 0xe3, 0x09, 0x6c, 0x7b, 0x9b, 0xa1, 0xec, 0x99, 0x22, 0x29, 0x61,
+	0xea, 0xf0, 0xaa, 0xe5, 0xa4, 0x44, 0x33, 0x94, 0x3d, 0xe3, 0xaf, 0x91, 0xd1, 0x8d, 0x20, 0x51,
+	0x03, 0xff, 0x20, 0x99, 0x6a, 0xc4, 0x6d, 0x29, 0xbd, 0xad, 0xd0, 0xdb, 0xb4, 0x25, 0x86, 0xcc,
+	0xcb, 0xc6, 0x17, 0xda, 0xa0, 0x07, 0xdb, 0xff, 0xa5, 0x77, 0x10, 0x95, 0xd9, 0xe4, 0x10, 0x02,
+	0x46, 0x47, 0x05, 0xea, 0xd4, 0x2c, 0x07, 0xea, 0xa8, 0xa3, 0xb6, 0x10, 0xac, 0x93, 0xe5, 0xc1,
+	0x3a, 0x83, 0xb6, 0x83, 0x75, 0xd4, 0x9d, 0xa3, 0x27, 0x60, 0xe7, 0x0b, 0x0e, 0x19, 0x43, 0x7b,
+	0x8a, 0x72, 0x99, 0xb0, 0x56, 0x27, 0x4a, 0x4e, 0xf6, 0xec, 0x0d, 0x8d, 0x3c, 0x0f, 0x6d, 0x50,
+	0x12, 0x8a, 0xde, 0x04, 0x46, 0x3f, 0xdc, 0x25, 0xcd, 0x24, 0xc1, 0x6d, 0x8b, 0x8f, 0x97, 0x5d,
+	0xd6, 0x0f, 0xb4, 0x2f, 0xdc, 0xd5, 0xc4, 0x66, 0x6b, 0xd9, 0x0a, 0x65, 0xf2, 0x08, 0xcd, 0x44,
+	0x2a, 0x20, 0x9a, 0x38, 0xed, 0x93, 0x41, 0x1e, 0x6d, 0x26, 0x6c, 0x6d, 0xec, 0x30, 0xe7, 0x91,
+	0x68, 0x20, 0x5a, 0xdc, 0x4c, 0x7a, 0xee, 0x8d, 0xda, 0xaa, 0xf1, 0x6d, 0x78, 0x06, 0x96, 0xbb,
+	0xee, 0xb9, 0x2f, 0xea, 0x4a, 0xa0, 0xb1, 0xc3, 0x28, 0x81, 0xc6, 0xfb, 0x2a, 0x80, 0x30, 0xc7,
+	0x7f, 0x43, 0xab, 0xb9, 0xed, 0x3d, 0x73, 0xd1, 0xb1, 0x93, 0xa1, 0xa3, 0xac, 0x34, 0xba, 0x28,
+	0xb8, 0xa7, 0xb5, 0x80, 0xc1, 0x9d, 0xd5, 0x18, 0x61, 0x1a, 0x2f, 0x6f, 0xdc, 0x56, 0x5a, 0x3f,
+	0x53, 0x83, 0x26, 0x23, 0x20, 0x10, 0x06, 0x82, 0x97, 0xfb, 0x3a, 0xca, 0x64, 0x42, 0x0f, 0x36,
+	0x61, 0xcb, 0x8f, 0xb9, 0xe8, 0x06, 0x20, 0xe5, 0x3c, 0x0e, 0x05, 0xc5, 0xd1, 0xdd, 0x21, 0xd5,
+	0x66, 0xb0, 0xed, 0x4d, 0xda, 0x3a, 0x1f, 0xb5, 0xf2, 0x33, 0xfc, 0x86, 0xbe, 0x38, 0xb7, 0x0c,
+	0xc8, 0xc2, 0xbd, 0x9b, 0x97, 0x20, 0x9d, 0xb2, 0x2c, 0xd7, 0x29, 0x8e, 0xa3, 0xa5, 0x15, 0x4d,
+	0x9b, 0xc2, 0x73, 0xe2, 0xeb, 0x2e, 0x3a, 0x76, 0x4a, 0x6e, 0xa1, 0x7c, 0xc8, 0xd3, 0x44, 0xe6,
+	0xde, 0x17, 0xc8, 0x65, 0x27, 0xcb, 0x3a, 0xde, 0xd7, 0xdb, 0xe2, 0xc2, 0x92, 0x1d, 0x32, 0x2e,
+	0xf8, 0x1f, 0x30, 0xea, 0x18, 0x04, 0xda, 0x61, 0x1e, 0x77, 0xde, 0x37, 0xd8, 0x3a, 0x5b, 0xb8,
+	0x07, 0x1f, 0x5f, 0x9b, 0xfc, 0x7f, 0x10, 0x3c, 0xb0, 0x04, 0x65, 0x21, 0xe0, 0xed, 0x5d, 0xd6,
+	0x4c, 0x26, 0x3a, 0x59, 0xf5, 0x02, 0x4f, 0x1d, 0x18, 0x45, 0x77, 0x85, 0x0c, 0xdd, 0x8e, 0x5b,
+	0xdd, 0xb6, 0x88, 0xfa, 0x1c, 0xbd, 0x3c, 0x5d, 0xb6, 0xfb, 0xbc, 0xcc, 0x50, 0xf2, 0xb3, 0x8b,
+	0xff, 0x4e, 0x41, 0x3e, 0x8b, 0xd5, 0xa2, 0x27, 0x70, 0x93, 0x57, 0xdb, 0x41, 0xea, 0xb9, 0xb6,
+	0xb6, 0x51, 0xbc, 0x96, 0xe5, 0xdb, 0x9f, 0xba, 0xb8, 0x5f, 0x33, 0xd8, 0x41, 0x81, 0xbd, 0xfb,
+	0x06, 0x19, 0x4e, 0xc3, 0x26, 0x6d, 0x04, 0x49, 0xea, 0x9d, 0x3e, 0x99, 0xae, 0xe4, 0xc6, 0x5d,
+	0xc1, 0x08, 0x14, 0x4b, 0xf7, 0x47, 0x1d, 0x32, 0x19, 0x24, 0x8d, 0x9d, 0xf0, 0x36, 0x5d, 0x89,
+	0x79, 0x01, 0x1e, 0xef, 0x8c, 0xad, 0xed, 0x48, 0x9a, 0xb1, 0x25, 0x65, 0x61, 0xf3, 0x34, 0xd9,
+	0x41, 0x91, 0xbf, 0xfb, 0x9d, 0x0e, 0x39, 0xcb, 0xab, 0x82, 0x16, 0x2b, 0x11, 0x9f, 0x3d, 0xa6,
+	0xd2, 0x90, 0x85, 0xab, 0xce, 0x95, 0x91, 0x84, 0x72, 0x4e, 0xac, 0xb8, 0x52, 0xa2, 0xbb, 0x81,
+	0xb0, 0xa0, 0x61, 0x7b, 0x4e, 0x0e, 0x92, 0x2c, 0x5f, 0xfa, 0x06, 0x08, 0x4c, 0xc6, 0xc5, 0x1a,
+	0xfa, 0xe7, 0x59, 0x86, 0x82, 0xfd, 0x6b, 0xe8, 0xeb, 0x95, 0xb6, 0x9e, 0xdd, 0xaf, 0xd2, 0x96,
+	0x7b, 0x93, 0x8c, 0x66, 0x71, 0x4b, 0x14, 0x0a, 0x49, 0x3d, 0x8f, 0xad, 0xc0, 0x0b, 0x65, 0xdf,
+	0xd6, 0x86, 0x42, 0xcb, 0x6f, 0x21, 0x39, 0x2c, 0x05, 0x9d, 0x0e, 0x0b, 0xf4, 0x11, 0x95, 0x34,
+	0x13, 0xa6, 0x54, 0x79, 0xb4, 0x10, 0xe8, 0xa3, 0x37, 0x82, 0x89, 0xcb, 0x53, 0x1f, 0x14, 0xb5,
+	0x32, 0x3c, 0x7b, 0x82, 0x96, 0xfa, 0xa0, 0x80, 0x00, 0xbd, 0xcf, 0xf4, 0x29, 0xb8, 0xf4, 0xf8,
+	0x71, 0x0a, 0x2e, 0xb9, 0x4d, 0xf2, 0x78, 0xd0, 0xcd, 0x62, 0x96, 0x32, 0xd3, 0x7c, 0x84, 0x47,
+	0x32, 0x5d, 0xe4, 0xc1, 0x51, 0xf7, 0xef, 0xcd, 0x3c, 0x3e, 0xb7, 0x0f, 0x1e, 0xec, 0x4b, 0x05,
+	0x93, 0x7d, 0x53, 0x51, 0x34, 0xca, 0x7b, 0x87, 0x2d, 0x69, 0xc4, 0x2c, 0x43, 0x25, 0x83, 0x44,
+	0x38, 0x0c, 0x14, 0x3f, 0x77, 0x83, 0x8c, 0x62, 0xec, 0xfb, 0x5c, 0x2b, 0x0c, 0xb0, 0x34, 0xc1,
+	0x13, 0x17, 0xab, 0xfd, 0x84, 0xbc, 0xab, 0x12, 0x2d, 0x5f, 0x09, 0x57, 0xf3, 0x27, 0x41, 0x27,
+	0xe3, 0x52, 0x32, 0x29, 0xc3, 0xb8, 0xa4, 0x71, 0x96, 0x07, 0x6f, 0x3f, 0x5d, 0x46, 0x79, 0x3d,
+	0x6e, 0xd6, 0x4d, 0x6c, 0xe5, 0xc1, 0xa0, 0x03, 0xa1, 0x48, 0x13, 0xf5, 0x9a, 0x9d, 0xb8, 0x89,
+	0x25, 0xd5, 0xd7, 0x03, 0x2c, 0x34, 0x33, 0x63, 0x6a, 0x77, 0xd7, 0xb5, 0x36, 0x30, 0x30, 0xd1,
+	0xc3, 0xb3, 0xcd, 0x33, 0x9b, 0x79, 0x4f, 0xda, 0xba, 0x44, 0x89, 0x54, 0x69, 0x42, 0x71, 0xc2,
+	0x7f, 0x80, 0x64, 0xe3, 0xfe, 0x8c, 0x43, 0x26, 0x0b, 0x41, 0xf2, 0xde, 0x3b, 0x6d, 0x5a, 0xf2,
+	0x34, 0xc2, 0xf3, 0x4f, 0xb3, 0xe9, 0x33, 0x81, 0x0f, 0x7a, 0x41, 0x50, 0xec, 0x11, 0x9f, 0x17,
+	0x96, 0x9e, 0xd0, 0x7b, 0xca, 0xde, 0xbc, 0x30, 0x82, 0x72, 0x5e, 0xd8, 0x0f, 0x90, 0x6c, 0xd0,
+	0x2d, 0x44, 0xe4, 0xd8, 0xf7, 0x9e, 0x36, 0xdd, 0x42, 0x44, 0x7c, 0x03, 0xc8, 0xf6, 0x9e, 0x94,
+	0x83, 0xcf, 0xd9, 0x4a, 0x39, 0xa8, 0xae, 0xa0, 0x47, 0x4f, 0x39, 0x38, 0xfd, 0xad, 0xe4, 0x54,
+	0xcf, 0xc5, 0xf5, 0x48, 0x39, 0xff, 0xde, 0x62, 0xce, 0x40, 0x2c, 0x10, 0xa8, 0xa7, 0x36, 0xb2,
+	0x5e, 0x70, 0xf8, 0x05, 0x32, 0xd6, 0x68, 0x75, 0x53, 0x54, 0xdf, 0xb0, 0xe4, 0x48, 0x03, 0xa6,
+	0xf1, 0x60, 0x41, 0x6b, 0x03, 0x03, 0xd3, 0xbf, 0x4a, 0xdc, 0xde, 0xc2, 0x87, 0xc7, 0xb2, 0xc2,
+	0xfd, 0x63, 0x87, 0x8c, 0x1b, 0xe2, 0x8d, 0x75, 0xff, 0x84, 0x25, 0xe2, 0xb6, 0xc3, 0x24, 0x89,
+	0x13, 0x2e, 0x3d, 0xae, 0xe2, 0xee, 0x9c, 0x8a, 0x04, 0x66, 0xcc, 0xcb, 0x69, 0xb5, 0xa7, 0x15,
+	0x4a, 0x9e, 0xf0, 0xff, 0xe9, 0x00, 0xc9, 0x43, 0xbf, 0x54, 0x49, 0x1f, 0xa7, 0x6f, 0x49, 0x9f,
+	0xe7, 0xc8, 0x30, 0x86, 0x45, 0xae, 0xe7, 0x85, 0x7f, 0xd4, 0xbb, 0x78, 0xb1, 0xbe, 0x76, 0x83,
+	0x61, 0x2a, 0x0c, 0x86, 0xfd, 0xca, 0x52, 0xd8, 0xca, 0x7a, 0x2b, 0xc3, 0xbc, 0xf8, 0x12, 0x87,
+	0x83, 0xc2, 0xc0, 0x08, 0x75, 0x7a, 0x9b, 0x2a, 0xab, 0x92, 0xba, 0xe3, 0x8b, 0x42, 0xaf, 0xac,
+	0xcd, 0x2c, 0x6e, 0x3d, 0x70, 0x70, 0x71, 0x6b, 0x26, 0xbb, 0x0a, 0x2b, 0x86, 0x37, 0x68, 0x2b,
+	0x27, 0x4a, 0x8f, 0x5d, 0x84, 0x1f, 0x58, 0x12, 0x0c, 0x8a, 0x65, 0x99, 0x8f, 0xc6, 0xc8, 0x89,
+	0xf8, 0x68, 0x68, 0x71, 0x88, 0xb5, 0xc3, 0xc6, 0x21, 0x9a, 0x6b, 0x7b, 0xf8, 0x50, 0x6b, 0xfb,
+	0x7b, 0xaa, 0x64, 0xe8, 0x65, 0x9a, 0xe0, 0xff, 0xb8, 0x19, 0xde, 0xe6, 0xff, 0x16, 0x93, 0x58,
+	0x08, 0x0c, 0x90, 0xed, 0xf8, 0xde, 0x36, 0xbb, 0x61, 0xab, 0xb9, 0x98, 0x7f, 0xc5, 0xea, 0xbd,
+	0xcd, 0xcb, 0x06, 0xc8, 0x71, 0xf0, 0x81, 0x6d, 0xbc, 0x84, 0xb4, 0xd1, 0xab, 0xb9, 0xe0, 0xa0,
+	0xb9, 0x2c, 0x1b, 0x20, 0xc7, 0x41, 0xdb, 0xdf, 0x76, 0x98, 0x6d, 0x04, 0xdb, 0x45, 0x33, 0xfb,
+	0x32, 0x83, 0x82, 0x68, 0x65, 0x36, 0xd6, 0x30, 0xdb, 0x48, 0x28, 0xd3, 0xd1, 0xf7, 0xe4, 0x7e,
+	0x5b, 0xd6, 0xda, 0xc0, 0xc0, 0x64, 0x5d, 0x8a, 0xc5, 0xc8, 0xbc, 0xc1, 0x42, 0x97, 0x64, 0x03,
+	0xe4, 0x38, 0xb8, 0xfe, 0x51, 0x61, 0x1b, 0xb6, 0x44, 0x64, 0x86, 0xb6, 0xfe, 0x17, 0x04, 0x1c,
+	0x14, 0x06, 0x62, 0xe3, 0x16, 0x86, 0xdb, 0x8f, 0x37, 0x6c, 0x62, 0xaf, 0x0b, 0x38, 0x28, 0x0c,
+	0xff, 0x65, 0x32, 0xce, 0xbf, 0xe4, 0x85, 0x56, 0x10, 0xb6, 0x97, 0x17, 0xdc, 0x2b, 0x3d, 0x41,
+	0x66, 0xcf, 0x96, 0x04, 0x99, 0x9d, 0x35, 0x1e, 0xea, 0x0d, 0x36, 0xf3, 0xbf, 0x52, 0x21, 0xc3,
+	0xea, 0x46, 0xab, 0x1b, 0xe7, 0x9d, 0x13, 0x31, 0xce, 0x77, 0xc8, 0x40, 0xda, 0xa1, 0x0d, 0x7b,
+	0xd9, 0x58, 0x54, 0x88, 0x6f, 0x87, 0x36, 0xf2, 0x2d, 0x0c, 0x7f, 0x01, 0xe3, 0xe4, 0xde, 0x25,
+	0x83, 0x29, 0xcf, 0x41, 0x54, 0xb5, 0x25, 0xbc, 0x2a, 0x9e, 0x8c, 0xae, 0xe6, 0x2c, 0xc6, 0x7e,
+	0x83, 0xe0, 0xe7, 0xff, 0x71, 0x85, 0x9c, 0x93, 0xa8, 0xf2, 0xda, 0xb9, 0xbc, 0xc0, 0xca, 0xee,
+	0x9f, 0xfc, 0x44, 0x27, 0xc6, 0x44, 0xaf, 0xdb, 0xbb, 0x38, 0x2f, 0x2f, 0xf4, 0x9d, 0xea, 0x57,
+	0x0b, 0x53, 0x0d, 0x56, 0xb9, 0xee, 0x3f, 0xd9, 0x7f, 0xe9, 0x90, 0xe9, 0xf2, 0xc9, 0x5e, 0x09,
+	0x53, 0xcc, 0x21, 0x51, 0x9c, 0xf0, 0xd9, 0x43, 0x86, 0x53, 0x86, 0x29, 0x9f, 0x6e, 0xf5, 0x71,
+	0x4a, 0x88, 0x36, 0xd9, 0x6f, 0xc8, 0xcc, 0xf5, 0x15, 0x5b, 0xd9, 0x94, 0xca, 0x87, 0x92, 0x1f,
+	0x92, 0x46, 0x5e, 0xfc, 0xff, 0xe9, 0x90, 0x33, 0xf2, 0x01, 0x76, 0x7a, 0xce, 0x87, 0x11, 0xf3,
+	0x04, 0x3b, 0xf9, 0x65, 0xf6, 0xba, 0xb1, 0xcc, 0x3e, 0x6c, 0x6f, 0xe0, 0xfa, 0x38, 0xfa, 0x2d,
+	0x38, 0xff, 0x7f, 0x38, 0xc4, 0x2b, 0x7b, 0xe0, 0x21, 0xbc, 0xf2, 0xd7, 0xcc, 0x57, 0xfe, 0xf2,
+	0xc9, 0x8c, 0xbc, 0xcf, 0x0b, 0xff, 0xbf, 0xd5, 0xf2, 0x71, 0xe3, 0xd4, 0x60, 0xa9, 0x29, 0x2e,
+	0x57, 0x39, 0xb6, 0x42, 0x20, 0x39, 0x8b, 0x72, 0x01, 0xad, 0x45, 0x06, 0x53, 0xe6, 0xf2, 0xe4,
+	0x55, 0x6c, 0x69, 0x81, 0xb9, 0x0b, 0x95, 0xb0, 0x50, 0xb0, 0xff, 0x41, 0xf0, 0x70, 0xbf, 0xcf,
+	0xc1, 0x1c, 0x02, 0xb2, 0x32, 0xb7, 0x74, 0x36, 0xdd, 0xb0, 0x34, 0x44, 0xa3, 0xe6, 0x37, 0x57,
+	0x67, 0xe5, 0x20, 0x4c, 0x40, 0x9b, 0xff, 0x70, 0xdf, 0x20, 0x23, 0x89, 0xac, 0xe2, 0xed, 0x0d,
+	0xd8, 0xda, 0x64, 0xcd, 0xea, 0xe0, 0xdc, 0x5e, 0xa5, 0x7e, 0x42, 0xce, 0xd1, 0xff, 0xc5, 0x0a,
+	0x39, 0x2f, 0x57, 0x00, 0xb3, 0x0c, 0xe7, 0x1b, 0x05, 0xab, 0xfe, 0x15, 0xa8, 0x9f, 0xf6, 0xea,
+	0x68, 0xe6, 0x2c, 0xf2, 0x4d, 0x21, 0x87, 0x81, 0xc6, 0x13, 0x13, 0xba, 0xb0, 0xba, 0x97, 0x4b,
+	0x61, 0x14, 0xb4, 0xc2, 0x57, 0x69, 0x02, 0xb4, 0xad, 0xfc, 0x68, 0xb4, 0x1a, 0xb0, 0x4b, 0x65,
+	0x48, 0x50, 0xfe, 0x6c, 0x8f, 0x3e, 0xa5, 0x7a, 0x58, 0x7d, 0x8a, 0xff, 0xfb, 0x0e, 0x19, 0x53,
+	0xb3, 0x75, 0xf2, 0x7b, 0x43, 0x6c, 0xee, 0x0d, 0x2f, 0xda, 0xdb, 0x1b, 0xfa, 0xec, 0x07, 0xf7,
+	0x6a, 0x64, 0x4a, 0xa2, 0xa8, 0x12, 0x08, 0xdf, 0xeb, 0x28, 0xef, 0x38, 0xee, 0x85, 0xfc, 0x31,
+	0x7b, 0xfd, 0x38, 0x4a, 0xd9, 0x01, 0x0c, 0x22, 0x31, 0x14, 0x23, 0x15, 0x5b, 0x79, 0x5e, 0x7b,
+	0x7a, 0x73, 0x8c, 0x9a, 0x0c, 0x5f, 0x70, 0x08, 0xe1, 0xfd, 0x14, 0x35, 0xb4, 0xb0, 0x6f, 0x9b,
+	0x27, 0x36, 0x53, 0xc8, 0x84, 0x77, 0x4d, 0x7d, 0x42, 0x79, 0x03, 0x68, 0x3d, 0x79, 0x0b, 0xc5,
+	0x16, 0xde, 0x72, 0x9d, 0x87, 0xcf, 0x3a, 0x64, 0xb2, 0xd0, 0xdd, 0x92, 0xe7, 0xb7, 0xcc, 0xcc,
+	0x8a, 0x16, 0x76, 0x3f, 0xb3, 0xa4, 0x90, 0xae, 0x45, 0xfa, 0xc5, 0x27, 0xf3, 0x0f, 0x98, 0x1d,
+	0x72, 0xaf, 0x91, 0x11, 0xa9, 0x02, 0x92, 0xcb, 0xfb, 0x45, 0x7b, 0x9a, 0xb6, 0xfc, 0x9e, 0x27,
+	0x21, 0x29, 0xe4, 0xfc, 0x0a, 0xce, 0xb7, 0x95, 0x43, 0x39, 0xdf, 0x1a, 0xb5, 0x87, 0xaa, 0x0f,
+	0xbb, 0xf6, 0x50, 0xb9, 0xd5, 0x61, 0xe0, 0x44, 0xac, 0x0e, 0x8f, 0x5b, 0xb7, 0x3a, 0x3c, 0xf1,
+	0x90, 0xad, 0x0e, 0x9a, 0x61, 0xb7, 0xf6, 0x16, 0x0c, 0xbb, 0xaf, 0x91, 0x33, 0xb7, 0xf3, 0xdb,
+	0xb7, 0x5a, 0x49, 0x22, 0xab, 0xe4, 0xb3, 0xa5, 0xb6, 0x06, 0x9a, 0xa4, 0x61, 0x9a, 0xd1, 0x28,
+	0xd3, 0xee, 0xed, 0xb9, 0xdf, 0xef, 0xcb, 0x25, 0xe4, 0xa0, 0x94, 0x49, 0xd1, 0x42, 0x37, 0x74,
+	0x08, 0x0b, 0xdd, 0xcf, 0xa3, 0x8d, 0xb3, 0x27, 0xca, 0x17, 0x55, 0x58, 0xc3, 0xb6, 0x4c, 0xed,
+	0x73, 0x65, 0xe4, 0x85, 0x29, 0xb4, 0xac, 0x09, 0xca, 0x3b, 0x84, 0x21, 0x54, 0xd2, 0x83, 0x83,
+	0x7b, 0x8b, 0x97, 0xbb, 0x5b, 0x7c, 0xa9, 0xe8, 0x16, 0x46, 0xd8, 0xd4, 0x7f, 0xdc, 0xae, 0xda,
+	0xc1, 0x82, 0x6b, 0xd8, 0xe8, 0x5b, 0x70, 0x0d, 0x2b, 0x98, 0x4b, 0xc7, 0x2c, 0x99, 0x4b, 0x23,
+	0x32, 0x15, 0xb6, 0x83, 0x6d, 0xba, 0xde, 0x6d, 0xb5, 0x78, 0xd8, 0x5e, 0xea, 0x8d, 0x5f, 0xac,
+	0xf6, 0x53, 0x65, 0xa2, 0xa5, 0xbc, 0x25, 0x32, 0x5b, 0x29, 0x4f, 0x79, 0x15, 0x9e, 0x78, 0xad,
+	0x40, 0x09, 0x7a, 0x68, 0xe3, 0x82, 0x65, 0x69, 0xae, 0x69, 0x86, 0xb3, 0xcd, 0xfc, 0x8f, 0x86,
+	0xe7, 0x27, 0xa5, 0x1d, 0x4f, 0x80, 0x41, 0xc7, 0x71, 0xaf, 0x93, 0x91, 0x66, 0x94, 0x8a, 0x84,
+	0x05, 0x93, 0x6c, 0x33, 0x7b, 0x17, 0x2b, 0x16, 0x72, 0xa3, 0xae, 0x52, 0x15, 0x3c, 0x5e, 0x92,
+	0xb7, 0x5d, 0xb5, 0x43, 0xfe, 0xbc, 0xbb, 0xca, 0x88, 0x89, 0x6a, 0xec, 0xdc, 0x2d, 0xe8, 0x62,
+	0x1f, 0x73, 0xe0, 0xe2, 0x0d, 0x59, 0x4f, 0x7e, 0x5c, 0xb0, 0xe3, 0x3f, 0x21, 0xa7, 0x80, 0xea,
+	0xc9, 0x38, 0xc2, 0xb4, 0x77, 0xde, 0x29, 0x53, 0x3d, 0xb9, 0xc6, 0xa0, 0x20, 0x5a, 0x79, 0x81,
+	0x89, 0xac, 0xa5, 0x4c, 0xfa, 0x17, 0xac, 0x15, 0x98, 0xc8, 0x1d, 0x6e, 0x45, 0x81, 0x89, 0x1c,
+	0x00, 0x3a, 0x4b, 0x77, 0xad, 0x9f, 0x6b, 0xc3, 0x69, 0xb6, 0x69, 0x1c, 0xdd, 0x51, 0x41, 0x8f,
+	0x39, 0x38, 0xb3, 0x5f, 0xcc, 0x41, 0xaf, 0x4d, 0xfe, 0xec, 0x11, 0x6c, 0xf2, 0x3b, 0x2c, 0x95,
+	0xfe, 0xf2, 0x82, 0x77, 0xce, 0xd6, 0x45, 0x97, 0x65, 0xe4, 0xe2, 0xce, 0xd4, 0xec, 0x5f, 0xe0,
+	0x0c, 0xfa, 0x86, 0x65, 0x9c, 0x3f, 0x76, 0x58, 0x46, 0xc1, 0xb0, 0xfd, 0xe8, 0x89, 0x19, 0xb6,
+	0xa7, 0x1f, 0x82, 0x61, 0xfb, 0xb1, 0x43, 0x1b, 0xb6, 0xef, 0x92, 0xd3, 0x9d, 0xb8, 0xb9, 0x18,
+	0xa6, 0x49, 0x97, 0x87, 0x56, 0x76, 0x9b, 0x58, 0xea, 0x6f, 0x86, 0x75, 0xf2, 0x5d, 0x7a, 0x27,
+	0x3b, 0xec, 0xab, 0x94, 0x1f, 0x5c, 0xe1, 0x01, 0x24, 0xc8, 0x3d, 0xb1, 0x4b, 0x1a, 0xa1, 0x8c,
+	0x85, 0x6e, 0x52, 0xbf, 0xf8, 0x70, 0x4c, 0xea, 0x1f, 0x24, 0xc3, 0xe9, 0x4e, 0x37, 0x6b, 0xc6,
+	0x77, 0x22, 0xe6, 0x37, 0x31, 0x32, 0xff, 0x4e, 0xa5, 0xa0, 0x17, 0x70, 0x4c, 0x00, 0x26, 0xff,
+	0xd7, 0x74, 0xf3, 0x02, 0xe2, 0xfe, 0x54, 0x9f, 0x90, 0x3e, 0xff, 0x24, 0x43, 0xfa, 0xce, 0x1f,
+	0x29, 0x9c, 0xaf, 0xcc, 0x6f, 0xe0, 0xc9, 0xaf, 0x39, 0xbf, 0x81, 0x2f, 0x3a, 0x64, 0xfc, 0xb6,
+	0x6e, 0x08, 0xf1, 0xde, 0x69, 0xcb, 0x73, 0xca, 0xb0, 0xaf, 0xcc, 0xfb, 0xb8, 0x69, 0x19, 0xa0,
+	0x07, 0x45, 0x00, 0x98, 0x3d, 0x29, 0xf1, 0xea, 0x7a, 0xea, 0xed, 0xf2, 0xea, 0x7a, 0x83, 0x8c,
+	0x76, 0xe2, 0xa6, 0xbc, 0xb1, 0x32, 0x87, 0x07, 0xbb, 0x7e, 0xe6, 0x5c, 0xfe, 0xcc, 0x59, 0x80,
+	0xce, 0x0f, 0x7d, 0xb0, 0xa7, 0xe4, 0x25, 0x4b, 0x18, 0x32, 0x53, 0xef, 0xeb, 0x6c, 0x75, 0x42,
+	0xdd, 0xed, 0x78, 0x6d, 0x87, 0x02, 0x1f, 0xe8, 0xe1, 0x8c, 0x02, 0x89, 0xf2, 0x02, 0xdc, 0x4e,
+	0xbd, 0x67, 0x72, 0x81, 0x64, 0x2e, 0x07, 0x83, 0x8e, 0xe3, 0xfe, 0xb4, 0x43, 0x6a, 0x3b, 0x71,
+	0xbc, 0x9b, 0x7a, 0xcf, 0xb2, 0x0d, 0xfd, 0x43, 0x96, 0x05, 0x4d, 0xac, 0x65, 0x26, 0x34, 0x1b,
+	0xb2, 0x1c, 0x50, 0x8d, 0xc1, 0x1e, 0xdc, 0x9b, 0x99, 0x30, 0x2a, 0xe3, 0xa6, 0x9f, 0x7e, 0x53,
+	0x83, 0x08, 0x8d, 0x2d, 0xeb, 0x9a, 0xfb, 0x79, 0x87, 0x4c, 0xdd, 0x29, 0x68, 0x27, 0xbc, 0xaf,
+	0xb7, 0x65, 0xb0, 0x29, 0xea, 0x3d, 0xf8, 0x74, 0x17, 0xa1, 0xd0, 0xd3, 0x03, 0x0c, 0x58, 0xd2,
+	0xb5, 0x96, 0xdc, 0xa7, 0xd8, 0xe2, 0x04, 0x16, 0xb4, 0xa4, 0x3c, 0x6c, 0xad, 0x8f, 0xfa, 0x52,
+	0xab, 0x38, 0xf3, 0xdc, 0x43, 0xa9, 0x38, 0xf3, 0xd6, 0xfd, 0x74, 0x70, 0xfa, 0xf2, 0xe5, 0x51,
+	0xf2, 0x28, 0x35, 0xd5, 0x35, 0x16, 0xb6, 0x17, 0x63, 0xc1, 0xe9, 0xda, 0x9a, 0xbf, 0x3a, 0x47,
+	0x26, 0x4c, 0x1b, 0xa9, 0xfb, 0x5e, 0xb3, 0x78, 0xde, 0x85, 0x62, 0x1d, 0xb2, 0x71, 0x89, 0x6f,
+	0xd4, 0x22, 0x33, 0x8a, 0x85, 0x55, 0x4e, 0xb4, 0x58, 0x58, 0xf5, 0xe1, 0x14, 0x0b, 0x9b, 0x3a,
+	0xe9, 0x62, 0x61, 0x67, 0x4f, 0xae, 0x58, 0xd8, 0xa9, 0x23, 0x15, 0x0b, 0xd3, 0x6a, 0xc2, 0x0d,
+	0x1c, 0x50, 0x13, 0x6e, 0x8e, 0x4c, 0xca, 0x40, 0x37, 0x2a, 0xca, 0x28, 0x71, 0x2f, 0x8d, 0xf3,
+	0xe2, 0x91, 0xc9, 0x05, 0xb3, 0x19, 0x8a, 0xf8, 0xb8, 0x7b, 0xd4, 0xa2, 0xb8, 0xa9, 0xb4, 0x2b,
+	0x1f, 0xb1, 0x6d, 0xe5, 0x67, 0x97, 0x7c, 0xb1, 0xf7, 0x4a, 0x3f, 0xfa, 0x1a, 0x83, 0x3d, 0x90,
+	0xff, 0x00, 0xef, 0x01, 0x26, 0x52, 0x8e, 0xb7, 0xb6, 0x5a, 0x71, 0xd0, 0xcc, 0x6b, 0xe2, 0x48,
+	0x37, 0x12, 0x1e, 0xa7, 0xae, 0x12, 0x29, 0xaf, 0xf5, 0xc1, 0x83, 0xbe, 0x14, 0x50, 0x4b, 0x33,
+	0x99, 0x66, 0x71, 0x42, 0x9b, 0xb9, 0x46, 0x69, 0x84, 0x8d, 0x99, 0x5a, 0x1f, 0x73, 0xdd, 0xe4,
+	0xc3, 0x47, 0xaf, 0x5e, 0x4a, 0xa1, 0x15, 0x8a, 0xdd, 0x72, 0x13, 0x72, 0xae, 0x53, 0xa6, 0xd0,
+	0x4a, 0xbd, 0xa1, 0x03, 0xd5, 0x6a, 0x72, 0x87, 0x38, 0x57, 0xaa, 0x12, 0x4b, 0xa1, 0x0f, 0x65,
+	0x7d, 0xeb, 0x1e, 0x7e, 0x38, 0xc5, 0xc2, 0x3e, 0x45, 0x48, 0x43, 0x26, 0xbd, 0x94, 0x2a, 0x92,
+	0xeb, 0x56, 0xe2, 0xc6, 0x38, 0xcd, 0x7c, 0xa3, 0x51, 0xa0, 0x14, 0x34, 0x96, 0xee, 0xff, 0x2a,
+	0xad, 0xa6, 0xc7, 0xf5, 0x40, 0xdb, 0xd6, 0xd7, 0xc4, 0xd7, 0x5c, 0x45, 0xbd, 0x7f, 0xe4, 0x90,
+	0x69, 0xbe, 0xf2, 0x8a, 0xb7, 0x16, 0x94, 0x99, 0xbc, 0x89, 0x13, 0xf1, 0x34, 0xe2, 0xa9, 0xe5,
+	0x0c, 0xae, 0x08, 0x87, 0x7d, 0x7a, 0x82, 0xa6, 0xa6, 0x9e, 0xbb, 0xd2, 0xa4, 0x2d, 0xcd, 0x6a,
+	0x79, 0x4d, 0xb4, 0xd3, 0xf7, 0x0f, 0x73, 0x3d, 0xfa, 0xa5, 0xbe, 0x8a, 0x5f, 0x97, 0x75, 0xef,
+	0xdb, 0x4e, 0x48, 0xf1, 0xab, 0x17, 0x6e, 0x3b, 0x92, 0xfa, 0xf7, 0xb3, 0x0e, 0x99, 0x0a, 0x0a,
+	0x9e, 0x41, 0xde, 0x69, 0x5b, 0x9a, 0xb3, 0xb9, 0x44, 0x11, 0xe5, 0xd2, 0x6b, 0xd1, 0x09, 0x09,
+	0x7a, 0x98, 0xbb, 0x5f, 0x71, 0xc8, 0x63, 0x79, 0x75, 0xb8, 0x34, 0x0f, 0x4c, 0x17, 0x9d, 0x3b,
+	0xc3, 0xbe, 0xc6, 0x57, 0xac, 0x7f, 0x8d, 0x1b, 0xfd, 0x79, 0xf2, 0xef, 0xf2, 0x49, 0xf1, 0x5d,
+	0x3e, 0xb6, 0x0f, 0x26, 0xec, 0xd7, 0xf5, 0xe9, 0xef, 0x75, 0x78, 0xb9, 0xdf, 0xbe, 0x92, 0xe5,
+	0xa6, 0x29, 0x59, 0xae, 0xd8, 0xac, 0x25, 0xa7, 0x8b, 0xb8, 0x3f, 0x8c, 0x79, 0x48, 0x4b, 0x4e,
+	0xa4, 0x92, 0x2e, 0x7d, 0xdc, 0xec, 0x92, 0xc5, 0xeb, 0xa3, 0xde, 0x21, 0x3b, 0x35, 0xec, 0x6e,
+	0x90, 0x8b, 0x07, 0xbd, 0xc5, 0x83, 0xe8, 0x0d, 0xeb, 0xd2, 0xf7, 0x17, 0x46, 0x35, 0x5b, 0x29,
+	0xba, 0xb4, 0xdb, 0x76, 0xb9, 0x8f, 0x30, 0xa9, 0x00, 0xea, 0x7b, 0xbd, 0x71, 0xdb, 0xb3, 0x2b,
+	0xeb, 0x7f, 0x22, 0x75, 0x10, 0x5c, 0xde, 0x66, 0xd3, 0x69, 0xb1, 0x02, 0xf4, 0xc0, 0xc3, 0xaf,
+	0x00, 0x7d, 0x87, 0x8c, 0xdc, 0x09, 0xb3, 0x1d, 0xe6, 0xf2, 0x21, 0x2c, 0x92, 0x16, 0x82, 0x7a,
+	0x91, 0x5c, 0x3e, 0xf6, 0x5b, 0x92, 0x01, 0xe4, 0xbc, 0xd0, 0x03, 0x1a, 0x7f, 0x30, 0x47, 0xfb,
+	0xa2, 0x07, 0xf4, 0x2d, 0xd9, 0x00, 0x39, 0x0e, 0x4e, 0xd6, 0x18, 0xfe, 0x92, 0xf9, 0xdf, 0xbc,
+	0x21, 0x5b, 0x2b, 0x44, 0x52, 0xe4, 0xa1, 0xf3, 0xb7, 0x34, 0x1e, 0x60, 0x70, 0x54, 0x35, 0x02,
+	0x86, 0xfb, 0xd6, 0x08, 0x78, 0x9d, 0x09, 0x6c, 0x59, 0x18, 0x75, 0xe9, 0x5a, 0xe4, 0x8d, 0xd8,
+	0xda, 0xb4, 0x16, 0x14, 0x4d, 0xae, 0x5b, 0xc8, 0x7f, 0x83, 0xc6, 0x4f, 0x33, 0x0c, 0x8d, 0xee,
+	0x6b, 0x18, 0xca, 0x75, 0x49, 0x63, 0xd6, 0x75, 0x49, 0x19, 0xed, 0xd8, 0xd1, 0x25, 0x25, 0xaa,
+	0x8e, 0xe8, 0x84, 0xdd, 0xf2, 0x9a, 0xdc, 0x28, 0xc7, 0xb7, 0x03, 0xb3, 0x72, 0xa8, 0xfb, 0x0d,
+	0x64, 0x64, 0x13, 0x8d, 0x09, 0x75, 0x0c, 0x03, 0x9b, 0x64, 0xd7, 0x5d, 0x66, 0x86, 0x9b, 0x97,
+	0x40, 0xc8, 0xdb, 0xbf, 0xa6, 0xd4, 0x22, 0x7f, 0xe9, 0x10, 0x57, 0x09, 0x86, 0x6a, 0xc7, 0x7f,
+	0x08, 0x4e, 0xba, 0xe8, 0x10, 0x88, 0x57, 0x53, 0xce, 0xd0, 0xee, 0x31, 0xcd, 0x69, 0xe6, 0x1d,
+	0xc8, 0x61, 0xa0, 0xf1, 0xf4, 0xff, 0xd4, 0x21, 0xe7, 0x7a, 0xc7, 0xfe, 0x10, 0x7c, 0xf1, 0xf6,
+	0x4c, 0x5f, 0xbc, 0x0d, 0x8b, 0x46, 0x13, 0x35, 0x8c, 0x3e, 0x5e, 0x79, 0x7f, 0x52, 0x21, 0x93,
+	0x3a, 0x72, 0x9d, 0x3e, 0x8c, 0x97, 0x7d, 0xc7, 0xf0, 0xc8, 0xbe, 0x69, 0x77, 0xbc, 0x75, 0x61,
+	0x7b, 0x2b, 0xf3, 0xfe, 0xff, 0x54, 0xc1, 0xfb, 0xff, 0x96, 0x7d, 0xd6, 0xfb, 0x87, 0x00, 0xfc,
+	0x57, 0x87, 0x9c, 0x2e, 0x3c, 0xf1, 0x10, 0x16, 0xd8, 0x6d, 0x73, 0x81, 0xbd, 0x64, 0x7d, 0xd4,
+	0x7d, 0x56, 0xd7, 0xcf, 0x56, 0x7a, 0x46, 0xcb, 0x6e, 0x99, 0xdf, 0xe3, 0x90, 0x1a, 0x8a, 0xf3,
+	0xd2, 0x2d, 0xee, 0xe3, 0x27, 0xb2, 0x02, 0xd8, 0xc5, 0x43, 0x1c, 0x1f, 0xaa, 0x7f, 0x0c, 0x06,
+	0x9c, 0xfb, 0xf4, 0x77, 0x3b, 0x84, 0xe4, 0x48, 0x6f, 0x97, 0x8c, 0xee, 0xff, 0x42, 0x85, 0x9c,
+	0x2d, 0x5d, 0x46, 0xe8, 0x4b, 0x2e, 0x54, 0x86, 0x8e, 0x6d, 0xa7, 0x4f, 0x83, 0x91, 0xae, 0x39,
+	0x1c, 0x37, 0x34, 0x87, 0x42, 0x61, 0xf8, 0x76, 0xdd, 0xb0, 0xc4, 0x36, 0xad, 0x4d, 0xd6, 0x1f,
+	0x39, 0xb9, 0x1f, 0xb1, 0x9c, 0xcc, 0xbf, 0x8e, 0x41, 0x61, 0xfe, 0x9f, 0x68, 0x11, 0x33, 0x72,
+	0xa0, 0x0f, 0x61, 0xaf, 0xb8, 0x63, 0xee, 0x15, 0x60, 0xdf, 0x82, 0xdf, 0x67, 0xb3, 0x78, 0x85,
+	0x94, 0x99, 0xf4, 0x0f, 0x97, 0xa1, 0xd6, 0x08, 0xaf, 0xae, 0x1c, 0x3a, 0xbc, 0x7a, 0x9c, 0x8c,
+	0x7e, 0x38, 0x54, 0xd9, 0x8d, 0xe7, 0x67, 0x7f, 0xe3, 0xab, 0x17, 0x1e, 0xf9, 0xad, 0xaf, 0x5e,
+	0x78, 0xe4, 0x2b, 0x5f, 0xbd, 0xf0, 0xc8, 0x77, 0xdc, 0xbf, 0xe0, 0xfc, 0xc6, 0xfd, 0x0b, 0xce,
+	0x6f, 0xdd, 0xbf, 0xe0, 0x7c, 0xe5, 0xfe, 0x05, 0xe7, 0x3f, 0xdf, 0xbf, 0xe0, 0xfc, 0xc8, 0x1f,
+	0x5c, 0x78, 0xe4, 0xc3, 0xc3, 0x72, 0x60, 0xff, 0x6f, 0x00, 0x31, 0x48, 0xd8, 0x83, 0xdb, 0xf8,
+	0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -5277,6 +5890,52 @@ func (m *Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApprovalDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i--
+	if m.Approved {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArchiveStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5492,6 +6151,11 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x72
 	i--
 	if m.Deleted {
 		dAtA[i] = 1
@@ -5757,6 +6421,16 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContentAddressed != nil {
+		i--
+		if *m.ContentAddressed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6007,6 +6681,16 @@ func (m *ArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContentAddressed != nil {
+		i--
+		if *m.ContentAddressed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6804,6 +7488,18 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -6819,6 +7515,49 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChildWorkflowTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientCertAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7328,6 +8067,202 @@ func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowBackfill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RerunFailed))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowBackfillInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfillInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfillInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Workflow)
+	copy(dAtA[i:], m.Workflow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Workflow)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowBackfillStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfillStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfillStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Intervals) > 0 {
+		for iNdEx := len(m.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RerunFailed))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowDependencies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowDependencies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowDependencies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.DeadlineSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CronWorkflows) > 0 {
+		for iNdEx := len(m.CronWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CronWorkflows[iNdEx])
+			copy(dAtA[i:], m.CronWorkflows[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CronWorkflows[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7395,6 +8330,44 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Dependencies != nil {
+		{
+			size, err := m.Dependencies.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CatchUpLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CatchUpLimit))
+		i--
+		dAtA[i] = 0x78
+	}
+	i -= len(m.CatchUpPolicy)
+	copy(dAtA[i:], m.CatchUpPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CatchUpPolicy)))
+	i--
+	dAtA[i] = 0x72
+	if len(m.Backfills) > 0 {
+		for iNdEx := len(m.Backfills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backfills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	i -= len(m.When)
 	copy(dAtA[i:], m.When)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
@@ -7499,6 +8472,34 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WaitingScheduledTimes) > 0 {
+		for iNdEx := len(m.WaitingScheduledTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WaitingScheduledTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Backfills) > 0 {
+		for iNdEx := len(m.Backfills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backfills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -7553,6 +8554,34 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DAGExpansion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGExpansion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAGExpansion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DAGTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7573,6 +8602,39 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.FanOut != nil {
+		{
+			size, err := m.FanOut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Expand != nil {
+		{
+			size, err := m.Expand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -7878,6 +8940,70 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDeduplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeduplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeduplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSubmissions))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7906,6 +9032,85 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FanOutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FanOutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanOutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFailed != nil {
+		{
+			size, err := m.MaxFailed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MinSucceeded != nil {
+		{
+			size, err := m.MinSucceeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FanOutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FanOutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanOutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Total))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8423,6 +9628,47 @@ func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ResponseArtifact)
+	copy(dAtA[i:], m.ResponseArtifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResponseArtifact)))
+	i--
+	dAtA[i] = 0x62
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BodyFrom != nil {
 		{
 			size, err := m.BodyFrom.MarshalToSizedBuffer(dAtA[:i])
@@ -8702,6 +9948,75 @@ func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPPagination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPPagination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPPagination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxPages))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Next)
+	copy(dAtA[i:], m.Next)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Next)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9103,6 +10418,11 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -9330,6 +10650,18 @@ func (m *Mutex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Preemption != nil {
+		{
+			size, err := m.Preemption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i--
 	if m.Database {
 		dAtA[i] = 1
@@ -9435,6 +10767,53 @@ func (m *MutexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Gate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NodeFlag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9544,6 +10923,73 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.ProgressEstimated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x88
+	if len(m.FanOut) > 0 {
+		keysForFanOut := make([]string, 0, len(m.FanOut))
+		for k := range m.FanOut {
+			keysForFanOut = append(keysForFanOut, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForFanOut)
+		for iNdEx := len(keysForFanOut) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.FanOut[string(keysForFanOut[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForFanOut[iNdEx])
+			copy(dAtA[i:], keysForFanOut[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForFanOut[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	i -= len(m.ChildWorkflow)
+	copy(dAtA[i:], m.ChildWorkflow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChildWorkflow)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDurationP90))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe8
 	if m.TaskResultSynced != nil {
 		i--
 		if *m.TaskResultSynced {
@@ -10297,6 +11743,16 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Schema)
+	copy(dAtA[i:], m.Schema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schema)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x42
 	if m.Description != nil {
 		i -= len(*m.Description)
 		copy(dAtA[i:], *m.Description)
@@ -10509,6 +11965,92 @@ func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Preemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	if m.PriorityGap != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PriorityGap))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Preemptor)
+	copy(dAtA[i:], m.Preemptor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Preemptor)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Holder)
+	copy(dAtA[i:], m.Holder)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holder)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Lock)
+	copy(dAtA[i:], m.Lock)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Lock)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10645,6 +12187,19 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.FieldManager)
+	copy(dAtA[i:], m.FieldManager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i--
+	dAtA[i] = 0x4a
 	if m.ManifestFrom != nil {
 		{
 			size, err := m.ManifestFrom.MarshalToSizedBuffer(dAtA[:i])
@@ -11096,6 +12651,34 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11191,6 +12774,18 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Preemption != nil {
+		{
+			size, err := m.Preemption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Database != nil {
 		{
 			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
@@ -11544,6 +13139,60 @@ func (m *SuppliedValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SuspendApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.AllowReject {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.Required != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Required))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Users[iNdEx])
+			copy(dAtA[i:], m.Users[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Users[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11564,6 +13213,18 @@ func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.Duration)
 	copy(dAtA[i:], m.Duration)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
@@ -11671,6 +13332,20 @@ func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Preemptions) > 0 {
+		for iNdEx := len(m.Preemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Mutex != nil {
 		{
 			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
@@ -11784,6 +13459,20 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChildWorkflow != nil {
+		{
+			size, err := m.ChildWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -12834,6 +14523,30 @@ func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Deduplicate != nil {
+		{
+			size, err := m.Deduplicate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Submit != nil {
 		{
 			size, err := m.Submit.MarshalToSizedBuffer(dAtA[:i])
@@ -13072,6 +14785,20 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.ArtifactGC != nil {
 		{
 			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
@@ -13595,6 +15322,11 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EstimatedDurationP90))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
 	if len(m.TaskResultsCompletionStatus) > 0 {
 		keysForTaskResultsCompletionStatus := make([]string, 0, len(m.TaskResultsCompletionStatus))
 		for k := range m.TaskResultsCompletionStatus {
@@ -13871,6 +15603,23 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchSize != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BatchSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.FanOut != nil {
+		{
+			size, err := m.FanOut.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -14465,6 +16214,20 @@ func (m *Amount) Size() (n int) {
 	return n
 }
 
+func (m *ApprovalDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ArchiveStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14567,6 +16330,8 @@ func (m *Artifact) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14672,6 +16437,9 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ContentAddressed != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -14745,6 +16513,9 @@ func (m *ArtifactRepository) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ContentAddressed != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -14995,6 +16766,23 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChildWorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WorkflowTemplateRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15179,6 +16967,82 @@ func (m *CronWorkflow) Size() (n int) {
 	return n
 }
 
+func (m *CronWorkflowBackfill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Start.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Parallelism != nil {
+		n += 1 + sovGenerated(uint64(*m.Parallelism))
+	}
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RerunFailed))
+	return n
+}
+
+func (m *CronWorkflowBackfillInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Workflow)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *CronWorkflowBackfillStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RerunFailed))
+	if len(m.Intervals) > 0 {
+		for _, e := range m.Intervals {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronWorkflowDependencies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CronWorkflows) > 0 {
+		for _, s := range m.CronWorkflows {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.DeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.DeadlineSeconds))
+	}
+	return n
+}
+
 func (m *CronWorkflowList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15234,6 +17098,21 @@ func (m *CronWorkflowSpec) Size() (n int) {
 	}
 	l = len(m.When)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Backfills) > 0 {
+		for _, e := range m.Backfills {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CatchUpPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CatchUpLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.CatchUpLimit))
+	}
+	if m.Dependencies != nil {
+		l = m.Dependencies.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15263,6 +17142,29 @@ func (m *CronWorkflowStatus) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.Failed))
 	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Backfills) > 0 {
+		for _, e := range m.Backfills {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.WaitingScheduledTimes) > 0 {
+		for _, e := range m.WaitingScheduledTimes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DAGExpansion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15323,6 +17225,17 @@ func (m *DAGTask) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Expand != nil {
+		l = m.Expand.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FanOut != nil {
+		l = m.FanOut.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.BatchSize != nil {
+		n += 2 + sovGenerated(uint64(*m.BatchSize))
+	}
 	return n
 }
 
@@ -15387,6 +17300,31 @@ func (m *Event) Size() (n int) {
 	return n
 }
 
+func (m *EventDeduplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EventRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxSubmissions))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ExecutorConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15398,6 +17336,35 @@ func (m *ExecutorConfig) Size() (n int) {
 	return n
 }
 
+func (m *FanOutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinSucceeded != nil {
+		l = m.MinSucceeded.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxFailed != nil {
+		l = m.MaxFailed.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *FanOutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Total))
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	return n
+}
+
 func (m *GCSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15599,6 +17566,20 @@ func (m *HTTP) Size() (n int) {
 		l = m.BodyFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ResponseArtifact)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15681,6 +17662,32 @@ func (m *HTTPHeaderSource) Size() (n int) {
 	return n
 }
 
+func (m *HTTPPagination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Next)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxPages))
+	return n
+}
+
+func (m *HTTPRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15846,6 +17853,8 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15930,6 +17939,10 @@ func (m *Mutex) Size() (n int) {
 	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.Preemption != nil {
+		l = m.Preemption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15967,6 +17980,23 @@ func (m *MutexStatus) Size() (n int) {
 	return n
 }
 
+func (m *NodeApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gate.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *NodeFlag) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16082,6 +18112,23 @@ func (m *NodeStatus) Size() (n int) {
 	if m.TaskResultSynced != nil {
 		n += 3
 	}
+	n += 2 + sovGenerated(uint64(m.EstimatedDurationP90))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ChildWorkflow)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.FanOut) > 0 {
+		for k, v := range m.FanOut {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 3
 	return n
 }
 
@@ -16306,6 +18353,10 @@ func (m *Parameter) Size() (n int) {
 		l = len(*m.Description)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schema)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16368,6 +18419,39 @@ func (m *PodGC) Size() (n int) {
 	return n
 }
 
+func (m *Preemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriorityGap != nil {
+		n += 1 + sovGenerated(uint64(*m.PriorityGap))
+	}
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PreemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lock)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Holder)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Preemptor)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Prometheus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16439,6 +18523,9 @@ func (m *ResourceTemplate) Size() (n int) {
 		l = m.ManifestFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -16580,6 +18667,17 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *SQLCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16626,6 +18724,10 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.Database.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Preemption != nil {
+		l = m.Preemption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16748,6 +18850,31 @@ func (m *SuppliedValueFrom) Size() (n int) {
 	return n
 }
 
+func (m *SuspendApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Required != nil {
+		n += 1 + sovGenerated(uint64(*m.Required))
+	}
+	n += 2
+	return n
+}
+
 func (m *SuspendTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16756,6 +18883,10 @@ func (m *SuspendTemplate) Size() (n int) {
 	_ = l
 	l = len(m.Duration)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16805,6 +18936,12 @@ func (m *SynchronizationStatus) Size() (n int) {
 		l = m.Mutex.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Preemptions) > 0 {
+		for _, e := range m.Preemptions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16998,6 +19135,10 @@ func (m *Template) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.ChildWorkflow != nil {
+		l = m.ChildWorkflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17198,6 +19339,14 @@ func (m *WorkflowEventBindingSpec) Size() (n int) {
 		l = m.Submit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Deduplicate != nil {
+		l = m.Deduplicate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17434,6 +19583,10 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.ArtifactGC.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17524,6 +19677,7 @@ func (m *WorkflowStatus) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	n += 2 + sovGenerated(uint64(m.EstimatedDurationP90))
 	return n
 }
 
@@ -17576,6 +19730,13 @@ func (m *WorkflowStep) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FanOut != nil {
+		l = m.FanOut.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BatchSize != nil {
+		n += 1 + sovGenerated(uint64(*m.BatchSize))
+	}
 	return n
 }
 
@@ -17744,6 +19905,18 @@ func (this *Amount) String() string {
 	}, "")
 	return s
 }
+func (this *ApprovalDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApprovalDecision{`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`Approved:` + fmt.Sprintf("%v", this.Approved) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArchiveStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -17827,6 +20000,7 @@ func (this *Artifact) String() string {
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17899,6 +20073,7 @@ func (this *ArtifactLocation) String() string {
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
 		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginArtifact", "PluginArtifact", 1) + `,`,
+		`ContentAddressed:` + valueToStringGenerated(this.ContentAddressed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17947,6 +20122,7 @@ func (this *ArtifactRepository) String() string {
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifactRepository", "GCSArtifactRepository", 1) + `,`,
 		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifactRepository", "AzureArtifactRepository", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginArtifactRepository", "PluginArtifactRepository", 1) + `,`,
+		`ContentAddressed:` + valueToStringGenerated(this.ContentAddressed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18057,8 +20233,8 @@ func (this *ArtifactoryAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ArtifactoryAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18092,7 +20268,7 @@ func (this *AzureBlobContainer) String() string {
 	s := strings.Join([]string{`&AzureBlobContainer{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
-		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`}`,
 	}, "")
@@ -18116,8 +20292,8 @@ func (this *BasicAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BasicAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18127,7 +20303,19 @@ func (this *Cache) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Cache{`,
-		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "LocalObjectReference", "v1.LocalObjectReference", 1) + `,`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "LocalObjectReference", "v11.LocalObjectReference", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLCache", "SQLCache", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflowTemplate{`,
+		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18137,8 +20325,8 @@ func (this *ClientCertAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClientCertAuth{`,
-		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18148,7 +20336,7 @@ func (this *ClusterWorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClusterWorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -18164,7 +20352,7 @@ func (this *ClusterWorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ClusterWorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -18199,7 +20387,7 @@ func (this *ContainerNode) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ContainerNode{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
 		`}`,
 	}, "")
@@ -18274,13 +20462,70 @@ func (this *CronWorkflow) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&CronWorkflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *CronWorkflowBackfill) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowBackfill{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`RerunFailed:` + fmt.Sprintf("%v", this.RerunFailed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowBackfillInterval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowBackfillInterval{`,
+		`ScheduledTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Workflow:` + fmt.Sprintf("%v", this.Workflow) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowBackfillStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIntervals := "[]CronWorkflowBackfillInterval{"
+	for _, f := range this.Intervals {
+		repeatedStringForIntervals += strings.Replace(strings.Replace(f.String(), "CronWorkflowBackfillInterval", "CronWorkflowBackfillInterval", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIntervals += "}"
+	s := strings.Join([]string{`&CronWorkflowBackfillStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`RerunFailed:` + fmt.Sprintf("%v", this.RerunFailed) + `,`,
+		`Intervals:` + repeatedStringForIntervals + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowDependencies) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowDependencies{`,
+		`CronWorkflows:` + fmt.Sprintf("%v", this.CronWorkflows) + `,`,
+		`DeadlineSeconds:` + valueToStringGenerated(this.DeadlineSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowList) String() string {
 	if this == nil {
 		return "nil"
@@ -18291,7 +20536,7 @@ func (this *CronWorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&CronWorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -18301,6 +20546,11 @@ func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForBackfills := "[]CronWorkflowBackfill{"
+	for _, f := range this.Backfills {
+		repeatedStringForBackfills += strings.Replace(strings.Replace(f.String(), "CronWorkflowBackfill", "CronWorkflowBackfill", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBackfills += "}"
 	s := strings.Join([]string{`&CronWorkflowSpec{`,
 		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
@@ -18309,10 +20559,14 @@ func (this *CronWorkflowSpec) String() string {
 		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v1.ObjectMeta", 1) + `,`,
 		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
 		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
 		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Backfills:` + repeatedStringForBackfills + `,`,
+		`CatchUpPolicy:` + fmt.Sprintf("%v", this.CatchUpPolicy) + `,`,
+		`CatchUpLimit:` + valueToStringGenerated(this.CatchUpLimit) + `,`,
+		`Dependencies:` + strings.Replace(this.Dependencies.String(), "CronWorkflowDependencies", "CronWorkflowDependencies", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18331,13 +20585,35 @@ func (this *CronWorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForBackfills := "[]CronWorkflowBackfillStatus{"
+	for _, f := range this.Backfills {
+		repeatedStringForBackfills += strings.Replace(strings.Replace(f.String(), "CronWorkflowBackfillStatus", "CronWorkflowBackfillStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBackfills += "}"
+	repeatedStringForWaitingScheduledTimes := "[]Time{"
+	for _, f := range this.WaitingScheduledTimes {
+		repeatedStringForWaitingScheduledTimes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForWaitingScheduledTimes += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
-		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v1.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Backfills:` + repeatedStringForBackfills + `,`,
+		`WaitingScheduledTimes:` + repeatedStringForWaitingScheduledTimes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGExpansion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DAGExpansion{`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18376,6 +20652,9 @@ func (this *DAGTask) String() string {
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`Expand:` + strings.Replace(this.Expand.String(), "DAGExpansion", "DAGExpansion", 1) + `,`,
+		`FanOut:` + strings.Replace(this.FanOut.String(), "FanOutPolicy", "FanOutPolicy", 1) + `,`,
+		`BatchSize:` + valueToStringGenerated(this.BatchSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18433,6 +20712,28 @@ func (this *Event) String() string {
 	}, "")
 	return s
 }
+func (this *EventDeduplication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventDeduplication{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventRateLimit{`,
+		`MaxSubmissions:` + fmt.Sprintf("%v", this.MaxSubmissions) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExecutorConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -18443,6 +20744,29 @@ func (this *ExecutorConfig) String() string {
 	}, "")
 	return s
 }
+func (this *FanOutPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FanOutPolicy{`,
+		`MinSucceeded:` + strings.Replace(fmt.Sprintf("%v", this.MinSucceeded), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`MaxFailed:` + strings.Replace(fmt.Sprintf("%v", this.MaxFailed), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FanOutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FanOutStatus{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GCSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -18471,7 +20795,7 @@ func (this *GCSBucket) String() string {
 	}
 	s := strings.Join([]string{`&GCSBucket{`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18497,9 +20821,9 @@ func (this *GitArtifact) String() string {
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
 		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
 		`DisableSubmodules:` + fmt.Sprintf("%v", this.DisableSubmodules) + `,`,
 		`SingleBranch:` + fmt.Sprintf("%v", this.SingleBranch) + `,`,
@@ -18551,11 +20875,11 @@ func (this *HDFSKrbConfig) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HDFSKrbConfig{`,
-		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
 		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
-		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
 		`}`,
 	}, "")
@@ -18579,6 +20903,10 @@ func (this *HTTP) String() string {
 		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
 		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
 		`BodyFrom:` + strings.Replace(this.BodyFrom.String(), "HTTPBodySource", "HTTPBodySource", 1) + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "HTTPAuth", "HTTPAuth", 1) + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "HTTPRetry", "HTTPRetry", 1) + `,`,
+		`Pagination:` + strings.Replace(this.Pagination.String(), "HTTPPagination", "HTTPPagination", 1) + `,`,
+		`ResponseArtifact:` + fmt.Sprintf("%v", this.ResponseArtifact) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18639,7 +20967,29 @@ func (this *HTTPHeaderSource) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HTTPHeaderSource{`,
-		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPPagination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPPagination{`,
+		`Next:` + fmt.Sprintf("%v", this.Next) + `,`,
+		`MaxPages:` + fmt.Sprintf("%v", this.MaxPages) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPRetry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPRetry{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18766,6 +21116,7 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18847,6 +21198,7 @@ func (this *Mutex) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Database:` + fmt.Sprintf("%v", this.Database) + `,`,
+		`Preemption:` + strings.Replace(this.Preemption.String(), "Preemption", "Preemption", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18883,6 +21235,22 @@ func (this *MutexStatus) String() string {
 	}, "")
 	return s
 }
+func (this *NodeApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDecisions := "[]ApprovalDecision{"
+	for _, f := range this.Decisions {
+		repeatedStringForDecisions += strings.Replace(strings.Replace(f.String(), "ApprovalDecision", "ApprovalDecision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDecisions += "}"
+	s := strings.Join([]string{`&NodeApproval{`,
+		`Gate:` + strings.Replace(strings.Replace(this.Gate.String(), "SuspendApproval", "SuspendApproval", 1), `&`, ``, 1) + `,`,
+		`Decisions:` + repeatedStringForDecisions + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NodeFlag) String() string {
 	if this == nil {
 		return "nil"
@@ -18921,6 +21289,16 @@ func (this *NodeStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForFanOut := make([]string, 0, len(this.FanOut))
+	for k := range this.FanOut {
+		keysForFanOut = append(keysForFanOut, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFanOut)
+	mapStringForFanOut := "map[string]FanOutStatus{"
+	for _, k := range keysForFanOut {
+		mapStringForFanOut += fmt.Sprintf("%v: %v,", k, this.FanOut[k])
+	}
+	mapStringForFanOut += "}"
 	s := strings.Join([]string{`&NodeStatus{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -18931,8 +21309,8 @@ func (this *NodeStatus) String() string {
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
 		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
 		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
@@ -18948,6 +21326,11 @@ func (this *NodeStatus) String() string {
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`NodeFlag:` + strings.Replace(this.NodeFlag.String(), "NodeFlag", "NodeFlag", 1) + `,`,
 		`TaskResultSynced:` + valueToStringGenerated(this.TaskResultSynced) + `,`,
+		`EstimatedDurationP90:` + fmt.Sprintf("%v", this.EstimatedDurationP90) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "NodeApproval", "NodeApproval", 1) + `,`,
+		`ChildWorkflow:` + fmt.Sprintf("%v", this.ChildWorkflow) + `,`,
+		`FanOut:` + mapStringForFanOut + `,`,
+		`ProgressEstimated:` + fmt.Sprintf("%v", this.ProgressEstimated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18981,9 +21364,9 @@ func (this *OAuth2Auth) String() string {
 	}
 	repeatedStringForEndpointParams += "}"
 	s := strings.Join([]string{`&OAuth2Auth{`,
-		`ClientIDSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientIDSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ClientSecretSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientSecretSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`TokenURLSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenURLSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientIDSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientIDSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientSecretSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientSecretSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`TokenURLSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenURLSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
 		`EndpointParams:` + repeatedStringForEndpointParams + `,`,
 		`}`,
@@ -19030,8 +21413,8 @@ func (this *OSSBucket) String() string {
 	s := strings.Join([]string{`&OSSBucket{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`CreateBucketIfNotPresent:` + fmt.Sprintf("%v", this.CreateBucketIfNotPresent) + `,`,
 		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
 		`LifecycleRule:` + strings.Replace(this.LifecycleRule.String(), "OSSLifecycleRule", "OSSLifecycleRule", 1) + `,`,
@@ -19111,6 +21494,8 @@ func (this *Parameter) String() string {
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19156,12 +21541,37 @@ func (this *PodGC) String() string {
 	}
 	s := strings.Join([]string{`&PodGC{`,
 		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
-		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`DeleteDelayDuration:` + fmt.Sprintf("%v", this.DeleteDelayDuration) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *Preemption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Preemption{`,
+		`PriorityGap:` + valueToStringGenerated(this.PriorityGap) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PreemptionRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreemptionRecord{`,
+		`Lock:` + fmt.Sprintf("%v", this.Lock) + `,`,
+		`Holder:` + fmt.Sprintf("%v", this.Holder) + `,`,
+		`Preemptor:` + fmt.Sprintf("%v", this.Preemptor) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Prometheus) String() string {
 	if this == nil {
 		return "nil"
@@ -19206,6 +21616,8 @@ func (this *ResourceTemplate) String() string {
 		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
 		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
 		`ManifestFrom:` + strings.Replace(this.ManifestFrom.String(), "ManifestFrom", "ManifestFrom", 1) + `,`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19275,14 +21687,14 @@ func (this *S3Bucket) String() string {
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
 		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
 		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`CreateBucketIfNotPresent:` + strings.Replace(this.CreateBucketIfNotPresent.String(), "CreateS3BucketOptions", "CreateS3BucketOptions", 1) + `,`,
 		`EncryptionOptions:` + strings.Replace(this.EncryptionOptions.String(), "S3EncryptionOptions", "S3EncryptionOptions", 1) + `,`,
-		`CASecret:` + strings.Replace(fmt.Sprintf("%v", this.CASecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SessionTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SessionTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`CASecret:` + strings.Replace(fmt.Sprintf("%v", this.CASecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SessionTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SessionTokenSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19295,7 +21707,17 @@ func (this *S3EncryptionOptions) String() string {
 		`KmsKeyId:` + fmt.Sprintf("%v", this.KmsKeyId) + `,`,
 		`KmsEncryptionContext:` + fmt.Sprintf("%v", this.KmsEncryptionContext) + `,`,
 		`EnableEncryption:` + fmt.Sprintf("%v", this.EnableEncryption) + `,`,
-		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19305,7 +21727,7 @@ func (this *ScriptTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ScriptTemplate{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`}`,
 	}, "")
@@ -19327,9 +21749,10 @@ func (this *SemaphoreRef) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
+		`Preemption:` + strings.Replace(this.Preemption.String(), "Preemption", "Preemption", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19385,7 +21808,7 @@ func (this *Submit) String() string {
 	s := strings.Join([]string{`&Submit{`,
 		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
 		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19403,7 +21826,7 @@ func (this *SubmitOpts) String() string {
 		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`ServerDryRun:` + fmt.Sprintf("%v", this.ServerDryRun) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
-		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v11.OwnerReference", 1) + `,`,
+		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v1.OwnerReference", 1) + `,`,
 		`Annotations:` + fmt.Sprintf("%v", this.Annotations) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
@@ -19420,12 +21843,26 @@ func (this *SuppliedValueFrom) String() string {
 	}, "")
 	return s
 }
+func (this *SuspendApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuspendApproval{`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`Required:` + valueToStringGenerated(this.Required) + `,`,
+		`AllowReject:` + fmt.Sprintf("%v", this.AllowReject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SuspendTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SuspendTemplate{`,
 		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "SuspendApproval", "SuspendApproval", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19465,9 +21902,15 @@ func (this *SynchronizationStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPreemptions := "[]PreemptionRecord{"
+	for _, f := range this.Preemptions {
+		repeatedStringForPreemptions += strings.Replace(strings.Replace(f.String(), "PreemptionRecord", "PreemptionRecord", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreemptions += "}"
 	s := strings.Join([]string{`&SynchronizationStatus{`,
 		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreStatus", "SemaphoreStatus", 1) + `,`,
 		`Mutex:` + strings.Replace(this.Mutex.String(), "MutexStatus", "MutexStatus", 1) + `,`,
+		`Preemptions:` + repeatedStringForPreemptions + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19553,11 +21996,11 @@ func (this *Template) String() string {
 		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
 		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
 		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
 		`Steps:` + repeatedStringForSteps + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1) + `,`,
 		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
 		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
 		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
@@ -19574,7 +22017,7 @@ func (this *Template) String() string {
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -19588,6 +22031,7 @@ func (this *Template) String() string {
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`ChildWorkflow:` + strings.Replace(this.ChildWorkflow.String(), "ChildWorkflowTemplate", "ChildWorkflowTemplate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19619,7 +22063,7 @@ func (this *UserContainer) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&UserContainer{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
 		`}`,
 	}, "")
@@ -19638,7 +22082,7 @@ func (this *ValueFrom) String() string {
 		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19675,7 +22119,7 @@ func (this *Workflow) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Workflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -19687,7 +22131,7 @@ func (this *WorkflowArtifactGCTask) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowArtifactGCTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ArtifactGCSpec", "ArtifactGCSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ArtifactGCStatus", "ArtifactGCStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -19704,7 +22148,7 @@ func (this *WorkflowArtifactGCTaskList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowArtifactGCTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19715,7 +22159,7 @@ func (this *WorkflowEventBinding) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowEventBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventBindingSpec", "WorkflowEventBindingSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -19731,7 +22175,7 @@ func (this *WorkflowEventBindingList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowEventBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19744,6 +22188,8 @@ func (this *WorkflowEventBindingSpec) String() string {
 	s := strings.Join([]string{`&WorkflowEventBindingSpec{`,
 		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
 		`Submit:` + strings.Replace(this.Submit.String(), "Submit", "Submit", 1) + `,`,
+		`Deduplicate:` + strings.Replace(this.Deduplicate.String(), "EventDeduplication", "EventDeduplication", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "EventRateLimit", "EventRateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19770,7 +22216,7 @@ func (this *WorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19883,12 +22329,12 @@ func (this *WorkflowSpec) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
 		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
 		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v11.PodDNSConfig", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
@@ -19896,7 +22342,7 @@ func (this *WorkflowSpec) String() string {
 		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -19914,6 +22360,7 @@ func (this *WorkflowSpec) String() string {
 		`Hooks:` + mapStringForHooks + `,`,
 		`WorkflowMetadata:` + strings.Replace(this.WorkflowMetadata.String(), "WorkflowMetadata", "WorkflowMetadata", 1) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "WorkflowLevelArtifactGC", "WorkflowLevelArtifactGC", 1) + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19974,8 +22421,8 @@ func (this *WorkflowStatus) String() string {
 	mapStringForTaskResultsCompletionStatus += "}"
 	s := strings.Join([]string{`&WorkflowStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
 		`Nodes:` + mapStringForNodes + `,`,
@@ -19992,6 +22439,7 @@ func (this *WorkflowStatus) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
 		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
 		`TaskResultsCompletionStatus:` + mapStringForTaskResultsCompletionStatus + `,`,
+		`EstimatedDurationP90:` + fmt.Sprintf("%v", this.EstimatedDurationP90) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20028,6 +22476,8 @@ func (this *WorkflowStep) String() string {
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`FanOut:` + strings.Replace(this.FanOut.String(), "FanOutPolicy", "FanOutPolicy", 1) + `,`,
+		`BatchSize:` + valueToStringGenerated(this.BatchSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20037,7 +22487,7 @@ func (this *WorkflowTaskResult) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskResult{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NodeResult:` + strings.Replace(strings.Replace(this.NodeResult.String(), "NodeResult", "NodeResult", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -20053,7 +22503,7 @@ func (this *WorkflowTaskResultList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskResultList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -20064,7 +22514,7 @@ func (this *WorkflowTaskSet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTaskSetSpec", "WorkflowTaskSetSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowTaskSetStatus", "WorkflowTaskSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -20081,7 +22531,7 @@ func (this *WorkflowTaskSetList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -20132,7 +22582,7 @@ func (this *WorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -20148,7 +22598,7 @@ func (this *WorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -20264,6 +22714,141 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApprovalDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21246,6 +23831,38 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Deleted = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22185,6 +24802,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentAddressed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ContentAddressed = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22806,6 +25444,27 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentAddressed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ContentAddressed = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24259,7 +26918,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24295,7 +26954,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24675,7 +27334,7 @@ func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccountKeySecret == nil {
-				m.AccountKeySecret = &v1.SecretKeySelector{}
+				m.AccountKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24963,7 +27622,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24999,7 +27658,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -25085,12 +27744,48 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.LocalObjectReference{}
+				m.ConfigMap = &v11.LocalObjectReference{}
 			}
 			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQL == nil {
+				m.SQL = &SQLCache{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25112,7 +27807,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25135,15 +27830,15 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25170,16 +27865,13 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientCertSecret == nil {
-				m.ClientCertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25206,10 +27898,7 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientKeySecret == nil {
-				m.ClientKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25234,7 +27923,7 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25257,15 +27946,15 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientCertAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientCertAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25292,13 +27981,16 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientCertSecret == nil {
+				m.ClientCertSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25325,7 +28017,10 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientKeySecret == nil {
+				m.ClientKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25350,7 +28045,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25373,15 +28068,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25408,13 +28103,13 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25441,8 +28136,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25467,7 +28161,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Column) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25490,17 +28184,17 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Column: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25510,29 +28204,30 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25542,55 +28237,25 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25613,7 +28278,7 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Column) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25636,15 +28301,15 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Column: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Column: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25672,11 +28337,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25704,11 +28369,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25736,7 +28401,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25759,7 +28424,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25782,17 +28447,17 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25802,28 +28467,27 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25851,7 +28515,39 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25874,7 +28570,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25897,17 +28593,17 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25917,29 +28613,30 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25949,27 +28646,23 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retries == nil {
-				m.Retries = &intstr.IntOrString{}
-			}
-			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25992,7 +28685,7 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26015,51 +28708,17 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26069,29 +28728,27 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26118,10 +28775,164 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &ContainerSetRetryStrategy{}
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeMounts = append(m.VolumeMounts, v11.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &ContainerSetRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26537,7 +29348,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowBackfill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26560,15 +29371,47 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowBackfill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowBackfill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26595,13 +29438,13 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26628,11 +29471,81 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Parallelism = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RerunFailed", wireType)
+			}
+			m.RerunFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RerunFailed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26654,7 +29567,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowBackfillInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26677,15 +29590,580 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowBackfillInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowBackfillInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = WorkflowPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowBackfillStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowBackfillStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowBackfillStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = WorkflowPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RerunFailed", wireType)
+			}
+			m.RerunFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RerunFailed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intervals = append(m.Intervals, CronWorkflowBackfillInterval{})
+			if err := m.Intervals[len(m.Intervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowDependencies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowDependencies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowDependencies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronWorkflows", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronWorkflows = append(m.CronWorkflows, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadlineSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26890,7 +30368,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
+				m.WorkflowMetadata = &v1.ObjectMeta{}
 			}
 			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -26996,6 +30474,128 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backfills = append(m.Backfills, CronWorkflowBackfill{})
+			if err := m.Backfills[len(m.Backfills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CatchUpPolicy = CatchUpPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CatchUpLimit = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dependencies == nil {
+				m.Dependencies = &CronWorkflowDependencies{}
+			}
+			if err := m.Dependencies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27075,7 +30675,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
+			m.Active = append(m.Active, v11.ObjectReference{})
 			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -27110,7 +30710,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+				m.LastScheduledTime = &v1.Time{}
 			}
 			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27220,6 +30820,156 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Phase = CronWorkflowPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backfills = append(m.Backfills, CronWorkflowBackfillStatus{})
+			if err := m.Backfills[len(m.Backfills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingScheduledTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaitingScheduledTimes = append(m.WaitingScheduledTimes, v1.Time{})
+			if err := m.WaitingScheduledTimes[len(m.WaitingScheduledTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGExpansion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGExpansion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGExpansion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27834,6 +31584,98 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expand == nil {
+				m.Expand = &DAGExpansion{}
+			}
+			if err := m.Expand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FanOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FanOut == nil {
+				m.FanOut = &FanOutPolicy{}
+			}
+			if err := m.FanOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28277,6 +32119,221 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDeduplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeduplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeduplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubmissions", wireType)
+			}
+			m.MaxSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubmissions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28300,17 +32357,221 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FanOutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FanOutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FanOutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSucceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSucceeded == nil {
+				m.MinSucceeded = &intstr.IntOrString{}
+			}
+			if err := m.MinSucceeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxFailed == nil {
+				m.MaxFailed = &intstr.IntOrString{}
+			}
+			if err := m.MaxFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FanOutStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FanOutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FanOutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28320,24 +32581,49 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28680,7 +32966,7 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+				m.ServiceAccountKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29017,7 +33303,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29053,7 +33339,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29089,7 +33375,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+				m.SSHPrivateKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29736,7 +34022,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+				m.KrbCCacheSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29772,7 +34058,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+				m.KrbKeytabSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29872,7 +34158,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+				m.KrbConfigConfigMap = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30108,11 +34394,289 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BodyFrom == nil {
+				m.BodyFrom = &HTTPBodySource{}
+			}
+			if err := m.BodyFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &HTTPAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &HTTPRetry{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &HTTPPagination{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseArtifact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseArtifact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30140,13 +34704,13 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30156,15 +34720,29 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureSkipVerify = bool(v != 0)
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BodyFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30191,10 +34769,10 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BodyFrom == nil {
-				m.BodyFrom = &HTTPBodySource{}
+			if m.Auth == nil {
+				m.Auth = &HTTPAuth{}
 			}
-			if err := m.BodyFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30219,7 +34797,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30242,17 +34820,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30262,27 +34840,28 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.ClientCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30309,14 +34888,13 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30343,10 +34921,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &HTTPAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30371,7 +34946,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
+func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30394,17 +34969,17 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPBodySource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPBodySource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30414,30 +34989,113 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClientCert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30447,28 +35105,27 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OAuth2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30495,7 +35152,10 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30520,7 +35180,7 @@ func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30543,17 +35203,17 @@ func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPBodySource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPBodySource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30563,24 +35223,26 @@ func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v11.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -30604,7 +35266,7 @@ func (m *HTTPBodySource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+func (m *HTTPPagination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30627,15 +35289,15 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPPagination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPPagination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30663,45 +35325,13 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Next = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPages", wireType)
 			}
-			var msglen int
+			m.MaxPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30711,28 +35341,11 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPages |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30754,7 +35367,7 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+func (m *HTTPRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30777,15 +35390,34 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30812,10 +35444,10 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
 			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32078,6 +36710,38 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = MemoizationCacheType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32864,6 +37528,42 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Database = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preemption == nil {
+				m.Preemption = &Preemption{}
+			}
+			if err := m.Preemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32908,17 +37608,131 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32928,29 +37742,31 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32960,23 +37776,25 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32999,7 +37817,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+func (m *NodeApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33022,15 +37840,15 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33057,14 +37875,13 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33091,8 +37908,8 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Decisions = append(m.Decisions, ApprovalDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34322,6 +39139,242 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.TaskResultSynced = &b
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationP90", wireType)
+			}
+			m.EstimatedDurationP90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationP90 |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &NodeApproval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildWorkflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FanOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FanOut == nil {
+				m.FanOut = make(map[string]FanOutStatus)
+			}
+			var mapkey string
+			mapvalue := &FanOutStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FanOutStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FanOut[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressEstimated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressEstimated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34534,7 +39587,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientIDSecret == nil {
-				m.ClientIDSecret = &v1.SecretKeySelector{}
+				m.ClientIDSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientIDSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34570,7 +39623,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientSecretSecret == nil {
-				m.ClientSecretSecret = &v1.SecretKeySelector{}
+				m.ClientSecretSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientSecretSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34606,7 +39659,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TokenURLSecret == nil {
-				m.TokenURLSecret = &v1.SecretKeySelector{}
+				m.TokenURLSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.TokenURLSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35166,7 +40219,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+				m.AccessKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35202,7 +40255,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+				m.SecretKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35836,11 +40889,439 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ParameterType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = ArtifactPluginName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35868,14 +41349,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Configuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionTimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.ConnectionTimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35885,30 +41365,16 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConnectionTimeoutSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35918,31 +41384,77 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35970,11 +41482,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.Name = ArtifactPluginName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36002,11 +41514,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36034,8 +41546,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Configuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36058,7 +41569,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36081,15 +41592,47 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36116,10 +41659,45 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36141,7 +41719,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
+func (m *Preemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36164,81 +41742,17 @@ func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Preemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Preemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = ArtifactPluginName(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Configuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionTimeoutSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityGap", wireType)
 			}
-			m.ConnectionTimeoutSeconds = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36248,14 +41762,15 @@ func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionTimeoutSeconds |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.PriorityGap = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36283,7 +41798,7 @@ func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Action = PreemptionAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36306,7 +41821,7 @@ func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *PreemptionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36329,15 +41844,15 @@ func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreemptionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36365,11 +41880,11 @@ func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = ArtifactPluginName(dAtA[iNdEx:postIndex])
+			m.Lock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36397,11 +41912,11 @@ func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preemptor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36429,61 +41944,11 @@ func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configuration = string(dAtA[iNdEx:postIndex])
+			m.Preemptor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodGC) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36511,11 +41976,11 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Action = PreemptionAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36542,45 +42007,10 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37249,6 +42679,58 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38066,7 +43548,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+				m.AccessKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38102,7 +43584,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+				m.SecretKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38262,7 +43744,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CASecret == nil {
-				m.CASecret = &v1.SecretKeySelector{}
+				m.CASecret = &v11.SecretKeySelector{}
 			}
 			if err := m.CASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38298,7 +43780,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SessionTokenSecret == nil {
-				m.SessionTokenSecret = &v1.SecretKeySelector{}
+				m.SessionTokenSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SessionTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38468,7 +43950,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+				m.ServerSideCustomerKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38495,6 +43977,88 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SQLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38783,7 +44347,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38857,6 +44421,42 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preemption == nil {
+				m.Preemption = &Preemption{}
+			}
+			if err := m.Preemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39711,7 +45311,7 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.OwnerReference == nil {
-				m.OwnerReference = &v11.OwnerReference{}
+				m.OwnerReference = &v1.OwnerReference{}
 			}
 			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -39872,6 +45472,160 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SuspendApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowReject", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowReject = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39933,6 +45687,42 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 			}
 			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &SuspendApproval{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40094,98 +45884,134 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphores = append(m.Semaphores, &SemaphoreRef{})
-			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Semaphores = append(m.Semaphores, &SemaphoreRef{})
+			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutexes = append(m.Mutexes, &Mutex{})
+			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Semaphore == nil {
+				m.Semaphore = &SemaphoreStatus{}
 			}
-			m.Mutexes = append(m.Mutexes, &Mutex{})
-			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SynchronizationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40212,16 +46038,16 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Semaphore == nil {
-				m.Semaphore = &SemaphoreStatus{}
+			if m.Mutex == nil {
+				m.Mutex = &MutexStatus{}
 			}
-			if err := m.Semaphore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preemptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40248,10 +46074,8 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutex == nil {
-				m.Mutex = &MutexStatus{}
-			}
-			if err := m.Mutex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Preemptions = append(m.Preemptions, PreemptionRecord{})
+			if err := m.Preemptions[len(m.Preemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40740,7 +46564,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40864,7 +46688,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Container == nil {
-				m.Container = &v1.Container{}
+				m.Container = &v11.Container{}
 			}
 			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41043,7 +46867,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -41273,7 +47097,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -41403,7 +47227,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -41438,7 +47262,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41831,140 +47655,176 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &Plugin{}
+			if m.Plugin == nil {
+				m.Plugin = &Plugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildWorkflow == nil {
+				m.ChildWorkflow = &ChildWorkflowTemplate{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChildWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42626,7 +48486,7 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -43213,15 +49073,164 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43248,46 +49257,13 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43314,7 +49290,8 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, WorkflowArtifactGCTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43339,7 +49316,7 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43362,15 +49339,15 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43397,13 +49374,13 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43430,8 +49407,7 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, WorkflowArtifactGCTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43456,7 +49432,7 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43479,15 +49455,15 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventBindingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43514,13 +49490,13 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43547,7 +49523,8 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, WorkflowEventBinding{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43572,7 +49549,7 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43595,15 +49572,15 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBindingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventBindingSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventBindingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43630,13 +49607,13 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43663,64 +49640,16 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, WorkflowEventBinding{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Submit == nil {
+				m.Submit = &Submit{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Submit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBindingSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBindingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deduplicate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43747,13 +49676,16 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Deduplicate == nil {
+				m.Deduplicate = &EventDeduplication{}
+			}
+			if err := m.Deduplicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43780,10 +49712,10 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Submit == nil {
-				m.Submit = &Submit{}
+			if m.RateLimit == nil {
+				m.RateLimit = &EventRateLimit{}
 			}
-			if err := m.Submit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44682,7 +50614,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44716,7 +50648,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v1.PersistentVolumeClaim{})
+			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v11.PersistentVolumeClaim{})
 			if err := m.VolumeClaimTemplates[len(m.VolumeClaimTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44955,7 +50887,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44990,7 +50922,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -45024,7 +50956,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
 			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -45113,7 +51045,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DNSConfig == nil {
-				m.DNSConfig = &v1.PodDNSConfig{}
+				m.DNSConfig = &v11.PodDNSConfig{}
 			}
 			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -45320,7 +51252,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -45355,7 +51287,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -46028,6 +51960,42 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46398,7 +52366,7 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v1.Volume{})
+			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v11.Volume{})
 			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -47057,6 +53025,25 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskResultsCompletionStatus[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationP90", wireType)
+			}
+			m.EstimatedDurationP90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDurationP90 |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47607,6 +53594,62 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FanOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FanOut == nil {
+				m.FanOut = &FanOutPolicy{}
+			}
+			if err := m.FanOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52,6 +52,40 @@ type HTTP struct {
 	BodyFrom *HTTPBodySource `json:"bodyFrom,omitempty" protobuf:"bytes,8,opt,name=bodyFrom"`
 	// InsecureSkipVerify is a bool when if set to true will skip TLS verification for the HTTP client
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"bytes,7,opt,name=insecureSkipVerify"`
+	// Auth contains information for client authentication, with a client certificate, OAuth2 client credentials or
+	// basic auth
+	Auth *HTTPAuth `json:"auth,omitempty" protobuf:"bytes,9,opt,name=auth"`
+	// Retry retries each request that fails to connect, or receives a 5xx response code
+	Retry *HTTPRetry `json:"retry,omitempty" protobuf:"bytes,10,opt,name=retry"`
+	// Pagination follows the pages of the response, whose bodies are joined by newlines
+	Pagination *HTTPPagination `json:"pagination,omitempty" protobuf:"bytes,11,opt,name=pagination"`
+	// ResponseArtifact is the name of the output artifact of the template the response body is saved to, instead of
+	// the result
+	ResponseArtifact string `json:"responseArtifact,omitempty" protobuf:"bytes,12,opt,name=responseArtifact"`
+}
+
+// HTTPRetry retries an HTTP request
+type HTTPRetry struct {
+	// Limit is the maximum number of times the request is retried
+	Limit int32 `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+	// Backoff between the retries, defaults to a duration of 1s and a factor of 2
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// HTTPPagination follows the pages of an HTTP response
+type HTTPPagination struct {
+	// Next is an expression for the URL of the next page, evaluated with the same variables as the successCondition.
+	// There are no more pages once it evaluates to an empty string. Defaults to the "next" URL of the Link header.
+	Next string `json:"next,omitempty" protobuf:"bytes,1,opt,name=next"`
+	// MaxPages is the maximum number of pages requested, defaults to 100
+	MaxPages int32 `json:"maxPages,omitempty" protobuf:"varint,2,opt,name=maxPages"`
+}
+
+func (p *HTTPPagination) GetMaxPages() int {
+	if p != nil && p.MaxPages > 0 {
+		return int(p.MaxPages)
+	}
+	return 100
 }
 
 func (h *HTTP) GetBodyBytes() []byte {
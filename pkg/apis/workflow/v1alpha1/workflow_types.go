@@ -35,17 +35,18 @@ type TemplateType string
 
 // Possible template types
 const (
-	TemplateTypeContainer    TemplateType = "Container"
-	TemplateTypeContainerSet TemplateType = "ContainerSet"
-	TemplateTypeSteps        TemplateType = "Steps"
-	TemplateTypeScript       TemplateType = "Script"
-	TemplateTypeResource     TemplateType = "Resource"
-	TemplateTypeDAG          TemplateType = "DAG"
-	TemplateTypeSuspend      TemplateType = "Suspend"
-	TemplateTypeData         TemplateType = "Data"
-	TemplateTypeHTTP         TemplateType = "HTTP"
-	TemplateTypePlugin       TemplateType = "Plugin"
-	TemplateTypeUnknown      TemplateType = "Unknown"
+	TemplateTypeContainer     TemplateType = "Container"
+	TemplateTypeContainerSet  TemplateType = "ContainerSet"
+	TemplateTypeSteps         TemplateType = "Steps"
+	TemplateTypeScript        TemplateType = "Script"
+	TemplateTypeResource      TemplateType = "Resource"
+	TemplateTypeDAG           TemplateType = "DAG"
+	TemplateTypeSuspend       TemplateType = "Suspend"
+	TemplateTypeData          TemplateType = "Data"
+	TemplateTypeHTTP          TemplateType = "HTTP"
+	TemplateTypePlugin        TemplateType = "Plugin"
+	TemplateTypeChildWorkflow TemplateType = "ChildWorkflow"
+	TemplateTypeUnknown       TemplateType = "Unknown"
 )
 
 // NodePhase is a label for the condition of a node at the current time.
@@ -74,17 +75,18 @@ type NodeType string
 
 // Node types
 const (
-	NodeTypePod       NodeType = "Pod"
-	NodeTypeContainer NodeType = "Container"
-	NodeTypeSteps     NodeType = "Steps"
-	NodeTypeStepGroup NodeType = "StepGroup"
-	NodeTypeDAG       NodeType = "DAG"
-	NodeTypeTaskGroup NodeType = "TaskGroup"
-	NodeTypeRetry     NodeType = "Retry"
-	NodeTypeSkipped   NodeType = "Skipped"
-	NodeTypeSuspend   NodeType = "Suspend"
-	NodeTypeHTTP      NodeType = "HTTP"
-	NodeTypePlugin    NodeType = "Plugin"
+	NodeTypePod           NodeType = "Pod"
+	NodeTypeContainer     NodeType = "Container"
+	NodeTypeSteps         NodeType = "Steps"
+	NodeTypeStepGroup     NodeType = "StepGroup"
+	NodeTypeDAG           NodeType = "DAG"
+	NodeTypeTaskGroup     NodeType = "TaskGroup"
+	NodeTypeRetry         NodeType = "Retry"
+	NodeTypeSkipped       NodeType = "Skipped"
+	NodeTypeSuspend       NodeType = "Suspend"
+	NodeTypeHTTP          NodeType = "HTTP"
+	NodeTypePlugin        NodeType = "Plugin"
+	NodeTypeChildWorkflow NodeType = "ChildWorkflow"
 )
 
 // ArtifactGCStrategy is the strategy when to delete artifacts for GC.
@@ -434,6 +436,11 @@ type WorkflowSpec struct {
 	// ArtifactGC describes the strategy to use when deleting artifacts from completed or deleted workflows (applies to all output Artifacts
 	// unless Artifact.ArtifactGC is specified, which overrides this)
 	ArtifactGC *WorkflowLevelArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,43,opt,name=artifactGC"`
+
+	// Outputs are the outputs of the workflow, resolved from the outputs of the entrypoint's steps or tasks when the
+	// entrypoint succeeds, and recorded in status.outputs. Parameters use valueFrom.parameter or valueFrom.expression,
+	// and artifacts use from or fromExpression.
+	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,44,opt,name=outputs"`
 }
 
 type LabelValueFrom struct {
@@ -668,6 +675,9 @@ type Template struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Plugin *Plugin `json:"plugin,omitempty" protobuf:"bytes,43,opt,name=plugin"`
 
+	// ChildWorkflow runs a workflow from a WorkflowTemplate as a child of the workflow
+	ChildWorkflow *ChildWorkflowTemplate `json:"childWorkflow,omitempty" protobuf:"bytes,45,opt,name=childWorkflow"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -980,6 +990,42 @@ type Parameter struct {
 
 	// Description is the parameter description
 	Description *AnyString `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+
+	// Type is the type of the parameter's value, one of: string, int, float, bool, json.
+	// Values that are not of the type are rejected, and expressions see the value as the type. Defaults to string.
+	Type ParameterType `json:"type,omitempty" protobuf:"bytes,8,opt,name=type,casttype=ParameterType"`
+
+	// Schema is a JSON Schema, as JSON or YAML, that the parameter's value must conform to.
+	// If Type is not set, the value must be JSON.
+	Schema string `json:"schema,omitempty" protobuf:"bytes,9,opt,name=schema"`
+}
+
+// ParameterType is the type of a parameter's value
+type ParameterType string
+
+const (
+	ParameterTypeString ParameterType = "string"
+	ParameterTypeInt    ParameterType = "int"
+	ParameterTypeFloat  ParameterType = "float"
+	ParameterTypeBool   ParameterType = "bool"
+	ParameterTypeJSON   ParameterType = "json"
+)
+
+// IsTyped returns whether the parameter declares a type or schema its value must conform to
+func (p *Parameter) IsTyped() bool {
+	return p.Type != "" || p.Schema != ""
+}
+
+// GetType returns the type of the parameter's value
+func (p *Parameter) GetType() ParameterType {
+	switch {
+	case p.Type != "":
+		return p.Type
+	case p.Schema != "":
+		return ParameterTypeJSON
+	default:
+		return ParameterTypeString
+	}
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -1073,6 +1119,10 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Digest is the digest of the content of the artifact, e.g. "sha256:<hex>". It is recorded when a file
+	// artifact is saved, and the content is verified against it when the artifact is loaded.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,14,opt,name=digest"`
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1084,6 +1134,16 @@ func (a *Artifact) GetArtifactGC() *ArtifactGC {
 	return a.ArtifactGC
 }
 
+// IsContentAddressed returns whether the artifact is stored at the key of its digest in a content-addressed
+// repository, and so may be shared with other workflows
+func (a *Artifact) IsContentAddressed() bool {
+	if a.Digest == "" {
+		return false
+	}
+	key, err := a.GetKey()
+	return err == nil && strings.HasPrefix(key, ContentAddressedKey(a.Digest)+"/")
+}
+
 // CleanPath validates and cleans the artifact path.
 func (a *Artifact) CleanPath() error {
 	if a.Path == "" {
@@ -1272,6 +1332,10 @@ type ArtifactLocation struct {
 
 	// Plugin contains plugin artifact location details
 	Plugin *PluginArtifact `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
+
+	// ContentAddressed indicates if file artifacts should be stored at a key derived from their digest, so byte-identical
+	// artifacts are only uploaded and stored once. It is only used in archive locations.
+	ContentAddressed *bool `json:"contentAddressed,omitempty" protobuf:"varint,12,opt,name=contentAddressed"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1384,6 +1448,10 @@ func (a *ArtifactLocation) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+func (a *ArtifactLocation) IsContentAddressed() bool {
+	return a != nil && a.ContentAddressed != nil && *a.ContentAddressed
+}
+
 func (a *ArtifactLocation) GetKey() (string, error) {
 	v, err := a.Get()
 	if err != nil {
@@ -1650,6 +1718,13 @@ type WorkflowStep struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+
+	// FanOut tolerates the failure of some of the steps expanded by withItems, withParam or withSequence
+	FanOut *FanOutPolicy `json:"fanOut,omitempty" protobuf:"bytes,14,opt,name=fanOut"`
+
+	// BatchSize groups the items of withItems, withParam or withSequence into batches of at most this many items,
+	// and expands the step once per batch, with {{item}} being the JSON list of the items of the batch
+	BatchSize *int64 `json:"batchSize,omitempty" protobuf:"varint,15,opt,name=batchSize"`
 }
 
 func (s *WorkflowStep) GetName() string {
@@ -1791,6 +1866,8 @@ type SemaphoreRef struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// SyncDatabaseRef is a database reference for Semaphore configuration
 	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,3,opt,name=database"`
+	// Preemption allows a waiting workflow with a higher priority to reclaim the semaphore from its lowest priority holder
+	Preemption *Preemption `json:"preemption,omitempty" protobuf:"bytes,4,opt,name=preemption"`
 }
 
 // Mutex holds Mutex configuration
@@ -1801,6 +1878,43 @@ type Mutex struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// Database specifies this is database controlled if this is set true
 	Database bool `json:"database,omitempty" protobuf:"bytes,3,opt,name=database"`
+	// Preemption allows a waiting workflow with a higher priority to reclaim the mutex from its holder
+	Preemption *Preemption `json:"preemption,omitempty" protobuf:"bytes,4,opt,name=preemption"`
+}
+
+// PreemptionAction is the action taken on the workflow of a preempted lock holder
+type PreemptionAction string
+
+const (
+	// PreemptionActionStop stops the workflow, running its exit handlers, which releases its locks
+	PreemptionActionStop PreemptionAction = "Stop"
+	// PreemptionActionSuspend suspends the workflow, template level locks are released as their nodes complete.
+	// It is not supported for workflow level locks, which a suspended workflow never releases
+	PreemptionActionSuspend PreemptionAction = "Suspend"
+)
+
+// Preemption allows a waiting workflow to reclaim a lock from the lowest priority holder, if the priority of the
+// waiting workflow is at least PriorityGap higher. Only one holder of a lock is preempted at a time.
+// Preemption is not supported for database locks.
+type Preemption struct {
+	// PriorityGap is how much higher the priority of the waiting workflow must be than that of the holder, default 1
+	PriorityGap *int32 `json:"priorityGap,omitempty" protobuf:"varint,1,opt,name=priorityGap"`
+	// Action is taken on the workflow of the preempted holder, either "Stop" (default) or "Suspend"
+	Action PreemptionAction `json:"action,omitempty" protobuf:"bytes,2,opt,name=action,casttype=PreemptionAction"`
+}
+
+func (p *Preemption) GetPriorityGap() int32 {
+	if p == nil || p.PriorityGap == nil || *p.PriorityGap < 1 {
+		return 1
+	}
+	return *p.PriorityGap
+}
+
+func (p *Preemption) GetAction() PreemptionAction {
+	if p == nil || p.Action == "" {
+		return PreemptionActionStop
+	}
+	return p.Action
 }
 
 // WorkflowTemplateRef is a reference to a WorkflowTemplate resource.
@@ -2042,9 +2156,13 @@ type WorkflowStatus struct {
 	// Time at which this workflow completed
 	FinishedAt metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,3,opt,name=finishedAt"`
 
-	// EstimatedDuration in seconds.
+	// EstimatedDuration in seconds. When estimated from several previous runs, this is the median (p50).
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,16,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationP90 is the 90th percentile of the durations of previous runs in seconds.
+	// Only set when the duration is estimated from several previous runs.
+	EstimatedDurationP90 EstimatedDuration `json:"estimatedDurationP90,omitempty" protobuf:"varint,21,opt,name=estimatedDurationP90,casttype=EstimatedDuration"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,17,opt,name=progress,casttype=Progress"`
 
@@ -2376,6 +2494,8 @@ const (
 	ConditionTypeMetricsError ConditionType = "MetricsError"
 	// ConditionTypeArtifactGCError is an error on artifact garbage collection
 	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
+	// ConditionTypePreempted signifies the workflow was preempted from a lock by a waiting workflow with a higher priority
+	ConditionTypePreempted ConditionType = "Preempted"
 )
 
 type Condition struct {
@@ -2433,12 +2553,20 @@ type NodeStatus struct {
 	// Time at which this node completed
 	FinishedAt metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,11,opt,name=finishedAt"`
 
-	// EstimatedDuration in seconds.
+	// EstimatedDuration in seconds. When estimated from several previous runs, this is the median (p50).
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,24,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationP90 is the 90th percentile of the durations of previous runs in seconds.
+	// Only set when the duration is estimated from several previous runs.
+	EstimatedDurationP90 EstimatedDuration `json:"estimatedDurationP90,omitempty" protobuf:"varint,29,opt,name=estimatedDurationP90,casttype=EstimatedDuration"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,26,opt,name=progress,casttype=Progress"`
 
+	// ProgressEstimated states that the Progress is estimated from the seconds the node has run against its EstimatedDuration,
+	// rather than reported by the node
+	ProgressEstimated bool `json:"progressEstimated,omitempty" protobuf:"varint,33,opt,name=progressEstimated"`
+
 	// ResourcesDuration is indicative, but not accurate, resource duration. This is populated when the nodes completes.
 	ResourcesDuration ResourcesDuration `json:"resourcesDuration,omitempty" protobuf:"bytes,21,opt,name=resourcesDuration"`
 
@@ -2485,6 +2613,16 @@ type NodeStatus struct {
 
 	// TaskResultSynced is used to determine if the node's output has been received
 	TaskResultSynced *bool `json:"taskResultSynced,omitempty" protobuf:"bytes,28,opt,name=taskResultSynced"`
+
+	// Approval is the state of the approval gate of a suspend node, if it has one
+	Approval *NodeApproval `json:"approval,omitempty" protobuf:"bytes,30,opt,name=approval"`
+
+	// ChildWorkflow is the name of the workflow run by a child workflow node
+	ChildWorkflow string `json:"childWorkflow,omitempty" protobuf:"bytes,31,opt,name=childWorkflow"`
+
+	// FanOut holds the counts of the expanded tasks, or steps, of the fan-outs of a task group or step group node,
+	// keyed by the name of the task or step with the fan-out policy
+	FanOut map[string]FanOutStatus `json:"fanOut,omitempty" protobuf:"bytes,32,rep,name=fanOut"`
 }
 
 // Completed is used to determine if this node can proceed
@@ -3260,12 +3398,21 @@ type ResourceTemplate struct {
 	// of the k8s resource in which the step was considered failed
 	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,6,opt,name=failureCondition"`
 
-	// Flags is a set of additional options passed to kubectl before submitting a resource
+	// Flags is a set of additional options of the action, as given to kubectl
 	// I.e. to disable resource validation:
 	// flags: [
 	// 	"--validate=false"  # disable resource validation
 	// ]
+	// Only the flags that map onto the Kubernetes API are supported, e.g. --validate, --force, --selector and -o.
 	Flags []string `json:"flags,omitempty" protobuf:"varint,7,opt,name=flags"`
+
+	// FieldManager is the name of the manager of the fields set by the action.
+	// It defaults to "argo-workflows" for apply.
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,9,opt,name=fieldManager"`
+
+	// DryRun submits the action to the server without persisting it.
+	// The success and failure conditions are evaluated against the resource returned by the server.
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,10,opt,name=dryRun"`
 }
 
 type ManifestFrom struct {
@@ -3305,6 +3452,9 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Plugin != nil {
 		return TemplateTypePlugin
 	}
+	if tmpl.ChildWorkflow != nil {
+		return TemplateTypeChildWorkflow
+	}
 	return TemplateTypeUnknown
 }
 
@@ -3325,6 +3475,8 @@ func (tmpl *Template) GetNodeType() NodeType {
 		return NodeTypeHTTP
 	case TemplateTypePlugin:
 		return NodeTypePlugin
+	case TemplateTypeChildWorkflow:
+		return NodeTypeChildWorkflow
 	}
 	return ""
 }
@@ -3476,6 +3628,24 @@ type DAGTask struct {
 	// Hooks hold the lifecycle hook which is invoked at lifecycle of
 	// task, irrespective of the success, failure, or error status of the primary task
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+
+	// Expand splices the DAG tasks emitted by this task into the DAG once it succeeds
+	Expand *DAGExpansion `json:"expand,omitempty" protobuf:"bytes,15,opt,name=expand"`
+
+	// FanOut tolerates the failure of some of the tasks expanded by withItems, withParam or withSequence
+	FanOut *FanOutPolicy `json:"fanOut,omitempty" protobuf:"bytes,16,opt,name=fanOut"`
+
+	// BatchSize groups the items of withItems, withParam or withSequence into batches of at most this many items,
+	// and expands the task once per batch, with {{item}} being the JSON list of the items of the batch
+	BatchSize *int64 `json:"batchSize,omitempty" protobuf:"varint,17,opt,name=batchSize"`
+}
+
+// DAGExpansion is the source of the DAG tasks emitted by a task, which are spliced into its DAG once it succeeds.
+// Emitted tasks without dependencies depend on the emitting task, and the tasks depending on the emitting task wait
+// for the emitted tasks to complete.
+type DAGExpansion struct {
+	// Parameter is the name of the task's output parameter holding the JSON list of DAG tasks to splice into the DAG
+	Parameter string `json:"parameter" protobuf:"bytes,1,opt,name=parameter"`
 }
 
 func (t *DAGTask) GetName() string {
@@ -3527,6 +3697,87 @@ type SuspendTemplate struct {
 	// Duration is the seconds to wait before automatically resuming a template. Must be a string. Default unit is seconds.
 	// Could also be a Duration, e.g.: "2m", "6h"
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+
+	// Approval makes the template an approval gate, only resumed once enough of the allowed approvers approve it.
+	// If Duration is also set, the node fails if it is not approved in time.
+	Approval *SuspendApproval `json:"approval,omitempty" protobuf:"bytes,2,opt,name=approval"`
+}
+
+// SuspendApproval is an approval gate of a suspend template
+type SuspendApproval struct {
+	// Users who may approve, matched against the subject or verified email of the caller's SSO claims
+	Users []string `json:"users,omitempty" protobuf:"bytes,1,rep,name=users"`
+
+	// Groups whose members may approve, matched against the groups of the caller's SSO claims.
+	// If neither Users nor Groups is set, any identified caller may approve.
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,2,rep,name=groups"`
+
+	// Required is the number of distinct approvers needed to resume the node. Defaults to 1.
+	Required *int32 `json:"required,omitempty" protobuf:"varint,3,opt,name=required"`
+
+	// AllowReject allows an approver to reject the node, which fails it
+	AllowReject bool `json:"allowReject,omitempty" protobuf:"varint,4,opt,name=allowReject"`
+}
+
+// GetRequired returns the number of distinct approvers needed
+func (a *SuspendApproval) GetRequired() int {
+	if a == nil || a.Required == nil {
+		return 1
+	}
+	return int(*a.Required)
+}
+
+// NodeApproval is the state of the approval gate of a suspend node
+type NodeApproval struct {
+	// Gate is the approval gate the node was created with
+	Gate SuspendApproval `json:"gate" protobuf:"bytes,1,opt,name=gate"`
+
+	// Decisions are the approvals and rejections made, in the order they were made
+	Decisions []ApprovalDecision `json:"decisions,omitempty" protobuf:"bytes,2,rep,name=decisions"`
+}
+
+// Approvals returns the number of approvals made
+func (a *NodeApproval) Approvals() int {
+	approvals := 0
+	for _, decision := range a.Decisions {
+		if decision.Approved {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// GetDecision returns the decision made by the user, if any
+func (a *NodeApproval) GetDecision(user string) *ApprovalDecision {
+	for i, decision := range a.Decisions {
+		if decision.User == user {
+			return &a.Decisions[i]
+		}
+	}
+	return nil
+}
+
+// ApprovalDecision is an approval or rejection of a suspend node's approval gate
+type ApprovalDecision struct {
+	// User is the identity of the approver
+	User string `json:"user" protobuf:"bytes,1,opt,name=user"`
+
+	// Approved is whether the user approved, rather than rejected, the node
+	Approved bool `json:"approved,omitempty" protobuf:"varint,2,opt,name=approved"`
+
+	// Time is when the decision was made
+	Time metav1.Time `json:"time,omitempty" protobuf:"bytes,3,opt,name=time"`
+}
+
+// ChildWorkflowTemplate is a template subtype to run a workflow from a WorkflowTemplate as a child of the workflow.
+// The child workflow is owned by the workflow, is stopped, terminated and suspended with it, and its outputs are the
+// outputs of the node.
+type ChildWorkflowTemplate struct {
+	// WorkflowTemplateRef is the WorkflowTemplate or ClusterWorkflowTemplate to run
+	WorkflowTemplateRef WorkflowTemplateRef `json:"workflowTemplateRef" protobuf:"bytes,1,opt,name=workflowTemplateRef"`
+
+	// Arguments are the arguments to the child workflow
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
 }
 
 // GetArtifactByName returns an input artifact by its name
@@ -3578,6 +3829,19 @@ func (out *Outputs) HasOutputs() bool {
 	return false
 }
 
+// GetParameterByName returns an output parameter by its name
+func (out *Outputs) GetParameterByName(name string) *Parameter {
+	if out == nil {
+		return nil
+	}
+	for i, param := range out.Parameters {
+		if param.Name == name {
+			return &out.Parameters[i]
+		}
+	}
+	return nil
+}
+
 func (out *Outputs) GetArtifactByName(name string) *Artifact {
 	if out == nil {
 		return nil
@@ -3800,6 +4064,29 @@ func (s *WorkflowStep) ContinuesOn(phase NodePhase) bool {
 	return continues(s.ContinueOn, phase)
 }
 
+// FanOutPolicy tolerates the failure of some of the tasks, or steps, expanded by withItems, withParam or withSequence.
+// A fan-out stops starting its remaining tasks as soon as it can no longer succeed, and fails once the started ones
+// complete.
+type FanOutPolicy struct {
+	// MinSucceeded is the number, or percentage (e.g. "98%") rounded up, of the expanded tasks that must succeed
+	MinSucceeded *intstr.IntOrString `json:"minSucceeded,omitempty" protobuf:"bytes,1,opt,name=minSucceeded"`
+
+	// MaxFailed is the number, or percentage (e.g. "2%") rounded down, of the expanded tasks that may fail or error
+	MaxFailed *intstr.IntOrString `json:"maxFailed,omitempty" protobuf:"bytes,2,opt,name=maxFailed"`
+}
+
+// FanOutStatus holds the counts of the tasks, or steps, expanded by a fan-out
+type FanOutStatus struct {
+	// Total is the number of expanded tasks
+	Total int64 `json:"total" protobuf:"varint,1,opt,name=total"`
+
+	// Succeeded is the number of expanded tasks that succeeded
+	Succeeded int64 `json:"succeeded" protobuf:"varint,2,opt,name=succeeded"`
+
+	// Failed is the number of expanded tasks that failed or errored
+	Failed int64 `json:"failed" protobuf:"varint,3,opt,name=failed"`
+}
+
 type MetricType string
 
 const (
@@ -3978,12 +4265,62 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used, ConfigMap if empty
+	CacheType MemoizationCacheType `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType,casttype=MemoizationCacheType"`
 }
 
+// GetCacheType returns the type of the cache that was used
+func (m *MemoizationStatus) GetCacheType() MemoizationCacheType {
+	if m == nil || m.CacheType == "" {
+		return MemoizationCacheTypeConfigMap
+	}
+	return m.CacheType
+}
+
+// MemoizationCacheType is the kind of storage backing a memoization cache
+type MemoizationCacheType string
+
+const (
+	MemoizationCacheTypeConfigMap MemoizationCacheType = "ConfigMap"
+	MemoizationCacheTypeSQL       MemoizationCacheType = "SQL"
+)
+
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.LocalObjectReference `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// +optional
+	ConfigMap *apiv1.LocalObjectReference `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL sets a cache stored in the database configured for persistence.
+	// Unlike a ConfigMap, it is not limited in size, but requires persistence to be enabled.
+	// +optional
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+}
+
+// GetType returns the type of the cache, ConfigMap unless another type is set
+func (c *Cache) GetType() MemoizationCacheType {
+	if c != nil && c.SQL != nil {
+		return MemoizationCacheTypeSQL
+	}
+	return MemoizationCacheTypeConfigMap
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch {
+	case c == nil:
+		return ""
+	case c.SQL != nil:
+		return c.SQL.Name
+	case c.ConfigMap != nil:
+		return c.ConfigMap.Name
+	}
+	return ""
+}
+
+// SQLCache is a memoization cache stored in the persistence database
+type SQLCache struct {
+	// Name of the cache. Entries are only shared between templates using the same name.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 type SynchronizationAction interface {
@@ -4165,6 +4502,23 @@ type SynchronizationStatus struct {
 	Semaphore *SemaphoreStatus `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex stores this workflow's mutex holder details
 	Mutex *MutexStatus `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// Preemptions stores the lock holders this workflow has preempted
+	// +listType=atomic
+	Preemptions []PreemptionRecord `json:"preemptions,omitempty" protobuf:"bytes,3,rep,name=preemptions"`
+}
+
+// PreemptionRecord records a lock holder being preempted by a waiting workflow
+type PreemptionRecord struct {
+	// Lock is the name of the lock
+	Lock string `json:"lock" protobuf:"bytes,1,opt,name=lock"`
+	// Holder is the key of the preempted lock holder
+	Holder string `json:"holder" protobuf:"bytes,2,opt,name=holder"`
+	// Preemptor is the key of the waiting lock holder which preempted it
+	Preemptor string `json:"preemptor" protobuf:"bytes,3,opt,name=preemptor"`
+	// Action taken on the workflow of the preempted holder
+	Action PreemptionAction `json:"action" protobuf:"bytes,4,opt,name=action,casttype=PreemptionAction"`
+	// Time of the preemption
+	Time metav1.Time `json:"time,omitempty" protobuf:"bytes,5,opt,name=time"`
 }
 
 type SynchronizationType string
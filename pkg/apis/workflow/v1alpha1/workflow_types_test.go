@@ -710,6 +710,25 @@ func TestPodGC_GetLabelSelector(t *testing.T) {
 	})
 }
 
+func TestCache(t *testing.T) {
+	var nilCache *Cache
+	assert.Equal(t, MemoizationCacheTypeConfigMap, nilCache.GetType())
+	assert.Empty(t, nilCache.GetName())
+	configMapCache := &Cache{ConfigMap: &corev1.LocalObjectReference{Name: "my-cm"}}
+	assert.Equal(t, MemoizationCacheTypeConfigMap, configMapCache.GetType())
+	assert.Equal(t, "my-cm", configMapCache.GetName())
+	sqlCache := &Cache{SQL: &SQLCache{Name: "my-cache"}}
+	assert.Equal(t, MemoizationCacheTypeSQL, sqlCache.GetType())
+	assert.Equal(t, "my-cache", sqlCache.GetName())
+}
+
+func TestMemoizationStatus_GetCacheType(t *testing.T) {
+	var nilStatus *MemoizationStatus
+	assert.Equal(t, MemoizationCacheTypeConfigMap, nilStatus.GetCacheType())
+	assert.Equal(t, MemoizationCacheTypeConfigMap, (&MemoizationStatus{}).GetCacheType())
+	assert.Equal(t, MemoizationCacheTypeSQL, (&MemoizationStatus{CacheType: MemoizationCacheTypeSQL}).GetCacheType())
+}
+
 func TestNodes_FindByDisplayName(t *testing.T) {
 	assert.Nil(t, Nodes{}.FindByDisplayName(""))
 	assert.NotNil(t, Nodes{"": NodeStatus{DisplayName: "foo"}}.FindByDisplayName("foo"))
@@ -31,6 +31,23 @@ func (in *Amount) DeepCopy() *Amount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalDecision) DeepCopyInto(out *ApprovalDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalDecision.
+func (in *ApprovalDecision) DeepCopy() *ApprovalDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 	*out = *in
@@ -279,6 +296,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(PluginArtifact)
 		**out = **in
 	}
+	if in.ContentAddressed != nil {
+		in, out := &in.ContentAddressed, &out.ContentAddressed
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -380,6 +402,11 @@ func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 		*out = new(PluginArtifactRepository)
 		**out = **in
 	}
+	if in.ContentAddressed != nil {
+		in, out := &in.ContentAddressed, &out.ContentAddressed
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -741,6 +768,11 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLCache)
+		**out = **in
+	}
 	return
 }
 
@@ -754,6 +786,24 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflowTemplate) DeepCopyInto(out *ChildWorkflowTemplate) {
+	*out = *in
+	out.WorkflowTemplateRef = in.WorkflowTemplateRef
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflowTemplate.
+func (in *ChildWorkflowTemplate) DeepCopy() *ChildWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientCertAuth) DeepCopyInto(out *ClientCertAuth) {
 	*out = *in
@@ -1068,6 +1118,95 @@ func (in *CronWorkflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfill) DeepCopyInto(out *CronWorkflowBackfill) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfill.
+func (in *CronWorkflowBackfill) DeepCopy() *CronWorkflowBackfill {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfillInterval) DeepCopyInto(out *CronWorkflowBackfillInterval) {
+	*out = *in
+	in.ScheduledTime.DeepCopyInto(&out.ScheduledTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfillInterval.
+func (in *CronWorkflowBackfillInterval) DeepCopy() *CronWorkflowBackfillInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfillInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfillStatus) DeepCopyInto(out *CronWorkflowBackfillStatus) {
+	*out = *in
+	if in.Intervals != nil {
+		in, out := &in.Intervals, &out.Intervals
+		*out = make([]CronWorkflowBackfillInterval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfillStatus.
+func (in *CronWorkflowBackfillStatus) DeepCopy() *CronWorkflowBackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowDependencies) DeepCopyInto(out *CronWorkflowDependencies) {
+	*out = *in
+	if in.CronWorkflows != nil {
+		in, out := &in.CronWorkflows, &out.CronWorkflows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeadlineSeconds != nil {
+		in, out := &in.DeadlineSeconds, &out.DeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowDependencies.
+func (in *CronWorkflowDependencies) DeepCopy() *CronWorkflowDependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowDependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowList) DeepCopyInto(out *CronWorkflowList) {
 	*out = *in
@@ -1135,6 +1274,23 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backfills != nil {
+		in, out := &in.Backfills, &out.Backfills
+		*out = make([]CronWorkflowBackfill, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CatchUpLimit != nil {
+		in, out := &in.CatchUpLimit, &out.CatchUpLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = new(CronWorkflowDependencies)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1165,6 +1321,20 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backfills != nil {
+		in, out := &in.Backfills, &out.Backfills
+		*out = make([]CronWorkflowBackfillStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WaitingScheduledTimes != nil {
+		in, out := &in.WaitingScheduledTimes, &out.WaitingScheduledTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1178,6 +1348,22 @@ func (in *CronWorkflowStatus) DeepCopy() *CronWorkflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGExpansion) DeepCopyInto(out *DAGExpansion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGExpansion.
+func (in *DAGExpansion) DeepCopy() *DAGExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 	*out = *in
@@ -1221,6 +1407,21 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Expand != nil {
+		in, out := &in.Expand, &out.Expand
+		*out = new(DAGExpansion)
+		**out = **in
+	}
+	if in.FanOut != nil {
+		in, out := &in.FanOut, &out.FanOut
+		*out = new(FanOutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1321,6 +1522,38 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDeduplication) DeepCopyInto(out *EventDeduplication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDeduplication.
+func (in *EventDeduplication) DeepCopy() *EventDeduplication {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDeduplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateLimit) DeepCopyInto(out *EventRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateLimit.
+func (in *EventRateLimit) DeepCopy() *EventRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
@@ -1337,6 +1570,48 @@ func (in *ExecutorConfig) DeepCopy() *ExecutorConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanOutPolicy) DeepCopyInto(out *FanOutPolicy) {
+	*out = *in
+	if in.MinSucceeded != nil {
+		in, out := &in.MinSucceeded, &out.MinSucceeded
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxFailed != nil {
+		in, out := &in.MaxFailed, &out.MaxFailed
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanOutPolicy.
+func (in *FanOutPolicy) DeepCopy() *FanOutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FanOutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanOutStatus) DeepCopyInto(out *FanOutStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanOutStatus.
+func (in *FanOutStatus) DeepCopy() *FanOutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FanOutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCSArtifact) DeepCopyInto(out *GCSArtifact) {
 	*out = *in
@@ -1561,6 +1836,21 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 		*out = new(HTTPBodySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(HTTPAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pagination != nil {
+		in, out := &in.Pagination, &out.Pagination
+		*out = new(HTTPPagination)
+		**out = **in
+	}
 	return
 }
 
@@ -1704,6 +1994,43 @@ func (in HTTPHeaders) DeepCopy() HTTPHeaders {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPagination) DeepCopyInto(out *HTTPPagination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPagination.
+func (in *HTTPPagination) DeepCopy() *HTTPPagination {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPagination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetry.
+func (in *HTTPRetry) DeepCopy() *HTTPRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -2033,6 +2360,11 @@ func (in *Metrics) DeepCopy() *Metrics {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Mutex) DeepCopyInto(out *Mutex) {
 	*out = *in
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(Preemption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2088,6 +2420,30 @@ func (in *MutexStatus) DeepCopy() *MutexStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeApproval) DeepCopyInto(out *NodeApproval) {
+	*out = *in
+	in.Gate.DeepCopyInto(&out.Gate)
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]ApprovalDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeApproval.
+func (in *NodeApproval) DeepCopy() *NodeApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeFlag) DeepCopyInto(out *NodeFlag) {
 	*out = *in
@@ -2187,6 +2543,18 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(NodeApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FanOut != nil {
+		in, out := &in.FanOut, &out.FanOut
+		*out = make(map[string]FanOutStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2587,6 +2955,44 @@ func (in *PodGC) DeepCopy() *PodGC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Preemption) DeepCopyInto(out *Preemption) {
+	*out = *in
+	if in.PriorityGap != nil {
+		in, out := &in.PriorityGap, &out.PriorityGap
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Preemption.
+func (in *Preemption) DeepCopy() *Preemption {
+	if in == nil {
+		return nil
+	}
+	out := new(Preemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreemptionRecord) DeepCopyInto(out *PreemptionRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreemptionRecord.
+func (in *PreemptionRecord) DeepCopy() *PreemptionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 	*out = *in
@@ -2867,6 +3273,22 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLCache) DeepCopyInto(out *SQLCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLCache.
+func (in *SQLCache) DeepCopy() *SQLCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -2918,6 +3340,11 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(SyncDatabaseRef)
 		**out = **in
 	}
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(Preemption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3078,9 +3505,45 @@ func (in *SuppliedValueFrom) DeepCopy() *SuppliedValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuspendApproval) DeepCopyInto(out *SuspendApproval) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendApproval.
+func (in *SuspendApproval) DeepCopy() *SuspendApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(SuspendApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendTemplate) DeepCopyInto(out *SuspendTemplate) {
 	*out = *in
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(SuspendApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3131,7 +3594,7 @@ func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(Mutex)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -3161,6 +3624,13 @@ func (in *SynchronizationStatus) DeepCopyInto(out *SynchronizationStatus) {
 		*out = new(MutexStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Preemptions != nil {
+		in, out := &in.Preemptions, &out.Preemptions
+		*out = make([]PreemptionRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3284,7 +3754,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(SuspendTemplate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
@@ -3301,6 +3771,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(Plugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChildWorkflow != nil {
+		in, out := &in.ChildWorkflow, &out.ChildWorkflow
+		*out = new(ChildWorkflowTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -3706,6 +4181,16 @@ func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec)
 		*out = new(Submit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Deduplicate != nil {
+		in, out := &in.Deduplicate, &out.Deduplicate
+		*out = new(EventDeduplication)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(EventRateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -3989,6 +4474,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowLevelArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(Outputs)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4123,6 +4613,16 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.FanOut != nil {
+		in, out := &in.FanOut, &out.FanOut
+		*out = new(FanOutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
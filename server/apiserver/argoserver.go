@@ -35,11 +35,13 @@ import (
 	argo "github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
 	persist "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	clusterwftemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	eventsourcepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/eventsource"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
 	sensorpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sensor"
 	syncpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/sync"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
@@ -49,14 +51,18 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/apiserver/accesslog"
 	"github.com/argoproj/argo-workflows/v3/server/artifacts"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/serviceaccount"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 	"github.com/argoproj/argo-workflows/v3/server/auth/webhook"
 	"github.com/argoproj/argo-workflows/v3/server/cache"
 	"github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/server/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/server/event"
+	"github.com/argoproj/argo-workflows/v3/server/event/source"
 	"github.com/argoproj/argo-workflows/v3/server/eventsource"
 	"github.com/argoproj/argo-workflows/v3/server/info"
+	"github.com/argoproj/argo-workflows/v3/server/memoization"
+	"github.com/argoproj/argo-workflows/v3/server/report"
 	"github.com/argoproj/argo-workflows/v3/server/sensor"
 	"github.com/argoproj/argo-workflows/v3/server/static"
 	serversync "github.com/argoproj/argo-workflows/v3/server/sync"
@@ -72,6 +78,7 @@ import (
 	k8sutil "github.com/argoproj/argo-workflows/v3/util/k8s"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	rbacutil "github.com/argoproj/argo-workflows/v3/util/rbac"
+	"github.com/argoproj/argo-workflows/v3/util/secrets"
 	"github.com/argoproj/argo-workflows/v3/util/sqldb"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/plugin"
@@ -81,6 +88,9 @@ import (
 
 var MaxGRPCMessageSize int
 
+// eventSourceRetryDelay is how long the server waits before retrying to create an event source that failed to create
+var eventSourceRetryDelay = 30 * time.Second
+
 type argoServer struct {
 	baseHRef string
 	// https://itnext.io/practical-guide-to-securing-grpc-connections-with-go-and-tls-part-1-f63058e9d6d1
@@ -236,12 +246,14 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	offloadRepo := persist.ExplosiveOffloadNodeStatusRepo
 	wfArchive := persist.NullWorkflowArchive
+	memoizationServer := memoization.NewCacheServer(nil, "")
 	persistence := config.Persistence
 	if persistence != nil {
 		session, err := sqldb.CreateDBSession(ctx, as.clients.Kubernetes, as.namespace, persistence.DBConfig)
 		if err != nil {
 			log.WithFatal().Error(ctx, err.Error())
 		}
+		memoizationServer = memoization.NewCacheServer(session, persistence.GetClusterName())
 		tableName, err := persist.GetTableName(persistence)
 		if err != nil {
 			log.WithFatal().Error(ctx, err.Error())
@@ -256,6 +268,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		// disable the archiving - and still read old records
 		wfArchive = persist.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
 	}
+	reportServer := report.NewReportServer(wfArchive, offloadRepo)
 	resourceCacheNamespace := getResourceCacheNamespace(as.managedNamespace)
 	wftmplStore, err := workflowtemplate.NewInformer(as.restConfig, resourceCacheNamespace)
 	if err != nil {
@@ -283,7 +296,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		log.WithFatal().Error(ctx, err.Error())
 	}
 	workflowServer := workflow.NewWorkflowServer(ctx, instanceIDService, offloadRepo, wfArchive, as.clients.Workflow, wfStore, wfStore, wftmplStore, cwftmplInformer, config.WorkflowDefaults, &resourceCacheNamespace)
-	grpcServer := as.newGRPCServer(ctx, instanceIDService, workflowServer, wftmplStore, cwftmplInformer, wfArchiveServer, syncServer, eventServer, config.Links, config.Columns, config.NavColor, config.WorkflowDefaults)
+	grpcServer := as.newGRPCServer(ctx, instanceIDService, workflowServer, wftmplStore, cwftmplInformer, wfArchiveServer, syncServer, memoizationServer, reportServer, eventServer, config.Links, config.Columns, config.NavColor, config.WorkflowDefaults)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
@@ -316,6 +329,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		cwftmplInformer.Run(ctx, as.stopCh)
 	}
 	go eventServer.Run(ctx, as.stopCh)
+	as.consumeEventSources(ctx, eventServer, config.EventSources)
 	go workflowServer.Run(as.stopCh)
 	go func() { as.checkServeErr(ctx, "httpServer", http.Serve(conn, handler)) }()
 	url := "http://localhost" + address
@@ -331,7 +345,69 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService instanceid.Service, workflowServer workflowpkg.WorkflowServiceServer, wftmplStore types.WorkflowTemplateStore, cwftmplStore types.ClusterWorkflowTemplateStore, wfArchiveServer workflowarchivepkg.ArchivedWorkflowServiceServer, syncServer syncpkg.SyncServiceServer, eventServer *event.Controller, links []*v1alpha1.Link, columns []*v1alpha1.Column, navColor string, wfDefaults *v1alpha1.Workflow) *grpc.Server {
+// consumeEventSources consumes the events of the message brokers of the event sources until the server stops
+func (as *argoServer) consumeEventSources(ctx context.Context, eventServer *event.Controller, eventSources []config.EventSource) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-as.stopCh
+		cancel()
+	}()
+	for _, eventSource := range eventSources {
+		go as.consumeEventSource(ctx, eventServer, eventSource)
+	}
+}
+
+// consumeEventSource consumes the events of an event source, retrying to create its source until it is created, or the
+// server stops
+func (as *argoServer) consumeEventSource(ctx context.Context, eventServer *event.Controller, eventSource config.EventSource) {
+	log := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"eventSource": eventSource.Name, "namespace": eventSource.Namespace})
+	for {
+		dispatchCtx, err := as.eventSourceContext(ctx, eventSource)
+		if err == nil {
+			var src source.Source
+			src, err = source.New(ctx, as.clients.Kubernetes, as.namespace, eventSource)
+			if err == nil {
+				log.Info(ctx, "Consuming event source")
+				eventServer.Consume(dispatchCtx, eventSource, src)
+				return
+			}
+		}
+		log.WithError(err).Error(ctx, "Failed to create event source, retrying")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventSourceRetryDelay):
+		}
+	}
+}
+
+// eventSourceContext returns a context that dispatches the events of an event source as its service account, in the
+// same way as the service accounts of the webhook clients, as there is no sender to authorize
+func (as *argoServer) eventSourceContext(ctx context.Context, eventSource config.EventSource) (context.Context, error) {
+	if eventSource.ServiceAccountName == "" {
+		return nil, fmt.Errorf("event source %q must set serviceAccountName", eventSource.Name)
+	}
+	serviceAccount, err := as.clients.Kubernetes.CoreV1().ServiceAccounts(eventSource.Namespace).Get(ctx, eventSource.ServiceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service account %q: %w", eventSource.ServiceAccountName, err)
+	}
+	tokenSecretName := secrets.TokenNameForServiceAccount(serviceAccount)
+	tokenSecret, err := as.clients.Kubernetes.CoreV1().Secrets(eventSource.Namespace).Get(ctx, tokenSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token secret %q: %w", tokenSecretName, err)
+	}
+	restConfig, clients, err := auth.DefaultClientForAuthorization("Bearer "+string(tokenSecret.Data["token"]), as.restConfig)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := serviceaccount.ClaimSetFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return auth.ContextWithClients(ctx, clients, claims), nil
+}
+
+func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService instanceid.Service, workflowServer workflowpkg.WorkflowServiceServer, wftmplStore types.WorkflowTemplateStore, cwftmplStore types.ClusterWorkflowTemplateStore, wfArchiveServer workflowarchivepkg.ArchivedWorkflowServiceServer, syncServer syncpkg.SyncServiceServer, memoizationServer cachepkg.CacheServiceServer, reportServer reportpkg.ReportServiceServer, eventServer *event.Controller, links []*v1alpha1.Link, columns []*v1alpha1.Column, navColor string, wfDefaults *v1alpha1.Workflow) *grpc.Server {
 	serverLog := logging.RequireLoggerFromContext(ctx)
 
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs. However, since it is bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default. To enable them please call the following in your server initialization code:"
@@ -375,6 +451,8 @@ func (as *argoServer) newGRPCServer(ctx context.Context, instanceIDService insta
 	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, wfArchiveServer)
 	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService, cwftmplStore, wfDefaults))
 	syncpkg.RegisterSyncServiceServer(grpcServer, syncServer)
+	cachepkg.RegisterCacheServiceServer(grpcServer, memoizationServer)
+	reportpkg.RegisterReportServiceServer(grpcServer, reportServer)
 	grpc_prometheus.Register(grpcServer)
 	return grpcServer
 }
@@ -433,6 +511,8 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(workflowarchivepkg.RegisterArchivedWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(syncpkg.RegisterSyncServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(cachepkg.RegisterCacheServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(reportpkg.RegisterReportServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 
 	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
 		// we must delete this header for API request to prevent "stream terminated by RST_STREAM with error code: PROTOCOL_ERROR" error
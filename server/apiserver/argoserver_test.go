@@ -231,3 +231,22 @@ func TestValidateArtifactDriverImages(t *testing.T) {
 		})
 	}
 }
+
+func TestEventSourceContext(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	as := &argoServer{clients: &types.Clients{Kubernetes: fake.NewSimpleClientset(
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "my-ns"}},
+	)}}
+	t.Run("NoServiceAccountName", func(t *testing.T) {
+		_, err := as.eventSourceContext(ctx, config.EventSource{Name: "my-source", Namespace: "my-ns"})
+		require.EqualError(t, err, `event source "my-source" must set serviceAccountName`)
+	})
+	t.Run("NoServiceAccount", func(t *testing.T) {
+		_, err := as.eventSourceContext(ctx, config.EventSource{Name: "my-source", Namespace: "my-ns", ServiceAccountName: "not-found"})
+		require.EqualError(t, err, `failed to get service account "not-found": serviceaccounts "not-found" not found`)
+	})
+	t.Run("NoTokenSecret", func(t *testing.T) {
+		_, err := as.eventSourceContext(ctx, config.EventSource{Name: "my-source", Namespace: "my-ns", ServiceAccountName: "my-sa"})
+		require.EqualError(t, err, `failed to get token secret "my-sa.service-account-token": secrets "my-sa.service-account-token" not found`)
+	})
+}
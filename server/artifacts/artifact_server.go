@@ -483,6 +483,8 @@ func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeID, artif
 		if err != nil {
 			return art, nil, fmt.Errorf("error appending filename %s to key of artifact %+v: err: %v", *fileName, art, err)
 		}
+		// the digest is of the whole artifact, not of a file in it
+		art.Digest = ""
 		logger.WithFields(logging.Fields{
 			"fileName": *fileName,
 			"artifact": art,
@@ -43,6 +43,7 @@ const (
 	EventsKey  ContextKey = "events.Interface"
 	KubeKey    ContextKey = "kubernetes.Interface"
 	ClaimsKey  ContextKey = "types.Claims"
+	ModeKey    ContextKey = "auth.Mode"
 )
 
 type Gatekeeper interface {
@@ -103,16 +104,21 @@ func (s *gatekeeper) StreamServerInterceptor() grpc.StreamServerInterceptor {
 }
 
 func (s *gatekeeper) ContextWithRequest(ctx context.Context, req interface{}) (context.Context, error) {
-	clients, claims, err := s.getClients(ctx, req)
+	clients, claims, mode, err := s.getClients(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	return context.WithValue(ContextWithClients(ctx, clients, claims), ModeKey, mode), nil
+}
+
+// ContextWithClients returns a context whose operations are performed with the clients, on behalf of the claims
+func ContextWithClients(ctx context.Context, clients *servertypes.Clients, claims *authTypes.Claims) context.Context {
 	ctx = context.WithValue(ctx, DynamicKey, clients.Dynamic)
 	ctx = context.WithValue(ctx, WfKey, clients.Workflow)
 	ctx = context.WithValue(ctx, EventsKey, clients.Events)
 	ctx = context.WithValue(ctx, KubeKey, clients.Kubernetes)
 	ctx = context.WithValue(ctx, ClaimsKey, claims)
-	return ctx, nil
+	return ctx
 }
 
 func (s *gatekeeper) Context(ctx context.Context) (context.Context, error) {
@@ -140,6 +146,12 @@ func GetClaims(ctx context.Context) *authTypes.Claims {
 	return config
 }
 
+// GetMode returns the auth mode of the request, or "" if the context is not of a request to the server
+func GetMode(ctx context.Context) Mode {
+	mode, _ := ctx.Value(ModeKey).(Mode)
+	return mode
+}
+
 func getAuthHeaders(md metadata.MD) []string {
 	// looks for the HTTP header `Authorization: Bearer ...`
 	for _, t := range md.Get("authorization") {
@@ -162,7 +174,7 @@ func getAuthHeaders(md metadata.MD) []string {
 	return authorizations
 }
 
-func (s *gatekeeper) getClients(ctx context.Context, req interface{}) (*servertypes.Clients, *authTypes.Claims, error) {
+func (s *gatekeeper) getClients(ctx context.Context, req interface{}) (*servertypes.Clients, *authTypes.Claims, Mode, error) {
 	md, _ := metadata.FromIncomingContext(ctx)
 	authorizations := getAuthHeaders(md)
 	// Required for GetMode() with Server auth when no auth header specified
@@ -182,36 +194,36 @@ func (s *gatekeeper) getClients(ctx context.Context, req interface{}) (*serverty
 		}
 	}
 	if !valid {
-		return nil, nil, status.Error(codes.Unauthenticated, "token not valid. see https://argo-workflows.readthedocs.io/en/latest/faq/")
+		return nil, nil, "", status.Error(codes.Unauthenticated, "token not valid. see https://argo-workflows.readthedocs.io/en/latest/faq/")
 	}
 	switch mode {
 	case Client:
 		restConfig, clients, err := s.clientForAuthorization(authorization, s.restConfig)
 		if err != nil {
-			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, nil, "", status.Error(codes.Unauthenticated, err.Error())
 		}
 		claims, _ := serviceaccount.ClaimSetFor(restConfig)
-		return clients, claims, nil
+		return clients, claims, mode, nil
 	case Server:
 		claims, _ := serviceaccount.ClaimSetFor(s.restConfig)
-		return s.clients, claims, nil
+		return s.clients, claims, mode, nil
 	case SSO:
 		logger := logging.RequireLoggerFromContext(ctx)
 		claims, err := s.ssoIf.Authorize(authorization)
 		if err != nil {
-			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, nil, "", status.Error(codes.Unauthenticated, err.Error())
 		}
 		if s.ssoIf.IsRBACEnabled() {
 			clients, err := s.rbacAuthorization(ctx, claims, req)
 			if err != nil {
 				logger.WithError(err).Error(ctx, "failed to perform RBAC authorization")
-				return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
+				return nil, nil, "", status.Error(codes.PermissionDenied, "not allowed")
 			}
-			return clients, claims, nil
+			return clients, claims, mode, nil
 		} else {
 			// important! write an audit entry (i.e. log entry) so we know which user performed an operation
 			logger.WithFields(addClaimsLogFields(claims, nil)).Info(ctx, "using the default service account for user")
-			return s.clients, claims, nil
+			return s.clients, claims, mode, nil
 		}
 	default:
 		panic("this should never happen")
@@ -134,6 +134,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		assert.NotEqual(t, wfClient, GetWfClient(ctx))
 		assert.NotEqual(t, kubeClient, GetKubeClient(ctx))
 		assert.Nil(t, GetClaims(ctx))
+		assert.Equal(t, Client, GetMode(ctx))
 	})
 	t.Run("Server", func(t *testing.T) {
 		g, err := NewGatekeeper(Modes{Server: true}, clients, &rest.Config{Username: "my-username"}, nil, clientForAuthorization, "", "", true, resourceCache)
@@ -143,6 +144,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		assert.Equal(t, wfClient, GetWfClient(ctx))
 		assert.Equal(t, kubeClient, GetKubeClient(ctx))
 		assert.NotNil(t, GetClaims(ctx))
+		assert.Equal(t, Server, GetMode(ctx))
 	})
 	t.Run("SSO", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
@@ -156,6 +158,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		assert.Equal(t, kubeClient, GetKubeClient(ctx))
 		require.NotNil(t, GetClaims(ctx))
 		assert.Equal(t, "my-sub", GetClaims(ctx).Subject)
+		assert.Equal(t, SSO, GetMode(ctx))
 	})
 	t.Run("SSO+RBAC,precedence=1", func(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
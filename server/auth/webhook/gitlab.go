@@ -6,8 +6,8 @@ import (
 	"gopkg.in/go-playground/webhooks.v5/gitlab"
 )
 
-func gitlabMatch(secret string, r *http.Request) bool {
-	hook, err := gitlab.New(gitlab.Options.Secret(secret))
+func gitlabMatch(client *webhookClient, r *http.Request) bool {
+	hook, err := gitlab.New(gitlab.Options.Secret(client.Secret))
 	if err != nil {
 		return false
 	}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultTimestampTolerance = 5 * time.Minute
+
+// hmacMatch verifies the HMAC-SHA256 signature of the body of a request from any sender, and, if the client has a
+// timestamp header, that the request was signed recently, so that it cannot be replayed later
+func hmacMatch(client *webhookClient, r *http.Request) bool {
+	if client.Secret == "" || client.Header == "" {
+		return false
+	}
+	signature, ok := strings.CutPrefix(r.Header.Get(client.Header), client.Prefix)
+	if !ok || signature == "" {
+		return false
+	}
+	var expected []byte
+	var err error
+	switch client.Encoding {
+	case "", "hex":
+		expected, err = hex.DecodeString(signature)
+	case "base64":
+		expected, err = base64.StdEncoding.DecodeString(signature)
+	default:
+		return false
+	}
+	if err != nil {
+		return false
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(client.Secret))
+	if client.TimestampHeader != "" {
+		timestamp := r.Header.Get(client.TimestampHeader)
+		if !recent(timestamp, client.TimestampTolerance) {
+			return false
+		}
+		// the timestamp is signed with the body, so that it cannot be changed
+		mac.Write([]byte(timestamp + "."))
+	}
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// recent returns whether a Unix timestamp, in seconds, is within the tolerance of now
+func recent(timestamp string, tolerance string) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	maxSkew := defaultTimestampTolerance
+	if tolerance != "" {
+		maxSkew, err = time.ParseDuration(tolerance)
+		if err != nil {
+			return false
+		}
+	}
+	skew := time.Since(time.Unix(seconds, 0))
+	return skew <= maxSkew && skew >= -maxSkew
+}
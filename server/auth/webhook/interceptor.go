@@ -2,14 +2,19 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/argoproj/argo-workflows/v3/server/cache"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/secrets"
 
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
@@ -20,9 +25,27 @@ type webhookClient struct {
 	Type string `json:"type"`
 	// e.g. "shh!"
 	Secret string `json:"secret"`
+	// for "hmac", the header holding the signature, e.g. "X-Signature", and for "jwt", the token, e.g. "X-Webhook-Token"
+	Header string `json:"header,omitempty"`
+	// for "hmac", the prefix of the signature in the header, e.g. "sha256=", and for "jwt", of the token
+	Prefix string `json:"prefix,omitempty"`
+	// for "hmac", the encoding of the signature, "hex" (default) or "base64"
+	Encoding string `json:"encoding,omitempty"`
+	// for "hmac", the header holding the Unix time the request was signed at, e.g. "X-Timestamp"
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+	// for "hmac", how far from now the timestamp can be, e.g. "5m" (default)
+	TimestampTolerance string `json:"timestampTolerance,omitempty"`
+	// for "jwt", the URL of the JSON Web Key Set of the issuer, e.g. "https://example.com/.well-known/jwks.json"
+	JWKSURL string `json:"jwksURL,omitempty"`
+	// for "jwt", e.g. "https://example.com"
+	Issuer string `json:"issuer,omitempty"`
+	// for "jwt", e.g. "argo-workflows"
+	Audience string `json:"audience,omitempty"`
+	// for "jwt", optional, e.g. "repo:my-org/my-repo:ref:refs/heads/main"
+	Subject string `json:"subject,omitempty"`
 }
 
-type matcher = func(secret string, r *http.Request) bool
+type matcher = func(client *webhookClient, r *http.Request) bool
 
 // parser for each types, these should be fast, i.e. no database or API interactions
 var webhookParsers = map[string]matcher{
@@ -30,16 +53,43 @@ var webhookParsers = map[string]matcher{
 	"bitbucketserver": bitbucketserverMatch,
 	"github":          githubMatch,
 	"gitlab":          gitlabMatch,
+	"hmac":            hmacMatch,
+	"jwt":             jwtMatch,
 }
 
-const pathPrefix = "/api/v1/events/"
+// credentialHeaders returns the header that carries the credentials of a request for each type, so that requests
+// without them are not matched, and their body is not read
+var credentialHeaders = map[string]func(client *webhookClient) string{
+	"bitbucket":       func(*webhookClient) string { return "X-Hook-UUID" },
+	"bitbucketserver": func(*webhookClient) string { return "X-Hub-Signature" },
+	"github":          func(*webhookClient) string { return "X-Hub-Signature-256" },
+	"gitlab":          func(*webhookClient) string { return "X-Gitlab-Token" },
+	"hmac":            func(client *webhookClient) string { return client.Header },
+	"jwt":             func(client *webhookClient) string { return client.Header },
+}
+
+// bodylessParsers are the parsers of the types whose credentials do not depend on the body of the request, so are
+// matched before it is read
+var bodylessParsers = map[string]bool{
+	"jwt": true,
+}
+
+const (
+	pathPrefix = "/api/v1/events/"
+	// maxBodySize is the largest body that is read to verify its signature, which is the most GitHub sends
+	maxBodySize = 25 * 1024 * 1024
+	// cacheTTL is how long the webhook clients of a namespace, and the tokens of their service accounts, are cached for
+	cacheTTL = time.Minute
+)
 
 type WebhookInterceptor struct {
 	logger logging.Logger
+	// cache holds the webhook clients of each namespace, and the token of each service account
+	cache cache.Interface
 }
 
 func NewWebhookInterceptor(logger logging.Logger) *WebhookInterceptor {
-	return &WebhookInterceptor{logger: logger}
+	return &WebhookInterceptor{logger: logger, cache: cache.NewLRUTtlCache(cacheTTL, 2000)}
 }
 
 // Interceptor creates an annotator that verifies webhook signatures and adds the appropriate access token to the request.
@@ -59,7 +109,7 @@ func (i *WebhookInterceptor) Interceptor(client kubernetes.Interface) func(w htt
 
 func (i *WebhookInterceptor) addWebhookAuthorization(r *http.Request, kube kubernetes.Interface) error {
 	// try and exit quickly before we do anything API calls
-	if r.Method != http.MethodPost || len(r.Header["Authorization"]) > 0 || !strings.HasPrefix(r.URL.Path, pathPrefix) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, pathPrefix) {
 		return nil
 	}
 	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, pathPrefix), "/", 2)
@@ -67,40 +117,122 @@ func (i *WebhookInterceptor) addWebhookAuthorization(r *http.Request, kube kuber
 		return nil
 	}
 	namespace := parts[0]
-	secretsInterface := kube.CoreV1().Secrets(namespace)
 	ctx := r.Context()
+	// a request with an Authorization header is authorized as usual, unless a client of the namespace reads its
+	// credentials from that header, e.g. a jwt client for a sender that can only send bearer tokens, and matches it
+	authorized := len(r.Header["Authorization"]) > 0
 
-	webhookClients, err := secretsInterface.Get(ctx, "argo-workflows-webhook-clients", metav1.GetOptions{})
+	webhookClients, err := i.getWebhookClients(ctx, kube, namespace)
 	if err != nil {
-		return fmt.Errorf("failed to get webhook clients: %w", err)
+		if authorized {
+			return nil
+		}
+		return err
 	}
-	// we need to read the request body to check the signature, but we still need it for the GRPC request,
-	// so read it all now, and then reinstate when we are done
-	buf, _ := io.ReadAll(r.Body)
-	defer func() { r.Body = io.NopCloser(bytes.NewBuffer(buf)) }()
-	serviceAccountInterface := kube.CoreV1().ServiceAccounts(namespace)
-	for serviceAccountName, data := range webhookClients.Data {
-		r.Body = io.NopCloser(bytes.NewBuffer(buf))
-		client := &webhookClient{}
-		err := yaml.Unmarshal(data, client)
-		if err != nil {
-			return fmt.Errorf("failed to unmarshal webhook client \"%s\": %w", serviceAccountName, err)
+	// only the clients whose credentials the request carries can match it
+	var candidates []string
+	for serviceAccountName, client := range webhookClients {
+		header := credentialHeaders[client.Type](client)
+		if authorized && http.CanonicalHeaderKey(header) != "Authorization" {
+			continue
 		}
-		i.logger.WithFields(logging.Fields{"serviceAccountName": serviceAccountName, "webhookType": client.Type}).Debug(r.Context(), "Attempting to match webhook request")
-		ok := webhookParsers[client.Type](client.Secret, r)
-		if ok {
-			i.logger.WithField("serviceAccountName", serviceAccountName).Debug(r.Context(), "Matched webhook request")
-			serviceAccount, err := serviceAccountInterface.Get(ctx, serviceAccountName, metav1.GetOptions{})
+		if header != "" && r.Header.Get(header) != "" {
+			candidates = append(candidates, serviceAccountName)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		return bodylessParsers[webhookClients[candidates[a]].Type] && !bodylessParsers[webhookClients[candidates[b]].Type]
+	})
+	var buf []byte
+	defer func() {
+		if buf != nil {
+			r.Body = io.NopCloser(bytes.NewBuffer(buf))
+		}
+	}()
+	for _, serviceAccountName := range candidates {
+		client := webhookClients[serviceAccountName]
+		if !bodylessParsers[client.Type] && buf == nil {
+			// we need to read the request body to check the signature, but we still need it for the GRPC request,
+			// so read it now, and then reinstate when we are done
+			buf, err = io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
 			if err != nil {
-				return fmt.Errorf("failed to get service account \"%s\": %w", serviceAccountName, err)
+				return fmt.Errorf("failed to read webhook request body: %w", err)
 			}
-			tokenSecret, err := secretsInterface.Get(ctx, secrets.TokenNameForServiceAccount(serviceAccount), metav1.GetOptions{})
+			if len(buf) > maxBodySize {
+				return fmt.Errorf("webhook request body is larger than %d bytes", maxBodySize)
+			}
+		}
+		if buf != nil {
+			r.Body = io.NopCloser(bytes.NewBuffer(buf))
+		}
+		i.logger.WithFields(logging.Fields{"serviceAccountName": serviceAccountName, "webhookType": client.Type}).Debug(ctx, "Attempting to match webhook request")
+		if webhookParsers[client.Type](client, r) {
+			i.logger.WithField("serviceAccountName", serviceAccountName).Debug(ctx, "Matched webhook request")
+			token, err := i.getServiceAccountToken(ctx, kube, namespace, serviceAccountName)
 			if err != nil {
-				return fmt.Errorf("failed to get token secret \"%s\": %w", tokenSecret, err)
+				return err
 			}
-			r.Header["Authorization"] = []string{"Bearer " + string(tokenSecret.Data["token"])}
+			r.Header["Authorization"] = []string{"Bearer " + token}
 			return nil
 		}
 	}
 	return nil
 }
+
+// getWebhookClients returns the webhook clients of a namespace, by service account name, from the cache, or from the
+// argo-workflows-webhook-clients secret of the namespace. That the namespace has no secret is cached too, as requests
+// with an Authorization header look for it.
+func (i *WebhookInterceptor) getWebhookClients(ctx context.Context, kube kubernetes.Interface, namespace string) (map[string]*webhookClient, error) {
+	key := "clients/" + namespace
+	if cached, ok := i.cache.Get(key); ok {
+		if err, ok := cached.(error); ok {
+			return nil, err
+		}
+		return cached.(map[string]*webhookClient), nil
+	}
+	secret, err := kube.CoreV1().Secrets(namespace).Get(ctx, "argo-workflows-webhook-clients", metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("failed to get webhook clients: %w", err)
+		if apierr.IsNotFound(err) {
+			i.cache.Add(key, err)
+		}
+		return nil, err
+	}
+	webhookClients := make(map[string]*webhookClient, len(secret.Data))
+	for serviceAccountName, data := range secret.Data {
+		client := &webhookClient{}
+		err := yaml.Unmarshal(data, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal webhook client \"%s\": %w", serviceAccountName, err)
+		}
+		if _, ok := webhookParsers[client.Type]; !ok {
+			return nil, fmt.Errorf("webhook client \"%s\" has unknown type \"%s\"", serviceAccountName, client.Type)
+		}
+		webhookClients[serviceAccountName] = client
+	}
+	i.cache.Add(key, webhookClients)
+	return webhookClients, nil
+}
+
+// getServiceAccountToken returns the token of a service account, from the cache, or from its token secret
+func (i *WebhookInterceptor) getServiceAccountToken(ctx context.Context, kube kubernetes.Interface, namespace, serviceAccountName string) (string, error) {
+	key := "token/" + namespace + "/" + serviceAccountName
+	if token, ok := i.cache.Get(key); ok {
+		return token.(string), nil
+	}
+	serviceAccount, err := kube.CoreV1().ServiceAccounts(namespace).Get(ctx, serviceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get service account \"%s\": %w", serviceAccountName, err)
+	}
+	tokenSecretName := secrets.TokenNameForServiceAccount(serviceAccount)
+	tokenSecret, err := kube.CoreV1().Secrets(namespace).Get(ctx, tokenSecretName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get token secret \"%s\": %w", tokenSecretName, err)
+	}
+	token := string(tokenSecret.Data["token"])
+	i.cache.Add(key, token)
+	return token, nil
+}
@@ -3,9 +3,14 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -74,6 +79,70 @@ func TestInterceptor(t *testing.T) {
 		})
 		assert.Equal(t, []string{"Bearer my-gitlab-token"}, r.Header["Authorization"])
 	})
+	t.Run("HMAC", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		r, _ := intercept(logging.TestContext(t.Context()), "POST", "/api/v1/events/my-ns/my-d", map[string]string{
+			"X-Signature": "sha256=" + sign("sh!", timestamp+".{}"),
+			"X-Timestamp": timestamp,
+		})
+		assert.Equal(t, []string{"Bearer my-hmac-token"}, r.Header["Authorization"])
+	})
+	// we reject these
+	t.Run("HMACWrongSignature", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		r, _ := intercept(logging.TestContext(t.Context()), "POST", "/api/v1/events/my-ns/my-d", map[string]string{
+			"X-Signature": "sha256=" + sign("guess", timestamp+".{}"),
+			"X-Timestamp": timestamp,
+		})
+		assert.Empty(t, r.Header["Authorization"])
+	})
+	t.Run("HMACReplayed", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+		r, _ := intercept(logging.TestContext(t.Context()), "POST", "/api/v1/events/my-ns/my-d", map[string]string{
+			"X-Signature": "sha256=" + sign("sh!", timestamp+".{}"),
+			"X-Timestamp": timestamp,
+		})
+		assert.Empty(t, r.Header["Authorization"])
+	})
+	t.Run("NoCredentials", func(t *testing.T) {
+		r, w := intercept(logging.TestContext(t.Context()), "POST", "/api/v1/events/my-ns/my-d", map[string]string{"X-Event-Key": "repo:push"})
+		assert.Empty(t, r.Header["Authorization"])
+		assert.Equal(t, 200, w.Code)
+	})
+}
+
+func TestInterceptorCache(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	k := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argo-workflows-webhook-clients", Namespace: "my-ns"},
+			Data:       map[string][]byte{"github": []byte("type: github\nsecret: sh!")},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "github", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "github-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "github-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-github-token")},
+		},
+	)
+	i := NewWebhookInterceptor(logging.RequireLoggerFromContext(ctx)).Interceptor(k)
+	for range 2 {
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", bytes.NewBufferString("{}"))
+		r.Header.Set("X-Github-Event", "push")
+		r.Header.Set("X-Hub-Signature-256", "sha256="+sign("sh!", "{}"))
+		i(httptest.NewRecorder(), r, &testHTTPHandler{})
+		assert.Equal(t, []string{"Bearer my-github-token"}, r.Header["Authorization"])
+	}
+	// the clients secret, service account and token secret are only read once
+	assert.Len(t, k.Actions(), 3)
+}
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
 func intercept(ctx context.Context, method string, target string, headers map[string]string) (*http.Request, *httptest.ResponseRecorder) {
@@ -86,6 +155,8 @@ func intercept(ctx context.Context, method string, target string, headers map[st
 				"bitbucketserver": []byte("type: bitbucketserver\nsecret: sh!"),
 				"github":          []byte("type: github\nsecret: sh!"),
 				"gitlab":          []byte("type: gitlab\nsecret: sh!"),
+				"hmac":            []byte("type: hmac\nsecret: sh!\nheader: X-Signature\nprefix: sha256=\ntimestampHeader: X-Timestamp"),
+				"jwt":             []byte("type: jwt\nheader: X-Webhook-Token\njwksURL: http://127.0.0.1:0/jwks\nissuer: https://issuer.example.com\naudience: argo-workflows"),
 			},
 		},
 		// bitbucket
@@ -124,6 +195,15 @@ func intercept(ctx context.Context, method string, target string, headers map[st
 			ObjectMeta: metav1.ObjectMeta{Name: "gitlab-token", Namespace: "my-ns"},
 			Data:       map[string][]byte{"token": []byte("my-gitlab-token")},
 		},
+		// hmac
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "hmac", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "hmac-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hmac-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-hmac-token")},
+		},
 	)
 	i := NewWebhookInterceptor(logging.RequireLoggerFromContext(ctx)).Interceptor(k)
 	w := httptest.NewRecorder()
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// keySets caches the key sets of JWKS URLs, so that their keys are only fetched when they are first used, or rotated
+var keySets sync.Map
+
+// jwtMatch verifies the JWT in the client's header of a request against the keys of the client's JWKS URL, and its
+// issuer, audience, expiry and, optionally, subject
+func jwtMatch(client *webhookClient, r *http.Request) bool {
+	if client.Header == "" || client.JWKSURL == "" || client.Issuer == "" || client.Audience == "" {
+		return false
+	}
+	token, ok := strings.CutPrefix(r.Header.Get(client.Header), client.Prefix)
+	if !ok || token == "" {
+		return false
+	}
+	keySet, ok := keySets.Load(client.JWKSURL)
+	if !ok {
+		keySet, _ = keySets.LoadOrStore(client.JWKSURL, oidc.NewRemoteKeySet(context.Background(), client.JWKSURL))
+	}
+	verifier := oidc.NewVerifier(client.Issuer, keySet.(*oidc.RemoteKeySet), &oidc.Config{ClientID: client.Audience})
+	idToken, err := verifier.Verify(r.Context(), token)
+	return err == nil && (client.Subject == "" || idToken.Subject == client.Subject)
+}
@@ -0,0 +1,143 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// newJWKSServer serves the JSON Web Key Set of a new key, and returns its URL and a signer with the key
+func newJWKSServer(t *testing.T) (string, jose.Signer) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "my-key", Algorithm: "RS256", Use: "sig"}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(server.Close)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "my-key"))
+	require.NoError(t, err)
+	return server.URL, signer
+}
+
+func TestJWTMatch(t *testing.T) {
+	jwksURL, signer := newJWKSServer(t)
+	client := &webhookClient{Type: "jwt", Header: "X-Webhook-Token", Prefix: "Bearer ", JWKSURL: jwksURL, Issuer: "https://issuer.example.com", Audience: "argo-workflows", Subject: "my-sender"}
+	claims := jwt.Claims{
+		Issuer:   "https://issuer.example.com",
+		Audience: jwt.Audience{"argo-workflows"},
+		Subject:  "my-sender",
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}
+	match := func(claims jwt.Claims) bool {
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)
+		r.Header.Set("X-Webhook-Token", "Bearer "+token)
+		return jwtMatch(client, r)
+	}
+
+	assert.True(t, match(claims))
+	t.Run("WrongIssuer", func(t *testing.T) {
+		claims := claims
+		claims.Issuer = "https://attacker.example.com"
+		assert.False(t, match(claims))
+	})
+	t.Run("WrongAudience", func(t *testing.T) {
+		claims := claims
+		claims.Audience = jwt.Audience{"another-service"}
+		assert.False(t, match(claims))
+	})
+	t.Run("WrongSubject", func(t *testing.T) {
+		claims := claims
+		claims.Subject = "another-sender"
+		assert.False(t, match(claims))
+	})
+	t.Run("Expired", func(t *testing.T) {
+		claims := claims
+		claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		assert.False(t, match(claims))
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		otherSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: otherKey}, (&jose.SignerOptions{}).WithHeader("kid", "my-key"))
+		require.NoError(t, err)
+		token, err := jwt.Signed(otherSigner).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		r := httptest.NewRequest("POST", "/api/v1/events/my-ns/my-d", nil)
+		r.Header.Set("X-Webhook-Token", "Bearer "+token)
+		assert.False(t, jwtMatch(client, r))
+	})
+}
+
+func TestInterceptorJWTAuthorization(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	jwksURL, signer := newJWKSServer(t)
+	k := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argo-workflows-webhook-clients", Namespace: "my-ns"},
+			Data:       map[string][]byte{"ci": []byte("type: jwt\nheader: authorization\nprefix: \"Bearer \"\njwksURL: " + jwksURL + "\nissuer: https://issuer.example.com\naudience: argo-workflows")},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "ci-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ci-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-ci-token")},
+		},
+	)
+	i := NewWebhookInterceptor(logging.RequireLoggerFromContext(ctx)).Interceptor(k)
+	intercept := func(namespace, authorization string) *http.Request {
+		r := httptest.NewRequest("POST", "/api/v1/events/"+namespace+"/my-d", nil)
+		r.Header.Set("Authorization", authorization)
+		w := httptest.NewRecorder()
+		i(w, r, &testHTTPHandler{})
+		assert.Equal(t, http.StatusOK, w.Code)
+		return r
+	}
+
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   "https://issuer.example.com",
+		Audience: jwt.Audience{"argo-workflows"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}).CompactSerialize()
+	require.NoError(t, err)
+	t.Run("Matched", func(t *testing.T) {
+		r := intercept("my-ns", "Bearer "+token)
+		assert.Equal(t, []string{"Bearer my-ci-token"}, r.Header["Authorization"])
+	})
+	t.Run("NotMatched", func(t *testing.T) {
+		r := intercept("my-ns", "Bearer my-user-token")
+		assert.Equal(t, []string{"Bearer my-user-token"}, r.Header["Authorization"], "the request is authorized as usual")
+	})
+	t.Run("NoWebhookClients", func(t *testing.T) {
+		for range 2 {
+			r := intercept("other-ns", "Bearer "+token)
+			assert.Equal(t, []string{"Bearer " + token}, r.Header["Authorization"])
+		}
+		var gets int
+		for _, action := range k.Actions() {
+			if action.GetNamespace() == "other-ns" {
+				gets++
+			}
+		}
+		assert.Equal(t, 1, gets, "that the namespace has no webhook clients is cached")
+	})
+}
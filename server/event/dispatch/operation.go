@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/expr-lang/expr"
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -97,9 +99,50 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 	if matched && submit != nil {
 		//nolint: contextcheck
 		client := auth.GetWfClient(o.ctx)
+		var idempotencyKey string
+		if wfeb.Spec.Deduplicate != nil {
+			idempotencyKey, err = o.evaluateStringExpression(wfeb.Spec.Deduplicate.Key, "idempotency key")
+			if err != nil {
+				return nil, err
+			}
+		}
+		now := time.Now()
+		created := false
+		var deduplicatedName string
+		if idempotencyKey != "" {
+			var suppressed *suppression
+			deduplicatedName, suppressed, err = deduplicate(ctx, client.ArgoprojV1alpha1().Workflows(wfeb.Namespace), wfeb, idempotencyKey, now)
+			if err != nil {
+				return nil, err
+			}
+			if suppressed != nil {
+				o.suppressed(ctx, wfeb, suppressed)
+				return nil, nil
+			}
+		}
+		if wfeb.Spec.RateLimit != nil {
+			//nolint: contextcheck
+			suppressed, err := rateLimit(ctx, auth.GetKubeClient(o.ctx), wfeb, now)
+			if err != nil {
+				return nil, err
+			}
+			if suppressed != nil {
+				o.suppressed(ctx, wfeb, suppressed)
+				return nil, nil
+			}
+			// the submission is counted before the workflow is created, so it is released if the workflow is not
+			defer func() {
+				if created {
+					return
+				}
+				//nolint: contextcheck
+				if err := releaseRateLimit(ctx, auth.GetKubeClient(o.ctx), wfeb, now); err != nil {
+					logger.WithError(err).WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name}).Error(ctx, "failed to release rate limit")
+				}
+			}()
+		}
 		ref := wfeb.Spec.Submit.WorkflowTemplateRef
 		var tmpl wfv1.WorkflowSpecHolder
-		var err error
 		if ref.ClusterScope {
 			tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
 		} else {
@@ -119,7 +162,11 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 			return nil, err
 		}
 
-		if wf.Name == "" {
+		if deduplicatedName != "" {
+			// every event with the idempotency key is submitted with the same name, so only one of them is created
+			wf.SetName(deduplicatedName)
+			wf.SetGenerateName("")
+		} else if wf.Name == "" {
 			wf.SetName(wf.GetGenerateName() + util.RandSuffix())
 		}
 
@@ -128,6 +175,9 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 		//nolint: contextcheck
 		creator.LabelCreator(o.ctx, wf)
 		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
+		if idempotencyKey != "" {
+			labels.Label(wf, common.LabelKeyWorkflowEventIdempotencyKey, idempotencyKeyLabel(idempotencyKey))
+		}
 		if submit.Arguments != nil {
 			for _, p := range submit.Arguments.Parameters {
 				if p.ValueFrom == nil {
@@ -149,14 +199,26 @@ func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding
 			}
 		}
 		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+		if deduplicatedName != "" && apierr.IsAlreadyExists(err) {
+			o.suppressed(ctx, wfeb, duplicate(deduplicatedName, idempotencyKey))
+			return nil, nil
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to create workflow: %w", err)
 		}
+		created = true
 		return wf, nil
 	}
 	return nil, nil
 }
 
+// suppressed logs, and reports as a Kubernetes event, why the submission of a workflow for the event was suppressed
+func (o *Operation) suppressed(ctx context.Context, wfeb wfv1.WorkflowEventBinding, suppressed *suppression) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	logger.WithFields(logging.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "reason": suppressed.reason}).Info(ctx, suppressed.message)
+	o.eventRecorder.Event(&wfeb, suppressed.eventType, suppressed.reason, suppressed.message)
+}
+
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
 	if len(metadata.Name) > 0 {
 		evalName, err := o.evaluateStringExpression(metadata.Name, "name")
@@ -3,15 +3,22 @@ package dispatch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -199,6 +206,147 @@ func TestNewOperation(t *testing.T) {
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to compile workflow template parameter my-param expression: unexpected token Operator(\"!\") (1:8)\n | rubbish!!!\n | .......^", <-recorder.Events)
 }
 
+func TestDispatchSuppression(t *testing.T) {
+	submitted := func(key string, generation int, created time.Time) *wfv1.Workflow {
+		return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{
+			Name:              deduplicatedName("deduplicated", key, generation),
+			Namespace:         "my-ns",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				common.LabelKeyWorkflowEventBinding:        "deduplicated",
+				common.LabelKeyWorkflowEventIdempotencyKey: idempotencyKeyLabel(key),
+			},
+		}}
+	}
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		},
+		submitted("recent", 0, time.Now().Add(-time.Minute)),
+		submitted("old", 0, time.Now().Add(-2*time.Hour)),
+		// the first generation of the workflows submitted for the key was deleted
+		submitted("deleted", 1, time.Now().Add(-2*time.Hour)),
+	)
+	submissions, err := json.Marshal([]time.Time{time.Now().Add(-2 * time.Hour), time.Now().Add(-time.Minute), time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+	kubeClient := kubefake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "rate-limited-rate-limit", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowEventBinding: "rate-limited"}},
+		Data:       map[string]string{rateLimitSubmissionsKey: string(submissions)},
+	})
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+	ctx = context.WithValue(ctx, auth.KubeKey, kubeClient)
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(8)
+	bindings := []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "deduplicated", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:       wfv1.Event{Selector: "true"},
+				Submit:      &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+				Deduplicate: &wfv1.EventDeduplication{Key: "payload.id", Window: "1h"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "rate-limited", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:     wfv1.Event{Selector: "true"},
+				Submit:    &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+				RateLimit: &wfv1.EventRateLimit{MaxSubmissions: 2, Interval: "1h"},
+			},
+		},
+	}
+	dispatch := func(id string) []wfv1.Workflow {
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, bindings, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"id": "` + id + `"}`)})
+		require.NoError(t, err)
+		require.NoError(t, operation.Dispatch(ctx))
+		list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflowEventBinding + "=deduplicated"})
+		require.NoError(t, err)
+		return list.Items
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		assert.Len(t, dispatch("recent"), 3)
+		assert.Equal(t, `Normal WorkflowEventBindingDuplicate suppressed duplicate event, workflow `+deduplicatedName("deduplicated", "recent", 0)+` was already submitted for the idempotency key "recent"`, <-recorder.Events)
+		assert.Equal(t, "Warning WorkflowEventBindingRateLimited suppressed event, 2 workflows were already submitted in the last 1h, the maximum", <-recorder.Events)
+	})
+	t.Run("OutsideWindow", func(t *testing.T) {
+		workflows := dispatch("old")
+		require.Len(t, workflows, 4)
+		assert.Equal(t, "Warning WorkflowEventBindingRateLimited suppressed event, 2 workflows were already submitted in the last 1h, the maximum", <-recorder.Events)
+		_, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, deduplicatedName("deduplicated", "old", 1), metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("GenerationDeleted", func(t *testing.T) {
+		require.Len(t, dispatch("deleted"), 5)
+		assert.Equal(t, "Warning WorkflowEventBindingRateLimited suppressed event, 2 workflows were already submitted in the last 1h, the maximum", <-recorder.Events)
+		_, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, deduplicatedName("deduplicated", "deleted", 2), metav1.GetOptions{})
+		require.NoError(t, err, "the generation after the latest is submitted")
+	})
+	t.Run("SubmittedConcurrently", func(t *testing.T) {
+		name := deduplicatedName("deduplicated", "concurrent", 0)
+		client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.(k8stesting.CreateAction).GetObject().(*wfv1.Workflow).Name == name {
+				return true, nil, apierr.NewAlreadyExists(wfv1.WorkflowSchemaGroupVersionKind.GroupVersion().WithResource("workflows").GroupResource(), name)
+			}
+			return false, nil, nil
+		})
+		assert.Len(t, dispatch("concurrent"), 5)
+		assert.Equal(t, `Normal WorkflowEventBindingDuplicate suppressed duplicate event, workflow `+name+` was already submitted for the idempotency key "concurrent"`, <-recorder.Events)
+		assert.Equal(t, "Warning WorkflowEventBindingRateLimited suppressed event, 2 workflows were already submitted in the last 1h, the maximum", <-recorder.Events)
+	})
+}
+
+func TestDispatchRateLimit(t *testing.T) {
+	client := fake.NewSimpleClientset(&wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+	})
+	kubeClient := kubefake.NewSimpleClientset()
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.WfKey, client)
+	ctx = context.WithValue(ctx, auth.KubeKey, kubeClient)
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(2)
+	bindings := []wfv1.WorkflowEventBinding{{
+		ObjectMeta: metav1.ObjectMeta{Name: "rate-limited", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event:     wfv1.Event{Selector: "true"},
+			Submit:    &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			RateLimit: &wfv1.EventRateLimit{MaxSubmissions: 2, Interval: "1h"},
+		},
+	}}
+	failCreate := true
+	client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if failCreate {
+			failCreate = false
+			return true, nil, apierr.NewForbidden(wfv1.WorkflowSchemaGroupVersionKind.GroupVersion().WithResource("workflows").GroupResource(), "", errors.New("quota exceeded"))
+		}
+		return false, nil, nil
+	})
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, bindings, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	require.Error(t, operation.Dispatch(ctx))
+	<-recorder.Events
+	cm, err := kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "rate-limited-rate-limit", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.JSONEq(t, "[]", cm.Data[rateLimitSubmissionsKey], "the submission of a workflow that failed to be created is not counted")
+
+	for range 3 {
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, bindings, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{}`)})
+		require.NoError(t, err)
+		require.NoError(t, operation.Dispatch(ctx))
+	}
+
+	list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+	assert.Equal(t, "Warning WorkflowEventBindingRateLimited suppressed event, 2 workflows were already submitted in the last 1h, the maximum", <-recorder.Events)
+	cm, err = kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "rate-limited-rate-limit", metav1.GetOptions{})
+	require.NoError(t, err)
+	var submissions []time.Time
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[rateLimitSubmissionsKey]), &submissions))
+	assert.Len(t, submissions, 2)
+	assert.Equal(t, "rate-limited", cm.OwnerReferences[0].Name)
+}
+
 func Test_populateWorkflowMetadata(t *testing.T) {
 	// set-up
 	client := fake.NewSimpleClientset(
@@ -0,0 +1,213 @@
+package dispatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// suppression is why the submission of a workflow for an event was suppressed, reported as a Kubernetes event
+type suppression struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+// rateLimitSubmissionsKey is the key of the rate limit config map holding the times of the binding's submissions
+const rateLimitSubmissionsKey = "submissions"
+
+// idempotencyKeyLabel hashes an idempotency key into a label value, as keys can be longer than label values, and
+// contain characters they cannot
+func idempotencyKeyLabel(key string) string {
+	return fmt.Sprintf("%x", sha256.Sum224([]byte(key)))
+}
+
+// deduplicatedName is the name of the workflow submitted for an idempotency key, which is the same for every event
+// with the key, so that only one of them can be created. Each generation is the name used once the workflow of the
+// previous one is older than the window.
+func deduplicatedName(binding, idempotencyKey string, generation int) string {
+	prefix := binding
+	if len(prefix) > 40 {
+		prefix = prefix[:40]
+	}
+	name := fmt.Sprintf("%s-%x", prefix, sha256.Sum256([]byte(binding+"\x00"+idempotencyKey)))[:len(prefix)+17]
+	if generation > 0 {
+		name = fmt.Sprintf("%s-%d", name, generation)
+	}
+	return name
+}
+
+func duplicate(name, idempotencyKey string) *suppression {
+	return &suppression{
+		eventType: corev1.EventTypeNormal,
+		reason:    "WorkflowEventBindingDuplicate",
+		message:   fmt.Sprintf("suppressed duplicate event, workflow %s was already submitted for the idempotency key %q", name, idempotencyKey),
+	}
+}
+
+// deduplicatedGeneration returns the generation of a workflow submitted for an idempotency key, from its name
+func deduplicatedGeneration(binding, idempotencyKey, name string) (int, bool) {
+	suffix, ok := strings.CutPrefix(name, deduplicatedName(binding, idempotencyKey, 0))
+	if !ok {
+		return 0, false
+	}
+	if suffix == "" {
+		return 0, true
+	}
+	generation, err := strconv.Atoi(strings.TrimPrefix(suffix, "-"))
+	return generation, err == nil && generation > 0
+}
+
+// deduplicate returns the name to submit the workflow for the event with, or why it is suppressed, because a workflow
+// was already submitted for an event with the same idempotency key within the window. The workflows submitted for the
+// key are found by their labels, and the new one is named with the generation after the latest of them, so that the
+// generations of deleted workflows are not reused. Creating the workflow with the name fails if one was submitted for
+// the same key since, which is also a duplicate.
+func deduplicate(ctx context.Context, workflows wfclientset.WorkflowInterface, wfeb wfv1.WorkflowEventBinding, idempotencyKey string, now time.Time) (string, *suppression, error) {
+	deduplicate := wfeb.Spec.Deduplicate
+	var window time.Duration
+	if deduplicate.Window != "" {
+		var err error
+		window, err = time.ParseDuration(deduplicate.Window)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parse deduplicate window: %w", err)
+		}
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		common.LabelKeyWorkflowEventBinding:        wfeb.Name,
+		common.LabelKeyWorkflowEventIdempotencyKey: idempotencyKeyLabel(idempotencyKey),
+	})
+	list, err := workflows.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list workflows submitted for the idempotency key: %w", err)
+	}
+	latest := -1
+	var newest *wfv1.Workflow
+	for i, wf := range list.Items {
+		if generation, ok := deduplicatedGeneration(wfeb.Name, idempotencyKey, wf.Name); ok && generation > latest {
+			latest = generation
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&wf.CreationTimestamp) {
+			newest = &list.Items[i]
+		}
+	}
+	if newest != nil && (deduplicate.Window == "" || now.Sub(newest.CreationTimestamp.Time) < window) {
+		return "", duplicate(newest.Name, idempotencyKey), nil
+	}
+	return deduplicatedName(wfeb.Name, idempotencyKey, latest+1), nil, nil
+}
+
+// rateLimit returns why the submission of a workflow for the event is suppressed, if the binding reached its rate
+// limit, and otherwise counts the submission. The submission is counted before the workflow is created, so that
+// concurrent events cannot exceed the limit, and must be released if it is not created.
+func rateLimit(ctx context.Context, kube kubernetes.Interface, wfeb wfv1.WorkflowEventBinding, now time.Time) (*suppression, error) {
+	rateLimit := wfeb.Spec.RateLimit
+	if rateLimit.MaxSubmissions < 1 {
+		return nil, fmt.Errorf("rateLimit.maxSubmissions must be greater than zero")
+	}
+	interval, err := time.ParseDuration(rateLimit.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rateLimit interval: %w", err)
+	}
+	var suppressed *suppression
+	err = updateSubmissions(ctx, kube, wfeb, func(submissions []time.Time) []time.Time {
+		suppressed = nil
+		var recent []time.Time
+		for _, submitted := range submissions {
+			if now.Sub(submitted) < interval {
+				recent = append(recent, submitted)
+			}
+		}
+		if int32(len(recent)) >= rateLimit.MaxSubmissions {
+			suppressed = &suppression{
+				eventType: corev1.EventTypeWarning,
+				reason:    "WorkflowEventBindingRateLimited",
+				message:   fmt.Sprintf("suppressed event, %d workflows were already submitted in the last %s, the maximum", len(recent), rateLimit.Interval),
+			}
+			return nil
+		}
+		return append(recent, now)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count submission for the rate limit: %w", err)
+	}
+	return suppressed, nil
+}
+
+// releaseRateLimit stops counting a submission for the rate limit, because its workflow was not created
+func releaseRateLimit(ctx context.Context, kube kubernetes.Interface, wfeb wfv1.WorkflowEventBinding, now time.Time) error {
+	err := updateSubmissions(ctx, kube, wfeb, func(submissions []time.Time) []time.Time {
+		if i := slices.IndexFunc(submissions, now.Equal); i >= 0 {
+			return slices.Delete(submissions, i, i+1)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release submission for the rate limit: %w", err)
+	}
+	return nil
+}
+
+// updateSubmissions updates the times of the binding's submissions within the rate limit interval, which are kept in a
+// config map owned by the binding, and updated with its resource version, so that concurrent submissions are counted
+// once each. The config map is left as it is if update returns nil.
+func updateSubmissions(ctx context.Context, kube kubernetes.Interface, wfeb wfv1.WorkflowEventBinding, update func(submissions []time.Time) []time.Time) error {
+	configMaps := kube.CoreV1().ConfigMaps(wfeb.Namespace)
+	name := wfeb.Name + "-rate-limit"
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierr.IsConflict(err) || apierr.IsAlreadyExists(err)
+	}, func() error {
+		cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		exists := err == nil
+		if apierr.IsNotFound(err) {
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       wfeb.Namespace,
+				Labels:          map[string]string{common.LabelKeyWorkflowEventBinding: wfeb.Name},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&wfeb, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowEventBindingKind))},
+			}}
+		} else if err != nil {
+			return fmt.Errorf("failed to get rate limit config map: %w", err)
+		} else if cm.Labels[common.LabelKeyWorkflowEventBinding] != wfeb.Name {
+			return fmt.Errorf("config map %s is not the rate limit of the binding", name)
+		}
+		var submissions []time.Time
+		if data := cm.Data[rateLimitSubmissionsKey]; data != "" {
+			if err := json.Unmarshal([]byte(data), &submissions); err != nil {
+				return fmt.Errorf("failed to unmarshal rate limit submissions: %w", err)
+			}
+		}
+		submissions = update(submissions)
+		if submissions == nil {
+			return nil
+		}
+		data, err := json.Marshal(submissions)
+		if err != nil {
+			return err
+		}
+		cm.Data = map[string]string{rateLimitSubmissionsKey: string(data)}
+		if exists {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		} else {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		}
+		return err
+	})
+}
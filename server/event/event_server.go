@@ -2,16 +2,20 @@ package event
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/event/dispatch"
+	"github.com/argoproj/argo-workflows/v3/server/event/source"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
@@ -73,15 +77,7 @@ func (s *Controller) Run(ctx context.Context, stopCh <-chan struct{}) {
 }
 
 func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventRequest) (*eventpkg.EventResponse, error) {
-	options := metav1.ListOptions{}
-	s.instanceIDService.With(&options)
-
-	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).List(ctx, options)
-	if err != nil {
-		return nil, sutils.ToStatusError(err, codes.Internal)
-	}
-
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	operation, err := s.newOperation(ctx, req.Namespace, req.Discriminator, req.Payload)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -101,6 +97,48 @@ func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventReques
 	}
 }
 
+// Consume dispatches the messages of an event source to the WorkflowEventBindings of its namespace, until the context
+// is done. A message is only acknowledged once it is dispatched, so is dispatched at least once, unless it fails to
+// dispatch on each of its maximum deliveries, when it is dropped.
+func (s *Controller) Consume(ctx context.Context, eventSource config.EventSource, src source.Source) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	src.Run(ctx, func(ctx context.Context, msg source.Message) error {
+		err := s.dispatchMessage(ctx, eventSource.Namespace, eventSource.GetDiscriminator(), msg)
+		if err != nil && msg.Deliveries >= eventSource.GetMaxDeliveries() {
+			logger.WithError(err).WithFields(logging.Fields{"eventSource": eventSource.Name, "deliveries": msg.Deliveries}).Error(ctx, "Dropping message that failed to dispatch")
+			return nil
+		}
+		return err
+	})
+}
+
+func (s *Controller) dispatchMessage(ctx context.Context, namespace, discriminator string, msg source.Message) error {
+	// the metadata of a message is available to expressions in the same way as the headers of a request
+	ctx = metadata.NewIncomingContext(ctx, msg.Metadata)
+	payload := &wfv1.Item{Value: msg.Data}
+	if !json.Valid(msg.Data) {
+		// a payload that is not JSON is a string
+		payload.Value, _ = json.Marshal(string(msg.Data))
+	}
+	operation, err := s.newOperation(ctx, namespace, discriminator, payload)
+	if err != nil {
+		return err
+	}
+	return operation.Dispatch(ctx)
+}
+
+func (s *Controller) newOperation(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) (*dispatch.Operation, error) {
+	options := metav1.ListOptions{}
+	s.instanceIDService.With(&options)
+
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(namespace).List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(ctx, namespace), list.Items, namespace, discriminator, payload)
+}
+
 func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg.ListWorkflowEventBindingsRequest) (*wfv1.WorkflowEventBindingList, error) {
 	listOptions := metav1.ListOptions{}
 	if in.ListOptions != nil {
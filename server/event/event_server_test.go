@@ -3,19 +3,27 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	fakekube "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/event/source"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 )
 
@@ -61,3 +69,42 @@ func TestController(t *testing.T) {
 		require.EqualError(t, err, "rpc error: code = Internal desc = failed to create workflow template expression environment: json: error calling MarshalJSON for type *v1alpha1.Item: invalid character '!' looking for beginning of value")
 	})
 }
+
+func TestControllerConsume(t *testing.T) {
+	instanceIDLabels := map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}
+	clientset := fake.NewSimpleClientset(
+		&wfv1.WorkflowEventBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns", Labels: instanceIDLabels},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: `discriminator == "my-source" && payload.id == "a" && metadata["x-trace-id"][0] == "1"`},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: instanceIDLabels}},
+	)
+	// the first submission fails, so the message is redelivered
+	failed := false
+	clientset.PrependReactor("create", "workflows", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if !failed {
+			failed = true
+			return true, nil, errors.New("admission webhook denied the request")
+		}
+		return false, nil, nil
+	})
+	ctx, cancel := context.WithCancel(context.WithValue(logging.TestContext(t.Context()), auth.WfKey, clientset))
+	defer cancel()
+	s := NewController(ctx, instanceid.NewService("my-instanceid"), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), 1, 1, false)
+	src := source.NewMemory()
+	go s.Consume(ctx, config.EventSource{Name: "my-source", Namespace: "my-ns"}, src)
+
+	src.Publish(source.Message{Metadata: map[string][]string{"x-trace-id": {"1"}}, Data: []byte(`{"id": "a"}`)})
+	src.Publish(source.Message{Data: []byte("not JSON")})
+	require.Eventually(t, func() bool { return len(src.Acked()) == 2 }, 10*time.Second, 10*time.Millisecond)
+
+	assert.True(t, failed)
+	assert.Equal(t, 2, src.Acked()[0].Deliveries, "redelivered once the submission failed")
+	assert.Equal(t, 5, src.Acked()[1].Deliveries, "dropped after failing to dispatch on each delivery")
+	list, err := clientset.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1, "one workflow submitted once the redelivered message was dispatched")
+}
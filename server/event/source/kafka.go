@@ -0,0 +1,139 @@
+package source
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// kafkaSource consumes a Kafka topic as a member of a consumer group, so that each partition is consumed by one
+// replica of the Argo Server, committing the offset of each record once it is handled
+type kafkaSource struct {
+	name string
+	cfg  config.KafkaEventSource
+	opts []kgo.Opt
+}
+
+// kafkaClient is the part of the Kafka client the source uses
+type kafkaClient interface {
+	PollFetches(ctx context.Context) kgo.Fetches
+	CommitRecords(ctx context.Context, rs ...*kgo.Record) error
+}
+
+func newKafkaSource(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, cfg config.KafkaEventSource) (*kafkaSource, error) {
+	if len(cfg.Brokers) == 0 || cfg.Topic == "" {
+		return nil, fmt.Errorf("event source %q: kafka.brokers and kafka.topic are required", name)
+	}
+	group := cfg.ConsumerGroup
+	if group == "" {
+		group = "argo-workflows-" + name
+	}
+	var startOffset kgo.Offset
+	switch cfg.StartOffset {
+	case "", "newest":
+		startOffset = kgo.NewOffset().AtEnd()
+	case "oldest":
+		startOffset = kgo.NewOffset().AtStart()
+	default:
+		return nil, fmt.Errorf("event source %q: kafka.startOffset must be newest or oldest, not %q", name, cfg.StartOffset)
+	}
+	s := &kafkaSource{name: name, cfg: cfg, opts: []kgo.Opt{
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.ClientID("argo-workflows"),
+		kgo.ConsumerGroup(group),
+		kgo.ConsumeTopics(cfg.Topic),
+		kgo.ConsumeResetOffset(startOffset),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		// offsets are committed once their records are handled
+		kgo.DisableAutoCommit(),
+	}}
+	if cfg.TLS {
+		s.opts = append(s.opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	username, password, err := credentials(ctx, kubeClient, namespace, cfg.UsernameSecret, cfg.PasswordSecret)
+	if err != nil {
+		return nil, fmt.Errorf("event source %q: %w", name, err)
+	}
+	if username != "" {
+		s.opts = append(s.opts, kgo.SASL(plain.Auth{User: username, Pass: password}.AsMechanism()))
+	}
+	return s, nil
+}
+
+func (s *kafkaSource) Run(ctx context.Context, handle Handler) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"eventSource": s.name, "topic": s.cfg.Topic})
+	client, err := kgo.NewClient(s.opts...)
+	if err != nil {
+		logger.WithError(err).Error(ctx, "Failed to create Kafka client")
+		return
+	}
+	// leaving the group on close hands its partitions to the other replicas straight away
+	defer client.Close()
+	s.consume(ctx, client, handle)
+}
+
+// consume handles the records of the partitions assigned to the client until the context is done. The client
+// reconnects, and rejoins the group, by itself.
+func (s *kafkaSource) consume(ctx context.Context, client kafkaClient, handle Handler) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"eventSource": s.name, "topic": s.cfg.Topic})
+	for {
+		fetches := client.PollFetches(ctx)
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(_ string, partition int32, err error) {
+			logger.WithError(err).WithField("partition", partition).Warn(ctx, "Failed to fetch Kafka partition")
+		})
+		for iter := fetches.RecordIter(); !iter.Done(); {
+			record := iter.Next()
+			if !s.handleRecord(ctx, handle, record) {
+				return
+			}
+			// a commit fails if the partition was assigned to another member since, which then consumes the record
+			// again
+			if err := client.CommitRecords(ctx, record); err != nil {
+				logger.WithError(err).WithFields(logging.Fields{"partition": record.Partition, "offset": record.Offset}).Warn(ctx, "Failed to commit Kafka offset")
+			}
+		}
+	}
+}
+
+// handleRecord handles a record, redelivering it after the redelivery delay until it is handled. It returns false if
+// the context is done first.
+func (s *kafkaSource) handleRecord(ctx context.Context, handle Handler, record *kgo.Record) bool {
+	msg := s.message(record)
+	for {
+		msg.Deliveries++
+		if handle(ctx, msg) == nil {
+			return true
+		}
+		if !sleep(ctx, redeliveryDelay) {
+			return false
+		}
+	}
+}
+
+func (s *kafkaSource) message(record *kgo.Record) Message {
+	metadata := map[string][]string{
+		"x-kafka-topic":     {record.Topic},
+		"x-kafka-partition": {strconv.Itoa(int(record.Partition))},
+		"x-kafka-offset":    {strconv.FormatInt(record.Offset, 10)},
+	}
+	if record.Key != nil {
+		metadata["x-kafka-key"] = []string{string(record.Key)}
+	}
+	for _, header := range record.Headers {
+		k := strings.ToLower(header.Key)
+		metadata[k] = append(metadata[k], string(header.Value))
+	}
+	return Message{Metadata: metadata, Data: record.Value}
+}
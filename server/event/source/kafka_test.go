@@ -0,0 +1,108 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// fakeKafkaClient returns each of its fetches once, and then cancels the context
+type fakeKafkaClient struct {
+	fetches []kgo.Fetches
+	cancel  context.CancelFunc
+	commits []int64
+}
+
+func (c *fakeKafkaClient) PollFetches(ctx context.Context) kgo.Fetches {
+	if len(c.fetches) == 0 {
+		c.cancel()
+		return kgo.NewErrFetch(ctx.Err())
+	}
+	fetches := c.fetches[0]
+	c.fetches = c.fetches[1:]
+	return fetches
+}
+
+func (c *fakeKafkaClient) CommitRecords(_ context.Context, rs ...*kgo.Record) error {
+	for _, r := range rs {
+		c.commits = append(c.commits, r.Offset+1)
+	}
+	return nil
+}
+
+func fetchRecords(records ...*kgo.Record) kgo.Fetches {
+	return kgo.Fetches{{Topics: []kgo.FetchTopic{{Topic: "events", Partitions: []kgo.FetchPartition{{Partition: 0, Records: records}}}}}}
+}
+
+func TestNewKafkaSource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Required", func(t *testing.T) {
+		_, err := newKafkaSource(ctx, nil, "argo", "my-source", config.KafkaEventSource{Topic: "events"})
+		require.EqualError(t, err, `event source "my-source": kafka.brokers and kafka.topic are required`)
+	})
+	t.Run("StartOffset", func(t *testing.T) {
+		_, err := newKafkaSource(ctx, nil, "argo", "my-source", config.KafkaEventSource{Brokers: []string{"kafka:9092"}, Topic: "events", StartOffset: "latest"})
+		require.EqualError(t, err, `event source "my-source": kafka.startOffset must be newest or oldest, not "latest"`)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		src, err := newKafkaSource(ctx, nil, "argo", "my-source", config.KafkaEventSource{Brokers: []string{"kafka:9092"}, Topic: "events", StartOffset: "oldest", TLS: true})
+		require.NoError(t, err)
+		client, err := kgo.NewClient(src.opts...)
+		require.NoError(t, err)
+		defer client.Close()
+		assert.Equal(t, "argo-workflows-my-source", client.OptValue(kgo.ConsumerGroup))
+		assert.Contains(t, client.OptValue(kgo.ConsumeTopics), "events")
+	})
+}
+
+func TestKafkaSource(t *testing.T) {
+	defer func(d time.Duration) { redeliveryDelay = d }(redeliveryDelay)
+	redeliveryDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(logging.TestContext(t.Context()))
+	defer cancel()
+	src, err := newKafkaSource(ctx, nil, "argo", "my-source", config.KafkaEventSource{Brokers: []string{"kafka:9092"}, Topic: "events"})
+	require.NoError(t, err)
+	client := &fakeKafkaClient{cancel: cancel, fetches: []kgo.Fetches{
+		fetchRecords(
+			&kgo.Record{Topic: "events", Offset: 0, Key: []byte("k"), Value: []byte(`{"id": "a"}`), Headers: []kgo.RecordHeader{{Key: "X-Trace-Id", Value: []byte("1")}}},
+			&kgo.Record{Topic: "events", Offset: 1, Value: []byte(`{"id": "b"}`)},
+		),
+		fetchRecords(&kgo.Record{Topic: "events", Offset: 2, Value: []byte(`{"id": "c"}`)}),
+	}}
+
+	var handled []Message
+	src.consume(ctx, client, func(ctx context.Context, msg Message) error {
+		handled = append(handled, msg)
+		if len(handled) == 2 {
+			return errors.New("failed to dispatch")
+		}
+		return nil
+	})
+
+	require.Len(t, handled, 4)
+	assert.Equal(t, Message{
+		Metadata: map[string][]string{
+			"x-kafka-topic":     {"events"},
+			"x-kafka-partition": {"0"},
+			"x-kafka-offset":    {"0"},
+			"x-kafka-key":       {"k"},
+			"x-trace-id":        {"1"},
+		},
+		Data:       []byte(`{"id": "a"}`),
+		Deliveries: 1,
+	}, handled[0])
+	assert.Equal(t, []byte(`{"id": "b"}`), handled[1].Data)
+	assert.Equal(t, []byte(`{"id": "b"}`), handled[2].Data, "redelivered once it failed")
+	assert.Equal(t, 2, handled[2].Deliveries)
+	assert.Equal(t, []byte(`{"id": "c"}`), handled[3].Data)
+	assert.Equal(t, []int64{1, 2, 3}, client.commits, "offsets are only committed once handled")
+}
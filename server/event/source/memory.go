@@ -0,0 +1,82 @@
+package source
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Memory is an in-process message broker, standing in for a real one in tests
+type Memory struct {
+	mu      sync.Mutex
+	pending []Message
+	acked   []Message
+	notify  chan struct{}
+	// deliveries of the message at the head of the queue
+	deliveries int
+	// redeliveryDelay is much shorter than that of a real broker, to keep tests quick
+	redeliveryDelay time.Duration
+}
+
+var _ Source = &Memory{}
+
+func NewMemory() *Memory {
+	return &Memory{notify: make(chan struct{}, 1), redeliveryDelay: 10 * time.Millisecond}
+}
+
+// Publish queues a message to be consumed
+func (m *Memory) Publish(msg Message) {
+	m.mu.Lock()
+	m.pending = append(m.pending, msg)
+	m.mu.Unlock()
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Acked returns the messages that were handled, in the order they were acknowledged
+func (m *Memory) Acked() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.acked...)
+}
+
+func (m *Memory) Run(ctx context.Context, handle Handler) {
+	for {
+		msg, ok := m.next()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.notify:
+				continue
+			}
+		}
+		m.mu.Lock()
+		m.deliveries++
+		msg.Deliveries = m.deliveries
+		m.mu.Unlock()
+		if err := handle(ctx, msg); err != nil {
+			// the message stays at the head of the queue, so is redelivered first
+			if !sleep(ctx, m.redeliveryDelay) {
+				return
+			}
+			continue
+		}
+		m.mu.Lock()
+		m.pending = m.pending[1:]
+		m.deliveries = 0
+		m.acked = append(m.acked, msg)
+		m.mu.Unlock()
+	}
+}
+
+func (m *Memory) next() (Message, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.pending) == 0 {
+		return Message{}, false
+	}
+	return m.pending[0], true
+}
@@ -0,0 +1,122 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// natsSource consumes a NATS JetStream stream with a durable pull consumer that explicitly acknowledges messages
+type natsSource struct {
+	name     string
+	cfg      config.NATSEventSource
+	consumer string
+	username string
+	password string
+}
+
+func newNATSSource(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, cfg config.NATSEventSource) (*natsSource, error) {
+	if cfg.URL == "" || cfg.Stream == "" {
+		return nil, fmt.Errorf("event source %q: nats.url and nats.stream are required", name)
+	}
+	s := &natsSource{name: name, cfg: cfg, consumer: cfg.Consumer}
+	if s.consumer == "" {
+		s.consumer = "argo-workflows-" + name
+	}
+	var err error
+	s.username, s.password, err = credentials(ctx, kubeClient, namespace, cfg.UsernameSecret, cfg.PasswordSecret)
+	if err != nil {
+		return nil, fmt.Errorf("event source %q: %w", name, err)
+	}
+	return s, nil
+}
+
+func (s *natsSource) Run(ctx context.Context, handle Handler) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"eventSource": s.name, "stream": s.cfg.Stream})
+	for {
+		err := s.consume(ctx, handle)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.WithError(err).Warn(ctx, "Failed to consume NATS stream, reconnecting")
+		if !sleep(ctx, redeliveryDelay) {
+			return
+		}
+	}
+}
+
+func (s *natsSource) consume(ctx context.Context, handle Handler) error {
+	var opts []nats.Option
+	if s.username != "" {
+		opts = append(opts, nats.UserInfo(s.username, s.password))
+	}
+	nc, err := nats.Connect(s.cfg.URL, opts...)
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return err
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, s.cfg.Stream, jetstream.ConsumerConfig{
+		Durable:       s.consumer,
+		FilterSubject: s.cfg.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create consumer %q: %w", s.consumer, err)
+	}
+	messages, err := consumer.Messages()
+	if err != nil {
+		return err
+	}
+	defer messages.Stop()
+	go func() {
+		// unblocks Next once the context is done
+		<-ctx.Done()
+		messages.Stop()
+	}()
+	for {
+		msg, err := messages.Next()
+		if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+		if err := handle(ctx, natsMessage(msg)); err != nil {
+			if err := msg.NakWithDelay(redeliveryDelay); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := msg.DoubleAck(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func natsMessage(msg jetstream.Msg) Message {
+	metadata := map[string][]string{"x-nats-subject": {msg.Subject()}}
+	deliveries := 1
+	if meta, err := msg.Metadata(); err == nil {
+		metadata["x-nats-stream"] = []string{meta.Stream}
+		metadata["x-nats-sequence"] = []string{strconv.FormatUint(meta.Sequence.Stream, 10)}
+		deliveries = int(meta.NumDelivered)
+	}
+	for k, v := range msg.Headers() {
+		k = strings.ToLower(k)
+		metadata[k] = append(metadata[k], v...)
+	}
+	return Message{Metadata: metadata, Data: msg.Data(), Deliveries: deliveries}
+}
@@ -0,0 +1,390 @@
+package source
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// jetStreamServer is an in-process NATS server with a single JetStream stream. It implements only as much of the
+// protocol and of the JetStream API as a durable pull consumer needs: creating the consumer, pulling messages, and
+// acknowledging them.
+type jetStreamServer struct {
+	listener net.Listener
+	stream   string
+
+	mu       sync.Mutex
+	subs     []*natsSubscription
+	messages []*streamMessage
+	consumer string
+	filter   string
+	// pull is the connection and inbox of the latest pull request, and credit how many more messages it can be sent
+	pull        *natsSubscription
+	credit      int
+	consumerSeq uint64
+	acks        []uint64
+}
+
+type natsSubscription struct {
+	conn    *natsConn
+	subject string
+	sid     string
+}
+
+type natsConn struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (c *natsConn) write(format string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, _ = fmt.Fprintf(c.conn, format, args...)
+}
+
+type streamMessage struct {
+	seq        uint64
+	subject    string
+	headers    map[string]string
+	data       string
+	deliveries int
+	inFlight   bool
+	acked      bool
+	// redeliverAt is when a message that was negatively acknowledged can be redelivered
+	redeliverAt time.Time
+}
+
+func newJetStreamServer(t *testing.T, stream string) *jetStreamServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &jetStreamServer{listener: listener, stream: stream}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { _ = conn.Close() })
+			go s.serve(&natsConn{conn: conn})
+		}
+	}()
+	return s
+}
+
+func (s *jetStreamServer) url() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+// publish stores a message in the stream
+func (s *jetStreamServer) publish(subject string, headers map[string]string, data string) {
+	s.mu.Lock()
+	s.messages = append(s.messages, &streamMessage{seq: uint64(len(s.messages) + 1), subject: subject, headers: headers, data: data})
+	s.mu.Unlock()
+	s.deliver()
+}
+
+// acknowledged returns the stream sequences of the messages that were acknowledged, in the order they were
+func (s *jetStreamServer) acknowledged() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint64(nil), s.acks...)
+}
+
+func (s *jetStreamServer) serve(c *natsConn) {
+	defer func() { _ = c.conn.Close() }()
+	c.write("INFO {\"server_id\":\"test\",\"version\":\"2.10.0\",\"proto\":1,\"headers\":true,\"jetstream\":true,\"max_payload\":1048576}\r\n")
+	r := bufio.NewReader(c.conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			continue
+		}
+		switch strings.ToUpper(args[0]) {
+		case "PING":
+			c.write("PONG\r\n")
+		case "SUB":
+			s.mu.Lock()
+			s.subs = append(s.subs, &natsSubscription{conn: c, subject: args[1], sid: args[len(args)-1]})
+			s.mu.Unlock()
+		case "UNSUB":
+			s.mu.Lock()
+			for i, sub := range s.subs {
+				if sub.conn == c && sub.sid == args[1] {
+					s.subs = append(s.subs[:i], s.subs[i+1:]...)
+					break
+				}
+			}
+			s.mu.Unlock()
+		case "PUB", "HPUB":
+			// the sizes end the line, HPUB giving that of the headers before the total, and the reply subject is
+			// optional, coming between the subject and the sizes
+			sizes := args[len(args)-1:]
+			if strings.EqualFold(args[0], "HPUB") {
+				sizes = args[len(args)-2:]
+			}
+			reply := ""
+			if len(args) > 2+len(sizes) {
+				reply = args[2]
+			}
+			headerSize := 0
+			if len(sizes) == 2 {
+				headerSize, _ = strconv.Atoi(sizes[0])
+			}
+			total, err := strconv.Atoi(sizes[len(sizes)-1])
+			if err != nil {
+				return
+			}
+			payload := make([]byte, total+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+			s.handle(c, args[1], reply, string(payload[headerSize:total]))
+		}
+	}
+}
+
+func (s *jetStreamServer) handle(c *natsConn, subject, reply, payload string) {
+	switch {
+	case strings.HasPrefix(subject, "$JS.API.CONSUMER.CREATE."):
+		var req struct {
+			Config map[string]any `json:"config"`
+		}
+		_ = json.Unmarshal([]byte(payload), &req)
+		s.mu.Lock()
+		s.consumer, _ = req.Config["durable_name"].(string)
+		s.filter, _ = req.Config["filter_subject"].(string)
+		s.mu.Unlock()
+		resp, _ := json.Marshal(map[string]any{
+			"type":        "io.nats.jetstream.api.v1.consumer_create_response",
+			"stream_name": s.stream,
+			"name":        req.Config["durable_name"],
+			"created":     time.Now().UTC(),
+			"config":      req.Config,
+		})
+		s.route(reply, "", nil, string(resp))
+	case strings.HasPrefix(subject, "$JS.API.CONSUMER.MSG.NEXT."):
+		var req struct {
+			Batch int `json:"batch"`
+		}
+		_ = json.Unmarshal([]byte(payload), &req)
+		s.mu.Lock()
+		s.pull = &natsSubscription{conn: c, subject: reply}
+		s.credit += req.Batch
+		s.mu.Unlock()
+		s.deliver()
+	case strings.HasPrefix(subject, "$JS.ACK."):
+		seq, _ := strconv.ParseUint(strings.Split(subject, ".")[5], 10, 64)
+		s.mu.Lock()
+		msg := s.messages[seq-1]
+		msg.inFlight = false
+		switch {
+		case payload == "+ACK":
+			msg.acked = true
+			s.acks = append(s.acks, seq)
+		case strings.HasPrefix(payload, "-NAK"):
+			var opts struct {
+				Delay time.Duration `json:"delay"`
+			}
+			_ = json.Unmarshal([]byte(strings.TrimPrefix(payload, "-NAK")), &opts)
+			msg.redeliverAt = time.Now().Add(opts.Delay)
+			time.AfterFunc(opts.Delay, s.deliver)
+		}
+		s.mu.Unlock()
+		if reply != "" {
+			s.route(reply, "", nil, "")
+		}
+		s.deliver()
+	default:
+		s.route(subject, reply, nil, payload)
+	}
+}
+
+// deliver sends the messages that can be delivered to the latest pull request, while it has credit
+func (s *jetStreamServer) deliver() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pull == nil || s.consumer == "" {
+		return
+	}
+	for _, msg := range s.messages {
+		if s.credit == 0 {
+			return
+		}
+		if msg.acked || msg.inFlight || time.Now().Before(msg.redeliverAt) || !subjectMatches(s.filter, msg.subject) {
+			continue
+		}
+		msg.deliveries++
+		msg.inFlight = true
+		s.consumerSeq++
+		s.credit--
+		reply := fmt.Sprintf("$JS.ACK.%s.%s.%d.%d.%d.%d.0", s.stream, s.consumer, msg.deliveries, msg.seq, s.consumerSeq, time.Now().UnixNano())
+		// a pulled message keeps its subject, and is sent to the subscription of the inbox of the pull request
+		s.write(s.pull.conn, msg.subject, s.sid(s.pull.conn, s.pull.subject), reply, msg.headers, msg.data)
+	}
+}
+
+// route sends a message to each subscription of its subject
+func (s *jetStreamServer) route(subject, reply string, headers map[string]string, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		if subjectMatches(sub.subject, subject) {
+			s.write(sub.conn, subject, sub.sid, reply, headers, data)
+		}
+	}
+}
+
+// sid returns the id of the subscription of a connection to a subject
+func (s *jetStreamServer) sid(c *natsConn, subject string) string {
+	for _, sub := range s.subs {
+		if sub.conn == c && subjectMatches(sub.subject, subject) {
+			return sub.sid
+		}
+	}
+	return ""
+}
+
+// write sends a message to a subscription of a connection
+func (s *jetStreamServer) write(c *natsConn, subject, sid, reply string, headers map[string]string, data string) {
+	if reply != "" {
+		reply += " "
+	}
+	if len(headers) == 0 {
+		c.write("MSG %s %s %s%d\r\n%s\r\n", subject, sid, reply, len(data), data)
+		return
+	}
+	header := "NATS/1.0\r\n"
+	for k, v := range headers {
+		header += k + ": " + v + "\r\n"
+	}
+	header += "\r\n"
+	c.write("HMSG %s %s %s%d %d\r\n%s%s\r\n", subject, sid, reply, len(header), len(header)+len(data), header, data)
+}
+
+// subjectMatches returns whether a subject matches a pattern, which may contain the * and > wildcards
+func subjectMatches(pattern, subject string) bool {
+	if pattern == "" {
+		return true
+	}
+	patternTokens, subjectTokens := strings.Split(pattern, "."), strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		switch {
+		case token == ">":
+			return len(subjectTokens) > i
+		case i >= len(subjectTokens):
+			return false
+		case token != "*" && token != subjectTokens[i]:
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
+
+func TestNewNATSSource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Required", func(t *testing.T) {
+		_, err := newNATSSource(ctx, nil, "argo", "my-source", config.NATSEventSource{URL: "nats://nats:4222"})
+		require.EqualError(t, err, `event source "my-source": nats.url and nats.stream are required`)
+	})
+	t.Run("DefaultConsumer", func(t *testing.T) {
+		src, err := newNATSSource(ctx, nil, "argo", "my-source", config.NATSEventSource{URL: "nats://nats:4222", Stream: "events"})
+		require.NoError(t, err)
+		assert.Equal(t, "argo-workflows-my-source", src.consumer)
+	})
+	t.Run("Consumer", func(t *testing.T) {
+		src, err := newNATSSource(ctx, nil, "argo", "my-source", config.NATSEventSource{URL: "nats://nats:4222", Stream: "events", Consumer: "my-consumer"})
+		require.NoError(t, err)
+		assert.Equal(t, "my-consumer", src.consumer)
+	})
+}
+
+func TestNATSSource(t *testing.T) {
+	defer func(d time.Duration) { redeliveryDelay = d }(redeliveryDelay)
+	redeliveryDelay = 10 * time.Millisecond
+
+	server := newJetStreamServer(t, "events")
+	server.publish("events.a", map[string]string{"X-Trace-Id": "1"}, `{"id": "a"}`)
+	server.publish("other.a", nil, `{"id": "filtered"}`)
+	server.publish("events.b", nil, `{"id": "b"}`)
+	server.publish("events.c", nil, `{"id": "c"}`)
+
+	ctx, cancel := context.WithCancel(logging.TestContext(t.Context()))
+	defer cancel()
+	src, err := newNATSSource(ctx, nil, "argo", "my-source", config.NATSEventSource{URL: server.url(), Stream: "events", Subject: "events.>"})
+	require.NoError(t, err)
+
+	// b fails to dispatch once, and c always fails to, so is dropped on its third delivery, as Controller.Consume
+	// drops messages once they reach their maximum deliveries
+	const maxDeliveries = 3
+	var mu sync.Mutex
+	var handled []Message
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		src.Run(ctx, func(ctx context.Context, msg Message) error {
+			mu.Lock()
+			defer mu.Unlock()
+			handled = append(handled, msg)
+			switch string(msg.Data) {
+			case `{"id": "b"}`:
+				if msg.Deliveries == 1 {
+					return errors.New("failed to dispatch")
+				}
+			case `{"id": "c"}`:
+				if msg.Deliveries < maxDeliveries {
+					return errors.New("failed to dispatch")
+				}
+			}
+			return nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return len(server.acknowledged()) == 3 }, 10*time.Second, 10*time.Millisecond)
+	// c is not redelivered once it is dropped
+	time.Sleep(10 * redeliveryDelay)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []uint64{1, 3, 4}, server.acknowledged(), "the filtered message is never delivered")
+	assert.Equal(t, Message{
+		Metadata: map[string][]string{
+			"x-nats-subject":  {"events.a"},
+			"x-nats-stream":   {"events"},
+			"x-nats-sequence": {"1"},
+			"x-trace-id":      {"1"},
+		},
+		Data:       []byte(`{"id": "a"}`),
+		Deliveries: 1,
+	}, handled[0])
+	deliveries := map[string][]int{}
+	for _, msg := range handled {
+		deliveries[string(msg.Data)] = append(deliveries[string(msg.Data)], msg.Deliveries)
+	}
+	assert.Equal(t, map[string][]int{
+		`{"id": "a"}`: {1},
+		`{"id": "b"}`: {1, 2},
+		`{"id": "c"}`: {1, 2, 3},
+	}, deliveries)
+}
@@ -0,0 +1,76 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util"
+)
+
+// redeliveryDelay is how long a source waits before redelivering a message that was not handled
+var redeliveryDelay = 5 * time.Second
+
+// Message is a message consumed from a message broker
+type Message struct {
+	// Metadata of the message, such as its headers, keyed by lower-case name
+	Metadata map[string][]string
+	// Data is the body of the message
+	Data []byte
+	// Deliveries is how many times the message has been delivered, including this delivery
+	Deliveries int
+}
+
+// Handler handles a message. The message is acknowledged only if it returns nil, and is redelivered otherwise.
+type Handler func(ctx context.Context, msg Message) error
+
+// Source consumes messages from a message broker
+type Source interface {
+	// Run consumes messages until the context is done, reconnecting if the connection to the broker fails
+	Run(ctx context.Context, handle Handler)
+}
+
+// New creates the source of an event source, reading its credentials from the secrets of the namespace
+func New(ctx context.Context, kubeClient kubernetes.Interface, namespace string, eventSource config.EventSource) (Source, error) {
+	switch {
+	case eventSource.NATS != nil:
+		return newNATSSource(ctx, kubeClient, namespace, eventSource.Name, *eventSource.NATS)
+	case eventSource.Kafka != nil:
+		return newKafkaSource(ctx, kubeClient, namespace, eventSource.Name, *eventSource.Kafka)
+	default:
+		return nil, fmt.Errorf("event source %q must configure nats or kafka", eventSource.Name)
+	}
+}
+
+// sleep waits for the duration, returning false if the context is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// credentials reads a username and password from the secrets they are referenced by, if they are
+func credentials(ctx context.Context, kubeClient kubernetes.Interface, namespace string, usernameSecret, passwordSecret *apiv1.SecretKeySelector) (string, string, error) {
+	var username, password []byte
+	var err error
+	if usernameSecret != nil {
+		username, err = util.GetSecrets(ctx, kubeClient, namespace, usernameSecret.Name, usernameSecret.Key)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read username: %w", err)
+		}
+	}
+	if passwordSecret != nil {
+		password, err = util.GetSecrets(ctx, kubeClient, namespace, passwordSecret.Name, passwordSecret.Key)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read password: %w", err)
+		}
+	}
+	return string(username), string(password), nil
+}
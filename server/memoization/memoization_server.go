@@ -0,0 +1,135 @@
+package memoization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/upper/db/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+type cacheServer struct {
+	// session and clusterName are only set when persistence is configured
+	session     db.Session
+	clusterName string
+}
+
+// NewCacheServer returns a server for managing memoization caches, SQL caches are only available when a
+// persistence session is provided
+func NewCacheServer(session db.Session, clusterName string) cachepkg.CacheServiceServer {
+	return &cacheServer{session: session, clusterName: clusterName}
+}
+
+// getCache returns the cache after checking the user may perform the verb on it.
+// ConfigMap caches are accessed with the user's own Kubernetes client, so Kubernetes RBAC applies directly.
+// There's no permission system for SQL caches, so we use the k8s RBAC check for workflows in the namespace.
+func (s *cacheServer) getCache(ctx context.Context, verb string, cacheType cachepkg.CacheType, namespace, name string) (controllercache.MemoizationCache, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "cache name is required")
+	}
+	switch cacheType {
+	case cachepkg.CacheType_CONFIGMAP:
+		return controllercache.NewConfigMapCache(namespace, auth.GetKubeClient(ctx), name), nil
+	case cachepkg.CacheType_SQL:
+		if s.session == nil {
+			return nil, status.Error(codes.FailedPrecondition, "SQL caches require persistence to be configured")
+		}
+		allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, namespace, "")
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to %s SQL cache entries in namespace \"%s\".", verb, namespace))
+		}
+		return controllercache.NewSQLCache(s.session, s.clusterName, namespace, name), nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported cache type: %s", cacheType))
+	}
+}
+
+func newCacheEntry(key string, entry *controllercache.Entry) *cachepkg.CacheEntry {
+	return &cachepkg.CacheEntry{
+		Key:               key,
+		NodeID:            entry.NodeID,
+		Outputs:           entry.Outputs,
+		CreationTimestamp: &entry.CreationTimestamp,
+		LastHitTimestamp:  &entry.LastHitTimestamp,
+	}
+}
+
+func (s *cacheServer) ListCacheEntries(ctx context.Context, req *cachepkg.ListCacheEntriesRequest) (*cachepkg.CacheEntryList, error) {
+	c, err := s.getCache(ctx, "get", req.Type, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*cachepkg.CacheEntry, 0, len(entries))
+	for key, entry := range entries {
+		items = append(items, newCacheEntry(key, entry))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return &cachepkg.CacheEntryList{Items: items}, nil
+}
+
+func (s *cacheServer) GetCacheEntry(ctx context.Context, req *cachepkg.GetCacheEntryRequest) (*cachepkg.CacheEntry, error) {
+	if err := controllercache.ValidateKey(req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	c, err := s.getCache(ctx, "get", req.Type, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := c.Get(ctx, req.Key)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if entry == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("cache entry \"%s\" not found in cache \"%s\"", req.Key, req.Name))
+	}
+	return newCacheEntry(req.Key, entry), nil
+}
+
+func (s *cacheServer) DeleteCacheEntry(ctx context.Context, req *cachepkg.DeleteCacheEntryRequest) (*cachepkg.DeleteCacheEntryResponse, error) {
+	if err := controllercache.ValidateKey(req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	c, err := s.getCache(ctx, "delete", req.Type, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Delete(ctx, req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &cachepkg.DeleteCacheEntryResponse{}, nil
+}
+
+func (s *cacheServer) PruneCache(ctx context.Context, req *cachepkg.PruneCacheRequest) (*cachepkg.PruneCacheResponse, error) {
+	notHitFor, err := time.ParseDuration(req.NotHitFor)
+	if err != nil {
+		return nil, sutils.ToStatusError(fmt.Errorf("invalid notHitFor duration: %w", err), codes.InvalidArgument)
+	}
+	if notHitFor < 0 {
+		return nil, status.Error(codes.InvalidArgument, "notHitFor must not be negative")
+	}
+	c, err := s.getCache(ctx, "delete", req.Type, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := c.Prune(ctx, time.Now().Add(-notHitFor))
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &cachepkg.PruneCacheResponse{Deleted: int32(deleted)}, nil
+}
@@ -0,0 +1,134 @@
+package memoization
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+	"github.com/upper/db/v4/adapter/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+func newTestSession(t *testing.T) db.Session {
+	t.Helper()
+	session, err := sqlite.Open(sqlite.ConnectionURL{Database: filepath.Join(t.TempDir(), "argo.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	_, err = session.SQL().Exec(`create table argo_memoization_cache (
+    clustername varchar(64) not null,
+    namespace varchar(256) not null,
+    name varchar(128) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs text not null,
+    createdat timestamp not null default CURRENT_TIMESTAMP,
+    lasthitat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, namespace, name, cachekey)
+)`)
+	require.NoError(t, err)
+	return session
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, code, statusErr.Code(), statusErr.Message())
+}
+
+func TestCacheServer_ConfigMap(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	require.NoError(t, controllercache.NewConfigMapCache("my-ns", kubeClient, "my-cache").Save(ctx, "my-key", "my-node", nil))
+	server := NewCacheServer(nil, "")
+
+	t.Run("List", func(t *testing.T) {
+		list, err := server.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "my-key", list.Items[0].Key)
+		assert.Equal(t, "my-node", list.Items[0].NodeID)
+	})
+	t.Run("ListMissingName", func(t *testing.T) {
+		_, err := server.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("Get", func(t *testing.T) {
+		entry, err := server.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-key"})
+		require.NoError(t, err)
+		assert.Equal(t, "my-node", entry.NodeID)
+	})
+	t.Run("GetNotFound", func(t *testing.T) {
+		_, err := server.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-missing-key"})
+		requireCode(t, codes.NotFound, err)
+	})
+	t.Run("GetInvalidKey", func(t *testing.T) {
+		_, err := server.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my key"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("PruneInvalidDuration", func(t *testing.T) {
+		_, err := server.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache", NotHitFor: "1 day"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("Prune", func(t *testing.T) {
+		resp, err := server.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache", NotHitFor: "1h"})
+		require.NoError(t, err)
+		assert.Zero(t, resp.Deleted)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		_, err := server.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-key"})
+		require.NoError(t, err)
+		_, err = server.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-key"})
+		requireCode(t, codes.NotFound, err)
+	})
+	t.Run("SQLWithoutPersistence", func(t *testing.T) {
+		_, err := server.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache", Type: cachepkg.CacheType_SQL})
+		requireCode(t, codes.FailedPrecondition, err)
+	})
+}
+
+func TestCacheServer_SQL(t *testing.T) {
+	kubeClient := &fake.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	session := newTestSession(t)
+	require.NoError(t, controllercache.NewSQLCache(session, "default", "my-ns", "my-cache").Save(ctx, "my-key", "my-node", nil))
+	server := NewCacheServer(session, "default")
+
+	t.Run("List", func(t *testing.T) {
+		list, err := server.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache", Type: cachepkg.CacheType_SQL})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "my-key", list.Items[0].Key)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		allowed = false
+		defer func() { allowed = true }()
+		_, err := server.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-key", Type: cachepkg.CacheType_SQL})
+		requireCode(t, codes.PermissionDenied, err)
+	})
+	t.Run("Prune", func(t *testing.T) {
+		resp, err := server.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", Name: "my-cache", Type: cachepkg.CacheType_SQL, NotHitFor: "0s"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.Deleted)
+	})
+}
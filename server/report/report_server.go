@@ -0,0 +1,276 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+)
+
+const (
+	groupByNamespace        = "namespace"
+	groupByLabel            = "label"
+	groupByWorkflowTemplate = "workflowTemplate"
+	groupByTemplate         = "template"
+)
+
+// archivePageSize is the number of archived workflows listed at a time
+const archivePageSize = 500
+
+var nowFn = time.Now
+
+type reportServer struct {
+	wfArchive             sqldb.WorkflowArchive
+	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
+	hydrator              hydrator.Interface
+}
+
+// NewReportServer returns a server for reporting the resource usage of workflows, archived workflows are only
+// included when the archive is enabled
+func NewReportServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) reportpkg.ReportServiceServer {
+	return &reportServer{wfArchive: wfArchive, offloadNodeStatusRepo: offloadNodeStatusRepo, hydrator: hydrator.New(offloadNodeStatusRepo)}
+}
+
+func (s *reportServer) GetUsageReport(ctx context.Context, req *reportpkg.UsageReportRequest) (*reportpkg.UsageReport, error) {
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = groupByNamespace
+	}
+	switch groupBy {
+	case groupByNamespace, groupByWorkflowTemplate, groupByTemplate:
+	case groupByLabel:
+		if req.Label == "" {
+			return nil, status.Error(codes.InvalidArgument, "label is required when grouping by label")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported group by: %s", groupBy))
+	}
+	startedAfter, err := parseTime(req.StartedAfter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid startedAfter: %v", err))
+	}
+	startedBefore, err := parseTime(req.StartedBefore)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid startedBefore: %v", err))
+	}
+	selector, err := labels.Parse(req.LabelSelector)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid label selector: %v", err))
+	}
+
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\".", req.Namespace))
+	}
+
+	r := newUsageReport(groupBy, req.Label)
+	inRange := func(wf *wfv1.Workflow) bool {
+		startedAt := wf.Status.StartedAt.Time
+		return (startedAfter.IsZero() || !startedAt.Before(startedAfter)) && (startedBefore.IsZero() || startedAt.Before(startedBefore))
+	}
+
+	live, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(req.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if groupBy == groupByTemplate {
+		if err := s.hydrate(ctx, req.Namespace, live.Items); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	seen := make(map[types.UID]bool)
+	for i := range live.Items {
+		wf := &live.Items[i]
+		seen[wf.UID] = true
+		if wf.Status.StartedAt.IsZero() || !inRange(wf) {
+			continue
+		}
+		r.add(wf)
+	}
+
+	if s.wfArchive.IsEnabled() {
+		requirements, _ := selector.Requirements()
+		options := sutils.ListOptions{
+			Namespace:         req.Namespace,
+			MinStartedAt:      startedAfter,
+			MaxStartedAt:      startedBefore,
+			LabelRequirements: requirements,
+			Limit:             archivePageSize,
+		}
+		for {
+			archived, err := s.wfArchive.ListWorkflows(ctx, options)
+			if err != nil {
+				return nil, sutils.ToStatusError(err, codes.Internal)
+			}
+			// workflows which have been archived, but not yet deleted, are already counted
+			page := make(wfv1.Workflows, 0, len(archived))
+			for _, wf := range archived {
+				if !seen[wf.UID] && inRange(&wf) {
+					page = append(page, wf)
+				}
+			}
+			// the archive only lists the metadata and status summary, so we must get the nodes
+			if groupBy == groupByTemplate && len(page) > 0 {
+				uids := make([]string, len(page))
+				for i, wf := range page {
+					uids[i] = string(wf.UID)
+				}
+				nodes, err := s.wfArchive.ListWorkflowsNodes(ctx, uids)
+				if err != nil {
+					return nil, sutils.ToStatusError(err, codes.Internal)
+				}
+				for i := range page {
+					page[i].Status.Nodes = nodes[string(page[i].UID)]
+				}
+			}
+			for i := range page {
+				r.add(&page[i])
+			}
+			if len(archived) < options.Limit {
+				break
+			}
+			options.Offset += len(archived)
+		}
+	}
+	return &reportpkg.UsageReport{Items: r.list()}, nil
+}
+
+// hydrate gets the nodes of the workflows, the offloaded nodes are listed once rather than got per workflow
+func (s *reportServer) hydrate(ctx context.Context, namespace string, wfs []wfv1.Workflow) error {
+	var offloadedNodes map[sqldb.UUIDVersion]wfv1.Nodes
+	for i := range wfs {
+		wf := &wfs[i]
+		if err := packer.DecompressWorkflow(ctx, wf); err != nil {
+			return err
+		}
+		if !wf.Status.IsOffloadNodeStatus() {
+			continue
+		}
+		if offloadedNodes == nil {
+			var err error
+			offloadedNodes, err = s.offloadNodeStatusRepo.List(ctx, namespace)
+			if err != nil {
+				return err
+			}
+		}
+		s.hydrator.HydrateWithNodes(wf, offloadedNodes[sqldb.UUIDVersion{UID: string(wf.UID), Version: wf.GetOffloadNodeStatusVersion()}])
+	}
+	return nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// wallTime is the duration until now for nodes or workflows that have not finished
+func wallTime(startedAt, finishedAt metav1.Time) time.Duration {
+	if startedAt.IsZero() {
+		return 0
+	}
+	if finishedAt.IsZero() {
+		return nowFn().Sub(startedAt.Time)
+	}
+	return finishedAt.Sub(startedAt.Time)
+}
+
+type usageReport struct {
+	groupBy string
+	label   string
+	items   map[string]*reportpkg.UsageReportItem
+}
+
+func newUsageReport(groupBy, label string) *usageReport {
+	return &usageReport{groupBy: groupBy, label: label, items: make(map[string]*reportpkg.UsageReportItem)}
+}
+
+func (r *usageReport) add(wf *wfv1.Workflow) {
+	switch r.groupBy {
+	case groupByTemplate:
+		for _, node := range wf.Status.Nodes {
+			if node.Type != wfv1.NodeTypePod {
+				continue
+			}
+			r.addUsage(templateGroup(node), wallTime(node.StartedAt, node.FinishedAt), node.ResourcesDuration)
+		}
+	case groupByLabel:
+		r.addUsage(wf.Labels[r.label], wallTime(wf.Status.StartedAt, wf.Status.FinishedAt), wf.Status.ResourcesDuration)
+	case groupByWorkflowTemplate:
+		r.addUsage(workflowTemplateGroup(wf), wallTime(wf.Status.StartedAt, wf.Status.FinishedAt), wf.Status.ResourcesDuration)
+	default:
+		r.addUsage(wf.Namespace, wallTime(wf.Status.StartedAt, wf.Status.FinishedAt), wf.Status.ResourcesDuration)
+	}
+}
+
+func (r *usageReport) addUsage(group string, wallTime time.Duration, resourcesDuration wfv1.ResourcesDuration) {
+	item, ok := r.items[group]
+	if !ok {
+		item = &reportpkg.UsageReportItem{Group: group, ResourcesDuration: make(map[string]int64)}
+		r.items[group] = item
+	}
+	item.Count++
+	item.WallTime += int64(wallTime.Seconds())
+	for name, duration := range resourcesDuration {
+		item.ResourcesDuration[string(name)] += int64(duration)
+	}
+}
+
+// list returns the items sorted by group
+func (r *usageReport) list() []*reportpkg.UsageReportItem {
+	items := make([]*reportpkg.UsageReportItem, 0, len(r.items))
+	for _, item := range r.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Group < items[j].Group })
+	return items
+}
+
+// workflowTemplateGroup uses the labels, as archived workflows do not have their spec listed.
+// ClusterWorkflowTemplates are prefixed with "cluster/" to tell them apart from WorkflowTemplates with the same name.
+func workflowTemplateGroup(wf *wfv1.Workflow) string {
+	if name, ok := wf.Labels[common.LabelKeyClusterWorkflowTemplate]; ok {
+		return "cluster/" + name
+	}
+	if name, ok := wf.Labels[common.LabelKeyWorkflowTemplate]; ok {
+		return name
+	}
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil {
+		if ref.ClusterScope {
+			return "cluster/" + ref.Name
+		}
+		return ref.Name
+	}
+	return ""
+}
+
+// templateGroup is "<workflow-template>/<template>" for referenced templates, otherwise the template name
+func templateGroup(node wfv1.NodeStatus) string {
+	if ref := node.TemplateRef; ref != nil {
+		if ref.ClusterScope {
+			return "cluster/" + ref.Name + "/" + ref.Template
+		}
+		return ref.Name + "/" + ref.Template
+	}
+	return node.TemplateName
+}
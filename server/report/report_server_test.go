@@ -0,0 +1,204 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	reportpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/report"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var start = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func usageWorkflow(namespace, name string, labels map[string]string, seconds int64, nodes wfv1.Nodes) wfv1.Workflow {
+	return wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID("uid-" + name), Labels: labels},
+		Status: wfv1.WorkflowStatus{
+			StartedAt:         metav1.Time{Time: start},
+			FinishedAt:        metav1.Time{Time: start.Add(time.Duration(seconds) * time.Second)},
+			ResourcesDuration: wfv1.ResourcesDuration{corev1.ResourceCPU: wfv1.ResourceDuration(seconds), corev1.ResourceMemory: wfv1.ResourceDuration(2 * seconds)},
+			Nodes:             nodes,
+		},
+	}
+}
+
+func podNode(template string, ref *wfv1.TemplateRef, seconds int64) wfv1.NodeStatus {
+	return wfv1.NodeStatus{
+		Type:              wfv1.NodeTypePod,
+		TemplateName:      template,
+		TemplateRef:       ref,
+		StartedAt:         metav1.Time{Time: start},
+		FinishedAt:        metav1.Time{Time: start.Add(time.Duration(seconds) * time.Second)},
+		ResourcesDuration: wfv1.ResourcesDuration{corev1.ResourceCPU: wfv1.ResourceDuration(seconds)},
+	}
+}
+
+func newTestContext(t *testing.T, allowed bool, wfs ...wfv1.Workflow) context.Context {
+	kubeClient := &fake.Clientset{}
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	var objects []runtime.Object
+	for i := range wfs {
+		objects = append(objects, &wfs[i])
+	}
+	ctx := context.WithValue(logging.TestContext(t.Context()), auth.KubeKey, kubeClient)
+	return context.WithValue(ctx, auth.WfKey, wffake.NewSimpleClientset(objects...))
+}
+
+func TestGetUsageReport(t *testing.T) {
+	live := []wfv1.Workflow{
+		usageWorkflow("ns-a", "wf-1", map[string]string{"team": "red", common.LabelKeyWorkflowTemplate: "my-wftmpl"}, 10, wfv1.Nodes{
+			"wf-1":   {Type: wfv1.NodeTypeSteps, TemplateName: "main"},
+			"wf-1-a": podNode("a", nil, 4),
+			"wf-1-b": podNode("", &wfv1.TemplateRef{Name: "my-wftmpl", Template: "b"}, 6),
+		}),
+		usageWorkflow("ns-b", "wf-2", map[string]string{"team": "blue"}, 20, wfv1.Nodes{
+			"wf-2": podNode("a", nil, 20),
+		}),
+	}
+	// wf-1 has been archived, but not yet deleted, so it must only be counted once
+	archived := wfv1.Workflows{
+		usageWorkflow("ns-a", "wf-1", map[string]string{"team": "red", common.LabelKeyWorkflowTemplate: "my-wftmpl"}, 10, nil),
+		usageWorkflow("ns-a", "wf-3", map[string]string{"team": "red", common.LabelKeyClusterWorkflowTemplate: "my-cwftmpl"}, 30, nil),
+	}
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsEnabled").Return(true)
+	wfArchive.On("ListWorkflows", mock.Anything, mock.Anything).Return(archived, nil)
+	wfArchive.On("ListWorkflowsNodes", mock.Anything, []string{"uid-wf-3"}).Return(map[string]wfv1.Nodes{"uid-wf-3": {"wf-3": podNode("c", nil, 30)}}, nil)
+	server := NewReportServer(wfArchive, sqldb.ExplosiveOffloadNodeStatusRepo)
+	ctx := newTestContext(t, true, live...)
+
+	t.Run("Namespace", func(t *testing.T) {
+		report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{})
+		require.NoError(t, err)
+		require.Len(t, report.Items, 2)
+		assert.Equal(t, &reportpkg.UsageReportItem{Group: "ns-a", Count: 2, WallTime: 40, ResourcesDuration: map[string]int64{"cpu": 40, "memory": 80}}, report.Items[0])
+		assert.Equal(t, &reportpkg.UsageReportItem{Group: "ns-b", Count: 1, WallTime: 20, ResourcesDuration: map[string]int64{"cpu": 20, "memory": 40}}, report.Items[1])
+	})
+	t.Run("Label", func(t *testing.T) {
+		report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{GroupBy: "label", Label: "team"})
+		require.NoError(t, err)
+		require.Len(t, report.Items, 2)
+		assert.Equal(t, "blue", report.Items[0].Group)
+		assert.Equal(t, "red", report.Items[1].Group)
+		assert.Equal(t, int64(2), report.Items[1].Count)
+	})
+	t.Run("WorkflowTemplate", func(t *testing.T) {
+		report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{GroupBy: "workflowTemplate"})
+		require.NoError(t, err)
+		require.Len(t, report.Items, 3)
+		assert.Empty(t, report.Items[0].Group)
+		assert.Equal(t, "cluster/my-cwftmpl", report.Items[1].Group)
+		assert.Equal(t, "my-wftmpl", report.Items[2].Group)
+	})
+	t.Run("Template", func(t *testing.T) {
+		report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{GroupBy: "template"})
+		require.NoError(t, err)
+		require.Len(t, report.Items, 3)
+		assert.Equal(t, &reportpkg.UsageReportItem{Group: "a", Count: 2, WallTime: 24, ResourcesDuration: map[string]int64{"cpu": 24}}, report.Items[0])
+		assert.Equal(t, "c", report.Items[1].Group)
+		assert.Equal(t, "my-wftmpl/b", report.Items[2].Group)
+	})
+	t.Run("TimeRange", func(t *testing.T) {
+		report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{StartedAfter: start.Add(time.Hour).Format(time.RFC3339)})
+		require.NoError(t, err)
+		assert.Empty(t, report.Items)
+		wfArchive.AssertCalled(t, "ListWorkflows", mock.Anything, sutils.ListOptions{MinStartedAt: start.Add(time.Hour), Limit: archivePageSize})
+	})
+	t.Run("InvalidTime", func(t *testing.T) {
+		_, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{StartedBefore: "yesterday"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("MissingLabel", func(t *testing.T) {
+		_, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{GroupBy: "label"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("InvalidGroupBy", func(t *testing.T) {
+		_, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{GroupBy: "pod"})
+		requireCode(t, codes.InvalidArgument, err)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		_, err := server.GetUsageReport(newTestContext(t, false), &reportpkg.UsageReportRequest{Namespace: "ns-a"})
+		requireCode(t, codes.PermissionDenied, err)
+	})
+}
+
+func TestGetUsageReport_ArchivePages(t *testing.T) {
+	firstPage := make(wfv1.Workflows, archivePageSize)
+	for i := range firstPage {
+		firstPage[i] = usageWorkflow("ns-a", fmt.Sprintf("wf-%d", i), nil, 1, nil)
+	}
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsEnabled").Return(true)
+	wfArchive.On("ListWorkflows", mock.Anything, sutils.ListOptions{Limit: archivePageSize}).Return(firstPage, nil).Once()
+	wfArchive.On("ListWorkflows", mock.Anything, sutils.ListOptions{Limit: archivePageSize, Offset: archivePageSize}).Return(wfv1.Workflows{usageWorkflow("ns-a", "wf-last", nil, 1, nil)}, nil).Once()
+	server := NewReportServer(wfArchive, sqldb.ExplosiveOffloadNodeStatusRepo)
+
+	report, err := server.GetUsageReport(newTestContext(t, true), &reportpkg.UsageReportRequest{})
+	require.NoError(t, err)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, int64(archivePageSize+1), report.Items[0].Count)
+	wfArchive.AssertExpectations(t)
+}
+
+func TestGetUsageReport_Offloaded(t *testing.T) {
+	offloaded := usageWorkflow("ns-a", "wf-1", nil, 10, nil)
+	offloaded.Status.OffloadNodeStatusVersion = "v1"
+	other := usageWorkflow("ns-a", "wf-2", nil, 10, nil)
+	other.Status.OffloadNodeStatusVersion = "v1"
+	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
+	offloadNodeStatusRepo.On("List", "ns-a").Return(map[sqldb.UUIDVersion]wfv1.Nodes{
+		{UID: "uid-wf-1", Version: "v1"}: {"wf-1": podNode("a", nil, 10)},
+		{UID: "uid-wf-2", Version: "v1"}: {"wf-2": podNode("b", nil, 10)},
+	}, nil).Once()
+	server := NewReportServer(sqldb.NullWorkflowArchive, offloadNodeStatusRepo)
+
+	report, err := server.GetUsageReport(newTestContext(t, true, offloaded, other), &reportpkg.UsageReportRequest{Namespace: "ns-a", GroupBy: "template"})
+	require.NoError(t, err)
+	require.Len(t, report.Items, 2)
+	assert.Equal(t, "a", report.Items[0].Group)
+	assert.Equal(t, "b", report.Items[1].Group)
+	offloadNodeStatusRepo.AssertExpectations(t)
+}
+
+func TestGetUsageReport_ArchiveDisabled(t *testing.T) {
+	server := NewReportServer(sqldb.NullWorkflowArchive, sqldb.ExplosiveOffloadNodeStatusRepo)
+	ctx := newTestContext(t, true, usageWorkflow("ns-a", "wf-1", nil, 10, nil))
+	report, err := server.GetUsageReport(ctx, &reportpkg.UsageReportRequest{Namespace: "ns-a"})
+	require.NoError(t, err)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, int64(10), report.Items[0].WallTime)
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, code, statusErr.Code(), statusErr.Message())
+}
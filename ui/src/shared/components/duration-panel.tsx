@@ -5,11 +5,15 @@ import {NODE_PHASE, NodePhase} from '../models';
 import {ProgressLine} from './progress-line';
 
 // duration panel in seconds
-export function DurationPanel(props: {phase: NodePhase; duration: number; estimatedDuration?: number}) {
+export function DurationPanel(props: {phase: NodePhase; duration: number; estimatedDuration?: number; estimatedDurationP90?: number}) {
     if (props.phase === NODE_PHASE.RUNNING && props.estimatedDuration) {
+        let title = 'Estimate duration: ' + formatDuration(props.estimatedDuration);
+        if (props.estimatedDurationP90) {
+            title += ' (p90: ' + formatDuration(props.estimatedDurationP90) + ')';
+        }
         return (
             <>
-                <span title={'Estimate duration: ' + formatDuration(props.estimatedDuration)}>
+                <span title={title}>
                     <ProgressLine progress={props.duration / props.estimatedDuration} width={32} height={8} />
                 </span>{' '}
                 {formatDuration(props.duration)}
@@ -7,7 +7,7 @@ interface Props {
     conditions: Condition[];
 }
 
-const WarningConditions: ConditionType[] = ['SpecWarning'];
+const WarningConditions: ConditionType[] = ['SpecWarning', 'Preempted'];
 const ErrorConditions: ConditionType[] = ['MetricsError', 'SubmissionError', 'SpecError', 'ArtifactGCError'];
 
 export function hasWarningConditionBadge(conditions: Condition[]): boolean {
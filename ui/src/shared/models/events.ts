@@ -25,5 +25,7 @@ export interface WorkflowEventBinding {
             workflowTemplateRef: WorkflowTemplateRef;
             arguments?: Arguments;
         };
+        deduplicate?: {key: string; window?: string};
+        rateLimit?: {maxSubmissions: number; interval: string};
     };
 }
@@ -485,6 +485,11 @@ export interface Template {
      */
     suspend?: any;
 
+    /**
+     * ChildWorkflow runs a workflow from a WorkflowTemplate as a child of the workflow
+     */
+    childWorkflow?: {workflowTemplateRef: WorkflowTemplateRef; arguments?: Arguments};
+
     /**
      * Template is the name of the template which is used as the base of this template.
      */
@@ -559,7 +564,7 @@ export function isArchivedWorkflow(wf?: Workflow): boolean {
     return labelValue === 'Archived' || labelValue === 'Persisted';
 }
 
-export type NodeType = 'Pod' | 'Container' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend';
+export type NodeType = 'Pod' | 'Container' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend' | 'ChildWorkflow';
 
 export interface NodeStatus {
     /**
@@ -614,11 +619,21 @@ export interface NodeStatus {
      */
     estimatedDuration?: number;
 
+    /**
+     * 90th percentile of the durations of previous runs in seconds.
+     */
+    estimatedDurationP90?: number;
+
     /**
      * Progress as numerator/denominator.
      */
     progress?: string;
 
+    /**
+     * Whether the progress is estimated from the estimated duration, rather than reported by the node.
+     */
+    progressEstimated?: boolean;
+
     /**
      * How much resource was requested.
      */
@@ -690,6 +705,22 @@ export interface NodeStatus {
      * Memoization
      */
     memoizationStatus: MemoizationStatus;
+
+    /**
+     * ChildWorkflow is the name of the workflow run by a child workflow node
+     */
+    childWorkflow?: string;
+
+    /**
+     * FanOut holds the counts of the fan-outs run by a task group or step group node, keyed by task or step name
+     */
+    fanOut?: {[name: string]: FanOutStatus};
+}
+
+export interface FanOutStatus {
+    total?: number;
+    succeeded?: number;
+    failed?: number;
 }
 
 export interface TemplateRef {
@@ -724,6 +755,11 @@ export interface WorkflowStatus {
      */
     estimatedDuration?: number;
 
+    /**
+     * 90th percentile of the durations of previous runs in seconds.
+     */
+    estimatedDurationP90?: number;
+
     /**
      * Progress as numerator/denominator.
      */
@@ -775,7 +811,7 @@ export interface Condition {
     message: string;
 }
 
-export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError' | 'ArtifactGCError';
+export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError' | 'ArtifactGCError' | 'Preempted';
 export type ConditionStatus = 'True' | 'False' | 'Unknown';
 
 /**
@@ -942,6 +978,40 @@ export interface BaseDAGTask {
     withItems?: any[];
     withParam?: string;
     withSequence?: Sequence;
+
+    /**
+     * Expand splices the DAG tasks emitted by this task into the DAG once it succeeds
+     */
+    expand?: DAGExpansion;
+
+    /**
+     * FanOut sets how many of the tasks expanded by withItems, withParam or withSequence must succeed, or may fail
+     */
+    fanOut?: FanOutPolicy;
+
+    /**
+     * BatchSize groups the items of withItems, withParam or withSequence into batches, and expands the task once per batch
+     */
+    batchSize?: number;
+}
+
+export interface FanOutPolicy {
+    /**
+     * MinSucceeded is the number, or percentage, of the expanded tasks that must succeed
+     */
+    minSucceeded?: number | string;
+
+    /**
+     * MaxFailed is the number, or percentage, of the expanded tasks that may fail or error
+     */
+    maxFailed?: number | string;
+}
+
+export interface DAGExpansion {
+    /**
+     * Parameter is the name of the task's output parameter holding the JSON list of DAG tasks to splice into the DAG
+     */
+    parameter: string;
 }
 
 /**
@@ -980,6 +1050,14 @@ export interface WorkflowStep {
     withParam?: string;
     withItems?: any[];
     withSequence?: Sequence;
+    /**
+     * FanOut sets how many of the steps expanded by withItems, withParam or withSequence must succeed, or may fail
+     */
+    fanOut?: FanOutPolicy;
+    /**
+     * BatchSize groups the items of withItems, withParam or withSequence into batches, and expands the step once per batch
+     */
+    batchSize?: number;
     /**
      * TemplateRef is the reference to the template resource which is used as the base of this template.
      */
@@ -1003,6 +1081,10 @@ export interface MemoizationStatus {
      * Cache name stores the identifier of the cache used for this node
      */
     cacheName: string;
+    /**
+     * Cache type is the kind of cache used for this node, ConfigMap if not set
+     */
+    cacheType?: 'ConfigMap' | 'SQL';
 }
 
 export type WorkflowPhase = 'Pending' | 'Running' | 'Succeeded' | 'Failed' | 'Error';
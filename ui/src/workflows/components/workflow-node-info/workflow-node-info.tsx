@@ -3,7 +3,9 @@ import {Ticker} from 'argo-ui/src/components/ticker';
 import {Tooltip} from 'argo-ui/src/components/tooltip/tooltip';
 import * as React from 'react';
 import {useState} from 'react';
+import {Link} from 'react-router-dom';
 
+import {uiUrl} from '../../../shared/base';
 import {Button} from '../../../shared/components/button';
 import {ClipboardText} from '../../../shared/components/clipboard-text';
 import {DurationPanel} from '../../../shared/components/duration-panel';
@@ -121,7 +123,18 @@ function WorkflowNodeSummary(props: Props) {
         {title: 'END TIME', value: <DisplayWorkflowTime date={props.node.finishedAt} timestampKey={TIMESTAMP_KEYS.WORKFLOW_NODE_FINISHED} />},
         {
             title: 'DURATION',
-            value: <Ticker>{now => <DurationPanel duration={nodeDuration(props.node, now)} phase={props.node.phase} estimatedDuration={props.node.estimatedDuration} />}</Ticker>
+            value: (
+                <Ticker>
+                    {now => (
+                        <DurationPanel
+                            duration={nodeDuration(props.node, now)}
+                            phase={props.node.phase}
+                            estimatedDuration={props.node.estimatedDuration}
+                            estimatedDurationP90={props.node.estimatedDurationP90}
+                        />
+                    )}
+                </Ticker>
+            )
         },
         {title: 'PROGRESS', value: props.node.progress || '-'},
         {
@@ -161,6 +174,12 @@ function WorkflowNodeSummary(props: Props) {
             }
         );
     }
+    if (props.node.type === 'ChildWorkflow' && props.node.childWorkflow) {
+        attributes.splice(2, 0, {
+            title: 'CHILD WORKFLOW',
+            value: <Link to={uiUrl(`workflows/${workflow.metadata.namespace}/${props.node.childWorkflow}`)}>{props.node.childWorkflow}</Link>
+        });
+    }
     if (props.node.type === 'Retry') {
         attributes.push({
             title: 'FAIL HOSTS',
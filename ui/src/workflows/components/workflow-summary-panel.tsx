@@ -46,6 +46,7 @@ export const WorkflowSummaryPanel = (props: {workflow: Workflow}) => (
                             phase={props.workflow.status.phase}
                             duration={wfDuration(props.workflow.status)}
                             estimatedDuration={props.workflow.status.estimatedDuration}
+                            estimatedDurationP90={props.workflow.status.estimatedDurationP90}
                         />
                     )
                 },
@@ -28,6 +28,7 @@ const (
 	AttribTemplateCluster   string = `cluster_scope`
 	AttribTemplateName      string = `name`
 	AttribTemplateNamespace string = `namespace`
+	AttribTenant            string = `tenant`
 	AttribWorkerType        string = `worker_type`
 	AttribWorkflowNamespace string = `namespace`
 	AttribWorkflowPhase     string = `phase`
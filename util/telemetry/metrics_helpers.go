@@ -58,6 +58,33 @@ func (m *Metrics) AddErrorCount(ctx context.Context, val int64, errorCause strin
 	m.AddInt(ctx, InstrumentErrorCount.Name(), val, attribs)
 }
 
+// ObserveFairshareUsage observes a value for the fairshare_usage gauge
+// This is a helper method for use inside RegisterCallback functions
+func (m *Metrics) ObserveFairshareUsage(ctx context.Context, o metric.Observer, val float64, tenant string) {
+	inst := m.GetInstrument(InstrumentFairshareUsage.Name())
+	if inst == nil {
+		return
+	}
+	attribs := InstAttribs{
+		{Name: AttribTenant, Value: tenant},
+	}
+	inst.ObserveFloat(ctx, o, val, attribs)
+}
+
+// ObserveFairshareWorkflows observes a value for the fairshare_workflows gauge
+// This is a helper method for use inside RegisterCallback functions
+func (m *Metrics) ObserveFairshareWorkflows(ctx context.Context, o metric.Observer, val int64, tenant string, workflowPhase string) {
+	inst := m.GetInstrument(InstrumentFairshareWorkflows.Name())
+	if inst == nil {
+		return
+	}
+	attribs := InstAttribs{
+		{Name: AttribTenant, Value: tenant},
+		{Name: AttribWorkflowPhase, Value: workflowPhase},
+	}
+	inst.ObserveInt(ctx, o, val, attribs)
+}
+
 // ObserveGauge observes a value for the gauge gauge
 // This is a helper method for use inside RegisterCallback functions
 func (m *Metrics) ObserveGauge(ctx context.Context, o metric.Observer, val int64, workflowStatus string) {
@@ -62,6 +62,33 @@ var InstrumentErrorCount = BuiltinInstrument{
 	},
 }
 
+var InstrumentFairshareUsage = BuiltinInstrument{
+	name:        "fairshare_usage",
+	description: "A gauge of the share of running workflows each fair-share tenant has, relative to the share its weight entitles it to",
+	unit:        "{share}",
+	instType:    Float64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribTenant,
+		},
+	},
+}
+
+var InstrumentFairshareWorkflows = BuiltinInstrument{
+	name:        "fairshare_workflows",
+	description: "A gauge of the number of running and pending workflows of each fair-share tenant",
+	unit:        "{workflow}",
+	instType:    Int64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribTenant,
+		},
+		{
+			name: AttribWorkflowPhase,
+		},
+	},
+}
+
 var InstrumentGauge = BuiltinInstrument{
 	name:        "gauge",
 	description: "A gauge of the number of workflows currently in the cluster in each phase",
@@ -0,0 +1,172 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// Tracing wraps an OpenTelemetry trace provider.
+// When no OTLP endpoint is configured the provider is a no-op, so callers never need to check whether tracing is enabled.
+type Tracing struct {
+	tracer   trace.Tracer
+	shutdown func(context.Context) error
+	enabled  bool
+}
+
+// NewTracing creates a trace provider exporting via OTLP if `OTEL_EXPORTER_OTLP_ENDPOINT` or
+// `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` is set, and installs it as the global provider.
+// extraOpts are intended for tests, and force the SDK provider to be used.
+func NewTracing(ctx context.Context, serviceName string, extraOpts ...tracesdk.TracerProviderOption) (*Tracing, error) {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpTracesEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_TRACES_ENDPOINT`)
+
+	t := &Tracing{shutdown: func(context.Context) error { return nil }}
+	if !otlpEnabled && !otlpTracesEnabled && len(extraOpts) == 0 {
+		t.tracer = noop.NewTracerProvider().Tracer(serviceName)
+		return t, nil
+	}
+
+	options := []tracesdk.TracerProviderOption{
+		tracesdk.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+		tracesdk.WithIDGenerator(&contextIDGenerator{}),
+	}
+	if otlpEnabled || otlpTracesEnabled {
+		logging.RequireLoggerFromContext(ctx).Info(ctx, "Starting OTLP trace exporter")
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, tracesdk.WithBatcher(exporter))
+	}
+	options = append(options, extraOpts...)
+
+	provider := tracesdk.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.tracer = provider.Tracer(serviceName)
+	t.shutdown = provider.Shutdown
+	t.enabled = true
+	return t, nil
+}
+
+// Tracer returns the tracer for this service
+func (t *Tracing) Tracer() trace.Tracer {
+	return t.tracer
+}
+
+// Enabled returns whether spans are recorded, rather than dropped by a no-op provider
+func (t *Tracing) Enabled() bool {
+	return t != nil && t.enabled
+}
+
+// Shutdown flushes any buffered spans. It must be called before the process exits
+// or short-lived processes such as argoexec will lose their spans.
+func (t *Tracing) Shutdown(ctx context.Context) {
+	if err := t.shutdown(ctx); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to shutdown trace provider")
+	}
+}
+
+type spanIDsKey struct{}
+
+type spanIDs struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// ContextWithSpanIDs requests that the next span started with the returned context uses the given IDs.
+// This allows spans to be recorded after the fact, possibly by a different process, and still be parented correctly.
+func ContextWithSpanIDs(ctx context.Context, traceID trace.TraceID, spanID trace.SpanID) context.Context {
+	return context.WithValue(ctx, spanIDsKey{}, spanIDs{traceID: traceID, spanID: spanID})
+}
+
+// contextIDGenerator uses IDs from ContextWithSpanIDs if present, and random ones otherwise
+type contextIDGenerator struct{}
+
+func (g *contextIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if ids, ok := ctx.Value(spanIDsKey{}).(spanIDs); ok {
+		return ids.traceID, ids.spanID
+	}
+	var traceID trace.TraceID
+	_, _ = rand.Read(traceID[:])
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+func (g *contextIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	if ids, ok := ctx.Value(spanIDsKey{}).(spanIDs); ok && ids.traceID == traceID {
+		return ids.spanID
+	}
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
+
+// WorkflowTraceID derives the trace ID of a workflow from its UID, so the controller and every pod agree
+// on it without having to store it anywhere.
+func WorkflowTraceID(workflowUID string) trace.TraceID {
+	var traceID trace.TraceID
+	if b, err := hex.DecodeString(strings.ReplaceAll(workflowUID, "-", "")); err == nil && len(b) == len(traceID) {
+		copy(traceID[:], b)
+		return traceID
+	}
+	sum := sha256.Sum256([]byte(workflowUID))
+	copy(traceID[:], sum[:])
+	return traceID
+}
+
+// WorkflowSpanContext is the span context of the span covering the whole workflow
+func WorkflowSpanContext(workflowUID string) trace.SpanContext {
+	return deterministicSpanContext(workflowUID, "")
+}
+
+// NodeSpanContext is the span context of the span covering a single node of a workflow
+func NodeSpanContext(workflowUID, nodeID string) trace.SpanContext {
+	return deterministicSpanContext(workflowUID, nodeID)
+}
+
+func deterministicSpanContext(workflowUID, nodeID string) trace.SpanContext {
+	var spanID trace.SpanID
+	sum := sha256.Sum256([]byte(workflowUID + "/" + nodeID))
+	copy(spanID[:], sum[:])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    WorkflowTraceID(workflowUID),
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// Traceparent formats a span context as a W3C traceparent header value
+func Traceparent(sc trace.SpanContext) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(trace.ContextWithSpanContext(context.Background(), sc), carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceparent returns a context whose parent span is described by the W3C traceparent value.
+// An empty or invalid value leaves the context unchanged.
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestNewTracingNoop(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tracing, err := NewTracing(ctx, testScopeName)
+	require.NoError(t, err)
+	assert.False(t, tracing.Enabled())
+	_, span := tracing.Tracer().Start(ctx, "noop")
+	assert.False(t, span.IsRecording())
+	span.End()
+	tracing.Shutdown(ctx)
+}
+
+func TestWorkflowTraceID(t *testing.T) {
+	assert.Equal(t, "9b8c4d0a54b44f1d8c9b1a2f3e4d5c6b", WorkflowTraceID("9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b").String())
+	// not a UUID, but must still be stable and valid
+	assert.Equal(t, WorkflowTraceID("my-uid"), WorkflowTraceID("my-uid"))
+	assert.True(t, WorkflowTraceID("my-uid").IsValid())
+}
+
+func TestNodeSpanContext(t *testing.T) {
+	uid := "9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b"
+	wf := WorkflowSpanContext(uid)
+	node := NodeSpanContext(uid, "my-wf-123")
+	assert.True(t, wf.IsValid())
+	assert.True(t, node.IsValid())
+	assert.Equal(t, wf.TraceID(), node.TraceID())
+	assert.NotEqual(t, wf.SpanID(), node.SpanID())
+	assert.Equal(t, node, NodeSpanContext(uid, "my-wf-123"))
+}
+
+func TestTraceparentRoundTrip(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	sc := NodeSpanContext("9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b", "my-wf-123")
+	traceparent := Traceparent(sc)
+	assert.Equal(t, "00-9b8c4d0a54b44f1d8c9b1a2f3e4d5c6b-"+sc.SpanID().String()+"-01", traceparent)
+
+	extracted := trace.SpanContextFromContext(ContextWithTraceparent(ctx, traceparent))
+	assert.Equal(t, sc.TraceID(), extracted.TraceID())
+	assert.Equal(t, sc.SpanID(), extracted.SpanID())
+
+	assert.False(t, trace.SpanContextFromContext(ContextWithTraceparent(ctx, "")).IsValid())
+	assert.False(t, trace.SpanContextFromContext(ContextWithTraceparent(ctx, "garbage")).IsValid())
+}
+
+func TestContextWithSpanIDs(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	exporter := tracetest.NewInMemoryExporter()
+	tracing, err := NewTracing(ctx, testScopeName, tracesdk.WithSyncer(exporter))
+	require.NoError(t, err)
+	defer tracing.Shutdown(ctx)
+	assert.True(t, tracing.Enabled())
+
+	uid := "9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b"
+	wf := WorkflowSpanContext(uid)
+	node := NodeSpanContext(uid, "my-wf-123")
+
+	_, root := tracing.Tracer().Start(ContextWithSpanIDs(ctx, wf.TraceID(), wf.SpanID()), "workflow")
+	root.End()
+	parentCtx := trace.ContextWithRemoteSpanContext(ctx, wf)
+	_, child := tracing.Tracer().Start(ContextWithSpanIDs(parentCtx, node.TraceID(), node.SpanID()), "node")
+	child.End()
+	_, random := tracing.Tracer().Start(parentCtx, "random")
+	random.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	assert.Equal(t, wf.TraceID(), spans[0].SpanContext.TraceID())
+	assert.Equal(t, wf.SpanID(), spans[0].SpanContext.SpanID())
+	assert.False(t, spans[0].Parent.IsValid())
+	assert.Equal(t, node.SpanID(), spans[1].SpanContext.SpanID())
+	assert.Equal(t, wf.SpanID(), spans[1].Parent.SpanID())
+	assert.Equal(t, wf.TraceID(), spans[2].SpanContext.TraceID())
+	assert.NotEqual(t, node.SpanID(), spans[2].SpanContext.SpanID())
+}
@@ -8,6 +8,12 @@ import (
 
 // Replace takes a json-formatted string and performs variable replacement.
 func Replace(ctx context.Context, s string, replaceMap map[string]string, allowUnresolved bool) (string, error) {
+	return ReplaceWithTypedValues(ctx, s, replaceMap, nil, allowUnresolved)
+}
+
+// ReplaceWithTypedValues takes a json-formatted string and performs variable replacement, where expressions see the
+// variables with typed values as those values rather than as strings.
+func ReplaceWithTypedValues(ctx context.Context, s string, replaceMap map[string]string, typedValues map[string]interface{}, allowUnresolved bool) (string, error) {
 	if !json.Valid([]byte(s)) {
 		return "", errors.New("cannot do template replacements with invalid JSON")
 	}
@@ -18,7 +24,11 @@ func Replace(ctx context.Context, s string, replaceMap map[string]string, allowU
 	}
 	interReplaceMap := make(map[string]interface{})
 	for k, v := range replaceMap {
-		interReplaceMap[k] = v
+		if typed, ok := typedValues[k]; ok {
+			interReplaceMap[k] = TypedValue{String: v, Value: typed}
+		} else {
+			interReplaceMap[k] = v
+		}
 	}
 	replacedString, err := t.Replace(ctx, interReplaceMap, allowUnresolved)
 	if err != nil {
@@ -176,3 +176,19 @@ func TestReplaceStringWithExpression(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, toJSONString("test world"), replacement)
 }
+
+func TestReplaceWithTypedValues(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	replaceMap := map[string]string{"inputs.parameters.replicas": "3", "inputs.parameters.debug": "true"}
+	typedValues := map[string]interface{}{"inputs.parameters.replicas": 3, "inputs.parameters.debug": true}
+
+	test := toJSONString(`{{inputs.parameters.replicas}} {{= inputs.parameters.replicas + 1 }} {{= inputs.parameters.debug ? "on" : "off" }}`)
+	replacement, err := ReplaceWithTypedValues(ctx, test, replaceMap, typedValues, false)
+	require.NoError(t, err)
+	assert.Equal(t, toJSONString("3 4 on"), replacement)
+
+	test = toJSONString(`{{= inputs.parameters.replicas + "1" }}`)
+	replacement, err = Replace(ctx, test, replaceMap, false)
+	require.NoError(t, err)
+	assert.Equal(t, toJSONString("31"), replacement)
+}
@@ -13,6 +13,9 @@ import (
 
 func simpleReplace(ctx context.Context, w io.Writer, tag string, replaceMap map[string]interface{}, allowUnresolved bool) (int, error) {
 	replacement, ok := replaceMap[strings.TrimSpace(tag)]
+	if typed, isTyped := replacement.(TypedValue); isTyped {
+		replacement = typed.String
+	}
 	if !ok {
 		// Attempt to resolve nested tags, if possible
 		if index := strings.LastIndex(tag, "{{"); index > 0 {
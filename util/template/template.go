@@ -15,6 +15,12 @@ const (
 	suffix = "}}"
 )
 
+// TypedValue is replaced by its string form, but is seen by expressions as its typed value
+type TypedValue struct {
+	String string
+	Value  interface{}
+}
+
 type Template interface {
 	Replace(ctx context.Context, replaceMap map[string]interface{}, allowUnresolved bool) (string, error)
 }
@@ -37,7 +43,7 @@ func (t *impl) Replace(ctx context.Context, replaceMap map[string]interface{}, a
 		kind, expression := parseTag(tag)
 		switch kind {
 		case kindExpression:
-			env := exprenv.GetFuncMap(replaceMap)
+			env := exprenv.GetFuncMap(typedValues(replaceMap))
 			return expressionReplace(ctx, w, expression, env, allowUnresolved)
 		default:
 			return simpleReplace(ctx, w, tag, replaceMap, allowUnresolved)
@@ -45,3 +51,15 @@ func (t *impl) Replace(ctx context.Context, replaceMap map[string]interface{}, a
 	})
 	return replacedTmpl.String(), err
 }
+
+// typedValues returns the replace map with any typed values in place of their string forms
+func typedValues(replaceMap map[string]interface{}) map[string]interface{} {
+	env := make(map[string]interface{}, len(replaceMap))
+	for k, v := range replaceMap {
+		if typed, ok := v.(TypedValue); ok {
+			v = typed.Value
+		}
+		env[k] = v
+	}
+	return env
+}
@@ -15,7 +15,9 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 
 	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
@@ -35,6 +37,12 @@ type ArtifactDriver struct {
 
 var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
 
+const (
+	uploadBlockSize      = 8 * 1024 * 1024
+	uploadConcurrency    = 4
+	downloadBlockRetries = 5
+)
+
 // newAzureContainerClient creates a new container.Client for interacting with the specified Azure Blob Storage container
 // The container client is created with the default azblob.ClientOptions which does include retry behavior
 // for failed requests.
@@ -190,7 +198,10 @@ func DownloadFile(ctx context.Context, containerClient *container.Client, blobNa
 		}
 	}()
 
-	_, err = blobClient.DownloadFile(ctx, outFile, nil)
+	// each block is retried from where it failed, rather than the whole file
+	_, err = blobClient.DownloadFile(ctx, outFile, &blob.DownloadFileOptions{
+		RetryReaderOptionsPerBlock: blob.RetryReaderOptions{MaxRetries: downloadBlockRetries},
+	})
 	return err
 }
 
@@ -322,7 +333,8 @@ func PutFile(ctx context.Context, containerClient *container.Client, blobName, p
 		}
 	}()
 
-	_, err = blobClient.UploadFile(ctx, file, nil)
+	// the file is uploaded in blocks, which are retried individually, and only committed once they are all uploaded
+	_, err = blobClient.UploadFile(ctx, file, &blockblob.UploadFileOptions{BlockSize: uploadBlockSize, Concurrency: uploadConcurrency})
 	return err
 }
 
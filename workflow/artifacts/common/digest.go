@@ -0,0 +1,81 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+const digestAlgorithmSHA256 = "sha256"
+
+// FileDigest returns the digest of the content of a file, as "sha256:<hex>"
+func FileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return formatDigest(h), nil
+}
+
+func formatDigest(h hash.Hash) string {
+	return digestAlgorithmSHA256 + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+func newDigestHash(digest string) (hash.Hash, error) {
+	algorithm, _, ok := strings.Cut(digest, ":")
+	if !ok || algorithm != digestAlgorithmSHA256 {
+		return nil, fmt.Errorf("unsupported artifact digest %q, only sha256 is supported", digest)
+	}
+	return sha256.New(), nil
+}
+
+// VerifyFileDigest returns an error if the content of the file does not match the digest
+func VerifyFileDigest(path, digest string) error {
+	if _, err := newDigestHash(digest); err != nil {
+		return err
+	}
+	actual, err := FileDigest(path)
+	if err != nil {
+		return err
+	}
+	if actual != digest {
+		return fmt.Errorf("artifact digest mismatch: expected %s, got %s", digest, actual)
+	}
+	return nil
+}
+
+type digestVerifyingReader struct {
+	io.ReadCloser
+	hash   hash.Hash
+	digest string
+}
+
+// NewDigestVerifyingReader returns a reader that returns an error instead of io.EOF if the content read does not
+// match the digest, so a truncated or corrupted stream is not mistaken for a complete one
+func NewDigestVerifyingReader(rc io.ReadCloser, digest string) (io.ReadCloser, error) {
+	h, err := newDigestHash(digest)
+	if err != nil {
+		return nil, err
+	}
+	return &digestVerifyingReader{ReadCloser: rc, hash: h, digest: digest}, nil
+}
+
+func (r *digestVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := formatDigest(r.hash); actual != r.digest {
+			return n, fmt.Errorf("artifact digest mismatch: expected %s, got %s", r.digest, actual)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the sha256 of "hello"
+const helloDigest = "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestFileDigest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hello")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+	digest, err := FileDigest(path)
+	require.NoError(t, err)
+	assert.Equal(t, helloDigest, digest)
+
+	require.NoError(t, VerifyFileDigest(path, helloDigest))
+	require.ErrorContains(t, VerifyFileDigest(path, "sha256:0000"), "artifact digest mismatch")
+	require.ErrorContains(t, VerifyFileDigest(path, "md5:5d41402abc4b2a76b9719d911017c592"), "unsupported artifact digest")
+}
+
+func TestDigestVerifyingReader(t *testing.T) {
+	t.Run("Match", func(t *testing.T) {
+		rc, err := NewDigestVerifyingReader(io.NopCloser(strings.NewReader("hello")), helloDigest)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		rc, err := NewDigestVerifyingReader(io.NopCloser(strings.NewReader("hell")), helloDigest)
+		require.NoError(t, err)
+		_, err = io.ReadAll(rc)
+		require.ErrorContains(t, err, "artifact digest mismatch")
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := NewDigestVerifyingReader(io.NopCloser(strings.NewReader("hello")), "hello")
+		require.Error(t, err)
+	})
+}
+
+// flakyReader fails once after returning failAfter bytes
+type flakyReader struct {
+	r         io.Reader
+	failAfter int
+	read      int
+}
+
+func (f *flakyReader) Read(p []byte) (int, error) {
+	if f.failAfter >= 0 && f.read >= f.failAfter {
+		return 0, errors.New("connection reset")
+	}
+	if f.failAfter >= 0 && len(p) > f.failAfter-f.read {
+		p = p[:f.failAfter-f.read]
+	}
+	n, err := f.r.Read(p)
+	f.read += n
+	return n, err
+}
+
+func TestResumingReader(t *testing.T) {
+	const content = "hello world"
+	var offsets []int64
+	open := func(offset int64) (io.ReadCloser, error) {
+		offsets = append(offsets, offset)
+		failAfter := -1
+		if len(offsets) == 1 {
+			failAfter = 4
+		}
+		return io.NopCloser(&flakyReader{r: strings.NewReader(content[offset:]), failAfter: failAfter}), nil
+	}
+	t.Run("Resumes", func(t *testing.T) {
+		offsets = nil
+		rc, err := NewResumingReader(open, 1)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+		assert.Equal(t, []int64{0, 4}, offsets)
+		require.NoError(t, rc.Close())
+	})
+	t.Run("NoRetries", func(t *testing.T) {
+		offsets = nil
+		rc, err := NewResumingReader(open, 0)
+		require.NoError(t, err)
+		_, err = io.ReadAll(rc)
+		require.EqualError(t, err, "connection reset")
+	})
+}
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"io"
+)
+
+type resumingReader struct {
+	open    func(offset int64) (io.ReadCloser, error)
+	rc      io.ReadCloser
+	offset  int64
+	retries int
+}
+
+// NewResumingReader returns a reader of the stream opened by open, which re-opens the stream at the current offset
+// when a read fails, up to retries times, so a transient failure part way through a large download does not
+// restart it from the beginning
+func NewResumingReader(open func(offset int64) (io.ReadCloser, error), retries int) (io.ReadCloser, error) {
+	rc, err := open(0)
+	if err != nil {
+		return nil, err
+	}
+	return &resumingReader{open: open, rc: rc, retries: retries}, nil
+}
+
+func (r *resumingReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.offset += int64(n)
+	if err == nil || err == io.EOF || r.retries <= 0 {
+		return n, err
+	}
+	r.retries--
+	_ = r.rc.Close()
+	rc, openErr := r.open(r.offset)
+	if openErr != nil {
+		// keep a reader to close, and report the original failure
+		r.rc = io.NopCloser(&errReader{err})
+		return n, err
+	}
+	r.rc = rc
+	if n > 0 {
+		return n, nil
+	}
+	return r.Read(p)
+}
+
+func (r *resumingReader) Close() error {
+	return r.rc.Close()
+}
+
+type errReader struct{ err error }
+
+func (r *errReader) Read([]byte) (int, error) { return 0, r.err }
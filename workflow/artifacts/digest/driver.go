@@ -0,0 +1,75 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// driver records the digest of file artifacts when they are saved, and verifies it when they are loaded,
+// so that a truncated or corrupted artifact is not mistaken for a good one, whichever driver is used
+type driver struct {
+	common.ArtifactDriver
+}
+
+func New(d common.ArtifactDriver) common.ArtifactDriver {
+	return &driver{d}
+}
+
+// isFile is false for directories, and paths the driver does not use locally
+func isFile(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.Mode().IsRegular()
+}
+
+// verifiable is false for artifacts without a digest, and for a sub-path of an artifact, as the digest is of the
+// whole artifact
+func verifiable(a *wfv1.Artifact) bool {
+	return a.Digest != "" && a.SubPath == ""
+}
+
+func (d *driver) Load(ctx context.Context, inputArtifact *wfv1.Artifact, path string) error {
+	if err := d.ArtifactDriver.Load(ctx, inputArtifact, path); err != nil {
+		return err
+	}
+	if !verifiable(inputArtifact) || !isFile(path) {
+		return nil
+	}
+	if err := common.VerifyFileDigest(path, inputArtifact.Digest); err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to verify artifact %s: %w", inputArtifact.Name, err)
+	}
+	return nil
+}
+
+func (d *driver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	rc, err := d.ArtifactDriver.OpenStream(ctx, inputArtifact)
+	if err != nil || !verifiable(inputArtifact) {
+		return rc, err
+	}
+	verifying, err := common.NewDigestVerifyingReader(rc, inputArtifact.Digest)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return verifying, nil
+}
+
+func (d *driver) Save(ctx context.Context, path string, outputArtifact *wfv1.Artifact) error {
+	var digest string
+	if isFile(path) {
+		var err error
+		if digest, err = common.FileDigest(path); err != nil {
+			return err
+		}
+	}
+	if err := d.ArtifactDriver.Save(ctx, path, outputArtifact); err != nil {
+		return err
+	}
+	outputArtifact.Digest = digest
+	return nil
+}
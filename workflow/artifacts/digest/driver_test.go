@@ -0,0 +1,89 @@
+package digest
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// the sha256 of "hello"
+const helloDigest = "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+// fakeDriver stores a single artifact in memory
+type fakeDriver struct {
+	common.ArtifactDriver
+	data string
+}
+
+func (d *fakeDriver) Load(_ context.Context, _ *wfv1.Artifact, path string) error {
+	return os.WriteFile(path, []byte(d.data), 0o600)
+}
+
+func (d *fakeDriver) OpenStream(context.Context, *wfv1.Artifact) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(d.data)), nil
+}
+
+func (d *fakeDriver) Save(_ context.Context, path string, _ *wfv1.Artifact) error {
+	if fi, err := os.Stat(path); err != nil || fi.IsDir() {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	d.data = string(data)
+	return err
+}
+
+func TestDriver(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "hello")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+	fake := &fakeDriver{}
+	drv := New(fake)
+
+	t.Run("Save", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "hello"}
+		require.NoError(t, drv.Save(ctx, path, art))
+		assert.Equal(t, helloDigest, art.Digest)
+	})
+	t.Run("SaveDirectory", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "dir", Digest: helloDigest}
+		require.NoError(t, drv.Save(ctx, t.TempDir(), art))
+		assert.Empty(t, art.Digest)
+	})
+	t.Run("Load", func(t *testing.T) {
+		out := filepath.Join(dir, "load")
+		require.NoError(t, drv.Load(ctx, &wfv1.Artifact{Name: "hello", Digest: helloDigest}, out))
+		assert.FileExists(t, out)
+	})
+	t.Run("LoadMismatch", func(t *testing.T) {
+		fake.data = "hell"
+		defer func() { fake.data = "hello" }()
+		out := filepath.Join(dir, "mismatch")
+		err := drv.Load(ctx, &wfv1.Artifact{Name: "hello", Digest: helloDigest}, out)
+		require.ErrorContains(t, err, "failed to verify artifact hello")
+		assert.NoFileExists(t, out)
+	})
+	t.Run("LoadSubPath", func(t *testing.T) {
+		fake.data = "hell"
+		defer func() { fake.data = "hello" }()
+		art := &wfv1.Artifact{Name: "hello", Digest: helloDigest, SubPath: "a"}
+		require.NoError(t, drv.Load(ctx, art, filepath.Join(dir, "subpath")))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		fake.data = "hell"
+		defer func() { fake.data = "hello" }()
+		rc, err := drv.OpenStream(ctx, &wfv1.Artifact{Name: "hello", Digest: helloDigest})
+		require.NoError(t, err)
+		_, err = io.ReadAll(rc)
+		require.ErrorContains(t, err, "artifact digest mismatch")
+	})
+}
@@ -36,6 +36,13 @@ var (
 	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
 )
 
+const (
+	uploadChunkSize          = 16 * 1024 * 1024
+	uploadChunkRetryDeadline = 5 * time.Minute
+	// downloadResumeRetries is how many times a download that fails part way through is resumed from where it failed
+	downloadResumeRetries = 5
+)
+
 // from https://github.com/googleapis/google-cloud-go/blob/master/storage/go110.go
 func isTransientGCSErr(ctx context.Context, err error) bool {
 	if err == io.ErrUnexpectedEOF || errutil.IsTransientErr(ctx, err) {
@@ -153,7 +160,10 @@ func downloadObject(ctx context.Context, client *storage.Client, bucket, key, ob
 			return fmt.Errorf("mkdir %s: %w", objectDir, err)
 		}
 	}
-	rc, err := client.Bucket(bucket).Object(objName).NewReader(ctx)
+	obj := client.Bucket(bucket).Object(objName)
+	rc, err := common.NewResumingReader(func(offset int64) (io.ReadCloser, error) {
+		return obj.NewRangeReader(ctx, offset, -1)
+	}, downloadResumeRetries)
 	if err != nil {
 		if err == storage.ErrObjectNotExist {
 			return errors.New(errors.CodeNotFound, err.Error())
@@ -309,6 +319,9 @@ func uploadObject(ctx context.Context, client *storage.Client, bucket, key, loca
 		}
 	}()
 	wc := client.Bucket(bucket).Object(key).NewWriter(ctx)
+	// the upload is resumable, each chunk is retried on transient failures rather than the whole object
+	wc.ChunkSize = uploadChunkSize
+	wc.ChunkRetryDeadline = uploadChunkRetryDeadline
 	if _, err = io.Copy(wc, f); err != nil {
 		return fmt.Errorf("io copy: %w", err)
 	}
@@ -0,0 +1,173 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // S3 part ETags are MD5 sums
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// multipartPartSize is the minimum size of the parts of large files. Files larger than this are uploaded in parts, and
+// the parts uploaded by a previous attempt are not uploaded again, so large uploads survive transient network failures.
+const multipartPartSize int64 = 64 * 1024 * 1024
+
+// maxMultipartParts is the most parts an S3 multipart upload can have
+const maxMultipartParts = 10000
+
+// multipartCore is the subset of minio.Core used for multipart uploads
+type multipartCore interface {
+	NewMultipartUpload(ctx context.Context, bucket, object string, opts minio.PutObjectOptions) (string, error)
+	ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (minio.ListMultipartUploadsResult, error)
+	ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker, maxParts int) (minio.ListObjectPartsResult, error)
+	PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data io.Reader, size int64, opts minio.PutObjectPartOptions) (minio.ObjectPart, error)
+	CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, parts []minio.CompletePart, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) error
+}
+
+var _ multipartCore = minio.Core{}
+
+// findMultipartUpload returns the most recent incomplete upload of the key and its uploaded parts by number.
+// Failing to list them, e.g. because the credentials do not allow it, just means a new upload is started.
+func findMultipartUpload(ctx context.Context, core multipartCore, bucket, key string) (string, map[int]minio.ObjectPart) {
+	log := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"bucket": bucket, "key": key})
+	uploads, err := core.ListMultipartUploads(ctx, bucket, key, "", "", "", 1000)
+	if err != nil {
+		log.WithError(err).Warn(ctx, "Failed to list incomplete multipart uploads")
+		return "", nil
+	}
+	var latest *minio.ObjectMultipartInfo
+	for i, upload := range uploads.Uploads {
+		if upload.Key == key && (latest == nil || upload.Initiated.After(latest.Initiated)) {
+			latest = &uploads.Uploads[i]
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+	result, err := core.ListObjectParts(ctx, bucket, key, latest.UploadID, 0, 10000)
+	if err != nil {
+		log.WithError(err).Warn(ctx, "Failed to list the parts of an incomplete multipart upload")
+		return "", nil
+	}
+	parts := make(map[int]minio.ObjectPart, len(result.ObjectParts))
+	for _, part := range result.ObjectParts {
+		parts[part.PartNumber] = part
+	}
+	log.WithFields(logging.Fields{"uploadID": latest.UploadID, "parts": len(parts)}).Info(ctx, "Resuming multipart upload")
+	return latest.UploadID, parts
+}
+
+// partSizeOf returns the size of the parts of a file: the minimum, unless the file needs more than maxMultipartParts of
+// those, when it is the size that needs the most parts rounded up to a MiB. An incomplete upload keeps the size of the
+// parts it was started with, which is that of its first part, if that size is still large enough for the file.
+func partSizeOf(fileSize, minPartSize int64, uploaded map[int]minio.ObjectPart) int64 {
+	if part, ok := uploaded[1]; ok && part.Size >= minPartSize && ceilDiv(fileSize, part.Size) <= maxMultipartParts {
+		return part.Size
+	}
+	partSize := ceilDiv(fileSize, maxMultipartParts)
+	if partSize <= minPartSize {
+		return minPartSize
+	}
+	const mib = 1024 * 1024
+	return ceilDiv(partSize, mib) * mib
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+func partMD5(r io.ReadSeeker) ([]byte, error) {
+	h := md5.New() //nolint:gosec
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	_, err := r.Seek(0, io.SeekStart)
+	return h.Sum(nil), err
+}
+
+// putFileMultipart uploads a file in parts of at least minPartSize, resuming an incomplete upload of the key if there
+// is one.
+// Uploaded parts are only reused if their ETag is the MD5 of the same part of the file, which is not the case for
+// encrypted objects, so those are uploaded again.
+// Parts that fail transiently are retried, and the upload is aborted if a part or its completion still fails, so
+// only uploads that are interrupted, e.g. by the pod being killed, are left to be resumed.
+func putFileMultipart(ctx context.Context, core multipartCore, bucket, key, path string, sse encrypt.ServerSide, minPartSize int64, sendContentMd5 bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	uploadID, uploaded := findMultipartUpload(ctx, core, bucket, key)
+	if uploadID == "" {
+		uploadID, err = core.NewMultipartUpload(ctx, bucket, key, minio.PutObjectOptions{ServerSideEncryption: sse})
+		if err != nil {
+			return err
+		}
+	}
+	partSize := partSizeOf(fi.Size(), minPartSize, uploaded)
+	err = putParts(ctx, core, bucket, key, uploadID, uploaded, f, fi.Size(), sse, partSize, sendContentMd5)
+	if err != nil {
+		// incomplete uploads are billed until they are aborted
+		if abortErr := core.AbortMultipartUpload(ctx, bucket, key, uploadID); abortErr != nil {
+			logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"bucket": bucket, "key": key, "uploadID": uploadID}).WithError(abortErr).Warn(ctx, "Failed to abort multipart upload")
+		}
+		return err
+	}
+	return nil
+}
+
+// putParts uploads the parts of the file that are not already uploaded, and completes the upload
+func putParts(ctx context.Context, core multipartCore, bucket, key, uploadID string, uploaded map[int]minio.ObjectPart, f io.ReaderAt, fileSize int64, sse encrypt.ServerSide, partSize int64, sendContentMd5 bool) error {
+	// only customer provided keys must be sent with every part
+	var partSSE encrypt.ServerSide
+	if sse != nil && sse.Type() == encrypt.SSEC {
+		partSSE = sse
+	}
+	var parts []minio.CompletePart
+	for number, offset := 1, int64(0); offset < fileSize; number, offset = number+1, offset+partSize {
+		size := min(partSize, fileSize-offset)
+		section := io.NewSectionReader(f, offset, size)
+		sum, err := partMD5(section)
+		if err != nil {
+			return err
+		}
+		if part, ok := uploaded[number]; ok && part.Size == size && strings.Trim(part.ETag, `"`) == hex.EncodeToString(sum) {
+			parts = append(parts, minio.CompletePart{PartNumber: number, ETag: part.ETag})
+			continue
+		}
+		opts := minio.PutObjectPartOptions{SSE: partSSE}
+		if sendContentMd5 {
+			opts.Md5Base64 = base64.StdEncoding.EncodeToString(sum)
+		}
+		var part minio.ObjectPart
+		err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
+			return isTransientS3Err(ctx, err)
+		}, func() error {
+			// a retry uploads the part from its start
+			_, err := section.Seek(0, io.SeekStart)
+			if err == nil {
+				part, err = core.PutObjectPart(ctx, bucket, key, uploadID, number, section, size, opts)
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		parts = append(parts, minio.CompletePart{PartNumber: number, ETag: part.ETag})
+	}
+	_, err := core.CompleteMultipartUpload(ctx, bucket, key, uploadID, parts, minio.PutObjectOptions{ServerSideEncryption: sse})
+	return err
+}
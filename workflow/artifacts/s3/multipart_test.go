@@ -0,0 +1,179 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// fakeCore records the parts put, and fails putting part failPart, and putting part transientPart transientFailures times
+type fakeCore struct {
+	uploads           []minio.ObjectMultipartInfo
+	parts             map[int]minio.ObjectPart
+	put               []int
+	failPart          int
+	transientPart     int
+	transientFailures int
+	failComplete      bool
+	completed         []minio.CompletePart
+	// failAbort fails aborts, as if the upload was interrupted, so that it is left to be resumed
+	failAbort bool
+	aborted   []string
+}
+
+func (c *fakeCore) NewMultipartUpload(context.Context, string, string, minio.PutObjectOptions) (string, error) {
+	c.uploads = append(c.uploads, minio.ObjectMultipartInfo{Key: "my-key", UploadID: "new", Initiated: time.Now()})
+	c.parts = map[int]minio.ObjectPart{}
+	return "new", nil
+}
+
+func (c *fakeCore) ListMultipartUploads(context.Context, string, string, string, string, string, int) (minio.ListMultipartUploadsResult, error) {
+	return minio.ListMultipartUploadsResult{Uploads: c.uploads}, nil
+}
+
+func (c *fakeCore) ListObjectParts(context.Context, string, string, string, int, int) (minio.ListObjectPartsResult, error) {
+	var result minio.ListObjectPartsResult
+	for _, part := range c.parts {
+		result.ObjectParts = append(result.ObjectParts, part)
+	}
+	return result, nil
+}
+
+func (c *fakeCore) PutObjectPart(_ context.Context, _, _, _ string, partID int, data io.Reader, size int64, _ minio.PutObjectPartOptions) (minio.ObjectPart, error) {
+	if partID == c.transientPart && c.transientFailures > 0 {
+		c.transientFailures--
+		return minio.ObjectPart{}, minio.ErrorResponse{Code: "SlowDown"}
+	}
+	if partID == c.failPart {
+		return minio.ObjectPart{}, fmt.Errorf("connection reset")
+	}
+	h := md5.New() //nolint:gosec
+	if _, err := io.Copy(h, data); err != nil {
+		return minio.ObjectPart{}, err
+	}
+	c.put = append(c.put, partID)
+	part := minio.ObjectPart{PartNumber: partID, Size: size, ETag: `"` + hex.EncodeToString(h.Sum(nil)) + `"`}
+	c.parts[partID] = part
+	return part, nil
+}
+
+func (c *fakeCore) CompleteMultipartUpload(_ context.Context, _, _, _ string, parts []minio.CompletePart, _ minio.PutObjectOptions) (minio.UploadInfo, error) {
+	if c.failComplete {
+		return minio.UploadInfo{}, fmt.Errorf("invalid part")
+	}
+	c.completed = parts
+	return minio.UploadInfo{}, nil
+}
+
+func (c *fakeCore) AbortMultipartUpload(_ context.Context, _, _, uploadID string) error {
+	if c.failAbort {
+		return fmt.Errorf("connection reset")
+	}
+	c.aborted = append(c.aborted, uploadID)
+	c.uploads = nil
+	c.parts = nil
+	return nil
+}
+
+func TestPutFileMultipart(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o600))
+	core := &fakeCore{failPart: 3, failAbort: true}
+
+	err := putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false)
+	require.EqualError(t, err, "connection reset")
+	assert.Equal(t, []int{1, 2}, core.put)
+	assert.Nil(t, core.completed)
+
+	// the retry of an upload that was not aborted only uploads the part that failed
+	core.put = nil
+	core.failPart = 0
+	require.NoError(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false))
+	assert.Equal(t, []int{3}, core.put)
+	require.Len(t, core.completed, 3)
+	for i, part := range core.completed {
+		assert.Equal(t, i+1, part.PartNumber)
+		assert.Equal(t, core.parts[i+1].ETag, part.ETag)
+	}
+	assert.Len(t, core.uploads, 1)
+}
+
+func TestPutFileMultipart_ChangedFile(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o600))
+	core := &fakeCore{failPart: 3, failAbort: true}
+	require.Error(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false))
+
+	// parts of the incomplete upload that no longer match the file are uploaded again
+	require.NoError(t, os.WriteFile(path, []byte("0123abcd89"), 0o600))
+	core.put = nil
+	core.failPart = 0
+	require.NoError(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false))
+	assert.Equal(t, []int{2, 3}, core.put)
+}
+
+func TestPutFileMultipart_Abort(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o600))
+
+	t.Run("PartFails", func(t *testing.T) {
+		core := &fakeCore{failPart: 2}
+		require.EqualError(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false), "connection reset")
+		assert.Equal(t, []int{1}, core.put)
+		assert.Equal(t, []string{"new"}, core.aborted)
+		assert.Empty(t, core.uploads, "nothing is left to be resumed")
+	})
+	t.Run("CompleteFails", func(t *testing.T) {
+		core := &fakeCore{failComplete: true}
+		require.EqualError(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false), "invalid part")
+		assert.Equal(t, []int{1, 2, 3}, core.put)
+		assert.Equal(t, []string{"new"}, core.aborted)
+	})
+	t.Run("TransientPartFailure", func(t *testing.T) {
+		core := &fakeCore{transientPart: 2, transientFailures: 1}
+		require.NoError(t, putFileMultipart(ctx, core, "my-bucket", "my-key", path, nil, 4, false))
+		assert.Equal(t, []int{1, 2, 3}, core.put)
+		assert.Empty(t, core.aborted)
+		assert.Len(t, core.completed, 3)
+	})
+}
+
+func TestPartSizeOf(t *testing.T) {
+	const mib, gib, tib = int64(1024 * 1024), int64(1024 * 1024 * 1024), int64(1024 * 1024 * 1024 * 1024)
+	tests := []struct {
+		name     string
+		fileSize int64
+		uploaded map[int]minio.ObjectPart
+		expected int64
+	}{
+		{"Minimum", 100 * mib, nil, multipartPartSize},
+		{"AtLimit", maxMultipartParts * multipartPartSize, nil, multipartPartSize},
+		{"AboveLimit", maxMultipartParts*multipartPartSize + 1, nil, 65 * mib},
+		{"Large", tib, nil, 105 * mib},
+		{"Resumed", tib, map[int]minio.ObjectPart{1: {Size: 128 * mib}}, 128 * mib},
+		{"ResumedTooSmall", tib, map[int]minio.ObjectPart{1: {Size: multipartPartSize}}, 105 * mib},
+		{"ResumedBelowMinimum", gib, map[int]minio.ObjectPart{1: {Size: mib}}, multipartPartSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			partSize := partSizeOf(tt.fileSize, multipartPartSize, tt.uploaded)
+			assert.Equal(t, tt.expected, partSize)
+			assert.LessOrEqual(t, ceilDiv(tt.fileSize, partSize), int64(maxMultipartParts))
+		})
+	}
+}
@@ -514,6 +514,9 @@ func (s *s3client) PutFile(bucket, key, path string) error {
 		return err
 	}
 
+	if fi, err := os.Stat(path); err == nil && fi.Size() > multipartPartSize {
+		return putFileMultipart(s.ctx, minio.Core{Client: s.minioClient}, bucket, key, path, encOpts, multipartPartSize, s.SendContentMd5)
+	}
 	_, err = s.minioClient.FPutObject(s.ctx, bucket, key, path, minio.PutObjectOptions{SendContentMd5: s.SendContentMd5, ServerSideEncryption: encOpts})
 	if err != nil {
 		return err
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// MaxBackfillScheduledTimes is the maximum number of scheduled times a single backfill may run the workflow for
+const MaxBackfillScheduledTimes = 1000
+
+// GetBackfillScheduledTimes returns the times from the start to the end of the backfill, inclusive, that match any of
+// the CronWorkflow's schedules, in order. It is an error for there to be more than MaxBackfillScheduledTimes.
+func GetBackfillScheduledTimes(spec *wfv1.CronWorkflowSpec, backfill wfv1.CronWorkflowBackfill) ([]time.Time, error) {
+	seen := make(map[time.Time]bool)
+	var times []time.Time
+	for _, schedule := range spec.GetSchedulesWithTimezone() {
+		sched, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("cron schedule %s is malformed: %w", schedule, err)
+		}
+		// Next returns the first time strictly after the one given, so start just before the start to include it
+		for t := sched.Next(backfill.Start.Add(-time.Second)); !t.IsZero() && !t.After(backfill.End.Time); t = sched.Next(t) {
+			t = t.UTC()
+			if seen[t] {
+				continue
+			}
+			if len(times) == MaxBackfillScheduledTimes {
+				return nil, fmt.Errorf("backfill %q has more than %d scheduled times", backfill.Name, MaxBackfillScheduledTimes)
+			}
+			seen[t] = true
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times, nil
+}
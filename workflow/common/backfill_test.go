@@ -0,0 +1,40 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestGetBackfillScheduledTimes(t *testing.T) {
+	date := func(hour, minute int) metav1.Time {
+		return metav1.NewTime(time.Date(2024, 10, 21, hour, minute, 0, 0, time.UTC))
+	}
+	spec := &wfv1.CronWorkflowSpec{Schedules: []string{"0 * * * *", "30 1 * * *", "0 2 * * *"}}
+
+	times, err := GetBackfillScheduledTimes(spec, wfv1.CronWorkflowBackfill{Name: "b", Start: date(1, 0), End: date(2, 0)})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{date(1, 0).Time, date(1, 30).Time, date(2, 0).Time}, times)
+
+	times, err = GetBackfillScheduledTimes(spec, wfv1.CronWorkflowBackfill{Name: "b", Start: date(1, 1), End: date(1, 29)})
+	require.NoError(t, err)
+	assert.Empty(t, times)
+
+	t.Run("Timezone", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedules: []string{"0 2 * * *"}, Timezone: "America/New_York"}
+		times, err := GetBackfillScheduledTimes(spec, wfv1.CronWorkflowBackfill{Name: "b", Start: date(0, 0), End: date(12, 0)})
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{date(6, 0).Time}, times)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		spec := &wfv1.CronWorkflowSpec{Schedules: []string{"* * * * *"}}
+		_, err := GetBackfillScheduledTimes(spec, wfv1.CronWorkflowBackfill{Name: "b", Start: date(0, 0), End: metav1.NewTime(date(0, 0).AddDate(0, 0, 1))})
+		require.EqualError(t, err, `backfill "b" has more than 1000 scheduled times`)
+	})
+}
@@ -64,6 +64,14 @@ const (
 	// the strategy for the pod, in case the pod is orphaned from its workflow
 	AnnotationKeyPodGCStrategy = workflow.WorkflowFullName + "/pod-gc-strategy"
 
+	// AnnotationKeySuspendedByParent is applied to child workflows suspended because their parent workflow was
+	// suspended, so that they are resumed with it
+	AnnotationKeySuspendedByParent = workflow.WorkflowFullName + "/suspended-by-parent"
+
+	// AnnotationKeyParentNodeAttempt is applied to child workflows to identify the attempt of the parent workflow's
+	// node they were submitted for
+	AnnotationKeyParentNodeAttempt = workflow.WorkflowFullName + "/parent-node-attempt"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -104,8 +112,13 @@ const (
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
+	// LabelKeyWorkflowEventIdempotencyKey is a label applied to Workflows that are submitted from a WorkflowEventBinding
+	// that deduplicates events, holding a hash of the idempotency key of the event
+	LabelKeyWorkflowEventIdempotencyKey = workflow.WorkflowFullName + "/workflow-event-idempotency-key"
 	// LabelKeyClusterWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
+	// LabelKeyParentWorkflow is a label applied to Workflows that are run by a childWorkflow template of another Workflow
+	LabelKeyParentWorkflow = workflow.WorkflowFullName + "/parent-workflow"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
 	// LabelKeyArtifactGCPodHash is a label applied to WorkflowTaskSets used by the Artifact Garbage Collection Pod
@@ -170,6 +183,8 @@ const (
 	EnvVarTemplate = "ARGO_TEMPLATE"
 	// EnvVarArgoTrace is used enable tracing statements in Argo components
 	EnvVarArgoTrace = "ARGO_TRACE"
+	// EnvVarTraceparent is the W3C traceparent of the node's span, so spans created in the pod are parented to it
+	EnvVarTraceparent = "ARGO_TRACEPARENT"
 	// EnvVarProgressPatchTickDuration sets the tick duration for patching pod annotations upon progress changes.
 	// Setting this or EnvVarProgressFileTickDuration to 0 will disable monitoring progress.
 	EnvVarProgressPatchTickDuration = "ARGO_PROGRESS_PATCH_TICK_DURATION"
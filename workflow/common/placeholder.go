@@ -23,5 +23,10 @@ func (p *placeholderGenerator) NextPlaceholder() string {
 }
 
 func (p *placeholderGenerator) IsPlaceholder(s string) bool {
+	return IsPlaceholder(s)
+}
+
+// IsPlaceholder returns whether the string is a placeholder substituted for a variable only known at runtime
+func IsPlaceholder(s string) bool {
 	return strings.HasPrefix(s, "placeholder-")
 }
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ValidateParameterType validates the type and schema declared by a parameter
+func ValidateParameterType(param wfv1.Parameter) error {
+	switch param.Type {
+	case "", wfv1.ParameterTypeString, wfv1.ParameterTypeInt, wfv1.ParameterTypeFloat, wfv1.ParameterTypeBool, wfv1.ParameterTypeJSON:
+	default:
+		return fmt.Errorf("type %q is not one of: string, int, float, bool, json", param.Type)
+	}
+	if param.Schema != "" {
+		if _, err := loadParameterSchema(param.Schema); err != nil {
+			return fmt.Errorf("schema is invalid: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetTypedParameterValue returns the value of the parameter as its declared type, or an error if the value is not of
+// the type or does not conform to the parameter's schema
+func GetTypedParameterValue(param wfv1.Parameter, value string) (interface{}, error) {
+	var typed interface{}
+	switch param.GetType() {
+	case wfv1.ParameterTypeString:
+		typed = value
+	case wfv1.ParameterTypeInt:
+		i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not an int", value)
+		}
+		typed = int(i)
+	case wfv1.ParameterTypeFloat:
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a float", value)
+		}
+		typed = f
+	case wfv1.ParameterTypeBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a bool", value)
+		}
+		typed = b
+	case wfv1.ParameterTypeJSON:
+		if err := json.Unmarshal([]byte(value), &typed); err != nil {
+			return nil, fmt.Errorf("value %q is not JSON: %w", value, err)
+		}
+	default:
+		return nil, fmt.Errorf("type %q is not one of: string, int, float, bool, json", param.Type)
+	}
+	if param.Schema != "" {
+		if err := validateParameterSchema(param.Schema, typed); err != nil {
+			return nil, err
+		}
+	}
+	return typed, nil
+}
+
+// CoerceParameterValue returns the canonical form of the value of the parameter's type, e.g. "True" for a bool is
+// "true", or an error if the value is not of the type or does not conform to the parameter's schema
+func CoerceParameterValue(param wfv1.Parameter, value string) (string, error) {
+	typed, err := GetTypedParameterValue(param, value)
+	if err != nil {
+		return "", err
+	}
+	switch param.GetType() {
+	case wfv1.ParameterTypeInt:
+		return strconv.Itoa(typed.(int)), nil
+	case wfv1.ParameterTypeFloat:
+		return strconv.FormatFloat(typed.(float64), 'f', -1, 64), nil
+	case wfv1.ParameterTypeBool:
+		return strconv.FormatBool(typed.(bool)), nil
+	case wfv1.ParameterTypeJSON:
+		// the value is compacted rather than re-encoded, which would unquote strings and round large numbers
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(value)); err != nil {
+			return "", err
+		}
+		return compacted.String(), nil
+	default:
+		return value, nil
+	}
+}
+
+func loadParameterSchema(schema string) (*gojsonschema.Schema, error) {
+	schemaJSON, err := yaml.YAMLToJSON([]byte(schema))
+	if err != nil {
+		return nil, err
+	}
+	return gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaJSON))
+}
+
+func validateParameterSchema(schema string, value interface{}) error {
+	s, err := loadParameterSchema(schema)
+	if err != nil {
+		return fmt.Errorf("schema is invalid: %w", err)
+	}
+	result, err := s.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return err
+	}
+	if !result.Valid() {
+		var reasons []string
+		for _, resultErr := range result.Errors() {
+			reasons = append(reasons, resultErr.String())
+		}
+		return fmt.Errorf("value does not conform to the schema: %s", strings.Join(reasons, "; "))
+	}
+	return nil
+}
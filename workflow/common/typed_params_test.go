@@ -0,0 +1,74 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestValidateParameterType(t *testing.T) {
+	require.NoError(t, ValidateParameterType(wfv1.Parameter{Type: wfv1.ParameterTypeInt}))
+	require.NoError(t, ValidateParameterType(wfv1.Parameter{Schema: "type: object\nrequired: [name]"}))
+	require.EqualError(t, ValidateParameterType(wfv1.Parameter{Type: "number"}), `type "number" is not one of: string, int, float, bool, json`)
+	require.ErrorContains(t, ValidateParameterType(wfv1.Parameter{Schema: `{"type": 1}`}), "schema is invalid")
+}
+
+func TestGetTypedParameterValue(t *testing.T) {
+	tests := []struct {
+		param wfv1.Parameter
+		value string
+		typed interface{}
+		err   string
+	}{
+		{wfv1.Parameter{}, "abc", "abc", ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeInt}, " 42 ", 42, ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeInt}, "4.2", nil, `value "4.2" is not an int`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeFloat}, "4.2", 4.2, ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeFloat}, "abc", nil, `value "abc" is not a float`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeBool}, "True", true, ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeBool}, "yes", nil, `value "yes" is not a bool`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeJSON}, `{"a": [1]}`, map[string]interface{}{"a": []interface{}{float64(1)}}, ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeJSON}, `{"a"`, nil, `value "{\"a\"" is not JSON: unexpected end of JSON input`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeInt, Schema: `{"minimum": 1, "maximum": 10}`}, "5", 5, ""},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeInt, Schema: `{"minimum": 1, "maximum": 10}`}, "11", nil, "value does not conform to the schema: (root): Must be less than or equal to 10"},
+		{wfv1.Parameter{Schema: "type: object\nrequired: [name]"}, `{"name": "x"}`, map[string]interface{}{"name": "x"}, ""},
+		{wfv1.Parameter{Schema: "type: object\nrequired: [name]"}, `{}`, nil, "value does not conform to the schema: (root): name is required"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.param.GetType())+"/"+tt.value, func(t *testing.T) {
+			typed, err := GetTypedParameterValue(tt.param, tt.value)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.typed, typed)
+			}
+		})
+	}
+}
+
+func TestCoerceParameterValue(t *testing.T) {
+	tests := []struct {
+		param    wfv1.Parameter
+		value    string
+		expected string
+	}{
+		{wfv1.Parameter{}, " abc ", " abc "},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeInt}, " 042", "42"},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeFloat}, "1e3", "1000"},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeBool}, "T", "true"},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeJSON}, `{ "a": 1 }`, `{"a":1}`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeJSON}, `"abc"`, `"abc"`},
+		{wfv1.Parameter{Type: wfv1.ParameterTypeJSON}, ` 12345678901234567890 `, `12345678901234567890`},
+	}
+	for _, tt := range tests {
+		coerced, err := CoerceParameterValue(tt.param, tt.value)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, coerced)
+	}
+	_, err := CoerceParameterValue(wfv1.Parameter{Type: wfv1.ParameterTypeInt}, "abc")
+	require.EqualError(t, err, `value "abc" is not an int`)
+}
@@ -205,7 +205,33 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 		}
 	}
 
-	return SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	newTmpl, err := SubstituteParams(ctx, newTmpl, globalParams, localParams)
+	if err != nil {
+		return nil, err
+	}
+	err = validateTypedInputs(newTmpl, validateOnly)
+	if err != nil {
+		return nil, err
+	}
+	return newTmpl, nil
+}
+
+// validateTypedInputs ensures the values of the input parameters are of their declared types. When only validating,
+// values that are not known until runtime are not checked.
+func validateTypedInputs(tmpl *wfv1.Template, validateOnly bool) error {
+	for _, inParam := range tmpl.Inputs.Parameters {
+		if !inParam.IsTyped() || inParam.Value == nil {
+			continue
+		}
+		value := inParam.Value.String()
+		if validateOnly && (strings.Contains(value, "{{") || IsPlaceholder(value)) {
+			continue
+		}
+		if _, err := GetTypedParameterValue(inParam, value); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s: %s", inParam.Name, err)
+		}
+	}
+	return nil
 }
 
 // substituteConfigMapKeyRefParam performs template substitution for ConfigMapKeyRef
@@ -239,11 +265,18 @@ func SubstituteParams(ctx context.Context, tmpl *wfv1.Template, globalParams, lo
 		return nil, errors.InternalWrapError(err)
 	}
 	// Now replace the rest of substitutions (the ones that can be made) in the template
+	typedValues := make(map[string]interface{})
 	for _, inParam := range globalReplacedTmpl.Inputs.Parameters {
 		if inParam.Value == nil && inParam.ValueFrom == nil {
 			return nil, errors.InternalErrorf("inputs.parameters.%s had no value", inParam.Name)
 		} else if inParam.Value != nil {
 			replaceMap["inputs.parameters."+inParam.Name] = inParam.Value.String()
+			// values that are not yet of their type are rejected by ProcessArgs, so are left as strings here
+			if inParam.IsTyped() {
+				if typed, err := GetTypedParameterValue(inParam, inParam.Value.String()); err == nil {
+					typedValues["inputs.parameters."+inParam.Name] = typed
+				}
+			}
 		}
 	}
 	// allow {{inputs.parameters}} to fetch the entire input parameters list as JSON
@@ -268,7 +301,7 @@ func SubstituteParams(ctx context.Context, tmpl *wfv1.Template, globalParams, lo
 		}
 	}
 
-	s, err := template.Replace(ctx, globalReplacedTmplStr, replaceMap, true)
+	s, err := template.ReplaceWithTypedValues(ctx, globalReplacedTmplStr, replaceMap, typedValues, true)
 	if err != nil {
 		return nil, err
 	}
@@ -363,3 +363,28 @@ func TestOverridableTemplateInputParamsValueFrom(t *testing.T) {
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), overrideConfigMapValue)
 }
+
+func TestProcessArgsTypedInputParams(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpl := wfv1.Template{
+		Name: "scale",
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{
+			{Name: "replicas", Type: wfv1.ParameterTypeInt},
+			{Name: "config", Type: wfv1.ParameterTypeJSON, Default: wfv1.AnyStringPtr(`{"debug": true}`)},
+		}},
+		Container: &corev1.Container{Args: []string{"{{inputs.parameters.replicas}}", "{{=inputs.parameters.replicas * 2}}", "{{=inputs.parameters.config.debug}}"}},
+	}
+
+	args := wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "replicas", Value: wfv1.AnyStringPtr("{{workflow.parameters.replicas}}")}}}
+	newTmpl, err := ProcessArgs(ctx, &tmpl, &args, Parameters{"workflow.parameters.replicas": "3"}, Parameters{}, false, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "6", "true"}, newTmpl.Container.Args)
+
+	_, err = ProcessArgs(ctx, &tmpl, &args, Parameters{"workflow.parameters.replicas": "three"}, Parameters{}, false, "", nil)
+	require.EqualError(t, err, `inputs.parameters.replicas: value "three" is not an int`)
+
+	// values only known at runtime are not checked when validating
+	newTmpl, err = ProcessArgs(ctx, &tmpl, &args, Parameters{}, Parameters{}, true, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "{{workflow.parameters.replicas}}", newTmpl.Inputs.Parameters[0].Value.String())
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
@@ -29,6 +30,10 @@ const artifactGCComponent = "artifact-gc"
 // artifactGCEnabled is a feature flag to globally disabled artifact GC in case of emergency
 var artifactGCEnabled, _ = env.GetBool("ARGO_ARTIFACT_GC_ENABLED", true)
 
+// contentAddressedArtifactGCGracePeriod is the minimum time a content-addressed artifact must be unused and unreferenced
+// before it is deleted, as an executor may find and reuse it before the workflow of that executor references it
+var contentAddressedArtifactGCGracePeriod = envutil.LookupEnvDurationOr(logging.InitLoggerInContext(), "CONTENT_ADDRESSED_ARTIFACT_GC_GRACE_PERIOD", 10*time.Minute)
+
 func (woc *wfOperationCtx) addArtifactGCFinalizer(ctx context.Context) {
 	if !artifactGCEnabled {
 		return
@@ -129,9 +134,12 @@ type templatesToArtifacts map[string]wfv1.ArtifactSearchResults
 // Artifact GC Strategy is ready: start up Pods to handle it
 func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strategy wfv1.ArtifactGCStrategy) error {
 
+	processed := true
 	defer func() {
-		woc.wf.Status.ArtifactGCStatus.SetArtifactGCStrategyProcessed(strategy, true)
-		woc.updated = true
+		if processed {
+			woc.wf.Status.ArtifactGCStatus.SetArtifactGCStrategyProcessed(strategy, true)
+			woc.updated = true
+		}
 	}()
 
 	var err error
@@ -139,7 +147,14 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 	woc.log.WithField("strategy", strategy).Debug(ctx, "processing Artifact GC Strategy")
 
 	// Search for artifacts
-	artifactSearchResults := woc.findArtifactsToGC(strategy)
+	artifactSearchResults, wait := woc.releaseSharedArtifacts(ctx, woc.findArtifactsToGC(strategy))
+	if wait > 0 {
+		// nothing has been deleted yet, so we process the strategy again once the grace period has passed
+		woc.log.WithFields(logging.Fields{"strategy": strategy, "wait": wait}).Info(ctx, "Waiting for the grace period of unreferenced content-addressed artifacts")
+		processed = false
+		woc.requeueAfter(wait)
+		return nil
+	}
 	if len(artifactSearchResults) == 0 {
 		woc.log.WithField("strategy", strategy).Debug(ctx, "No Artifact Search Results returned from strategy")
 		return nil
@@ -624,6 +639,86 @@ func (woc *wfOperationCtx) allArtifactsDeleted() bool {
 	return true
 }
 
+// releaseSharedArtifacts returns the artifacts that can be deleted. Content-addressed artifacts that are still
+// referenced by another artifact, of this or another live or archived workflow, are not deleted, but are marked as
+// deleted, as this workflow no longer references them. Unreferenced content-addressed artifacts are only deleted after
+// the grace period, and if it has not passed, how long to wait is returned.
+func (woc *wfOperationCtx) releaseSharedArtifacts(ctx context.Context, results wfv1.ArtifactSearchResults) (wfv1.ArtifactSearchResults, time.Duration) {
+	var toDelete wfv1.ArtifactSearchResults
+	var wait time.Duration
+	// the digests of the workflows with offloaded or compressed nodes are loaded once, for all the artifacts
+	var offloadedDigests map[string]bool
+	var offloadedErr error
+	for _, result := range results {
+		if !result.Artifact.IsContentAddressed() {
+			toDelete = append(toDelete, result)
+			continue
+		}
+		if offloadedDigests == nil && offloadedErr == nil {
+			offloadedDigests, offloadedErr = woc.controller.offloadedArtifactDigests(ctx, woc.wf.UID)
+		}
+		log := woc.log.WithFields(logging.Fields{"nodeID": result.NodeID, "artifactName": result.Name, "digest": result.Digest})
+		referenced, err := false, offloadedErr
+		if err == nil {
+			referenced, err = woc.isArtifactDigestReferenced(ctx, result.Digest, results, offloadedDigests)
+		}
+		if err != nil {
+			// keeping an artifact that is no longer needed is better than deleting one that is
+			log.WithError(err).Warn(ctx, "Failed to check whether a content-addressed artifact is shared, not deleting it")
+			referenced = true
+		}
+		node, err := woc.wf.Status.Nodes.Get(result.NodeID)
+		if !referenced {
+			var lastUsed time.Time
+			if err == nil {
+				lastUsed = node.FinishedAt.Time
+			}
+			if remaining := woc.controller.artifactGCGracePeriodRemaining(result.Digest, lastUsed); remaining > 0 {
+				wait = max(wait, remaining)
+				continue
+			}
+			toDelete = append(toDelete, result)
+			continue
+		}
+		woc.controller.forgetUnreferencedDigest(result.Digest)
+		log.Info(ctx, "Not deleting content-addressed artifact, as it is shared")
+		if err != nil || node.Outputs == nil {
+			continue
+		}
+		for i, a := range node.Outputs.Artifacts {
+			if a.Name == result.Name {
+				node.Outputs.Artifacts[i].Deleted = true
+			}
+		}
+		woc.wf.Status.Nodes.Set(ctx, result.NodeID, *node)
+		woc.updated = true
+	}
+	if wait > 0 {
+		return nil, wait
+	}
+	for _, result := range toDelete {
+		if result.Artifact.IsContentAddressed() {
+			woc.controller.forgetUnreferencedDigest(result.Digest)
+		}
+	}
+	return toDelete, 0
+}
+
+// isArtifactDigestReferenced returns whether an artifact with the digest, that is not being deleted, is referenced by
+// this workflow, or another live or archived workflow
+func (woc *wfOperationCtx) isArtifactDigestReferenced(ctx context.Context, digest string, deleting wfv1.ArtifactSearchResults, offloadedDigests map[string]bool) (bool, error) {
+	for _, n := range woc.wf.Status.Nodes {
+		for _, a := range n.GetOutputs().GetArtifacts() {
+			if a.Digest == digest && !a.Deleted && !slices.ContainsFunc(deleting, func(r wfv1.ArtifactSearchResult) bool {
+				return r.NodeID == n.ID && r.Name == a.Name
+			}) {
+				return true, nil
+			}
+		}
+	}
+	return woc.controller.isArtifactDigestReferenced(ctx, digest, woc.wf.UID, offloadedDigests)
+}
+
 func (woc *wfOperationCtx) findArtifactsToGC(strategy wfv1.ArtifactGCStrategy) wfv1.ArtifactSearchResults {
 
 	var results wfv1.ArtifactSearchResults
@@ -1,17 +1,24 @@
 package controller
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/file"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
@@ -622,6 +629,162 @@ func TestProcessCompletedWorkflowArtifactGCTask(t *testing.T) {
 
 }
 
+func contentAddressedArtifact(name, digest string) wfv1.Artifact {
+	return wfv1.Artifact{
+		Name:   name,
+		Digest: digest,
+		ArtifactLocation: wfv1.ArtifactLocation{
+			S3: &wfv1.S3Artifact{Key: wfv1.ContentAddressedKey(digest) + "/" + name + ".tgz"},
+		},
+	}
+}
+
+func setArtifactGCGracePeriod(t *testing.T, gracePeriod time.Duration) {
+	previous := contentAddressedArtifactGCGracePeriod
+	t.Cleanup(func() { contentAddressedArtifactGCGracePeriod = previous })
+	contentAddressedArtifactGCGracePeriod = gracePeriod
+}
+
+func TestReleaseSharedArtifacts(t *testing.T) {
+	setArtifactGCGracePeriod(t, 0)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-a", Namespace: "default", UID: types.UID("uid-a")},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"node-a": {ID: "node-a", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-live", "sha256:1"),
+				contentAddressedArtifact("shared-archived", "sha256:2"),
+				contentAddressedArtifact("unshared", "sha256:3"),
+				contentAddressedArtifact("shared-kept", "sha256:4"),
+				{Name: "plain", Digest: "sha256:1", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "wf-a/plain.tgz"}}},
+			}}},
+			"node-b": {ID: "node-b", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-kept", "sha256:4"),
+			}}},
+		}},
+	}
+	other := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-b", Namespace: "default", UID: types.UID("uid-b")},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"node-c": {ID: "node-c", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-live", "sha256:1"),
+			}}},
+		}},
+	}
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, other)
+	defer cancel()
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsArtifactDigestReferenced", mock.Anything, "sha256:2", "uid-a").Return(true, nil)
+	wfArchive.On("IsArtifactDigestReferenced", mock.Anything, mock.Anything, "uid-a").Return(false, nil)
+	controller.wfArchive = wfArchive
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	var results wfv1.ArtifactSearchResults
+	for _, a := range wf.Status.Nodes["node-a"].Outputs.Artifacts {
+		results = append(results, wfv1.ArtifactSearchResult{Artifact: a, NodeID: "node-a"})
+	}
+	toDelete, wait := woc.releaseSharedArtifacts(ctx, results)
+	assert.Zero(t, wait)
+
+	var names []string
+	for _, r := range toDelete {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"unshared", "plain"}, names)
+	artifacts := woc.wf.Status.Nodes["node-a"].Outputs.Artifacts
+	for name, deleted := range map[string]bool{"shared-live": true, "shared-archived": true, "unshared": false, "shared-kept": true, "plain": false} {
+		assert.Equal(t, deleted, artifacts.GetArtifactByName(name).Deleted, name)
+	}
+	assert.False(t, woc.wf.Status.Nodes["node-b"].Outputs.Artifacts[0].Deleted)
+}
+
+func TestReleaseSharedArtifactsCompressed(t *testing.T) {
+	setArtifactGCGracePeriod(t, 0)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-a", Namespace: "default", UID: types.UID("uid-a")},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"node-a": {ID: "node-a", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-compressed", "sha256:1"),
+				contentAddressedArtifact("unshared", "sha256:2"),
+			}}},
+		}},
+	}
+	ctx := logging.TestContext(t.Context())
+	nodes, err := json.Marshal(wfv1.Nodes{
+		"node-b": {ID: "node-b", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+			contentAddressedArtifact("shared-compressed", "sha256:1"),
+		}}},
+	})
+	require.NoError(t, err)
+	compressed := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-b", Namespace: "default", UID: types.UID("uid-b")},
+		Status:     wfv1.WorkflowStatus{CompressedNodes: file.CompressEncodeString(ctx, string(nodes))},
+	}
+	cancel, controller := newController(ctx, wf, compressed)
+	defer cancel()
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsArtifactDigestReferenced", mock.Anything, mock.Anything, "uid-a").Return(false, nil)
+	controller.wfArchive = wfArchive
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	var results wfv1.ArtifactSearchResults
+	for _, a := range wf.Status.Nodes["node-a"].Outputs.Artifacts {
+		results = append(results, wfv1.ArtifactSearchResult{Artifact: a, NodeID: "node-a"})
+	}
+	toDelete, wait := woc.releaseSharedArtifacts(ctx, results)
+	assert.Zero(t, wait)
+	require.Len(t, toDelete, 1)
+	assert.Equal(t, "unshared", toDelete[0].Name, "the artifact shared with the workflow with compressed nodes is kept")
+}
+
+func TestReleaseSharedArtifactsOffloaded(t *testing.T) {
+	setArtifactGCGracePeriod(t, 0)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-a", Namespace: "default", UID: types.UID("uid-a")},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"node-a": {ID: "node-a", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-offloaded", "sha256:1"),
+				contentAddressedArtifact("unshared", "sha256:2"),
+				contentAddressedArtifact("also-unshared", "sha256:3"),
+			}}},
+		}},
+	}
+	offloaded := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-b", Namespace: "default", UID: types.UID("uid-b")},
+		Status:     wfv1.WorkflowStatus{OffloadNodeStatusVersion: "fnv:1"},
+	}
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, offloaded)
+	defer cancel()
+	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
+	offloadNodeStatusRepo.On("List", mock.Anything, mock.Anything).Return(map[sqldb.UUIDVersion]wfv1.Nodes{
+		{UID: "uid-b", Version: "fnv:1"}: {
+			"node-b": {ID: "node-b", Type: wfv1.NodeTypePod, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("shared-offloaded", "sha256:1"),
+			}}},
+		},
+	}, nil)
+	controller.offloadNodeStatusRepo = offloadNodeStatusRepo
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsArtifactDigestReferenced", mock.Anything, mock.Anything, "uid-a").Return(false, nil)
+	controller.wfArchive = wfArchive
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	var results wfv1.ArtifactSearchResults
+	for _, a := range wf.Status.Nodes["node-a"].Outputs.Artifacts {
+		results = append(results, wfv1.ArtifactSearchResult{Artifact: a, NodeID: "node-a"})
+	}
+	toDelete, wait := woc.releaseSharedArtifacts(ctx, results)
+	assert.Zero(t, wait)
+	var names []string
+	for _, r := range toDelete {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"unshared", "also-unshared"}, names, "the artifact shared with the workflow with offloaded nodes is kept")
+	offloadNodeStatusRepo.AssertNumberOfCalls(t, "List", 1)
+	offloadNodeStatusRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestWorkflowHasArtifactGC(t *testing.T) {
 	tests := []struct {
 		name                      string
@@ -919,3 +1082,38 @@ func TestArtifactGCPodWithPlugins(t *testing.T) {
 	assert.Contains(t, mainContainer.Args, "artifact")
 	assert.Contains(t, mainContainer.Args, "delete")
 }
+
+func TestReleaseSharedArtifactsGracePeriod(t *testing.T) {
+	setArtifactGCGracePeriod(t, time.Hour)
+	finishedAt := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-a", Namespace: "default", UID: types.UID("uid-a")},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"node-a": {ID: "node-a", Type: wfv1.NodeTypePod, FinishedAt: finishedAt, Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				contentAddressedArtifact("unshared", "sha256:3"),
+				{Name: "plain", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "wf-a/plain.tgz"}}},
+			}}},
+		}},
+	}
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsArtifactDigestReferenced", mock.Anything, mock.Anything, "uid-a").Return(false, nil)
+	controller.wfArchive = wfArchive
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	var results wfv1.ArtifactSearchResults
+	for _, a := range wf.Status.Nodes["node-a"].Outputs.Artifacts {
+		results = append(results, wfv1.ArtifactSearchResult{Artifact: a, NodeID: "node-a"})
+	}
+
+	toDelete, wait := woc.releaseSharedArtifacts(ctx, results)
+	assert.Empty(t, toDelete, "nothing is deleted until the grace period has passed since the artifact was found unreferenced")
+	assert.InDelta(t, time.Hour.Seconds(), wait.Seconds(), 60)
+
+	controller.unreferencedDigests.since["sha256:3"] = time.Now().Add(-2 * time.Hour)
+	toDelete, wait = woc.releaseSharedArtifacts(ctx, results)
+	assert.Zero(t, wait)
+	assert.Len(t, toDelete, 2)
+	assert.NotContains(t, controller.unreferencedDigests.since, "sha256:3", "the digest is forgotten once deleted")
+}
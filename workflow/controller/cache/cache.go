@@ -2,21 +2,43 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
 	"sync"
 	"time"
 
+	"github.com/upper/db/v4"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
+// ErrPersistenceNotConfigured is returned for SQL caches when persistence is not configured
+var ErrPersistenceNotConfigured = errors.New("SQL caches require persistence to be configured")
+
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 
+// ValidateKey returns an error if the key cannot be used as a cache key
+func ValidateKey(key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	return nil
+}
+
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
 	Save(ctx context.Context, key string, nodeID string, value *wfv1.Outputs) error
+	// Get returns the entry for the key, or nil if there is none, without recording a hit
+	Get(ctx context.Context, key string) (*Entry, error)
+	// List returns all entries by key, without recording a hit
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete deletes the entry for the key, it is not an error if there is none
+	Delete(ctx context.Context, key string) error
+	// Prune deletes all entries that have not been hit since the given time, and returns how many were deleted
+	Prune(ctx context.Context, notHitSince time.Time) (int, error)
 }
 
 type Entry struct {
@@ -53,36 +75,75 @@ type cacheFactory struct {
 	kubeclient kubernetes.Interface
 	namespace  string
 	lock       sync.RWMutex
+	// session and clusterName are only set when persistence is configured
+	session     db.Session
+	clusterName string
 }
 
 type Factory interface {
-	GetCache(ct CacheType, name string) MemoizationCache
+	// GetCache returns the cache, or an error if caches of the type cannot be used
+	GetCache(ct CacheType, name string) (MemoizationCache, error)
+	// SetSession configures the persistence session used by SQL caches, a nil session disables them
+	SetSession(session db.Session, clusterName string)
+	// DeleteSQLEntriesNotHitSince deletes SQL cache entries that have not been hit since the given time
+	DeleteSQLEntriesNotHitSince(ctx context.Context, t time.Time) error
 }
 
 func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
-		sync.RWMutex{},
+		caches:     make(map[string]MemoizationCache),
+		kubeclient: ki,
+		namespace:  ns,
+		lock:       sync.RWMutex{},
 	}
 }
 
 type CacheType string
 
 const (
-	// Only config maps are currently supported for caching
 	ConfigMapCache CacheType = "ConfigMapCache"
+	SQLCache       CacheType = "SQLCache"
 )
 
+// CacheTypeFor returns the cache type to use for the type of cache declared in a template or node status
+func CacheTypeFor(t wfv1.MemoizationCacheType) CacheType {
+	if t == wfv1.MemoizationCacheTypeSQL {
+		return SQLCache
+	}
+	return ConfigMapCache
+}
+
+func (cf *cacheFactory) SetSession(session db.Session, clusterName string) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	cf.session = session
+	cf.clusterName = clusterName
+	// existing SQL caches hold the previous session
+	for idx, c := range cf.caches {
+		if _, ok := c.(*sqlCache); ok {
+			delete(cf.caches, idx)
+		}
+	}
+}
+
+func (cf *cacheFactory) DeleteSQLEntriesNotHitSince(ctx context.Context, t time.Time) error {
+	cf.lock.RLock()
+	session, clusterName := cf.session, cf.clusterName
+	cf.lock.RUnlock()
+	if session == nil {
+		return nil
+	}
+	return deleteSQLEntriesNotHitSince(ctx, session, clusterName, cf.namespace, t)
+}
+
 // Returns a cache if it exists and creates it otherwise
-func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
+func (cf *cacheFactory) GetCache(ct CacheType, name string) (MemoizationCache, error) {
 	cf.lock.RLock()
 
 	idx := string(ct) + "." + name
 	if c := cf.caches[idx]; c != nil {
 		cf.lock.RUnlock()
-		return c
+		return c, nil
 	}
 	cf.lock.RUnlock()
 
@@ -90,15 +151,22 @@ func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
 	defer cf.lock.Unlock()
 
 	if c := cf.caches[idx]; c != nil {
-		return c
+		return c, nil
 	}
 
 	switch ct {
 	case ConfigMapCache:
 		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
 		cf.caches[idx] = c
-		return c
+		return c, nil
+	case SQLCache:
+		if cf.session == nil {
+			return nil, ErrPersistenceNotConfigured
+		}
+		c := NewSQLCache(cf.session, cf.clusterName, cf.namespace, name)
+		cf.caches[idx] = c
+		return c, nil
 	default:
-		return nil
+		return nil, fmt.Errorf("unknown cache type %q", ct)
 	}
 }
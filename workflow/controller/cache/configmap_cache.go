@@ -207,3 +207,116 @@ func (c *configMapCache) save(ctx context.Context, key string, nodeID string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) retry(ctx context.Context, f func() error) error {
+	return retry.OnError(kwait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    5,
+		Cap:      30 * time.Second,
+	}, func(err error) bool {
+		return argoerr.IsTransientErr(ctx, err) || apierr.IsConflict(err)
+	}, f)
+}
+
+// getConfigMap returns the validated config map, or nil if it does not exist
+func (c *configMapCache) getConfigMap(ctx context.Context) (*apiv1.ConfigMap, error) {
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateConfigmap(ctx, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+func (c *configMapCache) Get(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return entries[key], nil
+}
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.getConfigMap(ctx)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+	entries := make(map[string]*Entry, len(cm.Data))
+	for key, rawEntry := range cm.Data {
+		if rawEntry == "" {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	return c.retry(ctx, func() error {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		cm, err := c.getConfigMap(ctx)
+		if err != nil || cm == nil {
+			return err
+		}
+		if _, ok := cm.Data[key]; !ok {
+			return nil
+		}
+		c.logInfo(ctx, logging.Fields{"key": key}, "Deleting ConfigMap cache entry")
+		delete(cm.Data, key)
+		_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (c *configMapCache) Prune(ctx context.Context, notHitSince time.Time) (int, error) {
+	var deleted int
+	err := c.retry(ctx, func() error {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		deleted = 0
+		cm, err := c.getConfigMap(ctx)
+		if err != nil || cm == nil {
+			return err
+		}
+		for key, rawEntry := range cm.Data {
+			var entry Entry
+			if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+				return fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+			}
+			if entry.LastHitTimestamp.Time.Before(notHitSince) {
+				delete(cm.Data, key)
+				deleted++
+			}
+		}
+		if deleted == 0 {
+			return nil
+		}
+		c.logInfo(ctx, logging.Fields{"deleted": deleted}, "Pruned ConfigMap cache entries")
+		_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	return deleted, err
+}
@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/upper/db/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// sqlCacheTableName is created by the persistence migrations, see persist/sqldb/migrate.go
+const sqlCacheTableName = "argo_memoization_cache"
+
+type sqlCacheRecord struct {
+	ClusterName string    `db:"clustername"`
+	Namespace   string    `db:"namespace"`
+	Name        string    `db:"name"`
+	Key         string    `db:"cachekey"`
+	NodeID      string    `db:"nodeid"`
+	Outputs     string    `db:"outputs"`
+	CreatedAt   time.Time `db:"createdat"`
+	LastHitAt   time.Time `db:"lasthitat"`
+}
+
+type sqlCache struct {
+	session     db.Session
+	clusterName string
+	namespace   string
+	name        string
+}
+
+// NewSQLCache returns a cache that stores its entries in the persistence database, so it is not subject to the
+// size limit of a ConfigMap
+func NewSQLCache(session db.Session, clusterName, ns, n string) MemoizationCache {
+	return &sqlCache{
+		session:     session,
+		clusterName: clusterName,
+		namespace:   ns,
+		name:        n,
+	}
+}
+
+func (c *sqlCache) logger(ctx context.Context, fields logging.Fields) logging.Logger {
+	return logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"namespace": c.namespace, "name": c.name}).WithFields(fields)
+}
+
+func (c *sqlCache) cond(key string) db.Cond {
+	return db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name, "cachekey": key}
+}
+
+func (c *sqlCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	record := &sqlCacheRecord{}
+	err := c.session.SQL().
+		SelectFrom(sqlCacheTableName).
+		Where(c.cond(key)).
+		One(record)
+	if errors.Is(err, db.ErrNoMoreRows) {
+		c.logger(ctx, logging.Fields{"key": key}).Info(ctx, "SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs *wfv1.Outputs
+	if err := json.Unmarshal([]byte(record.Outputs), &outputs); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+
+	hitTime := time.Now().UTC()
+	_, err = c.session.SQL().
+		Update(sqlCacheTableName).
+		Set("lasthitat", hitTime).
+		Where(c.cond(key)).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+	c.logger(ctx, logging.Fields{"key": key}).Info(ctx, "SQL cache loaded")
+	return &Entry{
+		NodeID:            record.NodeID,
+		Outputs:           outputs,
+		CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+		LastHitTimestamp:  metav1.Time{Time: hitTime},
+	}, nil
+}
+
+func (c *sqlCache) Save(ctx context.Context, key string, nodeID string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	c.logger(ctx, logging.Fields{"key": key, "nodeID": nodeID}).Info(ctx, "Saving SQL cache entry")
+
+	outputs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	creationTime := time.Now().UTC()
+	return c.session.Tx(func(sess db.Session) error {
+		_, err := sess.SQL().
+			DeleteFrom(sqlCacheTableName).
+			Where(c.cond(key)).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(sqlCacheTableName).
+			Insert(&sqlCacheRecord{
+				ClusterName: c.clusterName,
+				Namespace:   c.namespace,
+				Name:        c.name,
+				Key:         key,
+				NodeID:      nodeID,
+				Outputs:     string(outputs),
+				CreatedAt:   creationTime,
+				LastHitAt:   creationTime,
+			})
+		if err != nil {
+			return fmt.Errorf("error creating cache entry: %w", err)
+		}
+		return nil
+	})
+}
+
+func deleteSQLEntriesNotHitSince(ctx context.Context, session db.Session, clusterName, namespace string, t time.Time) error {
+	rs, err := session.SQL().
+		DeleteFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": clusterName, "namespace": namespace}).
+		And(db.Cond{"lasthitat <": t.UTC()}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	logging.RequireLoggerFromContext(ctx).WithField("rowsAffected", rowsAffected).Info(ctx, "Deleted SQL cache entries that have not been hit")
+	return nil
+}
+
+func (c *sqlCache) entry(record *sqlCacheRecord) (*Entry, error) {
+	var outputs *wfv1.Outputs
+	if err := json.Unmarshal([]byte(record.Outputs), &outputs); err != nil {
+		return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", record.Key, err)
+	}
+	return &Entry{
+		NodeID:            record.NodeID,
+		Outputs:           outputs,
+		CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+		LastHitTimestamp:  metav1.Time{Time: record.LastHitAt},
+	}, nil
+}
+
+func (c *sqlCache) Get(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	record := &sqlCacheRecord{}
+	err := c.session.SQL().
+		SelectFrom(sqlCacheTableName).
+		Where(c.cond(key)).
+		One(record)
+	if errors.Is(err, db.ErrNoMoreRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.entry(record)
+}
+
+func (c *sqlCache) List(ctx context.Context) (map[string]*Entry, error) {
+	var records []*sqlCacheRecord
+	err := c.session.SQL().
+		SelectFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]*Entry, len(records))
+	for _, record := range records {
+		entry, err := c.entry(record)
+		if err != nil {
+			return nil, err
+		}
+		entries[record.Key] = entry
+	}
+	return entries, nil
+}
+
+func (c *sqlCache) Delete(ctx context.Context, key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	c.logger(ctx, logging.Fields{"key": key}).Info(ctx, "Deleting SQL cache entry")
+	_, err := c.session.SQL().
+		DeleteFrom(sqlCacheTableName).
+		Where(c.cond(key)).
+		Exec()
+	return err
+}
+
+func (c *sqlCache) Prune(ctx context.Context, notHitSince time.Time) (int, error) {
+	rs, err := c.session.SQL().
+		DeleteFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name}).
+		And(db.Cond{"lasthitat <": notHitSince.UTC()}).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	c.logger(ctx, logging.Fields{"deleted": rowsAffected}).Info(ctx, "Pruned SQL cache entries")
+	return int(rowsAffected), nil
+}
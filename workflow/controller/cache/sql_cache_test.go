@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/upper/db/v4"
+	"github.com/upper/db/v4/adapter/sqlite"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func newTestSession(t *testing.T) db.Session {
+	t.Helper()
+	session, err := sqlite.Open(sqlite.ConnectionURL{Database: filepath.Join(t.TempDir(), "argo.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = session.Close() })
+	_, err = session.SQL().Exec(`create table ` + sqlCacheTableName + ` (
+    clustername varchar(64) not null,
+    namespace varchar(256) not null,
+    name varchar(128) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs text not null,
+    createdat timestamp not null default CURRENT_TIMESTAMP,
+    lasthitat timestamp not null default CURRENT_TIMESTAMP,
+    primary key (clustername, namespace, name, cachekey)
+)`)
+	require.NoError(t, err)
+	return session
+}
+
+func TestSQLCache(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	session := newTestSession(t)
+	c := NewSQLCache(session, "default", "argo", "my-cache")
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}
+
+	t.Run("Miss", func(t *testing.T) {
+		entry, err := c.Load(ctx, "my-key")
+		require.NoError(t, err)
+		assert.False(t, entry.Hit())
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		_, err := c.Load(ctx, "my key")
+		require.EqualError(t, err, "invalid cache key: my key")
+		require.EqualError(t, c.Save(ctx, "my key", "my-node", outputs), "invalid cache key: my key")
+	})
+	t.Run("Hit", func(t *testing.T) {
+		require.NoError(t, c.Save(ctx, "my-key", "my-node", outputs))
+		entry, err := c.Load(ctx, "my-key")
+		require.NoError(t, err)
+		assert.True(t, entry.Hit())
+		assert.Equal(t, "my-node", entry.NodeID)
+		assert.Equal(t, outputs, entry.GetOutputs())
+		assert.False(t, entry.LastHitTimestamp.Before(&entry.CreationTimestamp))
+		_, ok := entry.GetOutputsWithMaxAge(time.Hour)
+		assert.True(t, ok)
+		_, ok = entry.GetOutputsWithMaxAge(0)
+		assert.False(t, ok)
+	})
+	t.Run("Overwrite", func(t *testing.T) {
+		require.NoError(t, c.Save(ctx, "my-key", "my-other-node", nil))
+		entry, err := c.Load(ctx, "my-key")
+		require.NoError(t, err)
+		assert.Equal(t, "my-other-node", entry.NodeID)
+		assert.Nil(t, entry.GetOutputs())
+	})
+	t.Run("OtherCache", func(t *testing.T) {
+		entry, err := NewSQLCache(session, "default", "argo", "my-other-cache").Load(ctx, "my-key")
+		require.NoError(t, err)
+		assert.False(t, entry.Hit())
+	})
+}
+
+func TestSQLCacheManage(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	session := newTestSession(t)
+	c := NewSQLCache(session, "default", "argo", "my-cache")
+	require.NoError(t, NewSQLCache(session, "default", "argo", "my-other-cache").Save(ctx, "my-key", "my-node", nil))
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	require.NoError(t, c.Save(ctx, "my-key", "my-node", nil))
+	require.NoError(t, c.Save(ctx, "my-other-key", "my-other-node", nil))
+
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	entry, err := c.Get(ctx, "my-key")
+	require.NoError(t, err)
+	assert.Equal(t, "my-node", entry.NodeID)
+	entry, err = c.Get(ctx, "my-missing-key")
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+	_, err = c.Get(ctx, "my key")
+	require.EqualError(t, err, "invalid cache key: my key")
+
+	require.NoError(t, c.Delete(ctx, "my-key"))
+	require.NoError(t, c.Delete(ctx, "my-key"))
+	deleted, err := c.Prune(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	deleted, err = c.Prune(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	entry, err = NewSQLCache(session, "default", "argo", "my-other-cache").Get(ctx, "my-key")
+	require.NoError(t, err)
+	assert.NotNil(t, entry, "other caches are untouched")
+}
+
+func TestCacheFactorySQLCache(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	f := NewCacheFactory(fake.NewSimpleClientset(), "argo")
+	_, err := f.GetCache(SQLCache, "my-cache")
+	require.ErrorIs(t, err, ErrPersistenceNotConfigured)
+	require.NoError(t, f.DeleteSQLEntriesNotHitSince(ctx, time.Now()))
+
+	session := newTestSession(t)
+	f.SetSession(session, "default")
+	c, err := f.GetCache(SQLCache, "my-cache")
+	require.NoError(t, err)
+	again, err := f.GetCache(SQLCache, "my-cache")
+	require.NoError(t, err)
+	assert.Same(t, c, again)
+	require.NoError(t, c.Save(ctx, "my-key", "my-node", nil))
+
+	require.NoError(t, f.DeleteSQLEntriesNotHitSince(ctx, time.Now().Add(-time.Hour)))
+	entry, err := c.Load(ctx, "my-key")
+	require.NoError(t, err)
+	assert.True(t, entry.Hit())
+
+	require.NoError(t, f.DeleteSQLEntriesNotHitSince(ctx, time.Now().Add(time.Hour)))
+	entry, err = c.Load(ctx, "my-key")
+	require.NoError(t, err)
+	assert.False(t, entry.Hit())
+
+	f.SetSession(nil, "")
+	_, err = f.GetCache(SQLCache, "my-cache")
+	require.ErrorIs(t, err, ErrPersistenceNotConfigured)
+}
+
+func TestCacheTypeFor(t *testing.T) {
+	assert.Equal(t, ConfigMapCache, CacheTypeFor(""))
+	assert.Equal(t, ConfigMapCache, CacheTypeFor(wfv1.MemoizationCacheTypeConfigMap))
+	assert.Equal(t, SQLCache, CacheTypeFor(wfv1.MemoizationCacheTypeSQL))
+}
@@ -42,6 +42,10 @@ func (wfc *WorkflowController) syncAllCacheForGC(ctx context.Context) {
 			logger.WithField("configMap", cm.Name).WithError(err).Error(ctx, "Unable to sync ConfigMap")
 		}
 	}
+
+	if err := wfc.cacheFactory.DeleteSQLEntriesNotHitSince(ctx, time.Now().Add(-gcAfterNotHitDuration)); err != nil {
+		logger.WithError(err).Error(ctx, "Unable to delete SQL cache entries")
+	}
 }
 
 func (wfc *WorkflowController) cleanupUnusedCache(ctx context.Context, cm *apiv1.ConfigMap) error {
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -104,3 +105,89 @@ func TestConfigMapCacheSave(t *testing.T) {
 	wfv1.MustUnmarshal([]byte(cm.Data["hi-there-world"]), &entry)
 	assert.Equal(t, entry.LastHitTimestamp.Time, entry.CreationTimestamp.Time)
 }
+
+func TestConfigMapCacheManage(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "config map does not exist")
+	require.NoError(t, c.Delete(ctx, "hi-there-world"))
+	deleted, err := c.Prune(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, c.Save(ctx, "hi-there-mars", "my-node", nil))
+
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	entry, err := c.Get(ctx, "hi-there-world")
+	require.NoError(t, err)
+	assert.Equal(t, "memoized-simple-workflow-5wj2p", entry.NodeID)
+	assert.True(t, entry.LastHitTimestamp.IsZero(), "get does not record a hit")
+	entry, err = c.Get(ctx, "hi-there-venus")
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+
+	deleted, err = c.Prune(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	require.NoError(t, c.Delete(ctx, "hi-there-mars"))
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSQLCacheWithoutPersistence(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: memoized-workflow-test
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: my-key
+      cache:
+        sql:
+          name: my-cache
+    container:
+      image: my-image
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	node := woc.wf.Status.Nodes.FindByDisplayName("memoized-workflow-test")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeError, node.Phase)
+	assert.Contains(t, node.Message, "SQL caches require persistence to be configured")
+}
+
+func TestSaveMemoizedOutputsWithoutPersistence(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: memoized-workflow-test
+  namespace: default
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	err := woc.saveMemoizedOutputs(ctx, &wfv1.NodeStatus{
+		ID:                "my-node",
+		MemoizationStatus: &wfv1.MemoizationStatus{Key: "my-key", CacheName: "my-cache", CacheType: wfv1.MemoizationCacheTypeSQL},
+	})
+	require.ErrorIs(t, err, cache.ErrPersistenceNotConfigured)
+}
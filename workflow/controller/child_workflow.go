@@ -0,0 +1,273 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// executeChildWorkflow submits the child workflow of a childWorkflow template, and completes the node with the child
+// workflow's outputs once the child workflow completes
+func (woc *wfOperationCtx) executeChildWorkflow(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeChildWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
+	}
+
+	if node.ChildWorkflow == "" {
+		child := woc.newChildWorkflow(node, tmpl.ChildWorkflow)
+		_, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(child.Namespace).Create(ctx, child, metav1.CreateOptions{})
+		if apierr.IsAlreadyExists(err) {
+			// the child workflow was created by an earlier operation whose status update was lost, unless it is not
+			// this attempt's
+			existing, err := woc.controller.getChildWorkflow(ctx, child.Namespace, child.Name)
+			if err != nil {
+				return node, err
+			}
+			if !isChildWorkflowOf(existing, woc.wf, child.Annotations[common.AnnotationKeyParentNodeAttempt]) {
+				return woc.markNodeError(ctx, nodeName, fmt.Errorf("workflow %s already exists and is not the child workflow of this node", child.Name)), nil
+			}
+		} else if err != nil {
+			return node, err
+		}
+		woc.log.WithFields(logging.Fields{"nodeName": nodeName, "childWorkflow": child.Name}).Info(ctx, "Created child workflow")
+		node.ChildWorkflow = child.Name
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeRunning), nil
+	}
+
+	child, err := woc.controller.getChildWorkflow(ctx, woc.wf.Namespace, node.ChildWorkflow)
+	if apierr.IsNotFound(err) {
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, fmt.Sprintf("child workflow %s was deleted", node.ChildWorkflow)), nil
+	}
+	if err != nil {
+		return node, err
+	}
+	// the child workflow is yet to complete, and its completion requeues this workflow
+	if !child.Status.Phase.Completed() {
+		return node, nil
+	}
+	switch child.Status.Phase {
+	case wfv1.WorkflowSucceeded:
+		outputs, err := getChildWorkflowOutputs(tmpl.Outputs, child.Status.Outputs)
+		if err != nil {
+			return node, fmt.Errorf("child workflow %s: %w", child.Name, err)
+		}
+		node.Outputs = outputs
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded), nil
+	case wfv1.WorkflowFailed:
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, child.Status.Message), nil
+	default:
+		return woc.markNodePhase(ctx, nodeName, wfv1.NodeError, child.Status.Message), nil
+	}
+}
+
+// childWorkflowAttempt identifies the attempt of a child workflow node, by its ID and start time, which differ for
+// every attempt, including each retry of the node and each execution of it after the workflow is retried
+func childWorkflowAttempt(node *wfv1.NodeStatus) string {
+	return node.ID + "/" + node.StartedAt.UTC().Format(time.RFC3339)
+}
+
+// childWorkflowName returns the name of the child workflow of an attempt of a node, which is the same every time the
+// attempt is executed, so that its child workflow is only ever submitted once. Names that would be too long, as the
+// node IDs of parents with long names or of nested child workflows are, are truncated.
+func childWorkflowName(node *wfv1.NodeStatus) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(childWorkflowAttempt(node)))
+	return wfutil.TruncateWorkflowName(fmt.Sprintf("%s-%d", node.ID, h.Sum32()))
+}
+
+// isChildWorkflowOf returns whether a workflow is the child workflow of the attempt of a node of the parent workflow
+func isChildWorkflowOf(child, parent *wfv1.Workflow, attempt string) bool {
+	owner := metav1.GetControllerOf(child)
+	return owner != nil && owner.UID == parent.UID && child.Annotations[common.AnnotationKeyParentNodeAttempt] == attempt
+}
+
+// newChildWorkflow returns the child workflow of the current attempt of a node
+func (woc *wfOperationCtx) newChildWorkflow(node *wfv1.NodeStatus, tmpl *wfv1.ChildWorkflowTemplate) *wfv1.Workflow {
+	child := common.NewWorkflowFromWorkflowTemplate(tmpl.WorkflowTemplateRef.Name, tmpl.WorkflowTemplateRef.ClusterScope)
+	child.GenerateName = ""
+	child.Name = childWorkflowName(node)
+	child.Namespace = woc.wf.Namespace
+	child.Annotations[common.AnnotationKeyParentNodeAttempt] = childWorkflowAttempt(node)
+	child.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))}
+	child.Labels[common.LabelKeyParentWorkflow] = woc.wf.Name
+	if woc.controller.Config.InstanceID != "" {
+		child.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	for _, key := range []string{common.LabelKeyCreator, common.LabelKeyCreatorEmail, common.LabelKeyCreatorPreferredUsername} {
+		if value, ok := woc.wf.Labels[key]; ok {
+			child.Labels[key] = value
+		}
+	}
+	child.Spec.Arguments = *tmpl.Arguments.DeepCopy()
+	return child
+}
+
+// getChildWorkflowOutputs returns the outputs of a child workflow node: the outputs declared by its template, taken
+// from the child workflow's outputs of the same name, or all the child workflow's outputs if the template declares none
+func getChildWorkflowOutputs(declared wfv1.Outputs, childOutputs *wfv1.Outputs) (*wfv1.Outputs, error) {
+	if childOutputs == nil {
+		childOutputs = &wfv1.Outputs{}
+	}
+	if !declared.HasOutputs() {
+		if !childOutputs.HasOutputs() {
+			return nil, nil
+		}
+		outputs := &wfv1.Outputs{}
+		for _, param := range childOutputs.Parameters {
+			param.GlobalName = ""
+			outputs.Parameters = append(outputs.Parameters, param)
+		}
+		for _, art := range childOutputs.Artifacts {
+			art.GlobalName = ""
+			outputs.Artifacts = append(outputs.Artifacts, art)
+		}
+		return outputs, nil
+	}
+
+	outputs := &wfv1.Outputs{}
+	for _, param := range declared.Parameters {
+		childParam := childOutputs.GetParameterByName(param.Name)
+		switch {
+		case childParam != nil && childParam.Value != nil:
+			param.Value = childParam.Value
+		case param.ValueFrom != nil && param.ValueFrom.Default != nil:
+			param.Value = param.ValueFrom.Default
+		default:
+			return nil, fmt.Errorf("no output parameter named %q", param.Name)
+		}
+		if param.IsTyped() {
+			if _, err := common.GetTypedParameterValue(param, param.Value.String()); err != nil {
+				return nil, fmt.Errorf("outputs.parameters.%s: %w", param.Name, err)
+			}
+		}
+		outputs.Parameters = append(outputs.Parameters, param)
+	}
+	for _, art := range declared.Artifacts {
+		childArt := childOutputs.GetArtifactByName(art.Name)
+		if childArt == nil {
+			if art.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("no output artifact named %q", art.Name)
+		}
+		out := *childArt.DeepCopy()
+		out.GlobalName = art.GlobalName
+		outputs.Artifacts = append(outputs.Artifacts, out)
+	}
+	return outputs, nil
+}
+
+// childWorkflowReconciliation propagates the workflow's shutdown, deadline and suspension to the child workflows of its
+// running child workflow nodes, terminating them once the workflow exceeds its deadline. It returns the IDs of the nodes
+// whose child workflows have been shut down or have completed, which are the only child workflow nodes that can be
+// failed on shutdown or deadline without orphaning their child workflows.
+func (woc *wfOperationCtx) childWorkflowReconciliation(ctx context.Context) map[string]bool {
+	stopped := make(map[string]bool)
+	deadlineExceeded := woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)
+	nodes := woc.wf.Status.Nodes
+	for _, node := range nodes {
+		if node.Type != wfv1.NodeTypeChildWorkflow || node.Fulfilled() || node.ChildWorkflow == "" {
+			continue
+		}
+		exitHandler := node.IsPartOfExitHandler(ctx, nodes)
+		shutdown := woc.GetShutdownStrategy()
+		if shutdown.ShouldExecute(exitHandler) {
+			shutdown = ""
+		}
+		if !shutdown.Enabled() && deadlineExceeded && !exitHandler {
+			shutdown = wfv1.ShutdownStrategyTerminate
+		}
+		child, err := woc.controller.getChildWorkflow(ctx, woc.wf.Namespace, node.ChildWorkflow)
+		if apierr.IsNotFound(err) {
+			stopped[node.ID] = true
+			continue
+		}
+		if err != nil {
+			woc.log.WithField("childWorkflow", node.ChildWorkflow).WithError(err).Error(ctx, "Failed to get child workflow")
+			woc.requeue()
+			continue
+		}
+		if child.Status.Phase.Completed() {
+			stopped[node.ID] = true
+			continue
+		}
+		patch := getChildWorkflowPatch(child, shutdown, woc.ShouldSuspend())
+		if patch == nil {
+			stopped[node.ID] = shutdown.Enabled()
+			continue
+		}
+		data, err := json.Marshal(patch)
+		if err != nil {
+			woc.log.WithError(err).Error(ctx, "Failed to marshal child workflow patch")
+			continue
+		}
+		_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(child.Namespace).Patch(ctx, child.Name, types.MergePatchType, data, metav1.PatchOptions{})
+		if err != nil {
+			// the node is kept running until its child workflow has been shut down, so the patch is retried
+			woc.log.WithField("childWorkflow", child.Name).WithError(err).Error(ctx, "Failed to propagate to child workflow")
+			woc.requeue()
+			continue
+		}
+		woc.log.WithFields(logging.Fields{"childWorkflow": child.Name, "patch": string(data)}).Info(ctx, "Propagated to child workflow")
+		stopped[node.ID] = shutdown.Enabled()
+	}
+	return stopped
+}
+
+// getChildWorkflowPatch returns the merge patch that propagates the shutdown strategy and suspension of its parent
+// workflow to a child workflow, or nil if the child workflow is up-to-date. A child workflow suspended with its parent
+// is resumed with it, but one suspended by itself is not.
+func getChildWorkflowPatch(child *wfv1.Workflow, shutdown wfv1.ShutdownStrategy, suspend bool) map[string]interface{} {
+	suspendedByParent := child.Annotations[common.AnnotationKeySuspendedByParent] == "true"
+	switch {
+	case shutdown.Enabled() && child.Spec.Shutdown != shutdown:
+		return map[string]interface{}{"spec": map[string]interface{}{"shutdown": shutdown}}
+	case shutdown.Enabled():
+		return nil
+	case suspend && (child.Spec.Suspend == nil || !*child.Spec.Suspend):
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{"annotations": map[string]interface{}{common.AnnotationKeySuspendedByParent: "true"}},
+			"spec":     map[string]interface{}{"suspend": true},
+		}
+	case !suspend && suspendedByParent:
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{"annotations": map[string]interface{}{common.AnnotationKeySuspendedByParent: nil}},
+			"spec":     map[string]interface{}{"suspend": nil},
+		}
+	}
+	return nil
+}
+
+// getChildWorkflow returns a child workflow from the informer, or from the API if it has not been observed yet. A
+// child workflow is only looked for once it has been created, so a NotFound error means that it has been deleted.
+func (wfc *WorkflowController) getChildWorkflow(ctx context.Context, namespace, name string) (*wfv1.Workflow, error) {
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(cache.NewObjectName(namespace, name).String())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return wfc.wfclientset.ArgoprojV1alpha1().Workflows(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("object %+v is not an unstructured", obj)
+	}
+	return wfutil.FromUnstructured(un)
+}
@@ -0,0 +1,411 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+const childWorkflowParent = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parent
+  labels:
+    workflows.argoproj.io/creator: alice
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+  - name: build
+    inputs:
+      parameters:
+      - name: ref
+        value: main
+    childWorkflow:
+      workflowTemplateRef:
+        name: build
+      arguments:
+        parameters:
+        - name: ref
+          value: "{{inputs.parameters.ref}}"
+    outputs:
+      parameters:
+      - name: version
+      artifacts:
+      - name: binary
+`
+
+// observeChildWorkflow adds the child workflow to the informer, as if the controller had observed it
+func observeChildWorkflow(ctx context.Context, t *testing.T, controller *WorkflowController, name string, update func(child *wfv1.Workflow)) *wfv1.Workflow {
+	t.Helper()
+	child, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	if update != nil {
+		update(child)
+	}
+	un, err := wfutil.ToUnstructured(child)
+	require.NoError(t, err)
+	require.NoError(t, controller.wfInformer.GetIndexer().Update(un))
+	return child
+}
+
+func TestChildWorkflow(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeTypeChildWorkflow, node.Type)
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	assert.Equal(t, childWorkflowName(node), node.ChildWorkflow)
+
+	child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+	assert.Equal(t, childWorkflowAttempt(node), child.Annotations[common.AnnotationKeyParentNodeAttempt])
+	assert.Equal(t, "parent", child.Labels[common.LabelKeyParentWorkflow])
+	assert.Equal(t, "build", child.Labels[common.LabelKeyWorkflowTemplate])
+	assert.Equal(t, "alice", child.Labels[common.LabelKeyCreator])
+	require.Len(t, child.OwnerReferences, 1)
+	assert.Equal(t, "parent", child.OwnerReferences[0].Name)
+	assert.True(t, *child.OwnerReferences[0].Controller)
+	assert.Equal(t, &wfv1.WorkflowTemplateRef{Name: "build"}, child.Spec.WorkflowTemplateRef)
+	assert.Equal(t, "main", child.Spec.Arguments.GetParameterByName("ref").Value.String())
+
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByDisplayName("build").Phase)
+
+	observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, func(child *wfv1.Workflow) {
+		child.Labels[common.LabelKeyCompleted] = "true"
+		child.Status.Phase = wfv1.WorkflowSucceeded
+		child.Status.Outputs = &wfv1.Outputs{
+			Parameters: []wfv1.Parameter{
+				{Name: "version", GlobalName: "version", Value: wfv1.AnyStringPtr("1.2.3")},
+				{Name: "commit", GlobalName: "commit", Value: wfv1.AnyStringPtr("abc123")},
+			},
+			Artifacts: []wfv1.Artifact{{Name: "binary", GlobalName: "binary", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "binary.tgz"}}}},
+		}
+	})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	node = woc.wf.Status.Nodes.FindByDisplayName("build")
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	require.NotNil(t, node.Outputs)
+	require.Len(t, node.Outputs.Parameters, 1)
+	assert.Equal(t, "1.2.3", node.Outputs.Parameters[0].Value.String())
+	require.Len(t, node.Outputs.Artifacts, 1)
+	assert.Equal(t, "binary.tgz", node.Outputs.Artifacts[0].S3.Key)
+	assert.Empty(t, node.Outputs.Artifacts[0].GlobalName)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
+func TestChildWorkflowName(t *testing.T) {
+	node := &wfv1.NodeStatus{ID: "parent-1234", StartedAt: metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))}
+	name := childWorkflowName(node)
+	assert.Regexp(t, `^parent-1234-\d+$`, name)
+	assert.Equal(t, name, childWorkflowName(node.DeepCopy()))
+	retried := node.DeepCopy()
+	retried.StartedAt = metav1.NewTime(node.StartedAt.Add(time.Minute))
+	assert.NotEqual(t, name, childWorkflowName(retried), "each attempt of the node has its own child workflow")
+
+	t.Run("LongParentName", func(t *testing.T) {
+		parent := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("p", 60)}}
+		node := &wfv1.NodeStatus{ID: parent.NodeID("build"), StartedAt: node.StartedAt}
+		name := childWorkflowName(node)
+		assert.Len(t, name, 63)
+		assert.Regexp(t, `^p+-[0-9a-f]{8}$`, name)
+		assert.Equal(t, name, childWorkflowName(node.DeepCopy()))
+		retried := node.DeepCopy()
+		retried.StartedAt = metav1.NewTime(node.StartedAt.Add(time.Minute))
+		assert.NotEqual(t, name, childWorkflowName(retried), "each attempt of the node has its own child workflow")
+	})
+	t.Run("Nested", func(t *testing.T) {
+		name := "parent"
+		var names []string
+		for range 5 {
+			child := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			name = childWorkflowName(&wfv1.NodeStatus{ID: child.NodeID("build"), StartedAt: node.StartedAt})
+			assert.LessOrEqual(t, len(name), 63)
+			assert.NotContains(t, names, name)
+			names = append(names, name)
+		}
+	})
+}
+
+func TestChildWorkflowAlreadyExists(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	wf.UID = "parent-uid"
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+	childName := node.ChildWorkflow
+
+	t.Run("Owned", func(t *testing.T) {
+		// the status update recording the child workflow was lost
+		lost := woc.wf.DeepCopy()
+		lostNode := lost.Status.Nodes[node.ID]
+		lostNode.ChildWorkflow = ""
+		lostNode.Phase = wfv1.NodePending
+		lost.Status.Nodes[node.ID] = lostNode
+		woc := newWorkflowOperationCtx(ctx, lost, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("build")
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, childName, node.ChildWorkflow)
+	})
+
+	t.Run("NotOwned", func(t *testing.T) {
+		wfIf := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace)
+		child, err := wfIf.Get(ctx, childName, metav1.GetOptions{})
+		require.NoError(t, err)
+		child.OwnerReferences[0].UID = "other-uid"
+		_, err = wfIf.Update(ctx, child, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		lost := woc.wf.DeepCopy()
+		lostNode := lost.Status.Nodes[node.ID]
+		lostNode.ChildWorkflow = ""
+		lostNode.Phase = wfv1.NodePending
+		lost.Status.Nodes[node.ID] = lostNode
+		woc := newWorkflowOperationCtx(ctx, lost, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("build")
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "workflow "+childName+" already exists and is not the child workflow of this node", node.Message)
+		assert.Empty(t, node.ChildWorkflow)
+	})
+}
+
+func TestChildWorkflowFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+	observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, func(child *wfv1.Workflow) {
+		child.Labels[common.LabelKeyCompleted] = "true"
+		child.Status.Phase = wfv1.WorkflowFailed
+		child.Status.Message = "child failed"
+	})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	node = woc.wf.Status.Nodes.FindByDisplayName("build")
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "child failed", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
+
+func TestChildWorkflowPropagation(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+
+	t.Run("Suspend", func(t *testing.T) {
+		observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+		woc.wf.Spec.Suspend = ptr.To(true)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+		assert.True(t, *child.Spec.Suspend)
+		assert.Equal(t, "true", child.Annotations[common.AnnotationKeySuspendedByParent])
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		woc.wf.Spec.Suspend = nil
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+		assert.Nil(t, child.Spec.Suspend)
+		assert.NotContains(t, child.Annotations, common.AnnotationKeySuspendedByParent)
+	})
+
+	t.Run("Terminate", func(t *testing.T) {
+		woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+		assert.Equal(t, wfv1.ShutdownStrategyTerminate, child.Spec.Shutdown)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes.FindByDisplayName("build").Phase)
+	})
+}
+
+func TestChildWorkflowShutdownPatchFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+	observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+
+	patchFails := true
+	controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("patch", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return patchFails, nil, apierr.NewInternalError(errors.New("patch failed"))
+	})
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyStop
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	// the node is kept running so that the shutdown is propagated to its child workflow when the workflow is requeued
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByDisplayName("build").Phase)
+	child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+	assert.Empty(t, child.Spec.Shutdown)
+
+	patchFails = false
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes.FindByDisplayName("build").Phase)
+	child = observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+	assert.Equal(t, wfv1.ShutdownStrategyStop, child.Spec.Shutdown)
+}
+
+func TestChildWorkflowDeadline(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+	observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+
+	woc.wf.Spec.ActiveDeadlineSeconds = ptr.To(int64(10))
+	woc.wf.Status.StartedAt = metav1.NewTime(time.Now().Add(-time.Minute))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	child := observeChildWorkflow(ctx, t, controller, node.ChildWorkflow, nil)
+	assert.Equal(t, wfv1.ShutdownStrategyTerminate, child.Spec.Shutdown)
+	node = woc.wf.Status.Nodes.FindByDisplayName("build")
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "Step exceeded its deadline", node.Message)
+}
+
+func TestGetChildWorkflowOutputs(t *testing.T) {
+	childOutputs := &wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "version", GlobalName: "version", Value: wfv1.AnyStringPtr("v1")}},
+	}
+
+	t.Run("Undeclared", func(t *testing.T) {
+		outputs, err := getChildWorkflowOutputs(wfv1.Outputs{}, childOutputs)
+		require.NoError(t, err)
+		assert.Equal(t, &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "version", Value: wfv1.AnyStringPtr("v1")}}}, outputs)
+		assert.Equal(t, "version", childOutputs.Parameters[0].GlobalName)
+
+		outputs, err = getChildWorkflowOutputs(wfv1.Outputs{}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, outputs)
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		declared := wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "commit", ValueFrom: &wfv1.ValueFrom{Default: wfv1.AnyStringPtr("unknown")}}}}
+		outputs, err := getChildWorkflowOutputs(declared, childOutputs)
+		require.NoError(t, err)
+		assert.Equal(t, "unknown", outputs.Parameters[0].Value.String())
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := getChildWorkflowOutputs(wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "commit"}}}, childOutputs)
+		require.EqualError(t, err, `no output parameter named "commit"`)
+
+		_, err = getChildWorkflowOutputs(wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "binary"}}}, childOutputs)
+		require.EqualError(t, err, `no output artifact named "binary"`)
+
+		outputs, err := getChildWorkflowOutputs(wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "binary", Optional: true}}}, childOutputs)
+		require.NoError(t, err)
+		assert.Empty(t, outputs.Artifacts)
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		_, err := getChildWorkflowOutputs(wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "version", Type: wfv1.ParameterTypeInt}}}, childOutputs)
+		require.EqualError(t, err, `outputs.parameters.version: value "v1" is not an int`)
+	})
+}
+
+func TestGetChildWorkflowPatch(t *testing.T) {
+	child := &wfv1.Workflow{}
+	assert.Nil(t, getChildWorkflowPatch(child, "", false))
+	assert.Equal(t, map[string]interface{}{"spec": map[string]interface{}{"shutdown": wfv1.ShutdownStrategyStop}}, getChildWorkflowPatch(child, wfv1.ShutdownStrategyStop, true))
+
+	// a child workflow suspended by itself is not resumed with its parent
+	child.Spec.Suspend = ptr.To(true)
+	assert.Nil(t, getChildWorkflowPatch(child, "", false))
+}
+
+func TestChildWorkflowDeleted(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(childWorkflowParent)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	node := woc.wf.Status.Nodes.FindByDisplayName("build")
+	require.NotNil(t, node)
+
+	// the child workflow has been created but not observed yet
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByDisplayName("build").Phase)
+
+	// the child workflow was deleted before it was observed
+	require.NoError(t, controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Delete(ctx, node.ChildWorkflow, metav1.DeleteOptions{}))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	node = woc.wf.Status.Nodes.FindByDisplayName("build")
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "child workflow "+node.ChildWorkflow+" was deleted", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+}
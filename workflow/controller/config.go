@@ -31,6 +31,7 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 	wfc.archiveLabelSelector = labels.Everything()
 	if wfc.throttler != nil {
 		wfc.throttler.UpdateParallelism(wfc.Config.Parallelism)
+		wfc.throttler.UpdateFairShare(wfc.Config.FairShare, wfc.getWorkflowLabels)
 	}
 
 	persistence := wfc.Config.Persistence
@@ -49,6 +50,7 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 			wfc.session = session
 		}
 		sqldb.ConfigureDBSession(wfc.session, persistence.ConnectionPool)
+		wfc.cacheFactory.SetSession(wfc.session, persistence.GetClusterName())
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = persist.NewOffloadNodeStatusRepo(ctx, logger, wfc.session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -72,6 +74,7 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 		}
 	} else {
 		logger.Info(ctx, "Persistence configuration disabled")
+		wfc.cacheFactory.SetSession(nil, "")
 	}
 
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"os"
 	"slices"
-	"strconv"
+	"strings"
 	gosync "sync"
 	"time"
 
@@ -41,6 +41,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions"
 	wfextvv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
@@ -65,6 +66,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/gccontroller"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
@@ -82,6 +84,13 @@ type recentCompletions struct {
 	mutex       gosync.RWMutex
 }
 
+// unreferencedDigests records when the content-addressed artifacts with each digest were first found to be
+// unreferenced, so that they are only deleted once they have stayed unreferenced for the grace period
+type unreferencedDigests struct {
+	since map[string]time.Time
+	mutex gosync.Mutex
+}
+
 // WorkflowController is the controller for workflow resources
 type WorkflowController struct {
 	// namespace of the workflow controller
@@ -135,6 +144,7 @@ type WorkflowController struct {
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
+	tracing               *telemetry.Tracing
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
@@ -150,7 +160,8 @@ type WorkflowController struct {
 	progressFileTickDuration time.Duration
 	executorPlugins          map[string]map[string]*spec.Plugin // namespace -> name -> plugin
 
-	recentCompletions recentCompletions
+	recentCompletions   recentCompletions
+	unreferencedDigests unreferencedDigests
 	// lastUnreconciledWorkflows is a map of workflows that have been recently unreconciled
 	lastUnreconciledWorkflows map[string]*wfv1.Workflow
 }
@@ -222,10 +233,15 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 			WorkflowPhase:     wfc.getWorkflowPhaseMetrics,
 			WorkflowCondition: wfc.getWorkflowConditionMetrics,
 			IsLeader:          wfc.IsLeader,
+			FairShare:         wfc.getFairShareMetrics,
 		})
 	if err != nil {
 		return nil, err
 	}
+	wfc.tracing, err = telemetry.NewTracing(ctx, `workflows-controller`)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecation.Initialize(wfc.metrics.DeprecatedFeature)
 	wfc.entrypoint = entrypoint.New(kubeclientset, wfc.Config.Images)
@@ -240,7 +256,25 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 
 func (wfc *WorkflowController) newThrottler() sync.Throttler {
 	f := func(key string) { wfc.wfQueue.Add(key) }
-	return sync.NewMultiThrottler(wfc.Config.Parallelism, wfc.Config.NamespaceParallelism, f)
+	throttler := sync.NewMultiThrottler(wfc.Config.Parallelism, wfc.Config.NamespaceParallelism, f)
+	throttler.UpdateFairShare(wfc.Config.FairShare, wfc.getWorkflowLabels)
+	return throttler
+}
+
+// getWorkflowLabels returns the labels of the workflow from the informer, for the throttler to find its fair-share tenant
+func (wfc *WorkflowController) getWorkflowLabels(key string) map[string]string {
+	if wfc.wfInformer == nil {
+		return nil
+	}
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	return un.GetLabels()
 }
 
 // runGCcontroller runs the workflow garbage collector controller
@@ -274,6 +308,7 @@ var indexers = cache.Indexers{
 	indexes.WorkflowPhaseIndex:           indexes.MetaWorkflowPhaseIndexFunc(),
 	indexes.ConditionsIndex:              indexes.ConditionsIndexFunc,
 	indexes.UIDIndex:                     indexes.MetaUIDFunc,
+	indexes.ArtifactDigestIndex:          indexes.ArtifactDigestIndexFunc,
 	cache.NamespaceIndex:                 cache.MetaNamespaceIndexFunc,
 }
 
@@ -291,6 +326,12 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	defer cancel()
 
 	defer wfc.wfQueue.ShutDown()
+	defer func() {
+		// ctx is cancelled by now, but we still want to flush any buffered spans
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		wfc.tracing.Shutdown(shutdownCtx)
+	}()
 
 	logger.WithFields(argo.GetVersion().Fields()).WithFields(logging.Fields{
 		"instanceID":         wfc.Config.InstanceID,
@@ -400,27 +441,40 @@ func (wfc *WorkflowController) RunPrometheusServer(ctx context.Context, isDummy
 
 // Create and the Synchronization Manager
 func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context) {
-	getSyncLimit := func(ctx context.Context, lockKey string) (int, error) {
-		lockName, err := sync.DecodeLockName(ctx, lockKey)
-		if err != nil {
-			return 0, err
-		}
-		configmapsIf := wfc.kubeclientset.CoreV1().ConfigMaps(lockName.Namespace)
+	getConfigMapValue := func(ctx context.Context, namespace, name, key string) (string, error) {
+		configmapsIf := wfc.kubeclientset.CoreV1().ConfigMaps(namespace)
 		var configMap *apiv1.ConfigMap
-		err = waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
 			var err error
-			configMap, err = configmapsIf.Get(ctx, lockName.ResourceName, metav1.GetOptions{})
+			configMap, err = configmapsIf.Get(ctx, name, metav1.GetOptions{})
 			return !errors.IsTransientErr(ctx, err), err
 		})
 		if err != nil {
-			return 0, err
+			return "", err
 		}
 
-		value, found := configMap.Data[lockName.Key]
+		value, found := configMap.Data[key]
 		if !found {
-			return 0, argoErr.New(argoErr.CodeBadRequest, fmt.Sprintf("Sync configuration key '%s' not found in ConfigMap", lockName.Key))
+			return "", argoErr.New(argoErr.CodeBadRequest, fmt.Sprintf("Sync configuration key '%s' not found in ConfigMap", key))
+		}
+		return value, nil
+	}
+
+	limitFacts := sync.LimitFacts{
+		Running:        wfc.countRunningWorkflows,
+		ConfigMapValue: getConfigMapValue,
+	}
+
+	getSyncLimit := func(ctx context.Context, lockKey string) (int, error) {
+		lockName, err := sync.DecodeLockName(ctx, lockKey)
+		if err != nil {
+			return 0, err
 		}
-		return strconv.Atoi(value)
+		value, err := getConfigMapValue(ctx, lockName.Namespace, lockName.ResourceName, lockName.Key)
+		if err != nil {
+			return 0, err
+		}
+		return sync.ParseSyncLimit(ctx, value, lockName.Namespace, limitFacts)
 	}
 
 	nextWorkflow := func(key string) {
@@ -437,6 +491,7 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 	}
 
 	wfc.syncManager = sync.NewLockManager(ctx, wfc.kubeclientset, wfc.namespace, wfc.Config.Synchronization, getSyncLimit, nextWorkflow, isWFDeleted)
+	wfc.syncManager.SetPreemptWorkflow(wfc.preemptWorkflow)
 }
 
 // list all running workflows to initialize throttler and syncManager
@@ -1034,6 +1089,24 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 	if err != nil {
 		return err
 	}
+	_, err = wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && un.GetLabels()[common.LabelKeyParentWorkflow] != ""
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, newObj interface{}) {
+				oldWf, newWf := old.(*unstructured.Unstructured), newObj.(*unstructured.Unstructured)
+				// the parent workflow of a child workflow completes its node once the child workflow completes
+				if oldWf.GetLabels()[common.LabelKeyCompleted] != newWf.GetLabels()[common.LabelKeyCompleted] {
+					wfc.wfQueue.AddRateLimited(newWf.GetNamespace() + "/" + newWf.GetLabels()[common.LabelKeyParentWorkflow])
+				}
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
 	_, err = wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: func(obj interface{}) {
 			wf, ok := obj.(*unstructured.Unstructured)
@@ -1191,7 +1264,7 @@ func (wfc *WorkflowController) newConfigMapInformer(ctx context.Context) cache.S
 
 // call this func whenever the configuration changes, or when the workflow informer changes
 func (wfc *WorkflowController) updateEstimatorFactory(ctx context.Context) {
-	wfc.estimatorFactory = estimation.NewEstimatorFactory(ctx, wfc.wfInformer, wfc.hydrator, wfc.wfArchive)
+	wfc.estimatorFactory = estimation.NewEstimatorFactory(ctx, wfc.wfInformer, wfc.hydrator, wfc.wfArchive, wfc.Config.Estimation.GetRuns())
 }
 
 // setWorkflowDefaults sets values in the workflow.Spec with defaults from the
@@ -1259,6 +1332,62 @@ func (wfc *WorkflowController) releaseAllWorkflowLocks(ctx context.Context, obj
 	}
 }
 
+// preemptWorkflow stops or suspends the workflow of a preempted lock holder, so that it releases the lock
+func (wfc *WorkflowController) preemptWorkflow(ctx context.Context, preemptor *wfv1.Workflow, workflowKey string, record wfv1.PreemptionRecord) {
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"workflow": workflowKey, "lockName": record.Lock, "action": record.Action})
+	namespace, name, err := cache.SplitMetaNamespaceKey(workflowKey)
+	if err != nil {
+		logger.WithError(err).Error(ctx, "Invalid workflow key of preempted lock holder")
+		return
+	}
+	message := fmt.Sprintf("Preempted from lock %s by %s", record.Lock, record.Preemptor)
+	wfc.eventRecorderManager.Get(ctx, preemptor.Namespace).Event(preemptor, apiv1.EventTypeNormal, "LockHolderPreempted", fmt.Sprintf("Preempted %s from lock %s (%s)", record.Holder, record.Lock, record.Action))
+	// the sync manager lock is held, so we must not block
+	go func() {
+		defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+		wfIf := wfc.wfclientset.ArgoprojV1alpha1().Workflows(namespace)
+		var err error
+		switch record.Action {
+		case wfv1.PreemptionActionSuspend:
+			err = util.SuspendWorkflow(ctx, wfIf, name)
+		default:
+			err = util.StopWorkflow(ctx, wfIf, wfc.hydrator, name, "", message)
+		}
+		if err != nil {
+			logger.WithError(err).Error(ctx, "Failed to preempt workflow")
+			return
+		}
+		logger.Info(ctx, "Preempted workflow")
+		if err := setPreemptedCondition(ctx, wfIf, name, fmt.Sprintf("%s at %s (%s)", message, record.Time.Format(time.RFC3339), record.Action)); err != nil {
+			logger.WithError(err).Warn(ctx, "Failed to record preemption on workflow")
+		}
+		obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(workflowKey)
+		if err != nil || !exists {
+			return
+		}
+		if un, ok := obj.(*unstructured.Unstructured); ok {
+			wfc.eventRecorderManager.Get(ctx, namespace).Event(un, apiv1.EventTypeWarning, "WorkflowPreempted", fmt.Sprintf("%s (%s)", message, record.Action))
+		}
+	}()
+}
+
+// setPreemptedCondition records the preemption on the preempted workflow, so that it still says why it was stopped or
+// suspended once the locks have been released
+func setPreemptedCondition(ctx context.Context, wfIf v1alpha1.WorkflowInterface, name, message string) error {
+	return waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		wf, err := wfIf.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return !errors.IsTransientErr(ctx, err), err
+		}
+		wf.Status.Conditions.UpsertCondition(wfv1.Condition{Type: wfv1.ConditionTypePreempted, Status: metav1.ConditionTrue, Message: message})
+		_, err = wfIf.Update(ctx, wf, metav1.UpdateOptions{})
+		if apierr.IsConflict(err) {
+			return false, nil
+		}
+		return !errors.IsTransientErr(ctx, err), err
+	})
+}
+
 func (wfc *WorkflowController) isArchivable(wf *wfv1.Workflow) bool {
 	return wfc.archiveLabelSelector.Matches(labels.Set(wf.Labels))
 }
@@ -1275,6 +1404,116 @@ func (wfc *WorkflowController) getWorkflowPhaseMetrics(ctx context.Context) map[
 	return result
 }
 
+// countRunningWorkflows returns the number of Running workflows in the namespace, for semaphore limit expressions
+func (wfc *WorkflowController) countRunningWorkflows(namespace string) (int, error) {
+	if wfc.wfInformer == nil {
+		return 0, nil
+	}
+	keys, err := wfc.wfInformer.GetIndexer().IndexKeys(indexes.WorkflowPhaseIndex, string(wfv1.WorkflowRunning))
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, key := range keys {
+		if strings.HasPrefix(key, namespace+"/") {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isArtifactDigestReferenced returns whether a live or archived workflow, other than the excluded one, has an artifact
+// with the digest that has not been deleted. The workflows with offloaded or compressed nodes are not indexed by their
+// digests, so the digests of their artifacts are passed in, loaded once by offloadedArtifactDigests.
+func (wfc *WorkflowController) isArtifactDigestReferenced(ctx context.Context, digest string, exclude types.UID, offloadedDigests map[string]bool) (bool, error) {
+	objs, err := wfc.wfInformer.GetIndexer().ByIndex(indexes.ArtifactDigestIndex, digest)
+	if err != nil {
+		return false, err
+	}
+	for _, obj := range objs {
+		if un, ok := obj.(*unstructured.Unstructured); ok && un.GetUID() != exclude {
+			return true, nil
+		}
+	}
+	if offloadedDigests[digest] {
+		return true, nil
+	}
+	return wfc.wfArchive.IsArtifactDigestReferenced(ctx, digest, string(exclude))
+}
+
+// offloadedArtifactDigests returns the digests of the artifacts, that have not been deleted, of the live workflows,
+// other than the excluded one, with offloaded or compressed nodes. Compressed nodes are decompressed, and the nodes of
+// all the offloaded workflows are listed in a single query, rather than hydrating each workflow.
+func (wfc *WorkflowController) offloadedArtifactDigests(ctx context.Context, exclude types.UID) (map[string]bool, error) {
+	objs, err := wfc.wfInformer.GetIndexer().ByIndex(indexes.ArtifactDigestIndex, indexes.OffloadedNodesValue)
+	if err != nil {
+		return nil, err
+	}
+	digests := make(map[string]bool)
+	var offloaded map[sqldb.UUIDVersion]wfv1.Nodes
+	for _, obj := range objs {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok || un.GetUID() == exclude {
+			continue
+		}
+		wf, err := util.FromUnstructured(un)
+		if err != nil {
+			return nil, err
+		}
+		// nodes are compressed by the packer when the workflow is too large, and offloaded when that is not enough
+		if err := packer.DecompressWorkflow(ctx, wf); err != nil {
+			return nil, err
+		}
+		nodes := wf.Status.Nodes
+		if wf.Status.IsOffloadNodeStatus() {
+			if offloaded == nil {
+				if offloaded, err = wfc.offloadNodeStatusRepo.List(ctx, wfc.GetManagedNamespace()); err != nil {
+					return nil, err
+				}
+			}
+			if nodes, ok = offloaded[sqldb.UUIDVersion{UID: string(wf.UID), Version: wf.GetOffloadNodeStatusVersion()}]; !ok {
+				return nil, fmt.Errorf("offloaded nodes of workflow %s/%s not found", wf.Namespace, wf.Name)
+			}
+		}
+		for _, n := range nodes {
+			for _, a := range n.GetOutputs().GetArtifacts() {
+				if a.Digest != "" && !a.Deleted {
+					digests[a.Digest] = true
+				}
+			}
+		}
+	}
+	return digests, nil
+}
+
+// artifactGCGracePeriodRemaining returns how long until the content-addressed artifacts with the digest, that are now
+// unreferenced, may be deleted. They may not be deleted until the grace period has passed since both they were last
+// used and they were first found to be unreferenced, as an executor may have found them but not yet reported them.
+func (wfc *WorkflowController) artifactGCGracePeriodRemaining(digest string, lastUsed time.Time) time.Duration {
+	wfc.unreferencedDigests.mutex.Lock()
+	defer wfc.unreferencedDigests.mutex.Unlock()
+	if wfc.unreferencedDigests.since == nil {
+		wfc.unreferencedDigests.since = make(map[string]time.Time)
+	}
+	since, ok := wfc.unreferencedDigests.since[digest]
+	if !ok {
+		since = time.Now()
+		wfc.unreferencedDigests.since[digest] = since
+	}
+	if lastUsed.After(since) {
+		since = lastUsed
+	}
+	return max(time.Until(since.Add(contentAddressedArtifactGCGracePeriod)), 0)
+}
+
+// forgetUnreferencedDigest forgets that the content-addressed artifacts with the digest were unreferenced, because they
+// are referenced again, or are being deleted
+func (wfc *WorkflowController) forgetUnreferencedDigest(digest string) {
+	wfc.unreferencedDigests.mutex.Lock()
+	defer wfc.unreferencedDigests.mutex.Unlock()
+	delete(wfc.unreferencedDigests.since, digest)
+}
+
 func (wfc *WorkflowController) getWorkflowConditionMetrics(ctx context.Context) map[wfv1.Condition]int64 {
 	result := make(map[wfv1.Condition]int64, 0)
 	if wfc.wfInformer != nil {
@@ -1290,6 +1529,16 @@ func (wfc *WorkflowController) getWorkflowConditionMetrics(ctx context.Context)
 	return result
 }
 
+func (wfc *WorkflowController) getFairShareMetrics(ctx context.Context) map[string]metrics.TenantUsage {
+	result := make(map[string]metrics.TenantUsage)
+	if wfc.throttler != nil {
+		for tenant, usage := range wfc.throttler.TenantUsage() {
+			result[tenant] = metrics.TenantUsage{Running: int64(usage.Running), Pending: int64(usage.Pending), Share: usage.Share}
+		}
+	}
+	return result
+}
+
 func (wfc *WorkflowController) getPodPhaseMetrics(ctx context.Context) map[string]int64 {
 	// During startup we need this callback to exist, but it won't function until the PodController is started
 	if wfc.PodController != nil {
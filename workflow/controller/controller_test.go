@@ -8,6 +8,8 @@ import (
 	"github.com/argoproj/pkg/sync"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -253,6 +255,9 @@ var defaultServiceAccount = &apiv1.ServiceAccount{
 // test exporter extract metric values from the metrics subsystem
 var testExporter *telemetry.TestMetricsExporter
 
+// testSpanExporter collects the spans recorded by the controller
+var testSpanExporter *tracetest.InMemoryExporter
+
 func newController(ctx context.Context, options ...interface{}) (context.CancelFunc, *WorkflowController) {
 	// get all the objects and add to the fake
 	var objects, coreObjects []runtime.Object
@@ -313,6 +318,8 @@ func newController(ctx context.Context, options ...interface{}) (context.CancelF
 	// always compare to NewWorkflowController to see what this block of code should be doing
 	{
 		wfc.metrics, testExporter, _ = metrics.CreateDefaultTestMetrics(ctx)
+		testSpanExporter = tracetest.NewInMemoryExporter()
+		wfc.tracing, _ = telemetry.NewTracing(ctx, "workflows-controller", tracesdk.WithSyncer(testSpanExporter))
 		wfc.entrypoint = entrypoint.New(kube, wfc.Config.Images)
 		wfc.wfQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
 		wfc.throttler = wfc.newThrottler()
@@ -1357,3 +1364,17 @@ func TestPodSpecPatchTemplateLevel(t *testing.T) {
 	require.NotNil(t, mainContainer)
 	assert.Equal(t, "25Mi", mainContainer.Resources.Requests.Memory().String())
 }
+
+func TestSetPreemptedCondition(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	wfIf := controller.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace)
+	require.NoError(t, setPreemptedCondition(ctx, wfIf, wf.Name, "Preempted from lock default/Mutex/deploy by default/high (Stop)"))
+
+	wf, err := wfIf.Get(ctx, wf.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, wf.Status.Conditions, wfv1.Condition{Type: wfv1.ConditionTypePreempted, Status: metav1.ConditionTrue, Message: "Preempted from lock default/Mutex/deploy by default/high (Stop)"})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -14,8 +15,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 // dagContext holds context information about this context's DAG
@@ -56,6 +57,9 @@ type dagContext struct {
 	// are only computed once per operation
 	dependsLogic map[string]string
 
+	// expansions are the names of the tasks spliced into the DAG by each of its expanding tasks
+	expansions map[string][]string
+
 	// used for logging in the dag
 	log logging.Logger
 }
@@ -255,24 +259,26 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		onExitTemplate: opts.onExitTemplate,
 		dependencies:   make(map[string][]string),
 		dependsLogic:   make(map[string]string),
+		expansions:     make(map[string][]string),
 		log:            woc.log,
 	}
+	woc.expandDAG(ctx, dagCtx)
 
 	// Identify our target tasks. If user did not specify any, then we choose all tasks which have
-	// no dependants.
+	// no dependants. The leaves of the tasks spliced into the DAG are always targeted.
 	var targetTasks []string
 	if tmpl.DAG.Target == "" {
-		targetTasks = dagCtx.findLeafTaskNames(ctx, tmpl.DAG.Tasks)
+		targetTasks = dagCtx.findLeafTaskNames(ctx, dagCtx.tasks)
 	} else {
 		targetTasks = strings.Split(tmpl.DAG.Target, " ")
+		if len(dagCtx.tasks) > len(tmpl.DAG.Tasks) {
+			targetTasks = append(targetTasks, dagCtx.findLeafTaskNames(ctx, dagCtx.tasks[len(tmpl.DAG.Tasks):])...)
+		}
 	}
 
 	// pre-execute daemoned tasks
-	for _, task := range tmpl.DAG.Tasks {
+	for _, task := range dagCtx.tasks {
 		taskNode := dagCtx.getTaskNode(ctx, task.Name)
-		if err != nil {
-			continue
-		}
 		if taskNode != nil && taskNode.IsDaemoned() {
 			woc.executeDAGTask(ctx, dagCtx, task.Name)
 		}
@@ -336,7 +342,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 
 	// set outputs from tasks in order for DAG templates to support outputs
 	scope := createScope(tmpl)
-	for _, task := range tmpl.DAG.Tasks {
+	for _, task := range dagCtx.tasks {
 		taskNode := dagCtx.getTaskNode(ctx, task.Name)
 		if taskNode == nil {
 			// Can happen when dag.target was specified
@@ -359,6 +365,9 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 				woc.log.Error(ctx, "unable to processAggregateNodeOutputs")
 				return nil, errors.InternalWrapError(err)
 			}
+			if status, ok := taskNode.FanOut[task.Name]; ok {
+				addFanOutToScope(scope, prefix, status)
+			}
 		}
 		woc.buildLocalScope(scope, prefix, taskNode)
 		woc.addOutputsToGlobalScope(ctx, taskNode.Outputs)
@@ -378,8 +387,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
 	}
 	if node.MemoizationStatus != nil {
-		c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-		err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+		err := woc.saveMemoizedOutputs(ctx, node)
 		if err != nil {
 			woc.log.WithField("nodeID", node.ID).WithError(err).Error(ctx, "Failed to save node outputs to cache")
 			node.Phase = wfv1.NodeError
@@ -393,6 +401,82 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 	return woc.markNodePhase(ctx, nodeName, wfv1.NodeSucceeded), nil
 }
 
+// expandDAG splices the tasks emitted by the succeeded expanding tasks of a DAG into it. The emitted tasks are parsed
+// from the expanding task's output parameter on every operation, so they are never stored. An expanding task whose
+// emitted tasks are invalid is errored.
+func (woc *wfOperationCtx) expandDAG(ctx context.Context, dagCtx *dagContext) {
+	tasks := dagCtx.tasks
+	dagCtx.tasks = slices.Clone(tasks)
+	for _, task := range tasks {
+		if task.Expand == nil {
+			continue
+		}
+		node, err := woc.wf.Status.Nodes.Get(dagCtx.taskNodeID(task.Name))
+		if err != nil || node.Phase != wfv1.NodeSucceeded {
+			continue
+		}
+		expansion, err := getDAGExpansion(&task, node.Outputs)
+		if err == nil {
+			err = validate.ValidateDAGExpansion(ctx, dagCtx.tmplCtx, dagCtx.tmpl, dagCtx.tasks, task.Name, expansion)
+		}
+		if err != nil {
+			woc.markNodeError(ctx, node.Name, fmt.Errorf("failed to expand DAG: %w", err))
+			continue
+		}
+		dagCtx.expansions[task.Name] = make([]string, 0, len(expansion))
+		for _, t := range expansion {
+			dagCtx.expansions[task.Name] = append(dagCtx.expansions[task.Name], t.Name)
+		}
+		dagCtx.tasks = append(dagCtx.tasks, expansion...)
+	}
+}
+
+// getDAGExpansion returns the DAG tasks emitted by an expanding task. Emitted tasks without dependencies depend on
+// the expanding task.
+func getDAGExpansion(task *wfv1.DAGTask, outputs *wfv1.Outputs) ([]wfv1.DAGTask, error) {
+	var param *wfv1.Parameter
+	if outputs != nil {
+		param = outputs.GetParameterByName(task.Expand.Parameter)
+	}
+	if param == nil || param.Value == nil {
+		return nil, fmt.Errorf("no output parameter named %q", task.Expand.Parameter)
+	}
+	var expansion []wfv1.DAGTask
+	if err := json.Unmarshal([]byte(param.Value.String()), &expansion); err != nil {
+		return nil, fmt.Errorf("output parameter %q could not be parsed as a JSON list of DAG tasks: %w", task.Expand.Parameter, err)
+	}
+	for i := range expansion {
+		if expansion[i].Depends == "" && len(expansion[i].Dependencies) == 0 {
+			expansion[i].Depends = task.Name
+		}
+	}
+	return expansion, nil
+}
+
+// expansionFulfilled returns whether the tasks spliced into the DAG by an expanding task are all fulfilled. Tasks that
+// depend on an expanding task wait for them, except for the spliced tasks themselves.
+func (d *dagContext) expansionFulfilled(ctx context.Context, expandingTask, taskName string) bool {
+	if d.GetTask(ctx, expandingTask).Expand == nil {
+		return true
+	}
+	expansion, ok := d.expansions[expandingTask]
+	if !ok {
+		// the expanding task succeeded during this operation, and is expanded by the next one
+		node, err := d.wf.Status.Nodes.Get(d.taskNodeID(expandingTask))
+		return err == nil && node.Phase != wfv1.NodeSucceeded
+	}
+	if slices.Contains(expansion, taskName) {
+		return true
+	}
+	for _, name := range expansion {
+		node, err := d.wf.Status.Nodes.Get(d.taskNodeID(name))
+		if err != nil || !node.Fulfilled() {
+			return false
+		}
+	}
+	return true
+}
+
 func (woc *wfOperationCtx) updateOutboundNodesForTargetTasks(ctx context.Context, dagCtx *dagContext, targetTasks []string, nodeName string) error {
 	// set the outbound nodes from the target tasks
 	outbound := make([]string, 0)
@@ -592,9 +676,24 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	// A fan-out that can no longer succeed does not start its remaining tasks
+	fanOutFailure := ""
+	if task.FanOut != nil && taskGroupNode != nil {
+		fanOutFailure, err = woc.assessTaskGroupFanOut(ctx, dagCtx, task, expandedTasks)
+		if err != nil {
+			woc.markNodeError(ctx, taskGroupNode.Name, err)
+			return
+		}
+	}
+
 	for _, t := range expandedTasks {
 		taskNodeName := dagCtx.taskNodeName(t.Name)
 		node = dagCtx.getTaskNode(ctx, t.Name)
+		if node == nil && fanOutFailure != "" {
+			connectDependencies(taskNodeName)
+			woc.initializeNode(ctx, taskNodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeOmitted, &wfv1.NodeFlag{}, true, "omitted: fan-out failed")
+			continue
+		}
 		if node == nil {
 			woc.log.WithFields(logging.Fields{"nodeName": taskNodeName, "dependencies": taskDependencies}).Info(ctx, "All of node dependencies completed")
 			// Add the child relationship from our dependency's outbound nodes to this node.
@@ -648,6 +747,26 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	if taskGroupNode != nil && task.FanOut != nil {
+		fanOutFailure, err = woc.assessTaskGroupFanOut(ctx, dagCtx, task, expandedTasks)
+		if err != nil {
+			woc.markNodeError(ctx, taskGroupNode.Name, err)
+			return
+		}
+		for _, t := range expandedTasks {
+			node := dagCtx.getTaskNode(ctx, t.Name)
+			if node == nil || !node.Fulfilled() {
+				return
+			}
+		}
+		if fanOutFailure != "" {
+			woc.markNodePhase(ctx, taskGroupNode.Name, wfv1.NodeFailed, fmt.Sprintf("fan-out failed: %s", fanOutFailure))
+		} else {
+			woc.markNodePhase(ctx, taskGroupNode.Name, wfv1.NodeSucceeded)
+		}
+		return
+	}
+
 	if taskGroupNode != nil {
 		groupPhase := wfv1.NodeSucceeded
 		for _, t := range expandedTasks {
@@ -664,6 +783,22 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 }
 
+// assessTaskGroupFanOut assesses the fan-out of a task from the nodes of its expanded tasks, records its counts on the
+// task group node, and returns the reason it failed, if it did
+func (woc *wfOperationCtx) assessTaskGroupFanOut(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask, expandedTasks []wfv1.DAGTask) (string, error) {
+	var nodes []wfv1.NodeStatus
+	for _, t := range expandedTasks {
+		if node, err := woc.wf.Status.Nodes.Get(dagCtx.taskNodeID(t.Name)); err == nil {
+			nodes = append(nodes, *node)
+		}
+	}
+	status, failure, err := assessFanOut(task.FanOut, len(expandedTasks), nodes)
+	if err != nil {
+		return "", fmt.Errorf("tasks.%s.%w", task.Name, err)
+	}
+	return failure, woc.setFanOutStatus(ctx, dagCtx.taskNodeName(task.Name), task.Name, status)
+}
+
 func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
 	// build up the scope
 	scope := createScope(dagCtx.tmpl)
@@ -696,6 +831,9 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *
 			if err != nil {
 				return nil, errors.InternalWrapError(err)
 			}
+			if status, ok := ancestorNode.FanOut[ancestor]; ok {
+				addFanOutToScope(scope, prefix, status)
+			}
 		} else {
 			woc.buildLocalScope(scope, prefix, ancestorNode)
 		}
@@ -839,6 +977,13 @@ func expandTask(ctx context.Context, task wfv1.DAGTask, globalScope map[string]s
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
 	}
+	numItems := len(items)
+	if task.BatchSize != nil {
+		items, err = batchItems(items, *task.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+	}
 	expandedTasks := make([]wfv1.DAGTask, 0)
 	for i, item := range items {
 		var newTask wfv1.DAGTask
@@ -846,6 +991,9 @@ func expandTask(ctx context.Context, task wfv1.DAGTask, globalScope map[string]s
 		if err != nil {
 			return nil, err
 		}
+		if task.BatchSize != nil {
+			newTaskName = generateBatchNodeName(task.Name, i, *task.BatchSize, numItems)
+		}
 		newTask.Name = newTaskName
 		newTask.Template = task.Template
 		expandedTasks = append(expandedTasks, newTask)
@@ -867,6 +1015,7 @@ type TaskResults struct {
 // evaluateDependsLogic returns whether a node should execute and proceed. proceed means that all of its dependencies are
 // completed and execute means that given the results of its dependencies, this node should execute.
 func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string) (bool, bool, error) {
+	dependantName := taskName
 	node := d.getTaskNode(ctx, taskName)
 	if node != nil {
 		return true, true, nil
@@ -881,6 +1030,9 @@ func (d *dagContext) evaluateDependsLogic(ctx context.Context, taskName string)
 		if depNode == nil || !depNode.Fulfilled() || !common.CheckAllHooksFullfilled(depNode, d.wf.Status.Nodes) {
 			return false, false, nil
 		}
+		if !d.expansionFulfilled(ctx, taskName, dependantName) {
+			return false, false, nil
+		}
 
 		evalTaskName := strings.ReplaceAll(taskName, "-", "_")
 		if _, ok := evalScope[evalTaskName]; ok {
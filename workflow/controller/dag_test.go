@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -1105,6 +1107,35 @@ func TestDAGWithParamAndGlobalParam(t *testing.T) {
 	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
 }
 
+func TestExpandTaskBatchSize(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	task := wfv1.DAGTask{
+		Name:         "shards",
+		Template:     "work",
+		Arguments:    wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "items", Value: wfv1.AnyStringPtr("{{item}}")}}},
+		WithSequence: &wfv1.Sequence{Count: ptr.To(intstr.FromInt32(5))},
+		BatchSize:    ptr.To[int64](2),
+	}
+	tasks, err := expandTask(ctx, task, map[string]string{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	for i, expected := range []struct{ name, items string }{{"shards(0:0-1)", `["0","1"]`}, {"shards(1:2-3)", `["2","3"]`}, {"shards(2:4-4)", `["4"]`}} {
+		assert.Equal(t, expected.name, tasks[i].Name)
+		assert.JSONEq(t, expected.items, tasks[i].Arguments.Parameters[0].Value.String())
+	}
+
+	task.WithSequence = nil
+	task.WithParam = "[]"
+	tasks, err = expandTask(ctx, task, map[string]string{})
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	task.WithParam = "[1, 2]"
+	task.BatchSize = ptr.To[int64](0)
+	_, err = expandTask(ctx, task, map[string]string{})
+	require.EqualError(t, err, "batchSize must be greater than zero")
+}
+
 var terminatingDAGWithRetryStrategyNodes = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3731,3 +3762,146 @@ func TestDagWftmplHookWithRetry(t *testing.T) {
 	finishNode := woc.wf.Status.Nodes.FindByDisplayName("finish")
 	assert.Equal(t, wfv1.NodeOmitted, finishNode.Phase)
 }
+
+const dagExpansion = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-expansion
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: discover
+        template: discover
+        expand:
+          parameter: tasks
+      - name: report
+        template: work
+        depends: discover
+  - name: discover
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo discovering"]
+    outputs:
+      parameters:
+      - name: tasks
+        valueFrom:
+          path: /tmp/tasks.json
+  - name: work
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo working"]
+`
+
+// setDAGExpansion sets the emitted tasks of the discover task, as reconciled from its task result
+func setDAGExpansion(ctx context.Context, woc *wfOperationCtx, tasks string) {
+	node := woc.wf.Status.Nodes.FindByDisplayName("discover")
+	node.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "tasks", Value: wfv1.AnyStringPtr(tasks)}}}
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.wf.Status.MarkTaskResultComplete(ctx, node.ID)
+}
+
+func TestDAGExpansion(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagExpansion)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	require.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("discover"))
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	setDAGExpansion(ctx, woc, `[{"name": "partition-a", "template": "work"}, {"name": "partition-b", "template": "work", "depends": "partition-a"}]`)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes.FindByDisplayName("discover").Phase)
+	partitionA := woc.wf.Status.Nodes.FindByDisplayName("partition-a")
+	require.NotNil(t, partitionA)
+	assert.Equal(t, wfv1.NodePending, partitionA.Phase)
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("partition-b"))
+	// the tasks depending on the expanding task wait for the emitted tasks
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("report"))
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	require.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("partition-b"))
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("report"))
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	require.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("report"))
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	dagNode := woc.wf.Status.Nodes.FindByDisplayName("dag-expansion")
+	assert.Len(t, dagNode.OutboundNodes, 2)
+}
+
+func TestDAGExpansionInvalid(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagExpansion)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	setDAGExpansion(ctx, woc, `[{"name": "report", "template": "work"}]`)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	discover := woc.wf.Status.Nodes.FindByDisplayName("discover")
+	assert.Equal(t, wfv1.NodeError, discover.Phase)
+	assert.Equal(t, "failed to expand DAG: tasks.report is already defined", discover.Message)
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+}
+
+func TestDAGExpansionInline(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagExpansion)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	makePodsPhase(ctx, woc, v1.PodSucceeded)
+	setDAGExpansion(ctx, woc, `[{"name": "inject", "inline": {"container": {"image": "attacker", "securityContext": {"privileged": true}}}}]`)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	discover := woc.wf.Status.Nodes.FindByDisplayName("discover")
+	assert.Equal(t, wfv1.NodeError, discover.Phase)
+	assert.Equal(t, "failed to expand DAG: tasks.inject cannot use templateRef or inline, only the name of a template of the workflow", discover.Message)
+	assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("inject"))
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 1)
+}
+
+func TestGetDAGExpansion(t *testing.T) {
+	task := &wfv1.DAGTask{Name: "discover", Expand: &wfv1.DAGExpansion{Parameter: "tasks"}}
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "tasks", Value: wfv1.AnyStringPtr(`[{"name": "a", "template": "work"}, {"name": "b", "template": "work", "dependencies": ["a"]}]`)}}}
+	expansion, err := getDAGExpansion(task, outputs)
+	require.NoError(t, err)
+	assert.Equal(t, []wfv1.DAGTask{{Name: "a", Template: "work", Depends: "discover"}, {Name: "b", Template: "work", Dependencies: []string{"a"}}}, expansion)
+
+	_, err = getDAGExpansion(task, nil)
+	require.EqualError(t, err, `no output parameter named "tasks"`)
+
+	outputs.Parameters[0].Value = wfv1.AnyStringPtr("a")
+	_, err = getDAGExpansion(task, outputs)
+	require.ErrorContains(t, err, `output parameter "tasks" could not be parsed as a JSON list of DAG tasks`)
+}
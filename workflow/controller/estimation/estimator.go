@@ -12,6 +12,10 @@ import (
 type Estimator interface {
 	EstimateWorkflowDuration() wfv1.EstimatedDuration
 	EstimateNodeDuration(ctx context.Context, nodeName string) wfv1.EstimatedDuration
+	// EstimateWorkflowDurationP90 returns the 90th percentile, or zero if the estimate is not based on several runs
+	EstimateWorkflowDurationP90() wfv1.EstimatedDuration
+	// EstimateNodeDurationP90 returns the 90th percentile, or zero if the estimate is not based on several runs
+	EstimateNodeDurationP90(ctx context.Context, nodeName string) wfv1.EstimatedDuration
 }
 
 type estimator struct {
@@ -40,3 +44,11 @@ func (e *estimator) EstimateNodeDuration(ctx context.Context, nodeName string) w
 	}
 	return wfv1.NewEstimatedDuration(node.GetDuration())
 }
+
+func (e *estimator) EstimateWorkflowDurationP90() wfv1.EstimatedDuration {
+	return 0
+}
+
+func (e *estimator) EstimateNodeDurationP90(context.Context, string) wfv1.EstimatedDuration {
+	return 0
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -26,6 +27,8 @@ type estimatorFactory struct {
 	wfInformer cache.SharedIndexInformer
 	hydrator   hydrator.Interface
 	wfArchive  sqldb.WorkflowArchive
+	// runs is the number of archived runs to estimate from, if more than one then a percentileEstimator is used
+	runs int
 }
 
 var _ EstimatorFactory = &estimatorFactory{}
@@ -34,8 +37,8 @@ var (
 	skipWorkflowDurationEstimation = env.LookupEnvStringOr("SKIP_WORKFLOW_DURATION_ESTIMATION", "false")
 )
 
-func NewEstimatorFactory(ctx context.Context, wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive) EstimatorFactory {
-	return &estimatorFactory{wfInformer, hydrator, wfArchive}
+func NewEstimatorFactory(ctx context.Context, wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive, runs int) EstimatorFactory {
+	return &estimatorFactory{wfInformer, hydrator, wfArchive, runs}
 }
 
 func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow) (Estimator, error) {
@@ -50,6 +53,19 @@ func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow)
 	} {
 		labelValue, exists := wf.Labels[labelName]
 		if exists {
+			requirements, err := labels.ParseToRequirements(labelName + "=" + labelValue)
+			if err != nil {
+				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
+			}
+			if f.runs > 1 && f.wfArchive.IsEnabled() {
+				baselineWFs, err := f.wfArchive.ListWorkflowsForEstimator(ctx, wf.Namespace, requirements, f.runs)
+				if err != nil {
+					// we can still fall back to a single base-line
+					logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "failed to list archived workflows for estimator")
+				} else if len(baselineWFs) > 0 {
+					return newPercentileEstimator(wf, baselineWFs), nil
+				}
+			}
 			objs, err := f.wfInformer.GetIndexer().ByIndex(indexName, indexes.MetaNamespaceLabelIndex(wf.Namespace, labelValue))
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to list workflows by index: %v", err)
@@ -80,10 +96,6 @@ func (f *estimatorFactory) NewEstimator(ctx context.Context, wf *wfv1.Workflow)
 				return &estimator{wf, newestWf}, nil
 			}
 			// we failed to find a base-line in the live set, so we now look in the archive
-			requirements, err := labels.ParseToRequirements(labelName + "=" + labelValue)
-			if err != nil {
-				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
-			}
 			baselineWF, err := f.wfArchive.GetWorkflowForEstimator(ctx, wf.Namespace, requirements)
 			if err != nil {
 				return defaultEstimator, fmt.Errorf("failed to get archived workflow for estimator: %v", err)
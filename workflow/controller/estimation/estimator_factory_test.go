@@ -60,7 +60,7 @@ metadata:
 	wfArchive.On("GetWorkflowForEstimator", mock.Anything, "my-ns", r).Return(testutil.MustUnmarshalWorkflow(`
 metadata:
   name: my-archived-wftmpl-baseline`), nil)
-	f := NewEstimatorFactory(ctx, informer, hydratorfake.Always, wfArchive)
+	f := NewEstimatorFactory(ctx, informer, hydratorfake.Always, wfArchive, 1)
 	t.Run("None", func(t *testing.T) {
 		p, err := f.NewEstimator(ctx, &wfv1.Workflow{})
 		require.NoError(t, err)
@@ -113,3 +113,26 @@ metadata:
 		assert.Equal(t, "my-archived-wftmpl-baseline", e.baselineWF.Name)
 	})
 }
+
+func Test_estimatorFactory_Runs(t *testing.T) {
+	informer := testutil.NewSharedIndexInformer()
+	ctx := logging.TestContext(t.Context())
+	wfArchive := &sqldbmocks.WorkflowArchive{}
+	wfArchive.On("IsEnabled").Return(true)
+	r, err := labels.ParseToRequirements("workflows.argoproj.io/workflow-template=my-wftmpl")
+	require.NoError(t, err)
+	wfArchive.On("ListWorkflowsForEstimator", mock.Anything, "my-ns", r, 3).Return(wfv1.Workflows{
+		baselineWorkflow("my-baseline-0", 1),
+		baselineWorkflow("my-baseline-1", 2),
+		baselineWorkflow("my-baseline-2", 3),
+	}, nil)
+	f := NewEstimatorFactory(ctx, informer, hydratorfake.Always, wfArchive, 3)
+	p, err := f.NewEstimator(ctx, &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}},
+	})
+	require.NoError(t, err)
+	e, ok := p.(*percentileEstimator)
+	require.True(t, ok)
+	assert.Equal(t, wfv1.EstimatedDuration(2), e.EstimateWorkflowDuration())
+	assert.Equal(t, wfv1.EstimatedDuration(3), e.EstimateWorkflowDurationP90())
+}
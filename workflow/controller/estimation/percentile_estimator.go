@@ -0,0 +1,72 @@
+package estimation
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// percentileEstimator estimates from the durations of several baseline workflows, rather than a single one,
+// as a single previous run is a poor predictor for jobs with noisy durations
+type percentileEstimator struct {
+	wf *wfv1.Workflow
+	// sorted workflow durations
+	workflowDurations []time.Duration
+	// sorted node durations, keyed by the node name with the workflow name prefix removed
+	nodeDurations map[string][]time.Duration
+}
+
+func newPercentileEstimator(wf *wfv1.Workflow, baselineWFs wfv1.Workflows) *percentileEstimator {
+	e := &percentileEstimator{wf: wf, nodeDurations: make(map[string][]time.Duration)}
+	for _, baselineWF := range baselineWFs {
+		e.workflowDurations = append(e.workflowDurations, baselineWF.Status.GetDuration())
+		for _, node := range baselineWF.Status.Nodes {
+			if node.Phase != wfv1.NodeSucceeded || !strings.HasPrefix(node.Name, baselineWF.Name) {
+				continue
+			}
+			key := strings.TrimPrefix(node.Name, baselineWF.Name)
+			e.nodeDurations[key] = append(e.nodeDurations[key], node.GetDuration())
+		}
+	}
+	slices.Sort(e.workflowDurations)
+	for _, durations := range e.nodeDurations {
+		slices.Sort(durations)
+	}
+	return e
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations
+func percentile(sorted []time.Duration, p float64) wfv1.EstimatedDuration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := max(int(math.Ceil(p*float64(len(sorted))))-1, 0)
+	return wfv1.NewEstimatedDuration(sorted[rank])
+}
+
+func (e *percentileEstimator) nodeDurationsFor(nodeName string) []time.Duration {
+	if !strings.HasPrefix(nodeName, e.wf.Name) {
+		return nil
+	}
+	return e.nodeDurations[strings.TrimPrefix(nodeName, e.wf.Name)]
+}
+
+func (e *percentileEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
+	return percentile(e.workflowDurations, 0.5)
+}
+
+func (e *percentileEstimator) EstimateWorkflowDurationP90() wfv1.EstimatedDuration {
+	return percentile(e.workflowDurations, 0.9)
+}
+
+func (e *percentileEstimator) EstimateNodeDuration(_ context.Context, nodeName string) wfv1.EstimatedDuration {
+	return percentile(e.nodeDurationsFor(nodeName), 0.5)
+}
+
+func (e *percentileEstimator) EstimateNodeDurationP90(_ context.Context, nodeName string) wfv1.EstimatedDuration {
+	return percentile(e.nodeDurationsFor(nodeName), 0.9)
+}
@@ -0,0 +1,57 @@
+package estimation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func baselineWorkflow(name string, seconds int) wfv1.Workflow {
+	a := metav1.Time{}
+	b := metav1.Time{Time: time.Time{}.Add(time.Duration(seconds) * time.Second)}
+	return wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: wfv1.WorkflowStatus{
+			StartedAt:  a,
+			FinishedAt: b,
+			Nodes: map[string]wfv1.NodeStatus{
+				name:           {Name: name, Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + ".x":    {Name: name + ".x", Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + ".fail": {Name: name + ".fail", Phase: wfv1.NodeFailed, StartedAt: a, FinishedAt: b},
+			},
+		},
+	}
+}
+
+func Test_percentileEstimator(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	var baselineWFs wfv1.Workflows
+	for i, seconds := range []int{10, 1, 9, 2, 8, 3, 7, 4, 6, 5} {
+		baselineWFs = append(baselineWFs, baselineWorkflow(fmt.Sprintf("my-baseline-%d", i), seconds))
+	}
+	e := newPercentileEstimator(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}, baselineWFs)
+	assert.Equal(t, wfv1.EstimatedDuration(5), e.EstimateWorkflowDuration())
+	assert.Equal(t, wfv1.EstimatedDuration(9), e.EstimateWorkflowDurationP90())
+	assert.Equal(t, wfv1.EstimatedDuration(5), e.EstimateNodeDuration(ctx, "my-wf.x"))
+	assert.Equal(t, wfv1.EstimatedDuration(9), e.EstimateNodeDurationP90(ctx, "my-wf.x"))
+	t.Run("FailedNode", func(t *testing.T) {
+		assert.Equal(t, wfv1.EstimatedDuration(0), e.EstimateNodeDuration(ctx, "my-wf.fail"))
+	})
+	t.Run("MissingNode", func(t *testing.T) {
+		assert.Equal(t, wfv1.EstimatedDuration(0), e.EstimateNodeDuration(ctx, "my-wf.y"))
+		assert.Equal(t, wfv1.EstimatedDuration(0), e.EstimateNodeDurationP90(ctx, "other"))
+	})
+}
+
+func Test_percentile(t *testing.T) {
+	assert.Equal(t, wfv1.EstimatedDuration(0), percentile(nil, 0.5))
+	assert.Equal(t, wfv1.EstimatedDuration(3), percentile([]time.Duration{3 * time.Second}, 0.9))
+	assert.Equal(t, wfv1.EstimatedDuration(1), percentile([]time.Duration{1 * time.Second, 2 * time.Second}, 0.5))
+	assert.Equal(t, wfv1.EstimatedDuration(2), percentile([]time.Duration{1 * time.Second, 2 * time.Second}, 0.9))
+}
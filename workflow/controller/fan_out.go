@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// assessFanOut counts the nodes of the tasks, or steps, expanded by a fan-out, and returns the reason it failed, if
+// more of them failed than it tolerates, or too few of them can still succeed. Expanded tasks yet to start have no node.
+func assessFanOut(policy *wfv1.FanOutPolicy, total int, nodes []wfv1.NodeStatus) (wfv1.FanOutStatus, string, error) {
+	status := wfv1.FanOutStatus{Total: int64(total)}
+	var omitted int64
+	for _, node := range nodes {
+		switch {
+		case node.Phase == wfv1.NodeSucceeded:
+			status.Succeeded++
+		case node.FailedOrError():
+			status.Failed++
+		case node.Fulfilled():
+			omitted++
+		}
+	}
+	if policy.MaxFailed != nil {
+		maxFailed, err := intstr.GetScaledValueFromIntOrPercent(policy.MaxFailed, total, false)
+		if err != nil {
+			return status, "", fmt.Errorf("fanOut.maxFailed: %w", err)
+		}
+		if status.Failed > int64(maxFailed) {
+			return status, fmt.Sprintf("%d of %d failed, more than the maximum of %d", status.Failed, total, maxFailed), nil
+		}
+	}
+	if policy.MinSucceeded != nil {
+		minSucceeded, err := intstr.GetScaledValueFromIntOrPercent(policy.MinSucceeded, total, true)
+		if err != nil {
+			return status, "", fmt.Errorf("fanOut.minSucceeded: %w", err)
+		}
+		if possible := status.Total - status.Failed - omitted; possible < int64(minSucceeded) {
+			return status, fmt.Sprintf("at most %d of %d can succeed, fewer than the minimum of %d", possible, total, minSucceeded), nil
+		}
+	}
+	return status, "", nil
+}
+
+// addFanOutToScope adds the counts of a fan-out to the scope, as tasks.<name>.fanOut.* or steps.<name>.fanOut.*
+func addFanOutToScope(scope *wfScope, prefix string, status wfv1.FanOutStatus) {
+	scope.addParamToScope(prefix+".fanOut.total", fmt.Sprint(status.Total))
+	scope.addParamToScope(prefix+".fanOut.succeeded", fmt.Sprint(status.Succeeded))
+	scope.addParamToScope(prefix+".fanOut.failed", fmt.Sprint(status.Failed))
+}
+
+// setFanOutStatus records the counts of a fan-out on its task group, or step group, node
+func (woc *wfOperationCtx) setFanOutStatus(ctx context.Context, nodeName string, name string, status wfv1.FanOutStatus) error {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		return err
+	}
+	if current, ok := node.FanOut[name]; ok && current == status {
+		return nil
+	}
+	fanOut := make(map[string]wfv1.FanOutStatus, len(node.FanOut)+1)
+	maps.Copy(fanOut, node.FanOut)
+	fanOut[name] = status
+	node.FanOut = fanOut
+	woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+	woc.updated = true
+	return nil
+}
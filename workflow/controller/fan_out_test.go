@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const dagFanOut = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-fan-out
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    parallelism: %d
+    dag:
+      tasks:
+      - name: shards
+        template: work
+        withItems: [a, b, c, d]
+        fanOut:
+          %s
+      - name: report
+        template: report
+        depends: shards
+        arguments:
+          parameters:
+          - name: succeeded
+            value: "{{tasks.shards.fanOut.succeeded}}"
+  - name: work
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo working"]
+  - name: report
+    inputs:
+      parameters:
+      - name: succeeded
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo {{inputs.parameters.succeeded}}"]
+`
+
+const stepsFanOut = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-fan-out
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: shards
+        template: work
+        withItems: [a, b, c, d]
+        fanOut:
+          maxFailed: 25%
+    - - name: report
+        template: report
+        arguments:
+          parameters:
+          - name: failed
+            value: "{{steps.shards.fanOut.failed}}"
+  - name: work
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo working"]
+  - name: report
+    inputs:
+      parameters:
+      - name: failed
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo {{inputs.parameters.failed}}"]
+`
+
+// withFailedNodes fails the pods of the nodes with the given display names
+func withFailedNodes(displayNames ...string) with {
+	return func(pod *apiv1.Pod, woc *wfOperationCtx) {
+		node, err := woc.wf.Status.Nodes.Get(woc.nodeID(pod))
+		if err == nil && slices.Contains(displayNames, node.DisplayName) {
+			pod.Status.Phase = apiv1.PodFailed
+			pod.Status.Message = "Pod failed"
+		}
+	}
+}
+
+func TestAssessFanOut(t *testing.T) {
+	nodes := []wfv1.NodeStatus{{Phase: wfv1.NodeSucceeded}, {Phase: wfv1.NodeFailed}, {Phase: wfv1.NodeError}, {Phase: wfv1.NodeSkipped}, {Phase: wfv1.NodeRunning}}
+	percent := intstr.FromString
+
+	status, failure, err := assessFanOut(&wfv1.FanOutPolicy{MaxFailed: ptr.To(intstr.FromInt32(2))}, 10, nodes)
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.FanOutStatus{Total: 10, Succeeded: 1, Failed: 2}, status)
+	assert.Empty(t, failure)
+
+	_, failure, err = assessFanOut(&wfv1.FanOutPolicy{MaxFailed: ptr.To(percent("19%"))}, 10, nodes)
+	require.NoError(t, err)
+	assert.Equal(t, "2 of 10 failed, more than the maximum of 1", failure)
+
+	_, failure, err = assessFanOut(&wfv1.FanOutPolicy{MinSucceeded: ptr.To(percent("70%"))}, 10, nodes)
+	require.NoError(t, err)
+	assert.Empty(t, failure)
+
+	_, failure, err = assessFanOut(&wfv1.FanOutPolicy{MinSucceeded: ptr.To(percent("71%"))}, 10, nodes)
+	require.NoError(t, err)
+	assert.Equal(t, "at most 7 of 10 can succeed, fewer than the minimum of 8", failure)
+
+	_, _, err = assessFanOut(&wfv1.FanOutPolicy{MinSucceeded: ptr.To(percent("many"))}, 10, nodes)
+	require.ErrorContains(t, err, "fanOut.minSucceeded: ")
+}
+
+func TestDAGFanOut(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(fmt.Sprintf(dagFanOut, 4, `minSucceeded: "75%"`))
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 5 && woc.wf.Status.Phase == wfv1.WorkflowRunning; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withFailedNodes("shards(0:a)"))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+	}
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	shards := woc.wf.Status.Nodes.FindByDisplayName("shards")
+	assert.Equal(t, wfv1.NodeSucceeded, shards.Phase)
+	assert.Equal(t, map[string]wfv1.FanOutStatus{"shards": {Total: 4, Succeeded: 3, Failed: 1}}, shards.FanOut)
+	report := woc.wf.Status.Nodes.FindByDisplayName("report")
+	require.NotNil(t, report)
+	assert.Equal(t, "3", report.Inputs.GetParameterByName("succeeded").Value.String())
+}
+
+func TestDAGFanOutFailed(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(fmt.Sprintf(dagFanOut, 2, `maxFailed: 0`))
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 5 && woc.wf.Status.Phase == wfv1.WorkflowRunning; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withFailedNodes("shards(0:a)"))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+	}
+
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	shards := woc.wf.Status.Nodes.FindByDisplayName("shards")
+	assert.Equal(t, wfv1.NodeFailed, shards.Phase)
+	assert.Equal(t, "fan-out failed: 1 of 4 failed, more than the maximum of 0", shards.Message)
+	// the remaining tasks are not started once the fan-out fails
+	for _, name := range []string{"shards(2:c)", "shards(3:d)"} {
+		node := woc.wf.Status.Nodes.FindByDisplayName(name)
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeOmitted, node.Phase)
+		assert.Equal(t, "omitted: fan-out failed", node.Message)
+	}
+	assert.Equal(t, wfv1.NodeOmitted, woc.wf.Status.Nodes.FindByDisplayName("report").Phase)
+}
+
+func TestStepsFanOut(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(stepsFanOut)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 5 && woc.wf.Status.Phase == wfv1.WorkflowRunning; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withFailedNodes("shards(1:b)"))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+	}
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	stepGroup := woc.wf.Status.Nodes.FindByDisplayName("[0]")
+	assert.Equal(t, map[string]wfv1.FanOutStatus{"shards": {Total: 4, Succeeded: 3, Failed: 1}}, stepGroup.FanOut)
+	report := woc.wf.Status.Nodes.FindByDisplayName("report")
+	require.NotNil(t, report)
+	assert.Equal(t, "1", report.Inputs.GetParameterByName("failed").Value.String())
+}
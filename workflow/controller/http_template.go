@@ -2,8 +2,13 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 func (woc *wfOperationCtx) executeHTTPTemplate(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) *wfv1.NodeStatus {
@@ -12,7 +17,45 @@ func (woc *wfOperationCtx) executeHTTPTemplate(ctx context.Context, nodeName str
 		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
 	}
 	if !node.Fulfilled() {
+		if tmpl.HTTP.ResponseArtifact != "" {
+			if err := woc.addResponseArtifactLocation(ctx, nodeName, tmpl); err != nil {
+				return woc.markNodeError(ctx, nodeName, err)
+			}
+		}
 		woc.taskSet[node.ID] = *tmpl
 	}
 	return node
 }
+
+// addResponseArtifactLocation adds the archive location the agent saves the response artifact to. Its key is resolved
+// here, as the agent cannot, with the name the pod of the node would have.
+func (woc *wfOperationCtx) addResponseArtifactLocation(ctx context.Context, nodeName string, tmpl *wfv1.Template) error {
+	woc.addArchiveLocation(ctx, tmpl)
+	if tmpl.ArchiveLocation == nil {
+		return nil
+	}
+	params := woc.globalParams.DeepCopy()
+	for _, param := range tmpl.Inputs.Parameters {
+		if param.Value != nil {
+			params["inputs.parameters."+param.Name] = param.Value.String()
+		}
+	}
+	params[common.LocalVarPodName] = woc.getPodName(nodeName, tmpl.Name)
+	locationBytes, err := json.Marshal(tmpl.ArchiveLocation)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	replaced, err := template.Replace(ctx, string(locationBytes), params, true)
+	if err != nil {
+		return err
+	}
+	location := &wfv1.ArtifactLocation{}
+	if err := json.Unmarshal([]byte(replaced), location); err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if err := validate.VerifyResolvedVariables(location); err != nil {
+		return err
+	}
+	tmpl.ArchiveLocation = location
+	return nil
+}
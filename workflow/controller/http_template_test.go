@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -41,3 +42,37 @@ func TestNodeRequiresHttpReconciliation(t *testing.T) {
 	assert.True(t, woc.nodeRequiresTaskSetReconciliation(ctx, "child-http"))
 	assert.True(t, woc.nodeRequiresTaskSetReconciliation(ctx, "parent"))
 }
+
+func TestHTTPTemplateResponseArtifactLocation(t *testing.T) {
+	wf := v1alpha1.MustUnmarshalWorkflow(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http-template
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      http:
+        url: https://example.com/export
+        responseArtifact: export
+      outputs:
+        artifacts:
+          - name: export
+`)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf, defaultServiceAccount)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	ts, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskSets("default").Get(ctx, "http-template", v1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, ts.Spec.Tasks, 1)
+	for _, task := range ts.Spec.Tasks {
+		require.NotNil(t, task.ArchiveLocation)
+		key, err := task.ArchiveLocation.GetKey()
+		require.NoError(t, err)
+		assert.Equal(t, "http-template/http-template", key, "the key format is resolved, with the name of the node's pod")
+	}
+}
@@ -0,0 +1,48 @@
+package indexes
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// OffloadedNodesValue is the value workflows with offloaded or compressed nodes are indexed by in the
+// ArtifactDigestIndex, as their artifacts are not known without hydrating them
+const OffloadedNodesValue = "offloaded"
+
+// ArtifactDigestIndexFunc indexes workflows by the digests of their output artifacts that have not been deleted
+func ArtifactDigestIndexFunc(obj interface{}) ([]string, error) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	if version, _, _ := unstructured.NestedString(un.Object, "status", "offloadNodeStatusVersion"); version != "" {
+		return []string{OffloadedNodesValue}, nil
+	}
+	if compressed, _, _ := unstructured.NestedString(un.Object, "status", "compressedNodes"); compressed != "" {
+		return []string{OffloadedNodesValue}, nil
+	}
+	nodes, _, _ := unstructured.NestedFieldNoCopy(un.Object, "status", "nodes")
+	nodeMap, _ := nodes.(map[string]interface{})
+	var values []string
+	for _, node := range nodeMap {
+		n, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		artifacts, _, _ := unstructured.NestedFieldNoCopy(n, "outputs", "artifacts")
+		artifactList, _ := artifacts.([]interface{})
+		for _, artifact := range artifactList {
+			a, ok := artifact.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			digest, _ := a["digest"].(string)
+			deleted, _ := a["deleted"].(bool)
+			if digest != "" && !deleted && !slices.Contains(values, digest) {
+				values = append(values, digest)
+			}
+		}
+	}
+	return values, nil
+}
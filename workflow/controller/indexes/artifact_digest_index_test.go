@@ -0,0 +1,42 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func TestArtifactDigestIndexFunc(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		un, _ := util.ToUnstructured(&wfv1.Workflow{})
+		values, _ := ArtifactDigestIndexFunc(un)
+		assert.Nil(t, values)
+	})
+	t.Run("Some", func(t *testing.T) {
+		un, _ := util.ToUnstructured(&wfv1.Workflow{Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"a": {Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+					{Name: "x", Digest: "sha256:1"},
+					{Name: "y", Digest: "sha256:2", Deleted: true},
+					{Name: "z"},
+				}}},
+				"b": {Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "x", Digest: "sha256:1"}}}},
+			},
+		}})
+		values, _ := ArtifactDigestIndexFunc(un)
+		assert.Equal(t, []string{"sha256:1"}, values)
+	})
+	t.Run("Offloaded", func(t *testing.T) {
+		un, _ := util.ToUnstructured(&wfv1.Workflow{Status: wfv1.WorkflowStatus{OffloadNodeStatusVersion: "fnv:1"}})
+		values, _ := ArtifactDigestIndexFunc(un)
+		assert.Equal(t, []string{OffloadedNodesValue}, values)
+	})
+	t.Run("Compressed", func(t *testing.T) {
+		un, _ := util.ToUnstructured(&wfv1.Workflow{Status: wfv1.WorkflowStatus{CompressedNodes: "H4sIAAAAAAAA"}})
+		values, _ := ArtifactDigestIndexFunc(un)
+		assert.Equal(t, []string{OffloadedNodesValue}, values)
+	})
+}
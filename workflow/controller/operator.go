@@ -303,14 +303,17 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration(ctx)
+		woc.wf.Status.EstimatedDurationP90 = woc.estimateWorkflowDurationP90(ctx)
 	} else {
 		woc.workflowDeadline = woc.getWorkflowDeadline()
 		err, podReconciliationCompleted := woc.podReconciliation(ctx)
 		if err == nil {
+			stoppedChildWorkflows := woc.childWorkflowReconciliation(ctx)
 			// Execution control has been applied to the nodes with created pods after pod reconciliation.
-			// However, pending and suspended nodes do not have created pods, and taskset nodes use the agent pod.
-			// Apply execution control to these nodes now since pod reconciliation does not take effect on them.
-			woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx)
+			// However, pending and suspended nodes do not have created pods, taskset nodes use the agent pod, and
+			// child workflow nodes have child workflows. Apply execution control to these nodes now since pod
+			// reconciliation does not take effect on them.
+			woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx, stoppedChildWorkflows)
 		}
 
 		if err != nil {
@@ -390,6 +393,13 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		return
 	}
 
+	if node.Phase == wfv1.NodeSucceeded {
+		if err := woc.resolveWorkflowOutputs(ctx, node); err != nil {
+			woc.log.WithError(err).Error(ctx, "failed to resolve workflow outputs")
+			node = woc.markNodeError(ctx, node.Name, fmt.Errorf("failed to resolve workflow outputs: %w", err))
+		}
+	}
+
 	workflowStatus := map[wfv1.NodePhase]wfv1.WorkflowPhase{
 		wfv1.NodePending:   wfv1.WorkflowPending,
 		wfv1.NodeRunning:   wfv1.WorkflowRunning,
@@ -761,6 +771,8 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		woc.log.WithError(err).Warn(ctx, "error updating taskset")
 	}
 
+	// the nodes as they were before this update, so spans are only recorded for the nodes this update completed
+	previousNodes := woc.orig.Status.Nodes
 	wf, err := wfClient.Update(ctx, woc.wf, metav1.UpdateOptions{})
 	if err != nil {
 		woc.log.WithField("error", err).WithField("reason", apierr.ReasonForError(err)).Warn(ctx, "Error updating workflow")
@@ -772,13 +784,14 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 			return
 		}
 		woc.log.Info(ctx, "Re-applying updates on latest version and retrying update")
-		wf, err := woc.reapplyUpdate(ctx, wfClient, nodes)
+		wf, currNodes, err := woc.reapplyUpdate(ctx, wfClient, nodes)
 		if err != nil {
 			woc.wf.Labels[common.LabelKeyReApplyFailed] = "true"
 			woc.log.WithError(err).Info(ctx, "Failed to re-apply update")
 			return
 		}
 		woc.wf = wf
+		previousNodes = currNodes
 	} else {
 		woc.wf = wf
 		woc.controller.hydrator.HydrateWithNodes(woc.wf, nodes)
@@ -790,6 +803,7 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 
 	// Create WorkflowNode* events for nodes that have changed phase
 	woc.recordNodePhaseChangeEvents(ctx, woc.orig.Status.Nodes, woc.wf.Status.Nodes)
+	woc.recordSpans(ctx, previousNodes)
 
 	if !woc.controller.hydrator.IsHydrated(woc.wf) {
 		panic("workflow should be hydrated")
@@ -866,82 +880,83 @@ func (woc *wfOperationCtx) persistWorkflowSizeLimitErr(ctx context.Context, wfCl
 }
 
 // reapplyUpdate GETs the latest version of the workflow, re-applies the updates and
-// retries the UPDATE multiple times. For reasoning behind this technique, see:
+// retries the UPDATE multiple times. It also returns the nodes of the version the updates were re-applied to.
+// For reasoning behind this technique, see:
 // https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-func (woc *wfOperationCtx) reapplyUpdate(ctx context.Context, wfClient v1alpha1.WorkflowInterface, nodes wfv1.Nodes) (*wfv1.Workflow, error) {
+func (woc *wfOperationCtx) reapplyUpdate(ctx context.Context, wfClient v1alpha1.WorkflowInterface, nodes wfv1.Nodes) (*wfv1.Workflow, wfv1.Nodes, error) {
 	// if this condition is true, then this func will always error
 	if woc.orig.ResourceVersion != woc.wf.ResourceVersion {
 		woc.log.WithPanic().Error(ctx, "cannot re-apply update with mismatched resource versions")
 	}
 	err := woc.controller.hydrator.Hydrate(ctx, woc.orig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// First generate the patch
 	oldData, err := json.Marshal(woc.orig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	woc.controller.hydrator.HydrateWithNodes(woc.wf, nodes)
 	newData, err := json.Marshal(woc.wf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	patchBytes, err := jsonpatch.CreateMergePatch(oldData, newData)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// Next get latest version of the workflow, apply the patch and retry the update
 	attempt := 1
 	for {
 		currWf, err := wfClient.Get(ctx, woc.wf.Name, metav1.GetOptions{})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		// There is something about having informer indexers (introduced in v2.12) that means we are more likely to operate on the
 		// previous version of the workflow. This means under high load, a previously successful workflow could
 		// be operated on again. This can error (e.g. if any pod was deleted as part of clean-up). This check prevents that.
 		// https://github.com/argoproj/argo-workflows/issues/4798
 		if currWf.Status.Fulfilled() {
-			return nil, fmt.Errorf("must never update completed workflows")
+			return nil, nil, fmt.Errorf("must never update completed workflows")
 		}
 		err = woc.controller.hydrator.Hydrate(ctx, currWf)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		for id, node := range woc.wf.Status.Nodes {
 			currNode, err := currWf.Status.Nodes.Get(id)
 			if (err == nil) && currNode.Fulfilled() && node.Phase != currNode.Phase {
-				return nil, fmt.Errorf("must never update completed node %s", id)
+				return nil, nil, fmt.Errorf("must never update completed node %s", id)
 			}
 		}
 		currWfBytes, err := json.Marshal(currWf)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		newWfBytes, err := jsonpatch.MergePatch(currWfBytes, patchBytes)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		var newWf wfv1.Workflow
 		err = json.Unmarshal(newWfBytes, &newWf)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		err = woc.controller.hydrator.Dehydrate(ctx, &newWf)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		wf, err := wfClient.Update(ctx, &newWf, metav1.UpdateOptions{})
 		if err == nil {
 			woc.log.WithField("attempt", attempt).Info(ctx, "Update retry attempt successful")
 			woc.controller.hydrator.HydrateWithNodes(wf, nodes)
-			return wf, nil
+			return wf, currWf.Status.Nodes, nil
 		}
 		attempt++
 		woc.log.WithField("attempt", attempt).WithError(err).Warn(ctx, "Update retry attempt failed")
 		if attempt > 5 {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 }
@@ -1179,8 +1194,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) (error, bool)
 				woc.addOutputsToGlobalScope(ctx, newState.Outputs)
 				if newState.MemoizationStatus != nil {
 					if newState.Succeeded() {
-						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, newState.MemoizationStatus.CacheName)
-						err := c.Save(ctx, newState.MemoizationStatus.Key, newState.ID, newState.Outputs)
+						err := woc.saveMemoizedOutputs(ctx, newState)
 						if err != nil {
 							woc.log.WithFields(logging.Fields{"nodeID": newState.ID}).WithError(err).Error(ctx, "Failed to save node outputs to cache")
 							newState.Phase = wfv1.NodeError
@@ -1312,7 +1326,8 @@ func (woc *wfOperationCtx) shouldPrintPodSpec(node *wfv1.NodeStatus) bool {
 }
 
 // failNodesWithoutCreatedPodsAfterDeadlineOrShutdown mark the nodes without created pods failed when shutting down or exceeding deadline.
-func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx context.Context) {
+// Child workflow nodes are only failed once their child workflows have been stopped, or if they were never created.
+func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx context.Context, stoppedChildWorkflows map[string]bool) {
 	nodes := woc.wf.Status.Nodes
 	for _, node := range nodes {
 		if node.Fulfilled() {
@@ -1320,8 +1335,11 @@ func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ct
 		}
 		// Only fail nodes that are not part of exit handler if we are "Stopping" or all pods if we are "Terminating"
 		if woc.GetShutdownStrategy().Enabled() && !woc.GetShutdownStrategy().ShouldExecute(node.IsPartOfExitHandler(ctx, nodes)) {
-			// fail suspended nodes or taskset nodes when shutting down
-			if node.IsActiveSuspendNode() || node.IsTaskSetNode() {
+			// fail suspended nodes, taskset nodes or child workflow nodes when shutting down
+			if node.IsActiveSuspendNode() || node.IsTaskSetNode() || node.Type == wfv1.NodeTypeChildWorkflow {
+				if node.Type == wfv1.NodeTypeChildWorkflow && !isStoppedChildWorkflowNode(node, stoppedChildWorkflows) {
+					continue
+				}
 				message := fmt.Sprintf("Stopped with strategy '%s'", woc.GetShutdownStrategy())
 				woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, message)
 				continue
@@ -1330,7 +1348,7 @@ func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ct
 
 		// fail pending and suspended nodes that are not part of exit handler when exceeding deadline
 		deadlineExceeded := woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)
-		if deadlineExceeded && !node.IsPartOfExitHandler(ctx, nodes) && (node.Phase == wfv1.NodePending || node.IsActiveSuspendNode()) {
+		if deadlineExceeded && !node.IsPartOfExitHandler(ctx, nodes) && (node.Phase == wfv1.NodePending || node.IsActiveSuspendNode() || isStoppedChildWorkflowNode(node, stoppedChildWorkflows)) {
 			message := "Step exceeded its deadline"
 			woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, message)
 			continue
@@ -1338,6 +1356,12 @@ func (woc *wfOperationCtx) failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ct
 	}
 }
 
+// isStoppedChildWorkflowNode returns whether a node is a child workflow node whose child workflow was never created, or
+// has been stopped
+func isStoppedChildWorkflowNode(node wfv1.NodeStatus, stoppedChildWorkflows map[string]bool) bool {
+	return node.Type == wfv1.NodeTypeChildWorkflow && (node.ChildWorkflow == "" || stoppedChildWorkflows[node.ID])
+}
+
 // getAllWorkflowPods returns all pods related to the current workflow
 func (woc *wfOperationCtx) getAllWorkflowPods() ([]*apiv1.Pod, error) {
 	objs, err := woc.controller.PodController.GetPodsByIndex(indexes.WorkflowIndex, indexes.WorkflowIndexValue(woc.wf.Namespace, woc.wf.Name))
@@ -1477,6 +1501,7 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 
 	if !updated.Progress.IsValid() {
 		updated.Progress = wfv1.ProgressDefault
+		updated.ProgressEstimated = false
 	}
 
 	// We capture the exit-code after we look for the task-result.
@@ -2103,10 +2128,11 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	// Check memoization cache if the node is about to be created, or was created in the past but is only now allowed to run due to acquiring a lock
 	if processedTmpl.Memoize != nil {
 		if node == nil || unlockedNode {
-			memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
-			if memoizationCache == nil {
-				err := fmt.Errorf("cache could not be found or created")
-				woc.log.WithFields(logging.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			cacheType := processedTmpl.Memoize.Cache.GetType()
+			memoizationCache, err := woc.controller.cacheFactory.GetCache(controllercache.CacheTypeFor(cacheType), processedTmpl.Memoize.Cache.GetName())
+			if err != nil {
+				err := fmt.Errorf("cache could not be found or created: %w", err)
+				woc.log.WithFields(logging.Fields{"cacheName": processedTmpl.Memoize.Cache.GetName()}).WithError(err)
 				return woc.initializeNodeOrMarkError(ctx, node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
 			}
 
@@ -2136,7 +2162,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			memoizationStatus := &wfv1.MemoizationStatus{
 				Hit:       hit,
 				Key:       processedTmpl.Memoize.Key,
-				CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+				CacheName: processedTmpl.Memoize.Cache.GetName(),
+				CacheType: cacheType,
 			}
 			if hit {
 				if node == nil {
@@ -2170,6 +2197,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		if node.StartedAt.IsZero() {
 			node.StartedAt = metav1.Time{Time: time.Now().UTC()}
 			node.EstimatedDuration = woc.estimateNodeDuration(ctx, node.Name)
+			node.EstimatedDurationP90 = woc.estimateNodeDurationP90(ctx, node.Name)
 			woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
 			woc.updated = true
 		}
@@ -2306,6 +2334,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		node = woc.executeHTTPTemplate(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypePlugin:
 		node = woc.executePluginTemplate(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeChildWorkflow:
+		node, err = woc.executeChildWorkflow(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(ctx, nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeError, opts.nodeFlag, true, err.Error()), err
@@ -2496,6 +2526,7 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 		woc.updated = true
 		woc.wf.Status.StartedAt = metav1.Time{Time: time.Now().UTC()}
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration(ctx)
+		woc.wf.Status.EstimatedDurationP90 = woc.estimateWorkflowDurationP90(ctx)
 	}
 	if woc.wf.Status.Message != message {
 		woc.log.WithFields(logging.Fields{"fromMessage": woc.wf.Status.Message, "toMessage": message}).Info(ctx, "updated message")
@@ -2564,6 +2595,14 @@ func (woc *wfOperationCtx) estimateNodeDuration(ctx context.Context, nodeName st
 	return woc.getEstimator(ctx).EstimateNodeDuration(ctx, nodeName)
 }
 
+func (woc *wfOperationCtx) estimateWorkflowDurationP90(ctx context.Context) wfv1.EstimatedDuration {
+	return woc.getEstimator(ctx).EstimateWorkflowDurationP90()
+}
+
+func (woc *wfOperationCtx) estimateNodeDurationP90(ctx context.Context, nodeName string) wfv1.EstimatedDuration {
+	return woc.getEstimator(ctx).EstimateNodeDurationP90(ctx, nodeName)
+}
+
 func (woc *wfOperationCtx) hasDaemonNodes() bool {
 	for _, node := range woc.wf.Status.Nodes {
 		if node.IsDaemoned() {
@@ -2662,7 +2701,8 @@ func (woc *wfOperationCtx) initializeExecutableNode(ctx context.Context, nodeNam
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       false,
 			Key:       executeTmpl.Memoize.Key,
-			CacheName: executeTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: executeTmpl.Memoize.Cache.GetName(),
+			CacheType: executeTmpl.Memoize.Cache.GetType(),
 		}
 		node.MemoizationStatus = memoizationStatus
 	}
@@ -2682,6 +2722,15 @@ func (woc *wfOperationCtx) initializeExecutableNode(ctx context.Context, nodeNam
 	return node
 }
 
+// saveMemoizedOutputs saves the outputs of the memoized node to its cache
+func (woc *wfOperationCtx) saveMemoizedOutputs(ctx context.Context, node *wfv1.NodeStatus) error {
+	c, err := woc.controller.cacheFactory.GetCache(controllercache.CacheTypeFor(node.MemoizationStatus.GetCacheType()), node.MemoizationStatus.CacheName)
+	if err != nil {
+		return err
+	}
+	return c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+}
+
 // initializeNodeOrMarkError initializes an error node or mark a node if it already exists.
 func (woc *wfOperationCtx) initializeNodeOrMarkError(ctx context.Context, node *wfv1.NodeStatus, nodeName string, templateScope string, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, nodeFlag *wfv1.NodeFlag, err error) *wfv1.NodeStatus {
 	if node != nil {
@@ -2740,17 +2789,18 @@ func (woc *wfOperationCtx) initializeNode(ctx context.Context, nodeName string,
 	}
 
 	node := wfv1.NodeStatus{
-		ID:                nodeID,
-		Name:              nodeName,
-		TemplateName:      orgTmpl.GetTemplateName(),
-		TemplateRef:       orgTmpl.GetTemplateRef(),
-		TemplateScope:     templateScope,
-		Type:              nodeType,
-		BoundaryID:        boundaryID,
-		Phase:             phase,
-		NodeFlag:          nodeFlag,
-		StartedAt:         metav1.Time{Time: time.Now().UTC()},
-		EstimatedDuration: woc.estimateNodeDuration(ctx, nodeName),
+		ID:                   nodeID,
+		Name:                 nodeName,
+		TemplateName:         orgTmpl.GetTemplateName(),
+		TemplateRef:          orgTmpl.GetTemplateRef(),
+		TemplateScope:        templateScope,
+		Type:                 nodeType,
+		BoundaryID:           boundaryID,
+		Phase:                phase,
+		NodeFlag:             nodeFlag,
+		StartedAt:            metav1.Time{Time: time.Now().UTC()},
+		EstimatedDuration:    woc.estimateNodeDuration(ctx, nodeName),
+		EstimatedDurationP90: woc.estimateNodeDurationP90(ctx, nodeName),
 	}
 
 	if executable(nodeType) && !omitTaskResultSynced {
@@ -3080,7 +3130,7 @@ func (woc *wfOperationCtx) getOutboundNodes(ctx context.Context, nodeID string)
 		woc.log.WithPanic().WithField("nodeID", nodeID).Error(ctx, "was unable to obtain node")
 	}
 	switch node.Type {
-	case wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin:
+	case wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin, wfv1.NodeTypeChildWorkflow:
 		return []string{node.ID}
 	case wfv1.NodeTypePod:
 
@@ -3658,6 +3708,10 @@ func (woc *wfOperationCtx) executeSuspend(ctx context.Context, nodeName string,
 	if err != nil {
 		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypeSuspend, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, true)
 		woc.resolveInputFieldsForSuspendNode(ctx, node)
+		if tmpl.Suspend.Approval != nil {
+			node.Approval = &wfv1.NodeApproval{Gate: *tmpl.Suspend.Approval.DeepCopy()}
+			woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		}
 	}
 	woc.log.WithField("nodeName", nodeName).Info(ctx, "node suspended")
 
@@ -3677,6 +3731,11 @@ func (woc *wfOperationCtx) executeSuspend(ctx context.Context, nodeName string,
 		suspendDeadline := node.StartedAt.Add(suspendDuration)
 		requeueTime = &suspendDeadline
 		if time.Now().UTC().After(suspendDeadline) {
+			// an approval gate must not be passed without its approvals
+			if node.Approval != nil {
+				woc.log.WithField("nodeName", nodeName).Info(ctx, "approval timed out")
+				return woc.markNodePhase(ctx, nodeName, wfv1.NodeFailed, fmt.Sprintf("Not approved within %s", tmpl.Suspend.Duration)), nil
+			}
 			// Suspension is expired, node can be resumed
 			woc.log.WithField("nodeName", nodeName).Info(ctx, "auto resuming node")
 			if err := wfutil.OverrideOutputParametersWithDefault(node.Outputs); err != nil {
@@ -3822,6 +3881,31 @@ func generateNodeName(name string, index int, desc interface{}) string {
 	return newName
 }
 
+// batchItems groups the items of a loop into batches of at most batchSize items. Each batch is a list item, so
+// {{item}} is the JSON list of the items of the batch.
+func batchItems(items []wfv1.Item, batchSize int64) ([]wfv1.Item, error) {
+	if batchSize < 1 {
+		return nil, errors.Errorf(errors.CodeBadRequest, "batchSize must be greater than zero")
+	}
+	batches := make([]wfv1.Item, 0, (int64(len(items))+batchSize-1)/batchSize)
+	for batch := range slices.Chunk(items, int(batchSize)) {
+		value, err := json.Marshal(batch)
+		if err != nil {
+			return nil, errors.InternalWrapError(err)
+		}
+		batches = append(batches, wfv1.Item{Value: value})
+	}
+	return batches, nil
+}
+
+// generateBatchNodeName names the node of a batch after the indexes of its first and last items, e.g. NAME(1:100-199),
+// rather than after the items themselves
+func generateBatchNodeName(name string, index int, batchSize int64, numItems int) string {
+	first := int64(index) * batchSize
+	last := min(first+batchSize, int64(numItems)) - 1
+	return generateNodeName(name, index, fmt.Sprintf("%d-%d", first, last))
+}
+
 func expandSequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
 	var start, end int
 	var err error
@@ -79,8 +79,9 @@ func Test_wfOperationCtx_reapplyUpdate(t *testing.T) {
 		nodes := wfv1.Nodes{"foo": wfv1.NodeStatus{Name: "my-foo", Phase: wfv1.NodeSucceeded}}
 
 		// now force a re-apply update
-		updatedWf, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), nodes)
+		updatedWf, currNodes, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), nodes)
 		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodePhase(""), currNodes["foo"].Phase, "the nodes before the update are returned")
 		require.NotNil(t, updatedWf)
 		assert.True(t, woc.controller.hydrator.IsHydrated(updatedWf))
 		require.Contains(t, updatedWf.Status.Nodes, "foo")
@@ -98,7 +99,7 @@ func Test_wfOperationCtx_reapplyUpdate(t *testing.T) {
 		cancel, controller := newController(ctx, currWf)
 		defer cancel()
 		woc := newWorkflowOperationCtx(ctx, wf, controller)
-		_, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), wfv1.Nodes{})
+		_, _, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), wfv1.Nodes{})
 		require.EqualError(t, err, "must never update completed workflows")
 	})
 	t.Run("ErrUpdatingCompletedNode", func(t *testing.T) {
@@ -112,7 +113,7 @@ func Test_wfOperationCtx_reapplyUpdate(t *testing.T) {
 		cancel, controller := newController(ctx, currWf)
 		defer cancel()
 		woc := newWorkflowOperationCtx(ctx, wf, controller)
-		_, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), wf.Status.Nodes)
+		_, _, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), wf.Status.Nodes)
 		require.EqualError(t, err, "must never update completed node my-node")
 	})
 }
@@ -2744,6 +2745,61 @@ func TestExpandWithItemsMap(t *testing.T) {
 	assert.Equal(t, "debian 9.1 JSON({\"os\":\"debian\",\"version\":9.1})", newSteps[0].Arguments.Parameters[0].Value.String())
 }
 
+var expandWithItemsBatched = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: expand-with-items-batched
+spec:
+  entrypoint: expand-with-items
+  templates:
+  - name: expand-with-items
+    steps:
+    - - name: whalesay
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: "{{item}}"
+        withItems:
+        - {os: debian, version: 9.1}
+        - {os: debian, version: 9.1}
+        - {os: ubuntu, version: 16.10}
+        batchSize: 2
+
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      command: [sh, -c]
+      args: ["cowsay '{{inputs.parameters.message}}'"]
+`
+
+func TestExpandWithItemsBatched(t *testing.T) {
+	cancel, controller := newController(logging.TestContext(t.Context()))
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(expandWithItemsBatched)
+	wf, err := wfcset.Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	newSteps, err := woc.expandStep(ctx, wf.Spec.Templates[0].Steps[0].Steps[0], &wfScope{})
+	require.NoError(t, err)
+	require.Len(t, newSteps, 2)
+	assert.Equal(t, "whalesay(0:0-1)", newSteps[0].Name)
+	assert.JSONEq(t, `[{"os":"debian","version":9.1},{"os":"debian","version":9.1}]`, newSteps[0].Arguments.Parameters[0].Value.String())
+	assert.Equal(t, "whalesay(1:2-2)", newSteps[1].Name)
+	assert.JSONEq(t, `[{"os":"ubuntu","version":16.10}]`, newSteps[1].Arguments.Parameters[0].Value.String())
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+}
+
 var suspendTemplate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2996,6 +3052,66 @@ func TestSuspendResumeAfterTemplateNoWait(t *testing.T) {
 	assert.Empty(t, pods.Items)
 }
 
+var suspendApprovalTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: suspend-template
+spec:
+  entrypoint: suspend
+  templates:
+  - name: suspend
+    steps:
+    - - name: approve
+        template: approve
+    - - name: release
+        template: whalesay
+
+  - name: approve
+    suspend:
+      duration: 1h
+      approval:
+        groups: [release]
+
+  - name: whalesay
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ["hello world"]
+`
+
+func TestSuspendApprovalTemplate(t *testing.T) {
+	cancel, controller := newController(logging.TestContext(t.Context()))
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	// operate the workflow. the suspend node should record its approval gate
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(suspendApprovalTemplate)
+	wf, err := wfcset.Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+	require.NotNil(t, node.Approval)
+	assert.Equal(t, []string{"release"}, node.Approval.Gate.Groups)
+
+	// once the duration expires, the node fails rather than resuming
+	node.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByDisplayName("approve")
+	assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	assert.Equal(t, "Not approved within 1h", node.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
+
 var volumeWithParam = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -7423,7 +7539,7 @@ func TestWFWithRetryAndWithParam(t *testing.T) {
 		ctrs := pods.Items[0].Spec.Containers
 		assert.Len(t, ctrs, 2)
 		envs := ctrs[1].Env
-		assert.Len(t, envs, 7)
+		assert.Len(t, envs, 8)
 		assert.Equal(t, apiv1.EnvVar{Name: "ARGO_INCLUDE_SCRIPT_OUTPUT", Value: "true"}, envs[2])
 	})
 }
@@ -10288,7 +10404,7 @@ func TestFailNodesWithoutCreatedPodsAfterDeadlineOrShutdown(t *testing.T) {
 		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes[step1NodeName].Phase)
 		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes[step2NodeName].Phase)
 
-		woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx)
+		woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx, nil)
 
 		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes[step1NodeName].Phase)
 		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes[step2NodeName].Phase)
@@ -10323,7 +10439,7 @@ func TestFailNodesWithoutCreatedPodsAfterDeadlineOrShutdown(t *testing.T) {
 		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes[step1NodeName].Phase)
 		assert.Equal(t, wfv1.NodePending, woc.wf.Status.Nodes[step2NodeName].Phase)
 
-		woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx)
+		woc.failNodesWithoutCreatedPodsAfterDeadlineOrShutdown(ctx, nil)
 
 		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes[step1NodeName].Phase)
 		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Nodes[step2NodeName].Phase)
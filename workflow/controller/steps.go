@@ -17,7 +17,6 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -157,6 +156,9 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 				} else {
 					woc.log.WithField("childNode", childNode).Info(ctx, "Step has no expanded child nodes")
 				}
+				if status, ok := sgNode.FanOut[step.Name]; ok {
+					addFanOutToScope(stepsCtx.scope, prefix, status)
+				}
 			} else {
 				woc.buildLocalScope(stepsCtx.scope, prefix, childNode)
 			}
@@ -184,8 +186,7 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 	}
 
 	if node.MemoizationStatus != nil {
-		c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-		err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+		err := woc.saveMemoizedOutputs(ctx, node)
 		if err != nil {
 			woc.log.WithFields(logging.Fields{"nodeID": node.ID}).WithError(err).Error(ctx, "Failed to save node outputs to cache")
 			node.Phase = wfv1.NodeError
@@ -245,12 +246,25 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		return woc.markNodeError(ctx, sgNodeName, err), nil
 	}
 
+	var fanOutSteps []wfv1.WorkflowStep
+	for _, step := range stepGroup {
+		if step.FanOut != nil && step.ShouldExpand() {
+			fanOutSteps = append(fanOutSteps, step)
+		}
+	}
+
 	// Next, expand the step's withItems (if any)
 	stepGroup, err = woc.expandStepGroup(ctx, sgNodeName, stepGroup, stepsCtx)
 	if err != nil {
 		return woc.markNodeError(ctx, sgNodeName, err), nil
 	}
 
+	// A fan-out that can no longer succeed does not start its remaining steps
+	fanOutFailures, err := woc.assessStepGroupFanOuts(ctx, sgNodeName, fanOutSteps, stepGroup)
+	if err != nil {
+		return woc.markNodeError(ctx, sgNodeName, err), nil
+	}
+
 	// Maps nodes to their steps
 	nodeSteps := make(map[string]wfv1.WorkflowStep)
 
@@ -260,6 +274,11 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	// Kick off all parallel steps in the group
 	for _, step := range stepGroup {
 		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
+		if _, err := woc.wf.GetNodeByName(childNodeName); err != nil && isFanOutFailed(fanOutFailures, step.Name) {
+			woc.initializeNode(ctx, childNodeName, wfv1.NodeTypeSkipped, stepTemplateScope, &step, stepsCtx.boundaryID, wfv1.NodeOmitted, &wfv1.NodeFlag{}, true, "omitted: fan-out failed")
+			woc.addChildNode(ctx, sgNodeName, childNodeName)
+			continue
+		}
 
 		// Check the step's when clause to decide if it should execute
 		proceed, err := shouldExecute(step.When)
@@ -302,6 +321,11 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		}
 	}
 
+	fanOutFailures, err = woc.assessStepGroupFanOuts(ctx, sgNodeName, fanOutSteps, stepGroup)
+	if err != nil {
+		return woc.markNodeError(ctx, sgNodeName, err), nil
+	}
+
 	node, err = woc.wf.GetNodeByName(sgNodeName)
 	if err != nil {
 		return nil, err
@@ -350,13 +374,21 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	woc.addOutputsToGlobalScope(ctx, node.Outputs)
 
 	// All children completed. Determine step group status as a whole
+	for _, step := range fanOutSteps {
+		if failure, ok := fanOutFailures[step.Name]; ok {
+			failMessage := fmt.Sprintf("fan-out of step '%s' failed: %s", step.Name, failure)
+			woc.log.WithFields(logging.Fields{"nodeID": node.ID, "failMessage": failMessage}).Info(ctx, "Step group node deemed failed")
+			return woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, failMessage), nil
+		}
+	}
 	for _, childNodeID := range node.Children {
 		childNode, err := woc.wf.Status.Nodes.Get(childNodeID)
 		if err != nil {
 			woc.log.WithField("nodeID", childNodeID).WithPanic().Error(ctx, "Couldn't obtain child for nodeID, panicking")
 		}
 		step := nodeSteps[childNode.Name]
-		if childNode.FailedOrError() && !step.ContinuesOn(childNode.Phase) {
+		// the failures of the expanded steps of a fan-out are tolerated by its policy
+		if childNode.FailedOrError() && !step.ContinuesOn(childNode.Phase) && step.FanOut == nil {
 			failMessage := fmt.Sprintf("child '%s' failed", childNodeID)
 			woc.log.WithFields(logging.Fields{"nodeID": node.ID, "failMessage": failMessage}).Info(ctx, "Step group node deemed failed")
 			return woc.markNodePhase(ctx, node.Name, wfv1.NodeFailed, failMessage), nil
@@ -366,6 +398,46 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), nil
 }
 
+// assessStepGroupFanOuts assesses the fan-outs of the steps of a step group from the nodes of their expanded steps,
+// records their counts on the step group node, and returns the reasons of those that failed keyed by step name
+func (woc *wfOperationCtx) assessStepGroupFanOuts(ctx context.Context, sgNodeName string, fanOutSteps []wfv1.WorkflowStep, stepGroup []wfv1.WorkflowStep) (map[string]string, error) {
+	failures := make(map[string]string)
+	for _, step := range fanOutSteps {
+		total := 0
+		var nodes []wfv1.NodeStatus
+		for _, expandedStep := range stepGroup {
+			if !strings.HasPrefix(expandedStep.Name, step.Name+"(") {
+				continue
+			}
+			total++
+			if node, err := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", sgNodeName, expandedStep.Name)); err == nil {
+				nodes = append(nodes, *node)
+			}
+		}
+		status, failure, err := assessFanOut(step.FanOut, total, nodes)
+		if err != nil {
+			return nil, fmt.Errorf("steps.%s.%w", step.Name, err)
+		}
+		if err := woc.setFanOutStatus(ctx, sgNodeName, step.Name, status); err != nil {
+			return nil, err
+		}
+		if failure != "" {
+			failures[step.Name] = failure
+		}
+	}
+	return failures, nil
+}
+
+// isFanOutFailed returns whether an expanded step, named after its step, belongs to a fan-out that failed
+func isFanOutFailed(failures map[string]string, expandedStepName string) bool {
+	for name := range failures {
+		if strings.HasPrefix(expandedStepName, name+"(") {
+			return true
+		}
+	}
+	return false
+}
+
 // shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
 func shouldExecute(when string) (bool, error) {
 	if when == "" {
@@ -589,12 +661,22 @@ func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowSte
 		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
 	}
 
+	numItems := len(items)
+	if step.BatchSize != nil {
+		items, err = batchItems(items, *step.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+	}
 	for i, item := range items {
 		var newStep wfv1.WorkflowStep
 		newStepName, err := processItem(ctx, t, step.Name, i, item, &newStep, step.When, woc.globalParams.Merge(scope.getParameters()))
 		if err != nil {
 			return nil, err
 		}
+		if step.BatchSize != nil {
+			newStepName = generateBatchNodeName(step.Name, i, *step.BatchSize, numItems)
+		}
 		newStep.Name = newStepName
 		newStep.Template = step.Template
 		expandedStep = append(expandedStep, newStep)
@@ -125,6 +125,7 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 		}
 		if result.Progress.IsValid() {
 			newNode.Progress = result.Progress
+			newNode.ProgressEstimated = false
 		}
 		if !reflect.DeepEqual(old, newNode) {
 			woc.log.
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// Span attributes recorded on workflow and node spans
+const (
+	spanAttribWorkflowName      = attribute.Key("argo.workflow.name")
+	spanAttribWorkflowNamespace = attribute.Key("argo.workflow.namespace")
+	spanAttribWorkflowPhase     = attribute.Key("argo.workflow.phase")
+	spanAttribWorkflowTemplate  = attribute.Key("argo.workflow.template")
+	spanAttribNodeID            = attribute.Key("argo.node.id")
+	spanAttribNodeName          = attribute.Key("argo.node.name")
+	spanAttribNodeType          = attribute.Key("argo.node.type")
+	spanAttribNodeTemplate      = attribute.Key("argo.node.template")
+	spanAttribNodePhase         = attribute.Key("argo.node.phase")
+	spanAttribNodePodName       = attribute.Key("argo.node.pod_name")
+)
+
+// recordSpans emits a span for every node that completed during this operation, and for the workflow itself once it has completed.
+//
+// Spans are only recorded once their end time is known, using the start and finish times from the status, so they
+// survive controller restarts. Their IDs are derived from the workflow UID and node ID, so the executor can parent
+// its own spans to a node span (see common.EnvVarTraceparent) before the controller has emitted it.
+func (woc *wfOperationCtx) recordSpans(ctx context.Context, old wfv1.Nodes) {
+	uid := string(woc.wf.UID)
+	tracer := woc.controller.tracing.Tracer()
+
+	var parents map[string]string
+	for id, node := range woc.wf.Status.Nodes {
+		if !node.Fulfilled() {
+			continue
+		}
+		if oldNode, ok := old[id]; ok && oldNode.Fulfilled() {
+			continue
+		}
+		if parents == nil {
+			parents = woc.nodeParents()
+		}
+		parent := telemetry.WorkflowSpanContext(uid)
+		if parentID, ok := parents[id]; ok {
+			parent = telemetry.NodeSpanContext(uid, parentID)
+		}
+		// pods that never ran may not have timestamps
+		finishedAt := node.FinishedAt.Time
+		if finishedAt.IsZero() {
+			finishedAt = time.Now().UTC()
+		}
+		startedAt := node.StartedAt.Time
+		if startedAt.IsZero() {
+			startedAt = finishedAt
+		}
+		sc := telemetry.NodeSpanContext(uid, id)
+		spanCtx := telemetry.ContextWithSpanIDs(trace.ContextWithRemoteSpanContext(ctx, parent), sc.TraceID(), sc.SpanID())
+		_, span := tracer.Start(spanCtx, node.DisplayName,
+			trace.WithTimestamp(startedAt),
+			trace.WithAttributes(
+				spanAttribWorkflowName.String(woc.wf.Name),
+				spanAttribWorkflowNamespace.String(woc.wf.Namespace),
+				spanAttribNodeID.String(node.ID),
+				spanAttribNodeName.String(node.Name),
+				spanAttribNodeType.String(string(node.Type)),
+				spanAttribNodeTemplate.String(node.TemplateName),
+				spanAttribNodePhase.String(string(node.Phase)),
+			))
+		if node.Type == wfv1.NodeTypePod {
+			span.SetAttributes(spanAttribNodePodName.String(woc.getPodName(node.Name, wfutil.GetTemplateFromNode(node))))
+		}
+		if node.FailedOrError() {
+			span.SetStatus(codes.Error, node.Message)
+		}
+		span.End(trace.WithTimestamp(finishedAt))
+	}
+
+	if woc.orig.Status.Fulfilled() || !woc.wf.Status.Fulfilled() || woc.wf.Status.StartedAt.IsZero() {
+		return
+	}
+	sc := telemetry.WorkflowSpanContext(uid)
+	_, span := tracer.Start(telemetry.ContextWithSpanIDs(ctx, sc.TraceID(), sc.SpanID()), woc.wf.Name,
+		trace.WithNewRoot(),
+		trace.WithTimestamp(woc.wf.Status.StartedAt.Time),
+		trace.WithAttributes(
+			spanAttribWorkflowName.String(woc.wf.Name),
+			spanAttribWorkflowNamespace.String(woc.wf.Namespace),
+			spanAttribWorkflowPhase.String(string(woc.wf.Status.Phase)),
+		))
+	if ref := woc.wf.Spec.WorkflowTemplateRef; ref != nil {
+		span.SetAttributes(spanAttribWorkflowTemplate.String(ref.Name))
+	}
+	if woc.wf.Status.Phase != wfv1.WorkflowSucceeded {
+		span.SetStatus(codes.Error, woc.wf.Status.Message)
+	}
+	span.End(trace.WithTimestamp(woc.wf.Status.FinishedAt.Time))
+}
+
+// nodeParents maps each node ID to the ID of the node its span should be parented to: the retry node for retry
+// attempts, otherwise the boundary (steps or DAG) node the node was created in.
+func (woc *wfOperationCtx) nodeParents() map[string]string {
+	parents := make(map[string]string)
+	for id, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeRetry {
+			for _, child := range node.Children {
+				parents[child] = id
+			}
+		}
+	}
+	for id, node := range woc.wf.Status.Nodes {
+		if _, ok := parents[id]; ok || node.BoundaryID == "" {
+			continue
+		}
+		if _, ok := woc.wf.Status.Nodes[node.BoundaryID]; ok {
+			parents[id] = node.BoundaryID
+		}
+	}
+	return parents
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"testing"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestRecordSpans(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: 9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     dag:
+       tasks:
+       - name: pod
+         template: pod
+   - name: pod
+     container:
+       image: my-image
+`)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Empty(t, testSpanExporter.GetSpans(), "nothing has completed yet")
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	podNode := woc.wf.Status.Nodes.FindByDisplayName("pod")
+	require.NotNil(t, podNode)
+	assert.Contains(t, pods.Items[0].Spec.Containers[1].Env, apiv1.EnvVar{
+		Name:  common.EnvVarTraceparent,
+		Value: telemetry.Traceparent(telemetry.NodeSpanContext(string(wf.UID), podNode.ID)),
+	})
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+	spans := map[trace.SpanID]tracetest.SpanStub{}
+	for _, span := range testSpanExporter.GetSpans() {
+		spans[span.SpanContext.SpanID()] = span
+	}
+	require.Len(t, spans, 3)
+	wfSpanID := telemetry.WorkflowSpanContext(string(wf.UID)).SpanID()
+	rootSpanID := telemetry.NodeSpanContext(string(wf.UID), "my-wf").SpanID()
+	podSpanID := telemetry.NodeSpanContext(string(wf.UID), podNode.ID).SpanID()
+
+	require.Contains(t, spans, wfSpanID)
+	assert.Equal(t, "my-wf", spans[wfSpanID].Name)
+	assert.False(t, spans[wfSpanID].Parent.IsValid())
+	assert.Equal(t, codes.Unset, spans[wfSpanID].Status.Code)
+	assert.Equal(t, woc.wf.Status.StartedAt.Time, spans[wfSpanID].StartTime)
+	assert.Equal(t, woc.wf.Status.FinishedAt.Time, spans[wfSpanID].EndTime)
+
+	require.Contains(t, spans, rootSpanID)
+	assert.Equal(t, wfSpanID, spans[rootSpanID].Parent.SpanID())
+
+	require.Contains(t, spans, podSpanID)
+	assert.Equal(t, "pod", spans[podSpanID].Name)
+	assert.Equal(t, rootSpanID, spans[podSpanID].Parent.SpanID())
+	assert.Contains(t, spans[podSpanID].Attributes, spanAttribNodePhase.String(string(wfv1.NodeSucceeded)))
+
+	// operating on a completed workflow must not emit the spans again
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	assert.Len(t, testSpanExporter.GetSpans(), 3)
+}
+
+func TestRecordSpansConflict(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: 9b8c4d0a-54b4-4f1d-8c9b-1a2f3e4d5c6b
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     steps:
+     - - name: a
+         template: pod
+     - - name: b
+         template: pod
+   - name: pod
+     container:
+       image: my-image
+`)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	stale := woc.wf.DeepCopy()
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	spans := len(testSpanExporter.GetSpans())
+	require.Positive(t, spans, "a has completed")
+
+	// operating on the stale version conflicts, and the update is re-applied on top of the version in which a had
+	// already completed, so its spans must not be emitted again
+	conflicts := true
+	controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicts {
+			conflicts = false
+			return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "workflows"}, "my-wf", nil)
+		}
+		return false, nil, nil
+	})
+	woc = newWorkflowOperationCtx(ctx, stale, controller)
+	woc.operate(ctx)
+	assert.False(t, conflicts)
+	assert.Len(t, testSpanExporter.GetSpans(), spans)
+}
+
+func TestTraceparentOnlyWhenTracing(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	var err error
+	controller.tracing, err = telemetry.NewTracing(ctx, "workflows-controller")
+	require.NoError(t, err)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	for _, c := range pods.Items[0].Spec.Containers {
+		for _, env := range c.Env {
+			assert.NotEqual(t, common.EnvVarTraceparent, env.Name)
+		}
+	}
+}
+
+func TestNodeParents(t *testing.T) {
+	woc := &wfOperationCtx{wf: &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", Type: wfv1.NodeTypeDAG, Children: []string{"retry"}},
+			"retry":   {ID: "retry", Type: wfv1.NodeTypeRetry, BoundaryID: "my-wf", Children: []string{"retry-0"}},
+			"retry-0": {ID: "retry-0", Type: wfv1.NodeTypePod, BoundaryID: "my-wf", Children: []string{"after"}},
+			"after":   {ID: "after", Type: wfv1.NodeTypePod, BoundaryID: "my-wf"},
+		}},
+	}}
+	assert.Equal(t, map[string]string{
+		"retry":   "my-wf",
+		"retry-0": "retry",
+		"after":   "my-wf",
+	}, woc.nodeParents())
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// resolveWorkflowOutputs resolves the outputs declared by the workflow's spec from the outputs of the entrypoint's
+// steps or tasks, and records them in the workflow's status and the global scope
+func (woc *wfOperationCtx) resolveWorkflowOutputs(ctx context.Context, entryNode *wfv1.NodeStatus) error {
+	declared := woc.execWf.Spec.Outputs
+	if !declared.HasOutputs() {
+		return nil
+	}
+	scope, err := woc.buildWorkflowOutputsScope(entryNode)
+	if err != nil {
+		return err
+	}
+	outputs, err := woc.getTemplateOutputsFromScope(ctx, &wfv1.Template{Outputs: *declared}, scope)
+	if err != nil {
+		return err
+	}
+	for _, param := range outputs.Parameters {
+		if param.IsTyped() {
+			if _, err := common.GetTypedParameterValue(param, param.GetValue()); err != nil {
+				return fmt.Errorf("outputs.parameters.%s: %w", param.Name, err)
+			}
+		}
+		param.GlobalName = param.Name
+		woc.addParamToGlobalScope(ctx, param)
+	}
+	for _, art := range outputs.Artifacts {
+		art.GlobalName = art.Name
+		woc.addArtifactToGlobalScope(ctx, art)
+	}
+	return nil
+}
+
+// buildWorkflowOutputsScope returns the scope of the workflow's outputs: the outputs of the entrypoint's steps, as
+// steps.NAME, or tasks, as tasks.NAME, and the workflow's global outputs, as workflow.outputs
+func (woc *wfOperationCtx) buildWorkflowOutputsScope(entryNode *wfv1.NodeStatus) (*wfScope, error) {
+	scope := createScope(nil)
+	woc.addOutputsToLocalScope("workflow", woc.wf.Status.Outputs, scope)
+
+	var prefix string
+	switch entryNode.Type {
+	case wfv1.NodeTypeSteps:
+		prefix = "steps"
+	case wfv1.NodeTypeDAG:
+		prefix = "tasks"
+	default:
+		return scope, nil
+	}
+	direct := make(map[string]wfv1.NodeStatus)
+	expanded := make(map[string][]wfv1.NodeStatus)
+	for _, node := range woc.wf.Status.Nodes {
+		if node.BoundaryID != entryNode.ID || node.Type == wfv1.NodeTypeStepGroup {
+			continue
+		}
+		// the nodes of steps or tasks expanded by withItems or withParam, or retried, are named NAME(...)
+		if name, _, ok := strings.Cut(node.DisplayName, "("); ok {
+			if node.Type != wfv1.NodeTypeSkipped {
+				expanded[name] = append(expanded[name], node)
+			}
+			continue
+		}
+		direct[node.DisplayName] = node
+	}
+	for name, node := range direct {
+		if node.Type == wfv1.NodeTypeTaskGroup {
+			if err := woc.processAggregateNodeOutputs(scope, prefix+"."+name, expanded[name]); err != nil {
+				return nil, err
+			}
+		} else {
+			woc.buildLocalScope(scope, prefix+"."+name, &node)
+		}
+	}
+	for name, nodes := range expanded {
+		if _, ok := direct[name]; !ok {
+			if err := woc.processAggregateNodeOutputs(scope, prefix+"."+name, nodes); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return scope, nil
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const workflowOutputs = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: workflow-outputs
+spec:
+  entrypoint: main
+  outputs:
+    parameters:
+    - name: version
+      type: int
+      valueFrom:
+        parameter: "{{tasks.build.outputs.parameters.version}}"
+    - name: versions
+      valueFrom:
+        expression: "tasks.test.outputs.parameters.version"
+    - name: status
+      valueFrom:
+        expression: "tasks.build.status"
+    artifacts:
+    - name: binary
+      from: "{{tasks.build.outputs.artifacts.binary}}"
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: build
+        template: produce
+      - name: test
+        template: produce
+        depends: build
+        withItems: [a, b]
+  - name: produce
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo 3 > /tmp/version"]
+    outputs:
+      parameters:
+      - name: version
+        valueFrom:
+          path: /tmp/version
+      artifacts:
+      - name: binary
+        path: /tmp/binary
+`
+
+// setPodNodesOutputs sets the outputs of the workflow's pod nodes, as reconciled from their task results
+func setPodNodesOutputs(woc *wfOperationCtx, outputs wfv1.Outputs) {
+	for id, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod && node.Outputs == nil {
+			node.Outputs = outputs.DeepCopy()
+			woc.wf.Status.Nodes[id] = node
+		}
+	}
+}
+
+func TestResolveWorkflowOutputs(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(workflowOutputs)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	outputs := wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "version", Value: wfv1.AnyStringPtr("3")}},
+		Artifacts:  []wfv1.Artifact{{Name: "binary", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "binary.tgz"}}}},
+	}
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 5 && woc.wf.Status.Phase == wfv1.WorkflowRunning; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		setPodNodesOutputs(woc, outputs)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+	}
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	require.NotNil(t, woc.wf.Status.Outputs)
+	params := make(map[string]string)
+	for _, param := range woc.wf.Status.Outputs.Parameters {
+		params[param.Name] = param.Value.String()
+	}
+	assert.Equal(t, map[string]string{"version": "3", "versions": `["3","3"]`, "status": "Succeeded"}, params)
+	require.Len(t, woc.wf.Status.Outputs.Artifacts, 1)
+	assert.Equal(t, "binary", woc.wf.Status.Outputs.Artifacts[0].Name)
+	assert.Equal(t, "binary.tgz", woc.wf.Status.Outputs.Artifacts[0].S3.Key)
+}
+
+func TestResolveWorkflowOutputsOfWrongType(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(workflowOutputs)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	outputs := wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "version", Value: wfv1.AnyStringPtr("v3")}},
+		Artifacts:  []wfv1.Artifact{{Name: "binary", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "binary.tgz"}}}},
+	}
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 5 && woc.wf.Status.Phase == wfv1.WorkflowRunning; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		setPodNodesOutputs(woc, outputs)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+	}
+
+	assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	assert.Equal(t, `failed to resolve workflow outputs: outputs.parameters.version: value "v3" is not an int`, woc.wf.Status.Message)
+}
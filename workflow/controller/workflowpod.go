@@ -26,6 +26,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
@@ -294,6 +295,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 				log.WithPanic().Error(ctx, "was unable to obtain node")
 			}
 			node.Progress = p
+			node.ProgressEstimated = false
 			woc.wf.Status.Nodes.Set(ctx, nodeID, *node)
 		}
 	}
@@ -340,6 +342,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		{Name: common.EnvVarDeadline, Value: woc.getDeadline(opts).Format(time.RFC3339)},
 	}
 
+	// only set the traceparent if the controller records spans for the pod to be parented to
+	if woc.controller.tracing.Enabled() {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarTraceparent, Value: telemetry.Traceparent(telemetry.NodeSpanContext(string(woc.wf.UID), nodeID))})
+	}
+
 	// only set tick durations/EnvVarProgressFile if progress is enabled.
 	// The progress is only monitored if the tick durations are >0.
 	if woc.controller.progressPatchTickDuration != 0 && woc.controller.progressFileTickDuration != 0 {
@@ -0,0 +1,202 @@
+package cron
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// reconcileBackfills records the outcome of the workflows run by the CronWorkflow's backfills, and runs the workflows
+// for the backfills' outstanding scheduled times, up to each backfill's parallelism
+func (woc *cronWfOperationCtx) reconcileBackfills(ctx context.Context, workflows []v1alpha1.Workflow) error {
+	if len(woc.cronWf.Spec.Backfills) == 0 && len(woc.cronWf.Status.Backfills) == 0 {
+		return nil
+	}
+
+	backfillWfs := make(map[string]v1alpha1.Workflow)
+	for _, wf := range workflows {
+		if isBackfillWorkflow(wf) {
+			backfillWfs[wf.Name] = wf
+		}
+	}
+
+	var statuses []v1alpha1.CronWorkflowBackfillStatus
+	var errs []error
+	for _, backfill := range woc.cronWf.Spec.Backfills {
+		status := v1alpha1.CronWorkflowBackfillStatus{Name: backfill.Name}
+		if existing := woc.cronWf.Status.GetBackfill(backfill.Name); existing != nil {
+			status = *existing.DeepCopy()
+		}
+		// the progress made is recorded even if the backfill could not be fully reconciled
+		if err := woc.reconcileBackfill(ctx, backfill, &status, backfillWfs); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile backfill %q: %w", backfill.Name, err))
+		}
+		statuses = append(statuses, status)
+	}
+
+	if !equality.Semantic.DeepEqual(statuses, woc.cronWf.Status.Backfills) {
+		woc.cronWf.Status.Backfills = statuses
+		woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"backfills": statuses}})
+	}
+	return goerrors.Join(errs...)
+}
+
+func (woc *cronWfOperationCtx) reconcileBackfill(ctx context.Context, backfill v1alpha1.CronWorkflowBackfill, status *v1alpha1.CronWorkflowBackfillStatus, backfillWfs map[string]v1alpha1.Workflow) error {
+	log := woc.log.WithField("backfill", backfill.Name)
+
+	scheduledTimes, err := common.GetBackfillScheduledTimes(&woc.cronWf.Spec, backfill)
+	if err != nil {
+		return err
+	}
+
+	// the intervals follow the spec, so changing the start or end of a backfill keeps the outcome of the
+	// scheduled times that are still within it
+	existing := make(map[int64]v1alpha1.CronWorkflowBackfillInterval, len(status.Intervals))
+	for _, interval := range status.Intervals {
+		existing[interval.ScheduledTime.Unix()] = interval
+	}
+	intervals := make([]v1alpha1.CronWorkflowBackfillInterval, len(scheduledTimes))
+	for i, scheduledTime := range scheduledTimes {
+		interval, ok := existing[scheduledTime.Unix()]
+		if !ok {
+			interval = v1alpha1.CronWorkflowBackfillInterval{ScheduledTime: v1.Time{Time: scheduledTime}}
+		}
+		intervals[i] = interval
+	}
+	status.Intervals = intervals
+
+	if backfill.RerunFailed != status.RerunFailed {
+		log.WithField("rerunFailed", backfill.RerunFailed).Info(ctx, "Rerunning failed backfill scheduled times")
+		for i := range status.Intervals {
+			switch status.Intervals[i].Phase {
+			case v1alpha1.WorkflowFailed, v1alpha1.WorkflowError:
+				status.Intervals[i] = v1alpha1.CronWorkflowBackfillInterval{ScheduledTime: status.Intervals[i].ScheduledTime}
+			}
+		}
+		status.RerunFailed = backfill.RerunFailed
+	}
+
+	running := 0
+	for i := range status.Intervals {
+		interval := &status.Intervals[i]
+		if interval.Workflow == "" || interval.Fulfilled() {
+			continue
+		}
+		wf, ok := backfillWfs[interval.Workflow]
+		if !ok {
+			// the informer may not have seen a workflow that was only just submitted
+			got, err := woc.wfClient.Get(ctx, interval.Workflow, v1.GetOptions{})
+			if errors.IsNotFound(err) {
+				interval.Phase = v1alpha1.WorkflowError
+				interval.Message = "workflow was deleted before it completed"
+				continue
+			} else if err != nil {
+				return err
+			}
+			wf = *got
+		}
+		interval.Phase = wf.Status.Phase
+		if interval.Phase == v1alpha1.WorkflowUnknown {
+			interval.Phase = v1alpha1.WorkflowPending
+		}
+		if !interval.Fulfilled() {
+			running++
+		}
+	}
+
+	if !woc.cronWf.Spec.Suspend {
+		for i := range status.Intervals {
+			if running >= backfill.GetParallelism() {
+				break
+			}
+			interval := &status.Intervals[i]
+			if interval.Workflow != "" {
+				continue
+			}
+			err := woc.submitBackfillWorkflow(ctx, backfill, status.RerunFailed, interval)
+			if err != nil {
+				return err
+			}
+			if !interval.Fulfilled() {
+				running++
+			}
+		}
+	}
+
+	status.Phase = getBackfillPhase(status.Intervals)
+	return nil
+}
+
+func (woc *cronWfOperationCtx) submitBackfillWorkflow(ctx context.Context, backfill v1alpha1.CronWorkflowBackfill, rerun int32, interval *v1alpha1.CronWorkflowBackfillInterval) error {
+	scheduledTime := interval.ScheduledTime.Time
+	name := util.GenerateBackfillWorkflowName(woc.cronWf.Name, backfill.Name, scheduledTime, rerun)
+	wf := common.ConvertCronWorkflowToWorkflowWithProperties(ctx, woc.cronWf, name, scheduledTime)
+	wf.Labels[common.LabelKeyCronWorkflowBackfill] = backfill.Name
+	if backfill.Parameter != "" {
+		setBackfillParameter(wf, backfill.Parameter, scheduledTime.Format(time.RFC3339))
+	}
+
+	interval.Workflow = name
+	interval.Phase = v1alpha1.WorkflowPending
+	interval.Message = ""
+	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, woc.wfDefaults, &v1alpha1.SubmitOpts{})
+	if err != nil {
+		// a previous sync may have submitted it, but failed to record that it did
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+		if errorsutil.IsTransientErr(ctx, err) {
+			interval.Workflow = ""
+			interval.Phase = v1alpha1.WorkflowUnknown
+			return err
+		}
+		interval.Phase = v1alpha1.WorkflowError
+		interval.Message = fmt.Sprintf("Failed to submit Workflow: %s", err)
+		return nil
+	}
+	if runWf.Status.Phase != v1alpha1.WorkflowUnknown {
+		interval.Phase = runWf.Status.Phase
+	}
+	woc.log.WithFields(logging.Fields{"backfill": backfill.Name, "workflow": name, "scheduledTime": scheduledTime}).Info(ctx, "Submitted backfill workflow")
+	return nil
+}
+
+func setBackfillParameter(wf *v1alpha1.Workflow, name, value string) {
+	for i, param := range wf.Spec.Arguments.Parameters {
+		if param.Name == name {
+			wf.Spec.Arguments.Parameters[i].Value = v1alpha1.AnyStringPtr(value)
+			return
+		}
+	}
+	wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, v1alpha1.Parameter{Name: name, Value: v1alpha1.AnyStringPtr(value)})
+}
+
+func getBackfillPhase(intervals []v1alpha1.CronWorkflowBackfillInterval) v1alpha1.WorkflowPhase {
+	phase := v1alpha1.WorkflowSucceeded
+	for _, interval := range intervals {
+		switch interval.Phase {
+		case v1alpha1.WorkflowSucceeded:
+		case v1alpha1.WorkflowFailed, v1alpha1.WorkflowError:
+			phase = v1alpha1.WorkflowFailed
+		default:
+			return v1alpha1.WorkflowRunning
+		}
+	}
+	return phase
+}
+
+func isBackfillWorkflow(wf v1alpha1.Workflow) bool {
+	_, ok := wf.Labels[common.LabelKeyCronWorkflowBackfill]
+	return ok
+}
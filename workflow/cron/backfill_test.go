@@ -0,0 +1,171 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+var backfillCronWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: hourly
+  namespace: argo
+  uid: 5f1b3c1e-8d4b-4a8e-9d4e-2a1f0c6b7d11
+spec:
+  schedules:
+    - '0 * * * *'
+  backfills:
+    - name: october
+      start: "2024-10-21T00:00:00Z"
+      end: "2024-10-21T02:00:00Z"
+      parallelism: 2
+      parameter: date
+  workflowSpec:
+    entrypoint: main
+    arguments:
+      parameters:
+        - name: date
+          value: today
+    templates:
+      - name: main
+        container:
+          image: alpine
+`
+
+func newBackfillTestOperationCtx(t *testing.T) (*cronWfOperationCtx, *fake.Clientset) {
+	t.Helper()
+	ctx := logging.TestContext(t.Context())
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		wfClientset:       cs,
+		wfClient:          cs.ArgoprojV1alpha1().Workflows(cronWf.Namespace),
+		cronWfIf:          cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:            &cronWf,
+		log:               logging.RequireLoggerFromContext(ctx),
+		metrics:           testMetrics,
+		scheduledTimeFunc: inferScheduledTime,
+		ctx:               ctx,
+	}
+	return woc, cs
+}
+
+// listBackfillWorkflows returns the backfill's workflows, with their phases set as given by name
+func listBackfillWorkflows(t *testing.T, woc *cronWfOperationCtx, phases map[string]v1alpha1.WorkflowPhase) []v1alpha1.Workflow {
+	t.Helper()
+	wfList, err := woc.wfClient.List(t.Context(), v1.ListOptions{})
+	require.NoError(t, err)
+	for i := range wfList.Items {
+		wfList.Items[i].Status.Phase = phases[wfList.Items[i].Name]
+	}
+	return wfList.Items
+}
+
+func TestReconcileBackfills(t *testing.T) {
+	woc, _ := newBackfillTestOperationCtx(t)
+	ctx := woc.ctx
+
+	require.NoError(t, woc.reconcileBackfills(ctx, nil))
+	status := woc.cronWf.Status.GetBackfill("october")
+	require.NotNil(t, status)
+	assert.Equal(t, v1alpha1.WorkflowRunning, status.Phase)
+	require.Len(t, status.Intervals, 3)
+	assert.Equal(t, "hourly-backfill-october-1729468800", status.Intervals[0].Workflow)
+	assert.Equal(t, "hourly-backfill-october-1729472400", status.Intervals[1].Workflow)
+	assert.Empty(t, status.Intervals[2].Workflow, "parallelism limits the workflows running at once")
+	assert.Equal(t, v1alpha1.WorkflowPending, status.Intervals[0].Phase)
+
+	workflows := listBackfillWorkflows(t, woc, nil)
+	require.Len(t, workflows, 2)
+	wf := workflows[0]
+	assert.Equal(t, "october", wf.Labels[common.LabelKeyCronWorkflowBackfill])
+	assert.Equal(t, "hourly", wf.Labels[common.LabelKeyCronWorkflow])
+	assert.Equal(t, "2024-10-21T00:00:00Z", wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+	require.Len(t, wf.Spec.Arguments.Parameters, 1)
+	assert.Equal(t, "2024-10-21T00:00:00Z", wf.Spec.Arguments.Parameters[0].Value.String())
+
+	workflows = listBackfillWorkflows(t, woc, map[string]v1alpha1.WorkflowPhase{
+		"hourly-backfill-october-1729468800": v1alpha1.WorkflowSucceeded,
+		"hourly-backfill-october-1729472400": v1alpha1.WorkflowFailed,
+	})
+	require.NoError(t, woc.reconcileBackfills(ctx, workflows))
+	status = woc.cronWf.Status.GetBackfill("october")
+	assert.Equal(t, v1alpha1.WorkflowSucceeded, status.Intervals[0].Phase)
+	assert.Equal(t, v1alpha1.WorkflowFailed, status.Intervals[1].Phase)
+	assert.Equal(t, "hourly-backfill-october-1729476000", status.Intervals[2].Workflow)
+
+	phases := map[string]v1alpha1.WorkflowPhase{
+		"hourly-backfill-october-1729468800": v1alpha1.WorkflowSucceeded,
+		"hourly-backfill-october-1729472400": v1alpha1.WorkflowFailed,
+		"hourly-backfill-october-1729476000": v1alpha1.WorkflowSucceeded,
+	}
+	require.NoError(t, woc.reconcileBackfills(ctx, listBackfillWorkflows(t, woc, phases)))
+	status = woc.cronWf.Status.GetBackfill("october")
+	assert.Equal(t, v1alpha1.WorkflowFailed, status.Phase)
+
+	t.Run("RerunFailed", func(t *testing.T) {
+		woc.cronWf.Spec.Backfills[0].RerunFailed = 1
+		require.NoError(t, woc.reconcileBackfills(ctx, listBackfillWorkflows(t, woc, phases)))
+		status := woc.cronWf.Status.GetBackfill("october")
+		assert.Equal(t, v1alpha1.WorkflowRunning, status.Phase)
+		assert.Equal(t, int32(1), status.RerunFailed)
+		assert.Equal(t, "hourly-backfill-october-1729468800", status.Intervals[0].Workflow, "succeeded scheduled times are not rerun")
+		assert.Equal(t, "hourly-backfill-october-1729472400-1", status.Intervals[1].Workflow)
+		assert.Equal(t, v1alpha1.WorkflowPending, status.Intervals[1].Phase)
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		woc.cronWf.Spec.Backfills = nil
+		require.NoError(t, woc.reconcileBackfills(ctx, nil))
+		assert.Empty(t, woc.cronWf.Status.Backfills)
+	})
+}
+
+func TestReconcileBackfillsSuspended(t *testing.T) {
+	woc, cs := newBackfillTestOperationCtx(t)
+	woc.cronWf.Spec.Suspend = true
+
+	require.NoError(t, woc.reconcileBackfills(woc.ctx, nil))
+	status := woc.cronWf.Status.GetBackfill("october")
+	require.NotNil(t, status)
+	assert.Len(t, status.Intervals, 3)
+	wfList, err := cs.ArgoprojV1alpha1().Workflows(woc.cronWf.Namespace).List(woc.ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, wfList.Items)
+}
+
+func TestReconcileBackfillsDeletedWorkflow(t *testing.T) {
+	woc, _ := newBackfillTestOperationCtx(t)
+	woc.cronWf.Spec.Backfills[0].Parallelism = ptr.To(int32(3))
+	woc.cronWf.Status.Backfills = []v1alpha1.CronWorkflowBackfillStatus{{
+		Name: "october",
+		Intervals: []v1alpha1.CronWorkflowBackfillInterval{{
+			ScheduledTime: v1.NewTime(time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC)),
+			Workflow:      "hourly-backfill-october-1729468800",
+			Phase:         v1alpha1.WorkflowRunning,
+		}},
+	}}
+
+	require.NoError(t, woc.reconcileBackfills(woc.ctx, nil))
+	status := woc.cronWf.Status.GetBackfill("october")
+	assert.Equal(t, v1alpha1.WorkflowError, status.Intervals[0].Phase)
+	assert.NotEmpty(t, status.Intervals[0].Message)
+	assert.Equal(t, "hourly-backfill-october-1729472400", status.Intervals[1].Workflow)
+	assert.Equal(t, "hourly-backfill-october-1729476000", status.Intervals[2].Workflow)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -44,6 +45,7 @@ type Controller struct {
 	cron                 *cronFacade
 	keyLock              sync.KeyLock
 	wfClientset          versioned.Interface
+	wfInformer           cache.SharedIndexInformer
 	wfLister             util.WorkflowLister
 	cronWfInformer       informers.GenericInformer
 	wftmplInformer       wfextvv1alpha1.WorkflowTemplateInformer
@@ -112,13 +114,15 @@ func (cc *Controller) Run(ctx context.Context) {
 		cc.logger.WithFatal().Error(ctx, err.Error())
 	}
 
-	wfInformer := util.NewWorkflowInformer(ctx, cc.dynamicInterface, cc.managedNamespace, cronWorkflowResyncPeriod,
+	cc.wfInformer = util.NewWorkflowInformer(ctx, cc.dynamicInterface, cc.managedNamespace, cronWorkflowResyncPeriod,
 		func(options *v1.ListOptions) { wfInformerListOptionsFunc(options, cc.instanceID) },
 		func(options *v1.ListOptions) { wfInformerListOptionsFunc(options, cc.instanceID) },
-		cache.Indexers{})
-	go wfInformer.Run(ctx.Done())
+		cache.Indexers{
+			indexes.CronWorkflowIndex: indexes.MetaNamespaceLabelIndexFunc(common.LabelKeyCronWorkflow),
+		})
+	go cc.wfInformer.Run(ctx.Done())
 
-	cc.wfLister = util.NewWorkflowLister(ctx, wfInformer)
+	cc.wfLister = util.NewWorkflowLister(ctx, cc.wfInformer)
 
 	cc.cron.Start()
 	defer cc.cron.Stop()
@@ -179,7 +183,7 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	}
 	ctx = wfctx.InjectObjectMeta(ctx, &cronWf.ObjectMeta)
 
-	cronWorkflowOperationCtx := newCronWfOperationCtx(ctx, cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.wfDefaults)
+	cronWorkflowOperationCtx := newCronWfOperationCtx(ctx, cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.cronWfInformer.Informer().GetIndexer(), cc.wfInformer.GetIndexer(), cc.wfDefaults)
 
 	err = cronWorkflowOperationCtx.validateCronWorkflow(ctx)
 	if err != nil {
@@ -297,7 +301,7 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	cc.keyLock.Lock(key)
 	defer cc.keyLock.Unlock(key)
 
-	cwoc := newCronWfOperationCtx(ctx, cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.wfDefaults)
+	cwoc := newCronWfOperationCtx(ctx, cronWf, cc.wfClientset, cc.metrics, cc.wftmplInformer, cc.cwftmplInformer, cc.cronWfInformer.Informer().GetIndexer(), cc.wfInformer.GetIndexer(), cc.wfDefaults)
 	err := cwoc.enforceHistoryLimit(ctx, workflows)
 	if err != nil {
 		return err
@@ -306,6 +310,13 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	if err != nil {
 		return err
 	}
+	err = cwoc.reconcileBackfills(ctx, workflows)
+	if err != nil {
+		return err
+	}
+	if len(cronWf.Status.WaitingScheduledTimes) > 0 {
+		cwoc.runWaiting(ctx)
+	}
 
 	return nil
 }
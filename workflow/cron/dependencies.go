@@ -0,0 +1,254 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// maxDependencyLookback is how far before a scheduled time the scheduled time of a dependency's run for it is looked for
+const maxDependencyLookback = 366 * 24 * time.Hour
+
+type dependenciesPhase int
+
+const (
+	dependenciesSucceeded dependenciesPhase = iota
+	dependenciesPending
+	dependenciesFailed
+)
+
+// enforceDependencies returns whether the run for the scheduled time can proceed because the runs of its dependencies
+// succeeded. Otherwise, the run waits in the status to be tried again by runWaiting, or is skipped if it cannot wait.
+func (woc *cronWfOperationCtx) enforceDependencies(ctx context.Context, scheduledRuntime time.Time) bool {
+	woc.removeWaiting(scheduledRuntime)
+	phase, message := woc.checkDependencies(ctx, scheduledRuntime)
+	log := woc.log.WithFields(logging.Fields{"scheduledTime": scheduledRuntime, "reason": message})
+	switch phase {
+	case dependenciesSucceeded:
+		if len(woc.cronWf.Status.WaitingScheduledTimes) == 0 {
+			woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeDependenciesNotMet)
+		}
+		return true
+	case dependenciesPending:
+		if time.Now().Before(woc.getDependenciesDeadline(scheduledRuntime)) {
+			log.Info(ctx, "Run is waiting for its dependencies")
+			woc.cronWf.Status.WaitingScheduledTimes = append(woc.cronWf.Status.WaitingScheduledTimes, v1.Time{Time: scheduledRuntime})
+			woc.setDependenciesNotMet(scheduledRuntime, fmt.Sprintf("Run scheduled at %s is waiting: %s", scheduledRuntime.Format(time.RFC3339), message))
+			return false
+		}
+		message = "deadline passed while " + message
+	}
+	log.Info(ctx, "Run was skipped because of its dependencies")
+	woc.setDependenciesNotMet(scheduledRuntime, fmt.Sprintf("Run scheduled at %s was skipped: %s", scheduledRuntime.Format(time.RFC3339), message))
+	return false
+}
+
+func (woc *cronWfOperationCtx) setDependenciesNotMet(scheduledRuntime time.Time, message string) {
+	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeDependenciesNotMet,
+		Message: message,
+		Status:  v1.ConditionTrue,
+	})
+	// the run has been dealt with, so it must not be run again as a missed execution
+	if woc.cronWf.Status.LastScheduledTime == nil || scheduledRuntime.After(woc.cronWf.Status.LastScheduledTime.Time) {
+		woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	}
+}
+
+func (woc *cronWfOperationCtx) removeWaiting(scheduledRuntime time.Time) {
+	var waiting []v1.Time
+	for _, t := range woc.cronWf.Status.WaitingScheduledTimes {
+		if !t.Time.Equal(scheduledRuntime) {
+			waiting = append(waiting, t)
+		}
+	}
+	woc.cronWf.Status.WaitingScheduledTimes = waiting
+}
+
+// runWaiting tries the runs that are waiting for their dependencies again
+func (woc *cronWfOperationCtx) runWaiting(ctx context.Context) {
+	waiting := append([]v1.Time(nil), woc.cronWf.Status.WaitingScheduledTimes...)
+	for _, scheduledRuntime := range waiting {
+		woc.run(ctx, scheduledRuntime.Time)
+	}
+}
+
+// getDependenciesDeadline returns the time until which the run for the scheduled time waits for its dependencies
+func (woc *cronWfOperationCtx) getDependenciesDeadline(scheduledRuntime time.Time) time.Time {
+	if seconds := woc.cronWf.Spec.Dependencies.DeadlineSeconds; seconds != nil {
+		return scheduledRuntime.Add(time.Duration(*seconds) * time.Second)
+	}
+	return getNextScheduledTime(&woc.cronWf.Spec, scheduledRuntime)
+}
+
+// getNextScheduledTime returns the earliest time after the given time that matches any of the schedules
+func getNextScheduledTime(spec *v1alpha1.CronWorkflowSpec, after time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range spec.GetSchedulesWithTimezone() {
+		cronSchedule, err := cron.ParseStandard(schedule)
+		if err != nil {
+			continue
+		}
+		if t := cronSchedule.Next(after); next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	return next
+}
+
+// getDependedOnScheduledTimes returns the scheduled times, as Unix times, of the runs of the CronWorkflow that the
+// CronWorkflows depending on it are waiting for, or will check for their next run, so that their workflows are not
+// deleted by the history limit before they are checked
+func (woc *cronWfOperationCtx) getDependedOnScheduledTimes(ctx context.Context, now time.Time) map[int64]bool {
+	dependedOn := make(map[int64]bool)
+	err := cache.ListAllByNamespace(woc.cronWfIndexer, woc.cronWf.Namespace, labels.Everything(), func(obj interface{}) {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		dependent := &v1alpha1.CronWorkflow{}
+		if err := util.FromUnstructuredObj(un, dependent); err != nil {
+			woc.log.WithError(err).WithField("cronWorkflow", un.GetName()).Warn(ctx, "Failed to unmarshal CronWorkflow")
+			return
+		}
+		if dependent.Spec.Dependencies == nil || !slices.Contains(dependent.Spec.Dependencies.CronWorkflows, woc.cronWf.Name) {
+			return
+		}
+		scheduledTimes := []time.Time{getNextScheduledTime(&dependent.Spec, now)}
+		for _, t := range dependent.Status.WaitingScheduledTimes {
+			scheduledTimes = append(scheduledTimes, t.Time)
+		}
+		for _, t := range scheduledTimes {
+			if scheduledTime, err := getLastScheduledTimeAtOrBefore(&woc.cronWf.Spec, t); err == nil && !scheduledTime.IsZero() {
+				dependedOn[scheduledTime.Unix()] = true
+			}
+		}
+	})
+	if err != nil {
+		woc.log.WithError(err).Warn(ctx, "Failed to list the CronWorkflows depending on the CronWorkflow")
+	}
+	return dependedOn
+}
+
+// checkDependencies returns whether the dependencies' runs for the scheduled time succeeded, and if not, why
+func (woc *cronWfOperationCtx) checkDependencies(ctx context.Context, scheduledRuntime time.Time) (dependenciesPhase, string) {
+	phase := dependenciesSucceeded
+	var messages []string
+	for _, name := range woc.cronWf.Spec.Dependencies.CronWorkflows {
+		dependencyPhase, message := woc.checkDependency(ctx, name, scheduledRuntime)
+		if dependencyPhase > phase {
+			phase = dependencyPhase
+		}
+		if dependencyPhase != dependenciesSucceeded {
+			messages = append(messages, message)
+		}
+	}
+	return phase, strings.Join(messages, "; ")
+}
+
+func (woc *cronWfOperationCtx) checkDependency(ctx context.Context, name string, scheduledRuntime time.Time) (dependenciesPhase, string) {
+	dependency, err := woc.getDependency(name)
+	if err != nil {
+		return dependenciesPending, fmt.Sprintf("failed to get CronWorkflow %s: %s", name, err)
+	}
+	dependencyScheduledTime, err := getLastScheduledTimeAtOrBefore(&dependency.Spec, scheduledRuntime)
+	if err != nil {
+		return dependenciesFailed, fmt.Sprintf("CronWorkflow %s: %s", name, err)
+	}
+	if dependencyScheduledTime.IsZero() {
+		return dependenciesFailed, fmt.Sprintf("CronWorkflow %s is not scheduled to run before %s", name, scheduledRuntime.Format(time.RFC3339))
+	}
+	waitingFor := fmt.Sprintf("CronWorkflow %s's run scheduled at %s", name, dependencyScheduledTime.Format(time.RFC3339))
+	objs, err := woc.wfIndexer.ByIndex(indexes.CronWorkflowIndex, indexes.MetaNamespaceLabelIndex(woc.cronWf.Namespace, name))
+	if err != nil {
+		return dependenciesPending, fmt.Sprintf("failed to list the workflows of CronWorkflow %s: %s", name, err)
+	}
+	for _, obj := range objs {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok || !v1.IsControlledBy(un, dependency) {
+			continue
+		}
+		wfScheduledTime, err := time.Parse(time.RFC3339, un.GetAnnotations()[common.AnnotationKeyCronWfScheduledTime])
+		if err != nil || !wfScheduledTime.Equal(dependencyScheduledTime) {
+			continue
+		}
+		wf, err := util.FromUnstructured(un)
+		if err != nil {
+			woc.log.WithError(err).WithField("dependency", un.GetName()).Warn(ctx, "Failed to unmarshal dependency workflow")
+			continue
+		}
+		switch {
+		case wf.Status.Successful():
+			return dependenciesSucceeded, ""
+		case wf.Status.Fulfilled():
+			return dependenciesFailed, fmt.Sprintf("%s %s", waitingFor, strings.ToLower(string(wf.Status.Phase)))
+		}
+	}
+	return dependenciesPending, "waiting for " + waitingFor + " to succeed"
+}
+
+// getDependency returns the CronWorkflow from the informer cache
+func (woc *cronWfOperationCtx) getDependency(name string) (*v1alpha1.CronWorkflow, error) {
+	obj, exists, err := woc.cronWfIndexer.GetByKey(woc.cronWf.Namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("not found")
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expected *unstructured.Unstructured, got %T", obj)
+	}
+	dependency := &v1alpha1.CronWorkflow{}
+	if err := util.FromUnstructuredObj(un, dependency); err != nil {
+		return nil, err
+	}
+	return dependency, nil
+}
+
+// getLastScheduledTimeAtOrBefore returns the most recent time at or before the given time that matches any of the
+// schedules, or zero if there is none within maxDependencyLookback
+func getLastScheduledTimeAtOrBefore(spec *v1alpha1.CronWorkflowSpec, before time.Time) (time.Time, error) {
+	var times []time.Time
+	for _, schedule := range spec.GetSchedulesWithTimezone() {
+		cronSchedule, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cron schedule %s is malformed: %w", schedule, err)
+		}
+		// the cron library can only look forwards, so look forwards from ever further back until a time is found
+		for lookback := time.Hour; ; lookback = min(2*lookback, maxDependencyLookback) {
+			var last time.Time
+			for t := cronSchedule.Next(before.Add(-lookback)); !t.IsZero() && !t.After(before); t = cronSchedule.Next(t) {
+				last = t
+			}
+			if !last.IsZero() {
+				times = append(times, last)
+				break
+			}
+			if lookback == maxDependencyLookback {
+				break
+			}
+		}
+	}
+	if len(times) == 0 {
+		return time.Time{}, nil
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].After(times[j]) })
+	return times[0], nil
+}
@@ -0,0 +1,194 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+func TestGetLastScheduledTimeAtOrBefore(t *testing.T) {
+	date := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	for _, tt := range []struct {
+		name      string
+		schedules []string
+		before    time.Time
+		want      time.Time
+	}{
+		{"Earlier", []string{"0 1 * * *"}, date(10, 21, 2), date(10, 21, 1)},
+		{"Same", []string{"0 1 * * *"}, date(10, 21, 1), date(10, 21, 1)},
+		{"PreviousDay", []string{"0 3 * * *"}, date(10, 21, 2), date(10, 20, 3)},
+		{"MostRecentSchedule", []string{"0 3 * * *", "0 0 * * *"}, date(10, 21, 2), date(10, 21, 0)},
+		{"Yearly", []string{"0 0 1 1 *"}, date(10, 21, 2), date(1, 1, 0)},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &v1alpha1.CronWorkflowSpec{Schedules: tt.schedules, Timezone: "UTC"}
+			got, err := getLastScheduledTimeAtOrBefore(spec, tt.before)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func newDependenciesTestOperationCtx(t *testing.T) (*cronWfOperationCtx, *fake.Clientset, *v1alpha1.CronWorkflow) {
+	t.Helper()
+	ctx := logging.TestContext(t.Context())
+	schedule := v1alpha1.CronWorkflowSpec{
+		Schedules: []string{"0 * * * *"},
+		Timezone:  "UTC",
+		WorkflowSpec: v1alpha1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates:  []v1alpha1.Template{{Name: "main", Container: &corev1.Container{Image: "alpine"}}},
+		},
+	}
+	upstream := &v1alpha1.CronWorkflow{ObjectMeta: v1.ObjectMeta{Name: "nightly", Namespace: "argo", UID: "3f0c6f1e-7a6d-4a43-9d1b-6b6f7c1e2a10"}, Spec: schedule}
+	downstream := &v1alpha1.CronWorkflow{ObjectMeta: v1.ObjectMeta{Name: "report", Namespace: "argo", UID: "8b1d2e4f-1c3a-4b5d-8e7f-9a0b1c2d3e4f"}, Spec: *schedule.DeepCopy()}
+	downstream.Spec.Dependencies = &v1alpha1.CronWorkflowDependencies{CronWorkflows: []string{"nightly"}}
+	downstream.SetSchedule(downstream.Spec.GetScheduleWithTimezoneString())
+
+	cs := fake.NewSimpleClientset(upstream, downstream)
+	cronWfIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, cronWf := range []*v1alpha1.CronWorkflow{upstream, downstream} {
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cronWf)
+		require.NoError(t, err)
+		require.NoError(t, cronWfIndexer.Add(&unstructured.Unstructured{Object: un}))
+	}
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		wfClientset:   cs,
+		wfClient:      cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:      cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:        downstream,
+		cronWfIndexer: cronWfIndexer,
+		wfIndexer: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+			indexes.CronWorkflowIndex: indexes.MetaNamespaceLabelIndexFunc(common.LabelKeyCronWorkflow),
+		}),
+		log:     logging.RequireLoggerFromContext(ctx),
+		metrics: testMetrics,
+	}
+	return woc, cs, upstream
+}
+
+func setDependencyWorkflow(t *testing.T, woc *cronWfOperationCtx, wf *v1alpha1.Workflow) {
+	t.Helper()
+	wf.Namespace = woc.cronWf.Namespace
+	un, err := util.ToUnstructured(wf)
+	require.NoError(t, err)
+	require.NoError(t, woc.wfIndexer.Update(un))
+}
+
+func TestEnforceDependencies(t *testing.T) {
+	woc, _, upstream := newDependenciesTestOperationCtx(t)
+	ctx := logging.TestContext(t.Context())
+	scheduledTime := time.Now().UTC().Truncate(time.Hour)
+
+	// upstream has not run yet
+	assert.False(t, woc.enforceDependencies(ctx, scheduledTime))
+	require.Len(t, woc.cronWf.Status.WaitingScheduledTimes, 1)
+	assert.True(t, woc.cronWf.Status.WaitingScheduledTimes[0].Time.Equal(scheduledTime))
+	condition := woc.cronWf.Status.Conditions[0]
+	assert.Equal(t, v1alpha1.ConditionTypeDependenciesNotMet, condition.Type)
+	assert.Contains(t, condition.Message, "is waiting: waiting for CronWorkflow nightly's run scheduled at "+scheduledTime.Format(time.RFC3339)+" to succeed")
+
+	// upstream is running
+	upstreamWf := common.ConvertCronWorkflowToWorkflowWithProperties(ctx, upstream, getChildWorkflowName(upstream.Name, scheduledTime), scheduledTime)
+	upstreamWf.Status.Phase = v1alpha1.WorkflowRunning
+	setDependencyWorkflow(t, woc, upstreamWf)
+	assert.False(t, woc.enforceDependencies(ctx, scheduledTime))
+	assert.Len(t, woc.cronWf.Status.WaitingScheduledTimes, 1)
+
+	// upstream succeeded
+	upstreamWf.Status.Phase = v1alpha1.WorkflowSucceeded
+	setDependencyWorkflow(t, woc, upstreamWf)
+	assert.True(t, woc.enforceDependencies(ctx, scheduledTime))
+	assert.Empty(t, woc.cronWf.Status.WaitingScheduledTimes)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+
+	t.Run("UpstreamFailed", func(t *testing.T) {
+		upstreamWf.Status.Phase = v1alpha1.WorkflowFailed
+		setDependencyWorkflow(t, woc, upstreamWf)
+		assert.False(t, woc.enforceDependencies(ctx, scheduledTime))
+		assert.Empty(t, woc.cronWf.Status.WaitingScheduledTimes)
+		assert.Contains(t, woc.cronWf.Status.Conditions[0].Message, "was skipped: CronWorkflow nightly's run scheduled at "+scheduledTime.Format(time.RFC3339)+" failed")
+	})
+}
+
+func TestEnforceDependenciesDeadline(t *testing.T) {
+	woc, _, _ := newDependenciesTestOperationCtx(t)
+	ctx := logging.TestContext(t.Context())
+	woc.cronWf.Spec.Dependencies.DeadlineSeconds = ptr.To(int64(0))
+	scheduledTime := time.Now().UTC().Truncate(time.Hour)
+
+	assert.False(t, woc.enforceDependencies(ctx, scheduledTime))
+	assert.Empty(t, woc.cronWf.Status.WaitingScheduledTimes)
+	assert.Contains(t, woc.cronWf.Status.Conditions[0].Message, "was skipped: deadline passed while waiting for CronWorkflow nightly's run")
+	assert.True(t, woc.cronWf.Status.LastScheduledTime.Time.Equal(scheduledTime))
+}
+
+func TestRunWaiting(t *testing.T) {
+	woc, cs, upstream := newDependenciesTestOperationCtx(t)
+	ctx := logging.TestContext(t.Context())
+	scheduledTime := time.Now().UTC().Truncate(time.Hour)
+	upstreamWf := common.ConvertCronWorkflowToWorkflowWithProperties(ctx, upstream, getChildWorkflowName(upstream.Name, scheduledTime), scheduledTime)
+	upstreamWf.Status.Phase = v1alpha1.WorkflowSucceeded
+	setDependencyWorkflow(t, woc, upstreamWf)
+	woc.cronWf.Status.WaitingScheduledTimes = []v1.Time{{Time: scheduledTime}}
+
+	woc.runWaiting(ctx)
+	assert.Empty(t, woc.cronWf.Status.WaitingScheduledTimes)
+	wf, err := cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, getChildWorkflowName("report", scheduledTime), v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, scheduledTime.Format(time.RFC3339), wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+}
+
+func TestEnforceHistoryLimitKeepsDependedOnRuns(t *testing.T) {
+	woc, cs, upstream := newDependenciesTestOperationCtx(t)
+	ctx := logging.TestContext(t.Context())
+	scheduledTime := time.Now().UTC().Truncate(time.Hour)
+
+	// the downstream is waiting for the upstream's run scheduled at the same time
+	downstream := woc.cronWf.DeepCopy()
+	downstream.Status.WaitingScheduledTimes = []v1.Time{{Time: scheduledTime}}
+	un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(downstream)
+	require.NoError(t, err)
+	require.NoError(t, woc.cronWfIndexer.Update(&unstructured.Unstructured{Object: un}))
+
+	woc.cronWf = upstream
+	woc.cronWf.Spec.SuccessfulJobsHistoryLimit = ptr.To(int32(0))
+	var workflows []v1alpha1.Workflow
+	for _, runTime := range []time.Time{scheduledTime, scheduledTime.Add(-2 * time.Hour)} {
+		wf := common.ConvertCronWorkflowToWorkflowWithProperties(ctx, upstream, getChildWorkflowName(upstream.Name, runTime), runTime)
+		wf.Namespace = upstream.Namespace
+		wf.Status.Phase = v1alpha1.WorkflowSucceeded
+		wf.Status.FinishedAt = v1.Time{Time: runTime.Add(time.Minute)}
+		_, err := cs.ArgoprojV1alpha1().Workflows(upstream.Namespace).Create(ctx, wf, v1.CreateOptions{})
+		require.NoError(t, err)
+		workflows = append(workflows, *wf)
+	}
+
+	require.NoError(t, woc.enforceHistoryLimit(ctx, workflows))
+	_, err = cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, getChildWorkflowName(upstream.Name, scheduledTime), v1.GetOptions{})
+	require.NoError(t, err, "the run the downstream is waiting for is kept")
+	_, err = cs.ArgoprojV1alpha1().Workflows("argo").Get(ctx, getChildWorkflowName(upstream.Name, scheduledTime.Add(-2*time.Hour)), v1.GetOptions{})
+	require.True(t, errors.IsNotFound(err), "the other runs are deleted")
+}
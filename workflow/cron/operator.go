@@ -14,6 +14,7 @@ import (
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 
@@ -48,8 +49,11 @@ type cronWfOperationCtx struct {
 	cronWfIf        typed.CronWorkflowInterface
 	wftmplInformer  wfextvv1alpha1.WorkflowTemplateInformer
 	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer
-	log             logging.Logger
-	metrics         *metrics.Metrics
+	// cronWfIndexer and wfIndexer are the informer caches that dependencies are looked up in
+	cronWfIndexer cache.Indexer
+	wfIndexer     cache.Indexer
+	log           logging.Logger
+	metrics       *metrics.Metrics
 	// scheduledTimeFunc returns the last scheduled time when it is called
 	scheduledTimeFunc ScheduledTimeFunc
 	//nolint: containedctx
@@ -58,7 +62,8 @@ type cronWfOperationCtx struct {
 
 func newCronWfOperationCtx(ctx context.Context, cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface,
 	metrics *metrics.Metrics, wftmplInformer wfextvv1alpha1.WorkflowTemplateInformer,
-	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow,
+	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, cronWfIndexer, wfIndexer cache.Indexer,
+	wfDefaults *v1alpha1.Workflow,
 ) *cronWfOperationCtx {
 	log := logging.RequireLoggerFromContext(ctx)
 	return &cronWfOperationCtx{
@@ -69,6 +74,8 @@ func newCronWfOperationCtx(ctx context.Context, cronWorkflow *v1alpha1.CronWorkf
 		cronWfIf:        wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
 		wftmplInformer:  wftmplInformer,
 		cwftmplInformer: cwftmplInformer,
+		cronWfIndexer:   cronWfIndexer,
+		wfIndexer:       wfIndexer,
 		log: log.WithFields(logging.Fields{
 			"workflow":  cronWorkflow.Name,
 			"namespace": cronWorkflow.Namespace,
@@ -113,7 +120,7 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		woc.setAsCompleted()
 	}
 
-	proceed, err := woc.enforceRuntimePolicy(ctx)
+	proceed, err := woc.enforceRuntimePolicy(ctx, scheduledRuntime)
 	if err != nil {
 		woc.reportCronWorkflowError(ctx, v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("run policy error: %s", err))
 		return
@@ -137,7 +144,10 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 
 	woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
 	woc.cronWf.Status.Phase = v1alpha1.ActivePhase
-	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	// runs that waited for their dependencies may be submitted after later ones
+	if woc.cronWf.Status.LastScheduledTime == nil || scheduledRuntime.After(woc.cronWf.Status.LastScheduledTime.Time) {
+		woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledRuntime}
+	}
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
 }
 
@@ -243,7 +253,7 @@ func evalWhen(ctx context.Context, cron *v1alpha1.CronWorkflow) (bool, error) {
 	return shouldExecute(newCron.Spec.When)
 }
 
-func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool, error) {
+func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context, scheduledRuntime time.Time) (bool, error) {
 	if woc.cronWf.Spec.Suspend {
 		woc.log.Info(ctx, "CronWorkflow suspended, skipping execution")
 		return false, nil
@@ -259,6 +269,10 @@ func (woc *cronWfOperationCtx) enforceRuntimePolicy(ctx context.Context) (bool,
 		return canProceed, err
 	}
 
+	if woc.cronWf.Spec.Dependencies != nil && !woc.enforceDependencies(ctx, scheduledRuntime) {
+		return false, nil
+	}
+
 	if woc.cronWf.Spec.ConcurrencyPolicy != "" {
 		switch woc.cronWf.Spec.ConcurrencyPolicy {
 		case v1alpha1.AllowConcurrent, "":
@@ -306,15 +320,120 @@ func (woc *cronWfOperationCtx) terminateOutstandingWorkflows(ctx context.Context
 }
 
 func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (bool, error) {
-	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
+	missedExecutionTimes, err := woc.getMissedExecutionTimes(ctx)
 	if err != nil {
 		return false, err
 	}
-	if !missedExecutionTime.IsZero() {
+	// each missed execution is run with its own scheduled time, oldest first, so the last scheduled time ends up
+	// being the most recent one
+	for _, missedExecutionTime := range missedExecutionTimes {
 		woc.run(ctx, missedExecutionTime)
-		return true, nil
 	}
-	return false, nil
+	return len(missedExecutionTimes) > 0, nil
+}
+
+// getMissedExecutionTimes returns the missed executions to run according to the catch-up policy, oldest first
+func (woc *cronWfOperationCtx) getMissedExecutionTimes(ctx context.Context) ([]time.Time, error) {
+	switch woc.cronWf.Spec.CatchUpPolicy {
+	case v1alpha1.CatchUpNone:
+		return nil, nil
+	case v1alpha1.CatchUpAll:
+		missedExecutionTimes, err := woc.getAllMissedExecutionTimes(ctx)
+		if err != nil || len(missedExecutionTimes) < 2 {
+			return missedExecutionTimes, err
+		}
+		switch woc.cronWf.Spec.ConcurrencyPolicy {
+		case v1alpha1.ForbidConcurrent, v1alpha1.ReplaceConcurrent:
+			// validation rejects this, but as each missed execution would be forbidden by, or would replace, the one
+			// before it, we only run the most recent
+			woc.log.WithField("concurrencyPolicy", woc.cronWf.Spec.ConcurrencyPolicy).Warn(ctx, "catchUpPolicy All cannot be used with this concurrencyPolicy, only running the most recent missed execution")
+			return missedExecutionTimes[len(missedExecutionTimes)-1:], nil
+		}
+		return missedExecutionTimes, nil
+	default:
+		missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
+		if err != nil || missedExecutionTime.IsZero() {
+			return nil, err
+		}
+		return []time.Time{missedExecutionTime}, nil
+	}
+}
+
+// getAllMissedExecutionTimes returns every execution missed since the last scheduled time, within the
+// StartingDeadlineSeconds if it is set, up to the CatchUpLimit most recent ones
+func (woc *cronWfOperationCtx) getAllMissedExecutionTimes(ctx context.Context) ([]time.Time, error) {
+	// If the CronWorkflow schedule was just updated, then do not run any outstanding workflows.
+	if woc.cronWf.IsUsingNewSchedule() || woc.cronWf.Status.LastScheduledTime == nil {
+		return nil, nil
+	}
+	now := time.Now()
+	from := woc.cronWf.Status.LastScheduledTime.Time
+	if woc.cronWf.Spec.StartingDeadlineSeconds != nil {
+		// executions that are no longer within the deadline cannot be run, so start from the earliest that can
+		deadline := now.Add(-time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds) * time.Second)
+		if deadline.After(from) {
+			from = deadline
+		}
+	}
+	limit := woc.cronWf.Spec.GetCatchUpLimit()
+	seen := make(map[int64]bool)
+	var missedExecutionTimes []time.Time
+	truncated := false
+	for _, schedule := range woc.cronWf.Spec.GetSchedulesWithTimezone() {
+		cronSchedule, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return nil, err
+		}
+		times, more := recentExecutionTimes(cronSchedule, from, now, limit)
+		truncated = truncated || more
+		for _, t := range times {
+			if !seen[t.Unix()] {
+				seen[t.Unix()] = true
+				missedExecutionTimes = append(missedExecutionTimes, t)
+			}
+		}
+	}
+	sort.Slice(missedExecutionTimes, func(i, j int) bool { return missedExecutionTimes[i].Before(missedExecutionTimes[j]) })
+	if len(missedExecutionTimes) > limit {
+		truncated = true
+		missedExecutionTimes = missedExecutionTimes[len(missedExecutionTimes)-limit:]
+	}
+	if truncated {
+		woc.log.WithFields(logging.Fields{"catchUpLimit": limit}).Warn(ctx, "missed more executions than the catch-up limit, only running the most recent")
+	}
+	if len(missedExecutionTimes) > 0 {
+		woc.log.WithFields(logging.Fields{"name": woc.cronWf.Name, "missed": len(missedExecutionTimes)}).Info(ctx, "catching up missed executions")
+	}
+	return missedExecutionTimes, nil
+}
+
+// recentExecutionTimes returns the most recent times of a schedule after from and before now, at most limit of them,
+// and whether there were more. Rather than iterating over every time since from, which may be a very long time ago, it
+// looks back over a window that doubles until it holds the limit.
+func recentExecutionTimes(schedule cron.Schedule, from, now time.Time, limit int) ([]time.Time, bool) {
+	if limit <= 0 {
+		return nil, false
+	}
+	for window := time.Minute; ; window *= 2 {
+		start := now.Add(-window)
+		// the window overflows before it reaches a from that is centuries ago
+		if window <= 0 || !start.After(from) {
+			start = from
+		}
+		var times []time.Time
+		for t := schedule.Next(start); !t.IsZero() && t.Before(now); t = schedule.Next(t) {
+			times = append(times, t)
+		}
+		if len(times) >= limit || start.Equal(from) {
+			more := len(times) > limit
+			if more {
+				times = times[len(times)-limit:]
+			} else if next := schedule.Next(from); !start.Equal(from) && !next.IsZero() && !next.After(start) {
+				more = true
+			}
+			return times, more
+		}
+	}
 }
 
 func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun(ctx context.Context) (time.Time, error) {
@@ -363,6 +482,10 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 	updated := false
 	currentWfsFulfilled := make(map[types.UID]fulfilledWfsPhase, len(workflows))
 	for _, wf := range workflows {
+		// the workflows of backfills are tracked in the status of each backfill
+		if isBackfillWorkflow(wf) {
+			continue
+		}
 		currentWfsFulfilled[wf.UID] = fulfilledWfsPhase{
 			fulfilled: wf.Status.Fulfilled(),
 			phase:     wf.Status.Phase,
@@ -409,10 +532,15 @@ func (woc *cronWfOperationCtx) removeFromActiveList(uid types.UID) {
 func (woc *cronWfOperationCtx) enforceHistoryLimit(ctx context.Context, workflows []v1alpha1.Workflow) error {
 	woc.log.WithField("name", woc.cronWf.Name).Debug(ctx, "Enforcing history limit")
 
+	// the runs that CronWorkflows depending on this one are yet to check are kept
+	dependedOn := woc.getDependedOnScheduledTimes(ctx, time.Now())
 	var successfulWorkflows []v1alpha1.Workflow
 	var failedWorkflows []v1alpha1.Workflow
 	for _, wf := range workflows {
-		if wf.Labels[common.LabelKeyCronWorkflow] != woc.cronWf.Name {
+		if wf.Labels[common.LabelKeyCronWorkflow] != woc.cronWf.Name || isBackfillWorkflow(wf) {
+			continue
+		}
+		if scheduledTime, err := time.Parse(time.RFC3339, wf.Annotations[common.AnnotationKeyCronWfScheduledTime]); err == nil && dependedOn[scheduledTime.Unix()] {
 			continue
 		}
 		if wf.Status.Fulfilled() {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -816,3 +817,165 @@ func TestEvaluateWhenUnresolvedOutside(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, result)
 }
+
+func TestGetMissedExecutionTimes(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	now := time.Now()
+	hour := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.Local)
+	newWoc := func(policy v1alpha1.CatchUpPolicy) *cronWfOperationCtx {
+		cronWf := &v1alpha1.CronWorkflow{
+			Spec:   v1alpha1.CronWorkflowSpec{Schedules: []string{"0 * * * *"}, CatchUpPolicy: policy},
+			Status: v1alpha1.CronWorkflowStatus{LastScheduledTime: &v1.Time{Time: hour.Add(-3 * time.Hour)}},
+		}
+		cronWf.SetSchedule(cronWf.Spec.GetScheduleWithTimezoneString())
+		return &cronWfOperationCtx{cronWf: cronWf, log: logging.RequireLoggerFromContext(ctx)}
+	}
+
+	t.Run("None", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpNone)
+		woc.cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(24 * 60 * 60))
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, times)
+	})
+	t.Run("Latest", func(t *testing.T) {
+		woc := newWoc("")
+		woc.cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(24 * 60 * 60))
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{hour}, times)
+	})
+	t.Run("All", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpAll)
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{hour.Add(-2 * time.Hour), hour.Add(-time.Hour), hour}, times)
+	})
+	t.Run("AllWithinStartingDeadline", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpAll)
+		woc.cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(now.Sub(hour.Add(-time.Hour)).Seconds()) + 60)
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{hour.Add(-time.Hour), hour}, times)
+	})
+	t.Run("AllUpToCatchUpLimit", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpAll)
+		woc.cronWf.Spec.CatchUpLimit = ptr.To(int32(1))
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{hour}, times)
+	})
+	t.Run("AllUpToCatchUpLimitWithoutStartingDeadline", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpAll)
+		woc.cronWf.Spec.Schedules = []string{"* * * * *"}
+		woc.cronWf.Spec.CatchUpLimit = ptr.To(int32(2))
+		woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: hour.AddDate(-1, 0, 0)}
+		woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleWithTimezoneString())
+		minute := now.Truncate(time.Minute)
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{minute.Add(-time.Minute), minute}, times)
+	})
+	for _, policy := range []v1alpha1.ConcurrencyPolicy{v1alpha1.ForbidConcurrent, v1alpha1.ReplaceConcurrent} {
+		t.Run("AllWith"+string(policy), func(t *testing.T) {
+			woc := newWoc(v1alpha1.CatchUpAll)
+			woc.cronWf.Spec.ConcurrencyPolicy = policy
+			times, err := woc.getMissedExecutionTimes(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, []time.Time{hour}, times, "only the most recent is run")
+		})
+	}
+	t.Run("AllWithNewSchedule", func(t *testing.T) {
+		woc := newWoc(v1alpha1.CatchUpAll)
+		woc.cronWf.SetSchedule("0 0 * * *")
+		times, err := woc.getMissedExecutionTimes(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, times)
+	})
+}
+
+// countingSchedule counts how many times it is iterated over
+type countingSchedule struct {
+	cron.Schedule
+	calls int
+}
+
+func (s *countingSchedule) Next(t time.Time) time.Time {
+	s.calls++
+	return s.Schedule.Next(t)
+}
+
+func TestRecentExecutionTimes(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC)
+	everyMinute, err := cron.ParseStandard("* * * * *")
+	require.NoError(t, err)
+	hourly, err := cron.ParseStandard("0 * * * *")
+	require.NoError(t, err)
+	t.Run("StopsAtLimit", func(t *testing.T) {
+		schedule := &countingSchedule{Schedule: everyMinute}
+		times, more := recentExecutionTimes(schedule, now.AddDate(-1, 0, 0), now, 3)
+		assert.Equal(t, []time.Time{now.Add(-3 * time.Minute), now.Add(-2 * time.Minute), now.Add(-time.Minute)}, times)
+		assert.True(t, more)
+		assert.Less(t, schedule.calls, 20, "does not iterate over a year of minutes")
+	})
+	t.Run("BelowLimit", func(t *testing.T) {
+		times, more := recentExecutionTimes(hourly, now.Add(-150*time.Minute), now, 5)
+		assert.Equal(t, []time.Time{now.Add(-90 * time.Minute), now.Add(-30 * time.Minute)}, times)
+		assert.False(t, more)
+	})
+	t.Run("AtLimit", func(t *testing.T) {
+		times, more := recentExecutionTimes(hourly, now.Add(-150*time.Minute), now, 2)
+		assert.Len(t, times, 2)
+		assert.False(t, more)
+	})
+	t.Run("ZeroLimit", func(t *testing.T) {
+		times, more := recentExecutionTimes(hourly, now.Add(-150*time.Minute), now, 0)
+		assert.Empty(t, times)
+		assert.False(t, more)
+	})
+}
+
+func TestRunOutstandingWorkflowsCatchUpAll(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	// avoid the minute changing part way through the test
+	if _, _, sec := time.Now().Clock(); sec >= 55 {
+		time.Sleep(time.Duration(61-sec) * time.Second)
+	}
+	now := time.Now()
+	minute := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, time.Local)
+	cronWf.Spec.StartingDeadlineSeconds = nil
+	cronWf.Spec.CatchUpPolicy = v1alpha1.CatchUpAll
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: minute.Add(-3 * time.Minute)}
+	cronWf.SetSchedule(cronWf.Spec.GetScheduleWithTimezoneString())
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	testMetrics, err := metrics.New(ctx, telemetry.TestScopeName, telemetry.TestScopeName, &telemetry.Config{}, metrics.Callbacks{})
+	require.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(cronWf.Namespace),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace),
+		cronWf:      &cronWf,
+		log:         logging.RequireLoggerFromContext(ctx),
+		metrics:     testMetrics,
+	}
+	wasRun, err := woc.runOutstandingWorkflows(ctx)
+	require.NoError(t, err)
+	assert.True(t, wasRun)
+
+	wfList, err := woc.wfClient.List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfList.Items, 3)
+	var scheduledTimes []string
+	for _, wf := range wfList.Items {
+		scheduledTimes = append(scheduledTimes, wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+	}
+	assert.ElementsMatch(t, []string{
+		minute.Add(-2 * time.Minute).Format(time.RFC3339),
+		minute.Add(-time.Minute).Format(time.RFC3339),
+		minute.Format(time.RFC3339),
+	}, scheduledTimes)
+	assert.Equal(t, minute.Unix(), woc.cronWf.Status.LastScheduledTime.Unix())
+}
@@ -7,11 +7,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/expr-lang/expr"
+	"golang.org/x/oauth2"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,7 +38,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	exprenv "github.com/argoproj/argo-workflows/v3/util/expr/env"
+	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifacthttp "github.com/argoproj/argo-workflows/v3/workflow/artifacts/http"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -250,58 +261,166 @@ func (ae *AgentExecutor) executeHTTPTemplate(ctx context.Context, tmpl wfv1.Temp
 		return 0, nil
 	}
 
-	response, err := ae.executeHTTPTemplateRequest(ctx, tmpl.HTTP)
+	requester, err := ae.newHTTPRequester(ctx, tmpl.HTTP)
 	if err != nil {
 		return 0, err
 	}
-	defer response.Body.Close()
 
-	bodyBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		return 0, err
+	// the bodies of the pages are written to a file for the response artifact, rather than kept for the result
+	var out io.Writer
+	var body bytes.Buffer
+	var responseFile *os.File
+	if tmpl.HTTP.ResponseArtifact != "" {
+		responseFile, err = os.CreateTemp("", "http-response-")
+		if err != nil {
+			return 0, err
+		}
+		defer func() {
+			_ = responseFile.Close()
+			_ = os.Remove(responseFile.Name())
+		}()
+		out = responseFile
+	} else {
+		out = &body
 	}
 
-	outputs := wfv1.Outputs{Result: ptr.To(string(bodyBytes))}
 	phase := wfv1.NodeSucceeded
 	message := ""
-	if tmpl.HTTP.SuccessCondition == "" {
-		// Default success condition: StatusCode == 2xx
-		success := response.StatusCode >= 200 && response.StatusCode < 300
-		if !success {
-			phase = wfv1.NodeFailed
-			message = fmt.Sprintf("received non-2xx response code: %d", response.StatusCode)
+	pageURL := tmpl.HTTP.URL
+	for pages := 1; ; pages++ {
+		response, err := requester.do(ctx, pageURL, out)
+		if err != nil {
+			return 0, err
 		}
-	} else {
 		evalScope := map[string]interface{}{
 			"request": map[string]interface{}{
 				"method":    tmpl.HTTP.Method,
-				"url":       tmpl.HTTP.URL,
+				"url":       pageURL,
 				"body":      tmpl.HTTP.Body,
 				"bodyBytes": tmpl.HTTP.GetBodyBytes(),
 				"headers":   tmpl.HTTP.Headers.ToHeader(),
 			},
 			"response": map[string]interface{}{
-				"statusCode": response.StatusCode,
-				"body":       string(bodyBytes),
-				"headers":    response.Header,
+				"statusCode": response.statusCode,
+				"body":       response.body,
+				"headers":    response.header,
 			},
 		}
-		success, err := argoexpr.EvalBool(tmpl.HTTP.SuccessCondition, evalScope)
+		if tmpl.HTTP.SuccessCondition == "" {
+			// Default success condition: StatusCode == 2xx
+			success := response.statusCode >= 200 && response.statusCode < 300
+			if !success {
+				phase = wfv1.NodeFailed
+				message = fmt.Sprintf("received non-2xx response code: %d", response.statusCode)
+				break
+			}
+		} else {
+			success, err := argoexpr.EvalBool(tmpl.HTTP.SuccessCondition, evalScope)
+			if err != nil {
+				return 0, err
+			}
+			if !success {
+				phase = wfv1.NodeFailed
+				message = fmt.Sprintf("successCondition '%s' evaluated false", tmpl.HTTP.SuccessCondition)
+				break
+			}
+		}
+		nextURL, err := nextPageURL(tmpl.HTTP.Pagination, pageURL, evalScope, response.header)
 		if err != nil {
 			return 0, err
 		}
-		if !success {
-			phase = wfv1.NodeFailed
-			message = fmt.Sprintf("successCondition '%s' evaluated false", tmpl.HTTP.SuccessCondition)
+		if nextURL == "" || pages >= tmpl.HTTP.Pagination.GetMaxPages() {
+			break
+		}
+		pageURL = nextURL
+		if _, err := io.WriteString(out, "\n"); err != nil {
+			return 0, err
 		}
 	}
 
+	outputs := wfv1.Outputs{}
+	if responseFile == nil {
+		outputs.Result = ptr.To(body.String())
+	} else if phase == wfv1.NodeSucceeded {
+		if err := responseFile.Close(); err != nil {
+			return 0, err
+		}
+		art, err := ae.saveResponseArtifact(ctx, tmpl, responseFile.Name())
+		if err != nil {
+			return 0, err
+		}
+		outputs.Artifacts = wfv1.Artifacts{*art}
+	}
+
 	result.Phase = phase
 	result.Message = message
 	result.Outputs = &outputs
 	return 0, nil
 }
 
+// saveResponseArtifact saves the response body in the file as the response artifact of the template, to its own
+// location, or to the archive location of the template
+func (ae *AgentExecutor) saveResponseArtifact(ctx context.Context, tmpl wfv1.Template, file string) (*wfv1.Artifact, error) {
+	outputArt := tmpl.Outputs.GetArtifactByName(tmpl.HTTP.ResponseArtifact)
+	if outputArt == nil {
+		return nil, fmt.Errorf("response artifact '%s' is not an output artifact of the template", tmpl.HTTP.ResponseArtifact)
+	}
+	art := outputArt.DeepCopy()
+	if !art.HasKey() {
+		if tmpl.ArchiveLocation == nil {
+			return nil, fmt.Errorf("response artifact '%s' has no location, and there is no artifact repository", art.Name)
+		}
+		key, err := tmpl.ArchiveLocation.GetKey()
+		if err != nil {
+			return nil, err
+		}
+		artLocation, err := tmpl.ArchiveLocation.Get()
+		if err != nil {
+			return nil, err
+		}
+		if err := art.SetType(artLocation); err != nil {
+			return nil, err
+		}
+		if err := art.SetKey(path.Join(key, art.Name)); err != nil {
+			return nil, err
+		}
+	}
+	driverArt := art.DeepCopy()
+	if err := driverArt.Relocate(tmpl.ArchiveLocation); err != nil {
+		return nil, err
+	}
+	driver, err := artifacts.NewDriver(ctx, driverArt, ae)
+	if err != nil {
+		return nil, err
+	}
+	if err := driver.Save(ctx, file, driverArt); err != nil {
+		return nil, err
+	}
+	art.Digest = driverArt.Digest
+	// the body is saved as it is, rather than archived
+	art.Archive = &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}
+	return art, nil
+}
+
+// GetSecret gets the value of a key of a secret in the namespace, for artifact drivers
+func (ae *AgentExecutor) GetSecret(ctx context.Context, name, key string) (string, error) {
+	value, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, name, key)
+	return string(value), err
+}
+
+// GetConfigMapKey gets the value of a key of a config map in the namespace, for artifact drivers
+func (ae *AgentExecutor) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := ae.ClientSet.CoreV1().ConfigMaps(ae.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := configMap.Data[key]
+	if !ok {
+		return "", fmt.Errorf("configmap '%s' does not have the key '%s'", name, key)
+	}
+	return value, nil
+}
+
 var httpClientSkip = &http.Client{
 	Transport: &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -313,52 +432,276 @@ var httpClients = map[bool]*http.Client{
 	true:  httpClientSkip,
 }
 
-func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Response, error) {
-	var (
-		request *http.Request
-		err     error
-	)
-	if httpTemplate.BodyFrom != nil {
-		if httpTemplate.BodyFrom.Bytes != nil {
-			request, err = http.NewRequestWithContext(ctx, httpTemplate.Method, httpTemplate.URL, bytes.NewBuffer(httpTemplate.BodyFrom.Bytes))
-		}
-	} else {
-		request, err = http.NewRequestWithContext(ctx, httpTemplate.Method, httpTemplate.URL, bytes.NewBufferString(httpTemplate.Body))
-	}
-	if err != nil {
-		return nil, err
-	}
+// httpRequester makes the requests of an HTTP template
+type httpRequester struct {
+	template *wfv1.HTTP
+	client   *http.Client
+	header   http.Header
+	host     string
+	username string
+	password string
+	// readBody is whether the body of each response is read, to evaluate expressions with, rather than streamed
+	readBody bool
+}
 
-	if httpTemplate.TimeoutSeconds != nil {
-		ctx, cancel := context.WithTimeout(ctx, time.Duration(*httpTemplate.TimeoutSeconds)*time.Second)
-		defer cancel()
-		request = request.WithContext(ctx)
-	} else {
-		request = request.WithContext(ctx)
-	}
+// httpResponse is a response to a request of an HTTP template
+type httpResponse struct {
+	statusCode int
+	header     http.Header
+	body       string
+}
 
+func (ae *AgentExecutor) newHTTPRequester(ctx context.Context, httpTemplate *wfv1.HTTP) (*httpRequester, error) {
+	r := &httpRequester{
+		template: httpTemplate,
+		header:   make(http.Header),
+		readBody: httpTemplate.SuccessCondition != "" || (httpTemplate.Pagination != nil && httpTemplate.Pagination.Next != ""),
+	}
 	for _, header := range httpTemplate.Headers {
 		value := header.Value
 		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
-			secret, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
+			secret, err := ae.GetSecret(ctx, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
 			if err != nil {
 				return nil, err
 			}
-			value = string(secret)
+			value = secret
 		}
 		// for rewrite host header
 		if strings.ToLower(header.Name) == "host" {
-			request.Host = value
+			r.host = value
 		} else {
-			request.Header.Add(header.Name, value)
+			r.header.Add(header.Name, value)
 		}
 	}
+	if auth := httpTemplate.Auth; auth != nil && auth.BasicAuth.UsernameSecret != nil && auth.BasicAuth.PasswordSecret != nil {
+		var err error
+		if r.username, err = ae.GetSecret(ctx, auth.BasicAuth.UsernameSecret.Name, auth.BasicAuth.UsernameSecret.Key); err != nil {
+			return nil, err
+		}
+		if r.password, err = ae.GetSecret(ctx, auth.BasicAuth.PasswordSecret.Name, auth.BasicAuth.PasswordSecret.Key); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	r.client, err = ae.httpClient(ctx, httpTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
 
-	response, err := httpClients[httpTemplate.InsecureSkipVerify].Do(request)
+// httpClient returns the client for the requests of the template, which authenticates with its client certificate, and
+// OAuth2 client credentials
+func (ae *AgentExecutor) httpClient(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Client, error) {
+	client := httpClients[httpTemplate.InsecureSkipVerify]
+	auth := httpTemplate.Auth
+	if auth == nil {
+		return client, nil
+	}
+	if auth.ClientCert.ClientCertSecret != nil && auth.ClientCert.ClientKeySecret != nil {
+		clientCert, err := ae.GetSecret(ctx, auth.ClientCert.ClientCertSecret.Name, auth.ClientCert.ClientCertSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		clientKey, err := ae.GetSecret(ctx, auth.ClientCert.ClientKeySecret.Name, auth.ClientCert.ClientKeySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		client, err = artifacthttp.CreateClientWithCertificate([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, err
+		}
+		client.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = httpTemplate.InsecureSkipVerify
+	}
+	if auth.OAuth2.ClientIDSecret != nil && auth.OAuth2.ClientSecretSecret != nil && auth.OAuth2.TokenURLSecret != nil {
+		clientID, err := ae.GetSecret(ctx, auth.OAuth2.ClientIDSecret.Name, auth.OAuth2.ClientIDSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		clientSecret, err := ae.GetSecret(ctx, auth.OAuth2.ClientSecretSecret.Name, auth.OAuth2.ClientSecretSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		tokenURL, err := ae.GetSecret(ctx, auth.OAuth2.TokenURLSecret.Name, auth.OAuth2.TokenURLSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		// tokens are requested with the client, so with its client certificate
+		client = artifacthttp.CreateOauth2Client(context.WithValue(ctx, oauth2.HTTPClient, client), clientID, clientSecret, tokenURL, auth.OAuth2.Scopes, auth.OAuth2.EndpointParams)
+	}
+	return client, nil
+}
+
+// do requests the URL, writing the body of the response to out. The request is retried, as the template's retry
+// allows, if it fails to connect or receives a 5xx response code.
+func (r *httpRequester) do(ctx context.Context, pageURL string, out io.Writer) (*httpResponse, error) {
+	backoff, maxDuration, err := httpRetryBackoff(r.template.Retry)
 	if err != nil {
 		return nil, err
 	}
-	return response, nil
+	start := time.Now()
+	for retries := 0; ; retries++ {
+		delay := backoff.Step()
+		canRetry := r.template.Retry != nil && retries < int(r.template.Retry.Limit) && (maxDuration == 0 || time.Since(start)+delay <= maxDuration)
+		response, retry, err := r.request(ctx, pageURL, out, canRetry)
+		if !retry {
+			return response, err
+		}
+		logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"url": pageURL, "delay": delay}).Warn(ctx, "HTTP request failed, retrying")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// request requests the URL once, writing the body of the response to out. If canRetry, a failure to connect, or a
+// response with a 5xx status code, is returned to be retried rather than written.
+func (r *httpRequester) request(ctx context.Context, pageURL string, out io.Writer, canRetry bool) (*httpResponse, bool, error) {
+	requestCtx := ctx
+	if r.template.TimeoutSeconds != nil {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithTimeout(ctx, time.Duration(*r.template.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	var body io.Reader = strings.NewReader(r.template.Body)
+	if r.template.BodyFrom != nil {
+		body = bytes.NewReader(r.template.BodyFrom.Bytes)
+	}
+	request, err := http.NewRequestWithContext(requestCtx, r.template.Method, pageURL, body)
+	if err != nil {
+		return nil, false, err
+	}
+	request.Header = r.header.Clone()
+	if r.host != "" {
+		request.Host = r.host
+	}
+	if r.username != "" {
+		request.SetBasicAuth(r.username, r.password)
+	}
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, canRetry && ctx.Err() == nil, err
+	}
+	defer response.Body.Close()
+	if canRetry && response.StatusCode >= 500 {
+		return nil, true, fmt.Errorf("received response code: %d", response.StatusCode)
+	}
+	result := &httpResponse{statusCode: response.StatusCode, header: response.Header}
+	if !r.readBody {
+		_, err = io.Copy(out, response.Body)
+		return result, false, err
+	}
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	result.body = string(bodyBytes)
+	_, err = out.Write(bodyBytes)
+	return result, false, err
+}
+
+// httpRetryBackoff returns the backoff between the retries of a request, and the maximum duration of its retries
+func httpRetryBackoff(retry *wfv1.HTTPRetry) (wait.Backoff, time.Duration, error) {
+	backoff := wait.Backoff{Duration: time.Second, Factor: 2, Steps: math.MaxInt32}
+	if retry == nil || retry.Backoff == nil {
+		return backoff, 0, nil
+	}
+	backoff.Factor = 0
+	var err error
+	if retry.Backoff.Duration != "" {
+		if backoff.Duration, err = wfv1.ParseStringToDuration(retry.Backoff.Duration); err != nil {
+			return backoff, 0, err
+		}
+	}
+	factor, err := intstrutil.Int32(retry.Backoff.Factor)
+	if err != nil {
+		return backoff, 0, err
+	}
+	if factor != nil && *factor > 0 {
+		backoff.Factor = float64(*factor)
+	}
+	if retry.Backoff.Cap != "" {
+		if backoff.Cap, err = wfv1.ParseStringToDuration(retry.Backoff.Cap); err != nil {
+			return backoff, 0, err
+		}
+	}
+	var maxDuration time.Duration
+	if retry.Backoff.MaxDuration != "" {
+		if maxDuration, err = wfv1.ParseStringToDuration(retry.Backoff.MaxDuration); err != nil {
+			return backoff, 0, err
+		}
+	}
+	return backoff, maxDuration, nil
+}
+
+// nextPageURL returns the URL of the page after the one requested from the URL, or an empty string if it was the last.
+// It is an error for the next page to be on another scheme or host than the page.
+func nextPageURL(pagination *wfv1.HTTPPagination, pageURL string, evalScope map[string]interface{}, header http.Header) (string, error) {
+	if pagination == nil {
+		return "", nil
+	}
+	next := linkNext(header)
+	if pagination.Next != "" {
+		env := exprenv.GetFuncMap(evalScope)
+		program, err := expr.Compile(pagination.Next, expr.Env(env))
+		if err != nil {
+			return "", err
+		}
+		result, err := expr.Run(program, env)
+		if err != nil {
+			return "", fmt.Errorf("unable to evaluate expression '%s': %w", pagination.Next, err)
+		}
+		switch v := result.(type) {
+		case nil:
+			next = ""
+		case string:
+			next = v
+		default:
+			return "", fmt.Errorf("pagination.next must evaluate to a string, not a %T", result)
+		}
+	}
+	if next == "" {
+		return "", nil
+	}
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	nextURL := base.ResolveReference(ref)
+	// each page is requested with the credentials of the template, so only pages of the same origin are requested
+	if nextURL.Scheme != base.Scheme || nextURL.Host != base.Host {
+		return "", fmt.Errorf("the next page %s is not on the same scheme and host as %s", nextURL.Redacted(), base.Redacted())
+	}
+	return nextURL.String(), nil
+}
+
+// linkNext returns the URL of the Link header with the relation type "next", see RFC 8288
+func linkNext(header http.Header) string {
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			target, params, _ := strings.Cut(link, ";")
+			target = strings.TrimSpace(target)
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				key, rel, _ := strings.Cut(param, "=")
+				if !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				if slices.Contains(strings.Fields(strings.ToLower(strings.Trim(strings.TrimSpace(rel), `"`))), "next") {
+					return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				}
+			}
+		}
+	}
+	return ""
 }
 
 func (ae *AgentExecutor) executePluginTemplate(ctx context.Context, tmpl wfv1.Template, result *wfv1.NodeResult) (time.Duration, error) {
@@ -3,14 +3,23 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
@@ -98,3 +107,206 @@ func (a alwaysSucceededPlugin) ExecuteTemplate(_ context.Context, _ executorplug
 	reply.Requeue = &metav1.Duration{Duration: a.requeue}
 	return nil
 }
+
+func TestAgentHTTPTemplateRetry(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		limit         int32
+		expectPhase   v1alpha1.NodePhase
+		expectResult  string
+		expectRequest int32
+	}{
+		{name: "succeeds once retried", limit: 2, expectPhase: v1alpha1.NodeSucceeded, expectResult: "ok", expectRequest: 3},
+		{name: "fails once out of retries", limit: 1, expectPhase: v1alpha1.NodeFailed, expectResult: "", expectRequest: 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests.Store(0)
+			ctx := logging.TestContext(t.Context())
+			ae := &AgentExecutor{}
+			result := &v1alpha1.NodeResult{}
+			_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+				URL:   server.URL,
+				Retry: &v1alpha1.HTTPRetry{Limit: tc.limit, Backoff: &v1alpha1.Backoff{Duration: "1ms"}},
+			}}, result)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectPhase, result.Phase)
+			assert.Equal(t, tc.expectResult, *result.Outputs.Result)
+			assert.Equal(t, tc.expectRequest, requests.Load())
+		})
+	}
+}
+
+func TestAgentHTTPTemplatePagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		next, _ := strconv.Atoi(page)
+		next++
+		switch r.URL.Path {
+		case "/link":
+			if page != "3" {
+				w.Header().Add("Link", fmt.Sprintf(`</first>; rel="first", </link?page=%d>; rel="next"`, next))
+			}
+		case "/cursor":
+			nextURL := ""
+			if page != "3" {
+				nextURL = fmt.Sprintf("http://%s/cursor?page=%d", r.Host, next)
+			}
+			_, _ = fmt.Fprintf(w, `{"page": %q, "next": %q}`, page, nextURL)
+			return
+		}
+		_, _ = w.Write([]byte("page " + page))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		url          string
+		pagination   *v1alpha1.HTTPPagination
+		expectResult string
+	}{
+		{
+			name:         "follows the Link header",
+			url:          server.URL + "/link?page=1",
+			pagination:   &v1alpha1.HTTPPagination{},
+			expectResult: "page 1\npage 2\npage 3",
+		},
+		{
+			name:         "stops at maxPages",
+			url:          server.URL + "/link?page=1",
+			pagination:   &v1alpha1.HTTPPagination{MaxPages: 2},
+			expectResult: "page 1\npage 2",
+		},
+		{
+			name:         "follows the next expression",
+			url:          server.URL + "/cursor?page=1",
+			pagination:   &v1alpha1.HTTPPagination{Next: `jsonpath(response.body, '$.next')`},
+			expectResult: `{"page": "1", "next": "` + server.URL + `/cursor?page=2"}` + "\n" + `{"page": "2", "next": "` + server.URL + `/cursor?page=3"}` + "\n" + `{"page": "3", "next": ""}`,
+		},
+		{
+			name:         "requests a single page without pagination",
+			url:          server.URL + "/link?page=1",
+			expectResult: "page 1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			ae := &AgentExecutor{}
+			result := &v1alpha1.NodeResult{}
+			_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{HTTP: &v1alpha1.HTTP{URL: tc.url, Pagination: tc.pagination}}, result)
+			require.NoError(t, err)
+			assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase, result.Message)
+			assert.Equal(t, tc.expectResult, *result.Outputs.Result)
+		})
+	}
+}
+
+func TestAgentHTTPTemplatePaginationCrossHost(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("the page of another host was requested with the Authorization header %q", r.Header.Get("Authorization"))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Link", fmt.Sprintf(`<%s/steal>; rel="next"`, other.URL))
+		_, _ = w.Write([]byte("page 1"))
+	}))
+	defer server.Close()
+
+	ctx := logging.TestContext(t.Context())
+	ae := &AgentExecutor{}
+	result := &v1alpha1.NodeResult{}
+	_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+		URL:        server.URL,
+		Headers:    v1alpha1.HTTPHeaders{{Name: "Authorization", Value: "Bearer my-token"}},
+		Pagination: &v1alpha1.HTTPPagination{},
+	}}, result)
+	require.ErrorContains(t, err, "is not on the same scheme and host as")
+}
+
+func TestAgentHTTPTemplateResponseArtifact(t *testing.T) {
+	var saved []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			assert.Equal(t, "/artifacts/my-wf/my-wf-1234/response", r.URL.Path)
+			saved, _ = io.ReadAll(r.Body)
+		default:
+			_, _ = w.Write([]byte("a large response"))
+		}
+	}))
+	defer server.Close()
+
+	ctx := logging.TestContext(t.Context())
+	ae := &AgentExecutor{}
+	result := &v1alpha1.NodeResult{}
+	_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{
+		HTTP: &v1alpha1.HTTP{URL: server.URL, ResponseArtifact: "response"},
+		Outputs: v1alpha1.Outputs{Artifacts: v1alpha1.Artifacts{{
+			Name:             "response",
+			ArtifactLocation: v1alpha1.ArtifactLocation{HTTP: &v1alpha1.HTTPArtifact{URL: server.URL + "/artifacts/my-wf/my-wf-1234/response"}},
+		}}},
+	}, result)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase, result.Message)
+	assert.Nil(t, result.Outputs.Result)
+	require.Len(t, result.Outputs.Artifacts, 1)
+	art := result.Outputs.Artifacts[0]
+	assert.Equal(t, server.URL+"/artifacts/my-wf/my-wf-1234/response", art.HTTP.URL)
+	assert.NotNil(t, art.Archive.None)
+	assert.Equal(t, "a large response", string(saved))
+}
+
+func TestAgentHTTPTemplateOAuth2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			clientID, clientSecret, _ := r.BasicAuth()
+			assert.Equal(t, "my-client", clientID)
+			assert.Equal(t, "my-secret", clientSecret)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token": "my-token", "token_type": "bearer"}`))
+		default:
+			_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+		}
+	}))
+	defer server.Close()
+
+	ctx := logging.TestContext(t.Context())
+	ae := &AgentExecutor{
+		Namespace: "argo",
+		ClientSet: fake.NewSimpleClientset(&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "oauth2", Namespace: "argo"},
+			Data: map[string][]byte{
+				"client-id":     []byte("my-client"),
+				"client-secret": []byte("my-secret"),
+				"token-url":     []byte(server.URL + "/token"),
+			},
+		}),
+	}
+	secret := func(key string) *apiv1.SecretKeySelector {
+		return &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "oauth2"}, Key: key}
+	}
+	result := &v1alpha1.NodeResult{}
+	_, err := ae.executeHTTPTemplate(ctx, v1alpha1.Template{HTTP: &v1alpha1.HTTP{
+		URL: server.URL,
+		Auth: &v1alpha1.HTTPAuth{OAuth2: v1alpha1.OAuth2Auth{
+			ClientIDSecret:     secret("client-id"),
+			ClientSecretSecret: secret("client-secret"),
+			TokenURLSecret:     secret("token-url"),
+		}},
+	}}, result)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.NodeSucceeded, result.Phase, result.Message)
+	assert.Equal(t, "Bearer my-token", *result.Outputs.Result)
+}
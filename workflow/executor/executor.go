@@ -27,9 +27,11 @@ import (
 
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	retryutil "k8s.io/client-go/util/retry"
@@ -66,8 +68,11 @@ type WorkflowExecutor struct {
 	ClientSet           kubernetes.Interface
 	taskResultClient    argoprojv1.WorkflowTaskResultInterface
 	RESTClient          rest.Interface
-	Namespace           string
-	RuntimeExecutor     ContainerRuntimeExecutor
+	// DynamicClient and RESTMapper manage the resources of resource templates
+	DynamicClient   dynamic.Interface
+	RESTMapper      meta.RESTMapper
+	Namespace       string
+	RuntimeExecutor ContainerRuntimeExecutor
 
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
@@ -246,7 +251,9 @@ func (we *WorkflowExecutor) loadArtifacts(ctx context.Context, pluginName wfv1.A
 		if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
 			return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
 		}
-		err = artDriver.Load(ctx, driverArt, tempArtPath)
+		loadCtx, span := startArtifactSpan(ctx, "load-artifact", driverArt)
+		err = artDriver.Load(loadCtx, driverArt, tempArtPath)
+		endSpan(span, err)
 		if err != nil {
 			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
 				logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact that was not found")
@@ -395,12 +402,15 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if size == 0 {
 		logger.WithField("path", localArtPath).Warn(ctx, "The file is empty. It may not be uploaded successfully depending on the artifact driver")
 	}
-	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
+	saveCtx, span := startArtifactSpan(ctx, "save-artifact", art)
+	err = we.saveArtifactFromFile(saveCtx, art, fileName, localArtPath)
+	endSpan(span, err)
 	return err == nil, err
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
+	contentAddressed := false
 	if !art.HasKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
@@ -413,6 +423,15 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 		if err = art.SetType(artLocation); err != nil {
 			return err
 		}
+		if we.Template.ArchiveLocation.IsContentAddressed() {
+			if fi, err := os.Stat(localArtPath); err == nil && fi.Mode().IsRegular() {
+				if art.Digest, err = artifactcommon.FileDigest(localArtPath); err != nil {
+					return err
+				}
+				key = wfv1.ContentAddressedKey(art.Digest)
+				contentAddressed = true
+			}
+		}
 		if err := art.SetKey(path.Join(key, fileName)); err != nil {
 			return err
 		}
@@ -425,15 +444,45 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	if contentAddressed {
+		if existingKey := findContentAddressedObject(ctx, artDriver, driverArt); existingKey != "" {
+			logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"digest": art.Digest, "key": existingKey}).Info(ctx, "Artifact content already exists, skipping upload")
+			we.maybeDeleteLocalArtPath(ctx, localArtPath)
+			return art.SetKey(existingKey)
+		}
+	}
 	err = artDriver.Save(ctx, localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	art.Digest = driverArt.Digest
 	we.maybeDeleteLocalArtPath(ctx, localArtPath)
 	logging.RequireLoggerFromContext(ctx).WithField("path", localArtPath).Info(ctx, "Successfully saved file")
 	return nil
 }
 
+// findContentAddressedObject returns the key of an object with the same digest as the artifact, if there is one.
+// Failing to list the objects just means the artifact is uploaded again.
+func findContentAddressedObject(ctx context.Context, artDriver artifactcommon.ArtifactDriver, art *wfv1.Artifact) string {
+	prefix := art.DeepCopy()
+	if err := prefix.SetKey(wfv1.ContentAddressedKey(art.Digest)); err != nil {
+		return ""
+	}
+	keys, err := artDriver.ListObjects(ctx, prefix)
+	if err != nil {
+		if !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			logging.RequireLoggerFromContext(ctx).WithError(err).Warn(ctx, "Failed to list content-addressed artifacts")
+		}
+		return ""
+	}
+	for _, key := range keys {
+		if strings.HasPrefix(key, wfv1.ContentAddressedKey(art.Digest)+"/") {
+			return key
+		}
+	}
+	return ""
+}
+
 func (we *WorkflowExecutor) maybeDeleteLocalArtPath(ctx context.Context, localArtPath string) {
 	if os.Getenv("REMOVE_LOCAL_ART_PATH") == "true" {
 		logger := logging.RequireLoggerFromContext(ctx)
@@ -1214,11 +1263,13 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 
 	go we.monitorDeadline(ctx, containerNames)
 
+	_, span := tracer.Start(ctx, "main")
 	err := retryutil.OnError(executorretry.ExecutorRetry(ctx), func(err error) bool {
 		return errorsutil.IsTransientErr(ctx, err)
 	}, func() error {
 		return we.RuntimeExecutor.Wait(ctx, containerNames)
 	})
+	endSpan(span, err)
 
 	logger.WithError(err).Info(ctx, "Main container completed")
 
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -20,6 +21,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
@@ -589,3 +591,33 @@ func TestReportOutputs(t *testing.T) {
 	})
 
 }
+
+type listingArtifactDriver struct {
+	artifactcommon.ArtifactDriver
+	keys []string
+	err  error
+}
+
+func (d *listingArtifactDriver) ListObjects(context.Context, *wfv1.Artifact) ([]string, error) {
+	return d.keys, d.err
+}
+
+func TestFindContentAddressedObject(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	art := &wfv1.Artifact{
+		Digest:           "sha256:1",
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "cas/sha256/1/out.tgz"}},
+	}
+	t.Run("Exists", func(t *testing.T) {
+		drv := &listingArtifactDriver{keys: []string{"cas/sha256/1/other.tgz"}}
+		assert.Equal(t, "cas/sha256/1/other.tgz", findContentAddressedObject(ctx, drv, art))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		drv := &listingArtifactDriver{}
+		assert.Empty(t, findContentAddressedObject(ctx, drv, art))
+	})
+	t.Run("Error", func(t *testing.T) {
+		drv := &listingArtifactDriver{err: fmt.Errorf("access denied")}
+		assert.Empty(t, findContentAddressedObject(ctx, drv, art))
+	})
+}
@@ -5,9 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -15,15 +13,19 @@ import (
 	"github.com/itchyny/gojq"
 	"github.com/tidwall/gjson"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/client-go/util/retry"
-	"k8s.io/gengo/namer"
-	gengotypes "k8s.io/gengo/types"
-	kubectlcmd "k8s.io/kubectl/pkg/cmd"
-	kubectlutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -33,144 +35,316 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
-// ExecResource will run kubectl action against a manifest
-func (we *WorkflowExecutor) ExecResource(ctx context.Context, action string, manifestPath string, flags []string) (string, string, string, error) {
-	args, err := we.getKubectlArguments(action, manifestPath, flags)
+// defaultFieldManager is the manager of the fields of applied resources, unless the template sets another. It is also
+// the manager the Kubernetes API defaults to for the other actions, from the user agent of the executor.
+const defaultFieldManager = "argo-workflows"
+
+// clientSideFieldManagers are the managers of the fields of resources created or applied with kubectl without
+// server-side apply, such as by earlier versions of the executor
+var clientSideFieldManagers = []string{"kubectl-client-side-apply", "kubectl-create"}
+
+// ExecResource performs the action against the resource of the manifest, or the resource named by the flags, and
+// returns the resource, or nil if it was deleted or was not found. The flags are those of kubectl, which are mapped onto
+// the options of the Kubernetes API.
+func (we *WorkflowExecutor) ExecResource(ctx context.Context, action string, manifestPath string, flags []string) (*unstructured.Unstructured, error) {
+	opts, err := parseResourceFlags(action, flags)
 	if err != nil {
-		return "", "", "", err
+		return nil, err
 	}
-
-	var out []byte
+	// the flags that are also fields of the template are used as them, so that the resource is waited for the same way
+	if opts.dryRun {
+		we.Template.Resource.DryRun = true
+	}
+	if opts.fieldManager != "" && we.Template.Resource.FieldManager == "" {
+		we.Template.Resource.FieldManager = opts.fieldManager
+	}
+	var obj *unstructured.Unstructured
+	var resource dynamic.ResourceInterface
+	var patch []byte
+	if opts.resource != "" {
+		resource, obj, err = we.namedResource(opts)
+		if err == nil && action == "patch" {
+			patch, err = readPatch(manifestPath)
+		}
+	} else {
+		obj, err = we.readManifest(action, manifestPath)
+		if err == nil {
+			resource, err = we.resourceInterface(obj, opts.namespace)
+		}
+		if err == nil && action == "patch" {
+			patch, err = obj.MarshalJSON()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out *unstructured.Unstructured
 	err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
 		return argoerr.IsTransientErr(ctx, err)
 	}, func() error {
-		out, err = runKubectl(ctx, args...)
-		if err != nil {
-			return err
-		}
-		return nil
+		out, err = we.doResourceAction(ctx, resource, action, obj, patch, opts)
+		return err
 	})
 	if err != nil {
-		if exErr, ok := err.(*exec.ExitError); ok {
-			errMsg := strings.TrimSpace(string(exErr.Stderr))
-			err = errors.Wrap(err, errors.CodeBadRequest, errMsg)
-		} else {
-			err = errors.Wrap(err, errors.CodeBadRequest, err.Error())
+		if argoerr.IsTransientErrQuiet(ctx, err) {
+			err = fmt.Errorf("no more retries: %w", err)
 		}
-		err = errors.Wrap(err, errors.CodeBadRequest, "no more retries "+err.Error())
-		return "", "", "", err
+		return nil, resourceError(action, obj, err)
 	}
-	if action == "delete" {
-		return "", "", "", nil
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"action": action, "namespace": obj.GetNamespace(), "resource": resourceFullName(obj), "dryRun": we.Template.Resource.DryRun})
+	if out == nil {
+		logger.Info(ctx, "Resource deleted or not found")
+		return nil, nil
 	}
-	if action == "get" && len(out) == 0 {
-		return "", "", "", nil
+	logger.WithField("name", out.GetName()).Info(ctx, "Resource")
+	return out, nil
+}
+
+// readManifest reads the resource of the manifest
+func (we *WorkflowExecutor) readManifest(action string, manifestPath string) (*unstructured.Unstructured, error) {
+	buff, err := os.ReadFile(filepath.Clean(manifestPath))
+	if err != nil {
+		return nil, errors.New(errors.CodeBadRequest, err.Error())
 	}
-	obj := unstructured.Unstructured{}
-	err = json.Unmarshal(out, &obj)
+	if len(buff) == 0 {
+		return nil, errors.New(errors.CodeBadRequest, "Must provide at least one of flags or manifest.")
+	}
+	if action == "patch" && we.Template.Resource.MergeStrategy == "json" {
+		return nil, errors.New(errors.CodeBadRequest, "json patches do not name the resource to patch, which must be given by flags")
+	}
+	data, err := yaml.YAMLToJSON(buff)
 	if err != nil {
-		return "", "", "", err
+		return nil, errors.Errorf(errors.CodeBadRequest, "failed to parse the manifest: %v", err)
 	}
-	resourceGroup := obj.GroupVersionKind().Group
-	resourceName := obj.GetName()
-	resourceKind := obj.GroupVersionKind().Kind
-	if resourceName == "" || resourceKind == "" {
-		return "", "", "", errors.New(errors.CodeBadRequest, "Kind and name are both required but at least one of them is missing from the manifest")
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "failed to parse the manifest: %v", err)
 	}
-	resourceFullName := fmt.Sprintf("%s.%s/%s", strings.ToLower(resourceKind), resourceGroup, resourceName)
-	selfLink := inferObjectSelfLink(obj)
-	logger := logging.RequireLoggerFromContext(ctx)
-	logger.WithFields(logging.Fields{"namespace": obj.GetNamespace(), "resource": resourceFullName, "selfLink": selfLink}).Info(ctx, "Resource")
-	return obj.GetNamespace(), resourceFullName, selfLink, nil
+	if obj.GetName() == "" && (action != "create" || obj.GetGenerateName() == "") {
+		return nil, errors.New(errors.CodeBadRequest, "Kind and name are both required but at least one of them is missing from the manifest")
+	}
+	return obj, nil
 }
 
-func inferObjectSelfLink(obj unstructured.Unstructured) string {
-	gvk := obj.GroupVersionKind()
-	// This is the best guess we can do here and is what `kubectl` uses under the hood. Hopefully future versions of the
-	// REST client would remove the need to infer the plural name.
-	lowercaseNamer := namer.NewAllLowercasePluralNamer(map[string]string{})
-	pluralName := lowercaseNamer.Name(&gengotypes.Type{Name: gengotypes.Name{
-		Name: gvk.Kind,
-	}})
-
-	var selfLinkPrefix string
-	if gvk.Group == "" {
-		selfLinkPrefix = "api"
-	} else {
-		selfLinkPrefix = "apis"
+// readPatch reads the patch of the manifest, of the resource named by the flags
+func readPatch(manifestPath string) ([]byte, error) {
+	buff, err := os.ReadFile(filepath.Clean(manifestPath))
+	if err != nil {
+		return nil, errors.New(errors.CodeBadRequest, err.Error())
 	}
-	// We cannot use `obj.GetSelfLink()` directly since it is deprecated and will be removed after Kubernetes 1.21: https://github.com/kubernetes/enhancements/tree/master/keps/sig-api-machinery/1164-remove-selflink
-	var selfLink string
-	if obj.GetNamespace() == "" {
-		selfLink = fmt.Sprintf("%s/%s/%s/%s", selfLinkPrefix, obj.GetAPIVersion(), pluralName, obj.GetName())
-	} else {
-		selfLink = fmt.Sprintf("%s/%s/namespaces/%s/%s/%s",
-			selfLinkPrefix, obj.GetAPIVersion(), obj.GetNamespace(), pluralName, obj.GetName())
+	patch, err := yaml.YAMLToJSON(buff)
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "failed to parse the patch: %v", err)
 	}
-	return selfLink
+	return patch, nil
 }
 
-func (we *WorkflowExecutor) getKubectlArguments(action string, manifestPath string, flags []string) ([]string, error) {
-	buff, err := os.ReadFile(filepath.Clean(manifestPath))
+// resourceInterface returns the client of the resource of the object. Namespaced resources without a namespace are
+// defaulted to the namespace, or, if it is empty, the namespace of the workflow.
+func (we *WorkflowExecutor) resourceInterface(obj *unstructured.Unstructured, namespace string) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := we.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 	if err != nil {
-		return []string{}, errors.New(errors.CodeBadRequest, err.Error())
+		return nil, errors.Wrap(err, errors.CodeBadRequest, fmt.Sprintf("failed to find the resource of kind %s: %v", gvk, err))
 	}
-	if len(buff) == 0 && len(flags) == 0 {
-		return []string{}, errors.New(errors.CodeBadRequest, "Must provide at least one of flags or manifest.")
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return we.DynamicClient.Resource(mapping.Resource), nil
 	}
-
-	args := []string{
-		"kubectl",
-		action,
+	switch {
+	case obj.GetNamespace() == "" && namespace != "":
+		obj.SetNamespace(namespace)
+	case obj.GetNamespace() == "":
+		obj.SetNamespace(we.Namespace)
+	case namespace != "" && obj.GetNamespace() != namespace:
+		return nil, errors.Errorf(errors.CodeBadRequest, "the namespace of the manifest %s does not match the namespace %s of the flags", obj.GetNamespace(), namespace)
 	}
-	output := "json"
+	return we.DynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+}
 
-	if action == "delete" {
-		args = append(args, "--ignore-not-found")
-		output = "name"
+// namedResource returns the client of the resource named by the flags, e.g. `pod my-pod`, and the resource, with only
+// its kind and name
+func (we *WorkflowExecutor) namedResource(flags *resourceFlags) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	// like kubectl, the resource may be fully qualified, e.g. `jobs.v1.batch`, or only with its group, e.g. `jobs.batch`
+	gvr, gr := schema.ParseResourceArg(flags.resource)
+	var gvk schema.GroupVersionKind
+	err := fmt.Errorf("no matches for %s", flags.resource)
+	if gvr != nil {
+		gvk, err = we.RESTMapper.KindFor(*gvr)
 	}
+	if gvk.Empty() {
+		gvk, err = we.RESTMapper.KindFor(gr.WithVersion(""))
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errors.CodeBadRequest, fmt.Sprintf("failed to find the resource %s: %v", flags.resource, err))
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(flags.name)
+	resource, err := we.resourceInterface(obj, flags.namespace)
+	return resource, obj, err
+}
 
-	appendFileFlag := true
-	if action == "patch" {
-		mergeStrategy := "strategic"
-		if we.Template.Resource.MergeStrategy != "" {
-			mergeStrategy = we.Template.Resource.MergeStrategy
-		}
-		args = append(args, "--type")
-		args = append(args, mergeStrategy)
-
-		args = append(args, "--patch-file")
-		args = append(args, manifestPath)
-
-		// if there are flags and the manifest has no `kind`, assume: `kubectl patch <kind> <name> --patch-file <path>`
-		// json patches also use patch files by definition and so require resource arguments
-		// the other form in our case is `kubectl patch -f <path> --patch-file <path>`
-		if mergeStrategy == "json" {
-			appendFileFlag = false
-		} else {
-			var obj map[string]interface{}
-			err = yaml.Unmarshal(buff, &obj)
+// doResourceAction performs the action against the resource, with the options of the flags. The patch is the body of
+// patches.
+func (we *WorkflowExecutor) doResourceAction(ctx context.Context, resource dynamic.ResourceInterface, action string, obj *unstructured.Unstructured, patch []byte, flags *resourceFlags) (*unstructured.Unstructured, error) {
+	var dryRun []string
+	if we.Template.Resource.DryRun {
+		dryRun = []string{metav1.DryRunAll}
+	}
+	fieldManager := we.Template.Resource.FieldManager
+	// like kubectl, the dependents of deleted resources, such as the pods of a job, are deleted with them
+	deleteOptions := metav1.DeleteOptions{DryRun: dryRun, PropagationPolicy: ptr.To(metav1.DeletePropagationBackground), GracePeriodSeconds: flags.gracePeriod}
+	if flags.propagationPolicy != nil {
+		deleteOptions.PropagationPolicy = flags.propagationPolicy
+	}
+	switch action {
+	case "get":
+		out, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if apierr.IsNotFound(err) && flags.ignoreNotFound {
+			return nil, nil
+		}
+		return out, err
+	case "create":
+		return resource.Create(ctx, obj, metav1.CreateOptions{DryRun: dryRun, FieldManager: fieldManager, FieldValidation: flags.fieldValidation})
+	case "apply":
+		if fieldManager == "" {
+			fieldManager = defaultFieldManager
+		}
+		// like `kubectl apply --server-side`, fields owned by other managers are not taken from them, unless conflicts
+		// are forced, and their conflicts fail the apply, except for the fields of the resource that were created or
+		// applied without server-side apply, including by the other actions of the executor, which are migrated to the
+		// manager
+		if dryRun == nil {
+			if err := upgradeManagedFields(ctx, resource, obj.GetName(), fieldManager); err != nil {
+				return nil, err
+			}
+		}
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		return resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{DryRun: dryRun, FieldManager: fieldManager, Force: ptr.To(flags.forceConflicts), FieldValidation: flags.fieldValidation})
+	case "replace":
+		if flags.force {
+			// like `kubectl replace --force`, the resource is deleted, and created again once it no longer exists
+			if err := deleteResource(ctx, resource, obj.GetName(), deleteOptions, true); err != nil && !apierr.IsNotFound(err) {
+				return nil, err
+			}
+			replacement := obj.DeepCopy()
+			replacement.SetResourceVersion("")
+			return resource.Create(ctx, replacement, metav1.CreateOptions{FieldManager: fieldManager, FieldValidation: flags.fieldValidation})
+		}
+		return resource.Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRun, FieldManager: fieldManager, FieldValidation: flags.fieldValidation})
+	case "patch":
+		patchType := types.StrategicMergePatchType
+		switch we.Template.Resource.MergeStrategy {
+		case "merge":
+			patchType = types.MergePatchType
+		case "json":
+			patchType = types.JSONPatchType
+		}
+		return resource.Patch(ctx, obj.GetName(), patchType, patch, metav1.PatchOptions{DryRun: dryRun, FieldManager: fieldManager, FieldValidation: flags.fieldValidation})
+	case "delete":
+		names := []string{obj.GetName()}
+		if flags.selector != "" || flags.all {
+			list, err := resource.List(ctx, metav1.ListOptions{LabelSelector: flags.selector})
 			if err != nil {
-				return []string{}, errors.New(errors.CodeBadRequest, err.Error())
+				return nil, err
 			}
-			if len(flags) != 0 && obj["kind"] == nil {
-				appendFileFlag = false
+			names = nil
+			for _, item := range list.Items {
+				names = append(names, item.GetName())
 			}
 		}
+		for _, name := range names {
+			err := deleteResource(ctx, resource, name, deleteOptions, flags.wait)
+			if err != nil && (!apierr.IsNotFound(err) || !flags.ignoreNotFound) {
+				return nil, err
+			}
+		}
+		return nil, nil
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "unsupported resource action %q", action)
 	}
+}
 
-	if len(flags) != 0 {
-		args = append(args, flags...)
+// deleteResource deletes the resource, and, unless it is a dry run, waits until it is deleted, like kubectl, which waits
+// for the finalizers of the resource
+func deleteResource(ctx context.Context, resource dynamic.ResourceInterface, name string, options metav1.DeleteOptions, wait bool) error {
+	err := resource.Delete(ctx, name, options)
+	if err != nil || options.DryRun != nil || !wait {
+		return err
 	}
+	return waitDeleted(ctx, resource, name)
+}
 
-	if len(buff) != 0 && appendFileFlag {
-		args = append(args, "-f")
-		args = append(args, manifestPath)
-	}
-	args = append(args, "-o")
-	args = append(args, output)
+// upgradeManagedFields migrates the fields of the resource owned by the managers of updates with the same name as the
+// field manager, or of kubectl without server-side apply, to the field manager, like `kubectl apply --server-side`
+// does for the fields of `kubectl apply`. It does nothing if the resource does not exist.
+func upgradeManagedFields(ctx context.Context, resource dynamic.ResourceInterface, name, fieldManager string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := resource.Get(ctx, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// the patch replaces the resource version too, so it conflicts if the resource was updated since
+		patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, sets.New(clientSideFieldManagers...).Insert(fieldManager), fieldManager)
+		if err != nil || patch == nil {
+			return err
+		}
+		_, err = resource.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
 
-	return args, nil
+// waitDeleted waits until the resource no longer exists
+func waitDeleted(ctx context.Context, resource dynamic.ResourceInterface, name string) error {
+	return wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (bool, error) {
+		_, err := resource.Get(ctx, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil && !argoerr.IsTransientErr(ctx, err) {
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// resourceError returns an error with the code of the status of the error from the Kubernetes API, whose cause it
+// keeps
+func resourceError(action string, obj *unstructured.Unstructured, err error) error {
+	code := errors.CodeBadRequest
+	switch {
+	case apierr.IsNotFound(err):
+		code = errors.CodeNotFound
+	case apierr.IsForbidden(err):
+		code = errors.CodeForbidden
+	case apierr.IsUnauthorized(err):
+		code = errors.CodeUnauthorized
+	case apierr.IsTimeout(err), apierr.IsServerTimeout(err):
+		code = errors.CodeTimeout
+	case apierr.IsInternalError(err):
+		code = errors.CodeInternal
+	}
+	return errors.Wrap(err, code, fmt.Sprintf("failed to %s %s: %v", action, resourceFullName(obj), err))
+}
+
+// resourceFullName returns the name of the resource in the form of kubectl, e.g. "job.batch/my-job", or
+// "configmap/my-cm" for the core group
+func resourceFullName(obj *unstructured.Unstructured) string {
+	name := obj.GetName()
+	if name == "" {
+		name = obj.GetGenerateName()
+	}
+	kind := strings.ToLower(obj.GetKind())
+	if group := obj.GroupVersionKind().Group; group != "" {
+		kind += "." + group
+	}
+	return kind + "/" + name
 }
 
 // gjsonLabels is an implementation of labels.Labels interface
@@ -190,10 +364,11 @@ func (g gjsonLabels) Get(label string) string {
 	return gjson.GetBytes(g.json, label).String()
 }
 
-// WaitResource waits for a specific resource to satisfy either the success or failure condition
-func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace, resourceName, selfLink string) error {
+// WaitResource waits for a specific resource to satisfy either the success or failure condition. The conditions of
+// dry runs are evaluated once, against the resource returned by the server.
+func (we *WorkflowExecutor) WaitResource(ctx context.Context, obj *unstructured.Unstructured) error {
 	logger := logging.RequireLoggerFromContext(ctx)
-	if we.Template.Resource.SuccessCondition == "" && we.Template.Resource.FailureCondition == "" {
+	if obj == nil || (we.Template.Resource.SuccessCondition == "" && we.Template.Resource.FailureCondition == "") {
 		return nil
 	}
 	var successReqs labels.Requirements
@@ -215,10 +390,26 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 		logger.WithField("conditions", failSelector).Info(ctx, "Failing for conditions")
 		failReqs, _ = failSelector.Requirements()
 	}
-	err := wait.PollUntilContextCancel(ctx, envutil.LookupEnvDurationOr(ctx, "RESOURCE_STATE_CHECK_INTERVAL", time.Second*5),
+	resourceName, resourceNamespace := obj.GetName(), obj.GetNamespace()
+	if we.Template.Resource.DryRun {
+		jsonBytes, err := obj.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		notMatched, err := matchConditions(ctx, jsonBytes, successReqs, failReqs)
+		if notMatched {
+			return errors.Errorf(errors.CodeBadRequest, "success condition '%s' not matched by the dry run of %s", we.Template.Resource.SuccessCondition, resourceFullName(obj))
+		}
+		return err
+	}
+	resource, err := we.resourceInterface(obj, "")
+	if err != nil {
+		return err
+	}
+	err = wait.PollUntilContextCancel(ctx, envutil.LookupEnvDurationOr(ctx, "RESOURCE_STATE_CHECK_INTERVAL", time.Second*5),
 		true,
 		func(ctx context.Context) (bool, error) {
-			isErrRetryable, err := we.checkResourceState(ctx, selfLink, successReqs, failReqs)
+			isErrRetryable, err := checkResourceState(ctx, resource, resourceName, successReqs, failReqs)
 			if err == nil {
 				logger.WithFields(logging.Fields{"name": resourceName, "namespace": resourceNamespace}).Info(ctx, "Returning from successful wait for resource")
 				return true, nil
@@ -242,30 +433,21 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 	return nil
 }
 
-// checkResourceState performs resource status checking and then waiting on json reading.
+// checkResourceState gets the resource and matches its conditions.
 // The returning boolean indicates whether we should retry.
-func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
-	request := we.RESTClient.Get().RequestURI(selfLink)
-	stream, err := request.Stream(ctx)
-
+func checkResourceState(ctx context.Context, resource dynamic.ResourceInterface, name string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
+	obj, err := resource.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		err = errors.Cause(err)
 		if apierr.IsNotFound(err) {
 			return false, errors.Errorf(errors.CodeNotFound, "The resource has been deleted while its status was still being checked. Will not be retried: %v", err)
 		}
 		return false, err
 	}
-
-	defer func() { _ = stream.Close() }()
-	jsonBytes, err := io.ReadAll(stream)
+	jsonBytes, err := obj.MarshalJSON()
 	if err != nil {
 		return false, err
 	}
-	jsonString := string(jsonBytes)
-	logging.RequireLoggerFromContext(ctx).Debug(ctx, jsonString)
-	if !gjson.Valid(jsonString) {
-		return false, errors.Errorf(errors.CodeNotFound, "Encountered invalid JSON response when checking resource status. Will not be retried: %q", jsonString)
-	}
+	logging.RequireLoggerFromContext(ctx).Debug(ctx, string(jsonBytes))
 	return matchConditions(ctx, jsonBytes, successReqs, failReqs)
 }
 
@@ -298,19 +480,37 @@ func matchConditions(ctx context.Context, jsonBytes []byte, successReqs labels.R
 	return true, errors.Errorf(errors.CodeNotFound, "Neither success condition nor the failure condition has been matched. Retrying...")
 }
 
-// SaveResourceParameters will save any resource output parameters
-func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resourceNamespace string, resourceName string) error {
+// SaveResourceParameters will save any resource output parameters. They are taken from the resource as it is now, or
+// as it was returned by the server for dry runs.
+func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, obj *unstructured.Unstructured) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	if len(we.Template.Outputs.Parameters) == 0 {
 		logger.Info(ctx, "No output parameters")
 		return nil
 	}
 	logger.Info(ctx, "Saving resource output parameters")
+	if obj != nil && !we.Template.Resource.DryRun {
+		resource, err := we.resourceInterface(obj, "")
+		if err != nil {
+			return err
+		}
+		var current *unstructured.Unstructured
+		err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
+			return argoerr.IsTransientErr(ctx, err)
+		}, func() error {
+			current, err = resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+			return err
+		})
+		if err != nil {
+			return resourceError("get", obj, err)
+		}
+		obj = current
+	}
 	for i, param := range we.Template.Outputs.Parameters {
 		if param.ValueFrom == nil {
 			continue
 		}
-		if resourceNamespace == "" && resourceName == "" {
+		if obj == nil {
 			output := ""
 			if param.ValueFrom.Default != nil {
 				output = param.ValueFrom.Default.String()
@@ -318,28 +518,25 @@ func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resource
 			we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(output)
 			continue
 		}
-		var outputFormat string
+		var output string
+		var err error
 		if param.ValueFrom.JSONPath != "" {
-			outputFormat = fmt.Sprintf("jsonpath=%s", param.ValueFrom.JSONPath)
+			output, err = jsonPathFilter(obj, param.ValueFrom.JSONPath)
+			logger.WithError(err).WithField("out", output).WithField("jsonPath", param.ValueFrom.JSONPath).Info(ctx, "jsonpath")
 		} else if param.ValueFrom.JQFilter != "" {
-			outputFormat = "json"
+			var jsonBytes []byte
+			jsonBytes, err = obj.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			output, err = jqFilter(ctx, jsonBytes, param.ValueFrom.JQFilter)
+			logger.WithError(err).WithField("out", output).WithField("filter", param.ValueFrom.JQFilter).Info(ctx, "gojq")
 		} else {
 			continue
 		}
-		args := []string{"kubectl", "-n", resourceNamespace, "get", resourceName, "-o", outputFormat}
-		out, err := runKubectl(ctx, args...)
-		logger.WithError(err).WithField("out", string(out)).WithField("args", args).Info(ctx, "kubectl")
 		if err != nil {
 			return err
 		}
-		output := string(out)
-		if param.ValueFrom.JQFilter != "" {
-			output, err = jqFilter(ctx, out, param.ValueFrom.JQFilter)
-			logger.WithError(err).WithField("out", string(out)).WithField("filter", param.ValueFrom.JQFilter).Info(ctx, "gojq")
-			if err != nil {
-				return err
-			}
-		}
 
 		we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(output)
 		logger.WithFields(logging.Fields{"name": param.Name, "value": output}).Info(ctx, "Saved output parameter")
@@ -348,6 +545,19 @@ func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resource
 	return err
 }
 
+// jsonPathFilter evaluates the JSONPath template against the resource, the same as `kubectl get -o jsonpath=`
+func jsonPathFilter(obj *unstructured.Unstructured, template string) (string, error) {
+	j := jsonpath.New("output").AllowMissingKeys(true)
+	if err := j.Parse(template); err != nil {
+		return "", errors.Errorf(errors.CodeBadRequest, "failed to parse JSONPath %q: %v", template, err)
+	}
+	var buf bytes.Buffer
+	if err := j.Execute(&buf, obj.UnstructuredContent()); err != nil {
+		return "", errors.Errorf(errors.CodeBadRequest, "failed to execute JSONPath %q: %v", template, err)
+	}
+	return buf.String(), nil
+}
+
 func jqFilter(ctx context.Context, input []byte, filter string) (string, error) {
 	var v interface{}
 	if err := json.Unmarshal(input, &v); err != nil {
@@ -380,36 +590,3 @@ func jqFilter(ctx context.Context, input []byte, filter string) (string, error)
 	}
 	return strings.TrimSpace(buf.String()), nil
 }
-
-func runKubectl(ctx context.Context, args ...string) ([]byte, error) {
-	logging.RequireLoggerFromContext(ctx).Info(ctx, strings.Join(args, " "))
-	osArgs := append([]string{}, os.Args...)
-	os.Args = args
-	defer func() {
-		os.Args = osArgs
-	}()
-
-	var fatalErr error
-	// catch `os.Exit(1)` from kubectl
-	kubectlutil.BehaviorOnFatal(func(msg string, code int) {
-		fatalErr = errors.New(fmt.Sprint(code), msg)
-	})
-
-	var buf bytes.Buffer
-	if err := kubectlcmd.NewKubectlCommand(kubectlcmd.KubectlOptions{
-		Arguments: args,
-		// TODO(vadasambar): use `DefaultConfigFlags` variable from upstream
-		// as value for `ConfigFlags` once https://github.com/kubernetes/kubernetes/pull/120024 is merged
-		ConfigFlags: genericclioptions.NewConfigFlags(true).
-			WithDeprecatedPasswordFlag().
-			WithDiscoveryBurst(300).
-			WithDiscoveryQPS(50.0),
-		IOStreams: genericclioptions.IOStreams{Out: &buf, ErrOut: os.Stderr},
-	}).Execute(); err != nil {
-		return nil, err
-	}
-	if fatalErr != nil {
-		return nil, fatalErr
-	}
-	return buf.Bytes(), nil
-}
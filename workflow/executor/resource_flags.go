@@ -0,0 +1,237 @@
+package executor
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+)
+
+// resourceFlags are the options of a resource template given by its flags. The flags are those of kubectl, and only
+// those that can be performed with the Kubernetes API are supported.
+type resourceFlags struct {
+	// resource and name name the resource instead of the manifest, e.g. `pod my-pod` or `pod/my-pod`
+	resource string
+	name     string
+	// namespace is the namespace of resources without one, instead of the namespace of the workflow
+	namespace string
+	// selector and all select the resources to delete
+	selector string
+	all      bool
+	// fieldValidation is how the server validates the fields of the manifest
+	fieldValidation string
+	// force deletes the resource immediately, or deletes and re-creates the resource to replace it
+	force             bool
+	gracePeriod       *int64
+	propagationPolicy *metav1.DeletionPropagation
+	// wait waits until deleted resources no longer exist
+	wait           bool
+	ignoreNotFound bool
+	fieldManager   string
+	// forceConflicts takes the ownership of the fields of other managers whose values conflict with an apply
+	forceConflicts bool
+	dryRun         bool
+}
+
+// parseResourceFlags returns the options of the action given by the flags, or an error for flags that are not
+// supported, or are not supported by the action
+func parseResourceFlags(action string, flags []string) (*resourceFlags, error) {
+	f := &resourceFlags{wait: true, ignoreNotFound: action == "delete"}
+	var args []string
+	for i := 0; i < len(flags); i++ {
+		arg := flags[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			args = append(args, arg)
+			continue
+		}
+		var name, value string
+		var hasValue bool
+		if strings.HasPrefix(arg, "--") {
+			name, value, hasValue = strings.Cut(arg[2:], "=")
+		} else {
+			// short flags may be followed by their value, e.g. `-lapp=foo`, `-l=app=foo` or `-l app=foo`
+			name, value = arg[1:2], strings.TrimPrefix(arg[2:], "=")
+			hasValue = len(arg) > 2
+			name = map[string]string{"l": "selector", "n": "namespace", "o": "output"}[name]
+		}
+		// the value of the flag, from the next argument if it is not given with the flag
+		nextValue := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 == len(flags) {
+				return "", errors.Errorf(errors.CodeBadRequest, "flag %s needs a value", arg)
+			}
+			i++
+			return flags[i], nil
+		}
+		// the value of a boolean flag, which can only be given with the flag, e.g. `--wait=false`
+		boolValue := func() (bool, error) {
+			if !hasValue {
+				return true, nil
+			}
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return false, errors.Errorf(errors.CodeBadRequest, "invalid value of flag %s", arg)
+			}
+			return b, nil
+		}
+		only := func(actions ...string) error {
+			if !slices.Contains(actions, action) {
+				return errors.Errorf(errors.CodeBadRequest, "flag %s is not supported by the %s action", arg, action)
+			}
+			return nil
+		}
+		var err error
+		switch name {
+		case "namespace":
+			f.namespace, err = nextValue()
+		case "selector":
+			if err = only("delete"); err == nil {
+				f.selector, err = nextValue()
+			}
+		case "all":
+			if err = only("delete"); err == nil {
+				f.all, err = boolValue()
+			}
+		case "output":
+			// the resource is always returned by the Kubernetes API, so the output format does not matter
+			var output string
+			if output, err = nextValue(); err == nil && !slices.Contains([]string{"json", "yaml", "name"}, output) {
+				err = errors.Errorf(errors.CodeBadRequest, "output format %q is not supported", output)
+			}
+		case "validate":
+			if err = only("create", "apply", "replace", "patch"); err == nil {
+				f.fieldValidation, err = fieldValidation(value)
+			}
+		case "force":
+			if err = only("delete", "replace"); err == nil {
+				f.force, err = boolValue()
+			}
+		case "grace-period":
+			if err = only("delete", "replace"); err == nil {
+				var gracePeriod int64
+				if value, err = nextValue(); err == nil {
+					if gracePeriod, err = strconv.ParseInt(value, 10, 64); err != nil {
+						err = errors.Errorf(errors.CodeBadRequest, "invalid value of flag %s", arg)
+					} else if gracePeriod >= 0 {
+						f.gracePeriod = ptr.To(gracePeriod)
+					}
+				}
+			}
+		case "cascade":
+			if err = only("delete", "replace"); err == nil {
+				f.propagationPolicy, err = propagationPolicy(value)
+			}
+		case "wait":
+			if err = only("delete", "replace"); err == nil {
+				f.wait, err = boolValue()
+			}
+		case "ignore-not-found":
+			if err = only("get", "delete"); err == nil {
+				f.ignoreNotFound, err = boolValue()
+			}
+		case "field-manager":
+			if err = only("create", "apply", "replace", "patch"); err == nil {
+				f.fieldManager, err = nextValue()
+			}
+		case "force-conflicts":
+			if err = only("apply"); err == nil {
+				f.forceConflicts, err = boolValue()
+			}
+		case "server-side":
+			// applies are always server-side
+			var serverSide bool
+			if err = only("apply"); err == nil {
+				if serverSide, err = boolValue(); err == nil && !serverSide {
+					err = errors.New(errors.CodeBadRequest, "client-side applies are not supported")
+				}
+			}
+		case "dry-run":
+			switch value {
+			case "server":
+				f.dryRun = true
+			case "none":
+			default:
+				err = errors.Errorf(errors.CodeBadRequest, "flag %s is not supported, only --dry-run=server is", arg)
+			}
+		default:
+			err = errors.Errorf(errors.CodeBadRequest, "flag %s is not supported", arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := f.setResource(action, args); err != nil {
+		return nil, err
+	}
+	// like kubectl, a grace period of 0 is only immediate if forced
+	if f.force && f.gracePeriod == nil {
+		f.gracePeriod = ptr.To(int64(0))
+	} else if !f.force && f.gracePeriod != nil && *f.gracePeriod == 0 {
+		f.gracePeriod = ptr.To(int64(1))
+	}
+	if action == "replace" && f.force && f.dryRun {
+		return nil, errors.New(errors.CodeBadRequest, "forced replaces cannot be dry runs")
+	}
+	return f, nil
+}
+
+// setResource sets the resource named by the arguments, e.g. `pod my-pod` or `pod/my-pod`, or selected by the selector
+func (f *resourceFlags) setResource(action string, args []string) error {
+	switch len(args) {
+	case 0:
+	case 1:
+		f.resource, f.name, _ = strings.Cut(args[0], "/")
+	case 2:
+		if strings.Contains(args[0], "/") {
+			return errors.Errorf(errors.CodeBadRequest, "only one resource can be named, not %s", strings.Join(args, " "))
+		}
+		f.resource, f.name = args[0], args[1]
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "only one resource can be named, not %s", strings.Join(args, " "))
+	}
+	if f.resource != "" && !slices.Contains([]string{"get", "patch", "delete"}, action) {
+		return errors.Errorf(errors.CodeBadRequest, "the %s action cannot name the resource with flags, it is named by the manifest", action)
+	}
+	selected := f.selector != "" || f.all
+	switch {
+	case selected && f.resource == "":
+		return errors.New(errors.CodeBadRequest, "the type of the resources to delete must be given with the selector, e.g. `configmap --selector app=foo`")
+	case selected && f.name != "":
+		return errors.New(errors.CodeBadRequest, "a resource cannot be both named and selected")
+	case !selected && f.resource != "" && f.name == "":
+		return errors.Errorf(errors.CodeBadRequest, "the name of the %s must be given", f.resource)
+	}
+	return nil
+}
+
+// fieldValidation returns the field validation of the value of the --validate flag
+func fieldValidation(value string) (string, error) {
+	switch value {
+	case "", "true", "strict":
+		return metav1.FieldValidationStrict, nil
+	case "warn":
+		return metav1.FieldValidationWarn, nil
+	case "false", "ignore":
+		return metav1.FieldValidationIgnore, nil
+	}
+	return "", errors.Errorf(errors.CodeBadRequest, "invalid value of flag --validate=%s", value)
+}
+
+// propagationPolicy returns the propagation policy of the value of the --cascade flag
+func propagationPolicy(value string) (*metav1.DeletionPropagation, error) {
+	switch value {
+	case "", "true", "background":
+		return ptr.To(metav1.DeletePropagationBackground), nil
+	case "foreground":
+		return ptr.To(metav1.DeletePropagationForeground), nil
+	case "false", "orphan":
+		return ptr.To(metav1.DeletePropagationOrphan), nil
+	}
+	return nil, errors.Errorf(errors.CodeBadRequest, "invalid value of flag --cascade=%s", value)
+}
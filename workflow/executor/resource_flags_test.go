@@ -0,0 +1,67 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseResourceFlags(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		action string
+		flags  []string
+		want   *resourceFlags
+		err    string
+	}{
+		{name: "None", action: "create", want: &resourceFlags{wait: true}},
+		{name: "DeleteIgnoresNotFound", action: "delete", want: &resourceFlags{wait: true, ignoreNotFound: true}},
+		{name: "Namespace", action: "create", flags: []string{"-n", "foo"}, want: &resourceFlags{namespace: "foo", wait: true}},
+		{name: "NamespaceWithValue", action: "create", flags: []string{"--namespace=foo"}, want: &resourceFlags{namespace: "foo", wait: true}},
+		{name: "Output", action: "get", flags: []string{"-o", "json", "--output=yaml", "-oname"}, want: &resourceFlags{wait: true}},
+		{name: "UnsupportedOutput", action: "get", flags: []string{"-o", "wide"}, err: `output format "wide" is not supported`},
+		{name: "Validate", action: "apply", flags: []string{"--validate"}, want: &resourceFlags{fieldValidation: metav1.FieldValidationStrict, wait: true}},
+		{name: "NoValidate", action: "create", flags: []string{"--validate=false"}, want: &resourceFlags{fieldValidation: metav1.FieldValidationIgnore, wait: true}},
+		{name: "ValidateWarn", action: "replace", flags: []string{"--validate=warn"}, want: &resourceFlags{fieldValidation: metav1.FieldValidationWarn, wait: true}},
+		{name: "InvalidValidate", action: "create", flags: []string{"--validate=maybe"}, err: "invalid value of flag --validate=maybe"},
+		{name: "NamedDelete", action: "delete", flags: []string{"pod", "my-pod"}, want: &resourceFlags{resource: "pod", name: "my-pod", wait: true, ignoreNotFound: true}},
+		{name: "SlashNamedGet", action: "get", flags: []string{"pod/my-pod"}, want: &resourceFlags{resource: "pod", name: "my-pod", wait: true}},
+		{name: "Selector", action: "delete", flags: []string{"pods", "-l", "app=foo"}, want: &resourceFlags{resource: "pods", selector: "app=foo", wait: true, ignoreNotFound: true}},
+		{name: "SelectorWithValue", action: "delete", flags: []string{"pods", "-lapp=foo"}, want: &resourceFlags{resource: "pods", selector: "app=foo", wait: true, ignoreNotFound: true}},
+		{name: "All", action: "delete", flags: []string{"pods", "--all", "--wait=false"}, want: &resourceFlags{resource: "pods", all: true, ignoreNotFound: true}},
+		{name: "SelectorWithoutType", action: "delete", flags: []string{"--selector", "app=foo"}, err: "the type of the resources to delete must be given with the selector, e.g. `configmap --selector app=foo`"},
+		{name: "SelectorAndName", action: "delete", flags: []string{"pod/my-pod", "-l", "app=foo"}, err: "a resource cannot be both named and selected"},
+		{name: "SelectorNotDelete", action: "get", flags: []string{"pods", "-l", "app=foo"}, err: "flag -l is not supported by the get action"},
+		{name: "MissingName", action: "get", flags: []string{"pod"}, err: "the name of the pod must be given"},
+		{name: "NamedCreate", action: "create", flags: []string{"pod/my-pod"}, err: "the create action cannot name the resource with flags, it is named by the manifest"},
+		{name: "TooManyNames", action: "delete", flags: []string{"pod", "a", "b"}, err: "only one resource can be named, not pod a b"},
+		{name: "Force", action: "delete", flags: []string{"pod/my-pod", "--force"}, want: &resourceFlags{resource: "pod", name: "my-pod", force: true, gracePeriod: ptr.To(int64(0)), wait: true, ignoreNotFound: true}},
+		{name: "GracePeriod", action: "delete", flags: []string{"pod/my-pod", "--grace-period", "30"}, want: &resourceFlags{resource: "pod", name: "my-pod", gracePeriod: ptr.To(int64(30)), wait: true, ignoreNotFound: true}},
+		{name: "UnforcedGracePeriod", action: "delete", flags: []string{"pod/my-pod", "--grace-period=0"}, want: &resourceFlags{resource: "pod", name: "my-pod", gracePeriod: ptr.To(int64(1)), wait: true, ignoreNotFound: true}},
+		{name: "DefaultGracePeriod", action: "delete", flags: []string{"pod/my-pod", "--grace-period=-1"}, want: &resourceFlags{resource: "pod", name: "my-pod", wait: true, ignoreNotFound: true}},
+		{name: "Cascade", action: "delete", flags: []string{"pod/my-pod", "--cascade=orphan"}, want: &resourceFlags{resource: "pod", name: "my-pod", propagationPolicy: ptr.To(metav1.DeletePropagationOrphan), wait: true, ignoreNotFound: true}},
+		{name: "NoIgnoreNotFound", action: "delete", flags: []string{"pod/my-pod", "--ignore-not-found=false"}, want: &resourceFlags{resource: "pod", name: "my-pod", wait: true}},
+		{name: "ForceConflicts", action: "apply", flags: []string{"--server-side", "--force-conflicts", "--field-manager", "foo"}, want: &resourceFlags{forceConflicts: true, fieldManager: "foo", wait: true}},
+		{name: "ClientSideApply", action: "apply", flags: []string{"--server-side=false"}, err: "client-side applies are not supported"},
+		{name: "ForceConflictsNotApply", action: "create", flags: []string{"--force-conflicts"}, err: "flag --force-conflicts is not supported by the create action"},
+		{name: "DryRun", action: "create", flags: []string{"--dry-run=server"}, want: &resourceFlags{dryRun: true, wait: true}},
+		{name: "ClientDryRun", action: "create", flags: []string{"--dry-run=client"}, err: "flag --dry-run=client is not supported, only --dry-run=server is"},
+		{name: "ForcedDryRunReplace", action: "replace", flags: []string{"--force", "--dry-run=server"}, err: "forced replaces cannot be dry runs"},
+		{name: "MissingValue", action: "create", flags: []string{"--namespace"}, err: "flag --namespace needs a value"},
+		{name: "Unsupported", action: "create", flags: []string{"--save-config"}, err: "flag --save-config is not supported"},
+		{name: "UnsupportedShort", action: "create", flags: []string{"-f", "manifest.yaml"}, err: "flag -f is not supported"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseResourceFlags(tt.action, tt.flags)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,131 +1,35 @@
 package executor
 
 import (
+	"context"
 	"os"
-	"path"
-	"runtime"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
 
-// TestResourceFlags tests whether Resource Flags
-// are properly passed to `kubectl` command
-func TestResourceFlags(t *testing.T) {
-	manifestPath := "../../examples/hello-world.yaml"
-	fakeClientset := fake.NewSimpleClientset()
-	fakeFlags := []string{"--fake=true"}
-
-	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
-
-	template := wfv1.Template{
-		Resource: &wfv1.ResourceTemplate{
-			Action: "fake",
-			Flags:  fakeFlags,
-		},
-	}
-
-	we := WorkflowExecutor{
-		PodName:         fakePodName,
-		Template:        template,
-		ClientSet:       fakeClientset,
-		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mockRuntimeExecutor,
-	}
-	args, err := we.getKubectlArguments("fake", manifestPath, fakeFlags)
-	require.NoError(t, err)
-	assert.Contains(t, args, fakeFlags[0])
-
-	_, err = we.getKubectlArguments("fake", manifestPath, nil)
-	require.NoError(t, err)
-	_, err = we.getKubectlArguments("fake", "unknown-location", fakeFlags)
-	if runtime.GOOS == "windows" {
-		require.EqualError(t, err, "open unknown-location: The system cannot find the file specified.")
-	} else {
-		require.EqualError(t, err, "open unknown-location: no such file or directory")
-	}
-
-	emptyFile, err := os.CreateTemp("/tmp", "empty-manifest")
-	require.NoError(t, err)
-	defer func() { _ = os.Remove(emptyFile.Name()) }()
-	_, err = we.getKubectlArguments("fake", emptyFile.Name(), nil)
-	require.EqualError(t, err, "Must provide at least one of flags or manifest.")
-}
-
-// TestResourcePatchFlags tests whether Resource Flags
-// are properly passed to `kubectl patch` command
-func TestResourcePatchFlags(t *testing.T) {
-	fakeFlags := []string{"pod", "mypod"}
-	fakeClientset := fake.NewSimpleClientset()
-	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
-
-	tests := []struct {
-		name           string
-		patchType      string
-		appendFileFlag bool
-		manifestPath   string
-	}{
-		{
-			name:           "strategic -f --patch-file",
-			patchType:      "strategic",
-			appendFileFlag: true,
-			manifestPath:   "../../examples/hello-world.yaml", // any YAML with a `kind`
-		},
-		{
-			name:           "json --patch-file",
-			patchType:      "json",
-			appendFileFlag: false,
-			manifestPath:   "../../.golangci.yml", // any YAML without a `kind`
-		},
-		{
-			name:           "merge --patch-file",
-			patchType:      "merge",
-			appendFileFlag: false,
-			manifestPath:   "../../.golangci.yml", // any YAML without a `kind`
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			expectedArgs := []string{"kubectl", "patch", "--type", tt.patchType, "--patch-file", tt.manifestPath}
-			expectedArgs = append(expectedArgs, fakeFlags...)
-			if tt.appendFileFlag {
-				expectedArgs = append(expectedArgs, "-f", tt.manifestPath)
-			}
-			expectedArgs = append(expectedArgs, "-o", "json")
-
-			template := wfv1.Template{
-				Resource: &wfv1.ResourceTemplate{
-					Action:        "patch",
-					Flags:         fakeFlags,
-					MergeStrategy: tt.patchType,
-				},
-			}
-			we := WorkflowExecutor{
-				PodName:         fakePodName,
-				Template:        template,
-				ClientSet:       fakeClientset,
-				Namespace:       fakeNamespace,
-				RuntimeExecutor: &mockRuntimeExecutor,
-			}
-			args, err := we.getKubectlArguments("patch", tt.manifestPath, fakeFlags)
-
-			require.NoError(t, err)
-			assert.Equal(t, expectedArgs, args)
-		})
-	}
-}
-
 // TestResourceConditionsMatching tests whether the JSON response match
 // with either success or failure conditions.
 func TestResourceConditionsMatching(t *testing.T) {
@@ -157,70 +61,350 @@ func TestResourceConditionsMatching(t *testing.T) {
 	assert.True(t, finished)
 }
 
-// TestInferSelfLink tests whether the inferred self link for k8s objects are correct.
-func TestInferSelfLink(t *testing.T) {
-	obj := unstructured.Unstructured{}
-	obj.SetNamespace("test-namespace")
-	obj.SetName("test-name")
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "",
-		Version: "v1",
-		Kind:    "Pod",
-	})
-	assert.Equal(t, "api/v1/namespaces/test-namespace/pods/test-name", inferObjectSelfLink(obj))
+// newResourceExecutor returns an executor of the resource template whose resources are the objects
+func newResourceExecutor(t *testing.T, resource *wfv1.ResourceTemplate, objects ...runtime.Object) (*WorkflowExecutor, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	return &WorkflowExecutor{
+		PodName:          fakePodName,
+		Template:         wfv1.Template{Resource: resource},
+		ClientSet:        fake.NewSimpleClientset(),
+		DynamicClient:    dynamicClient,
+		RESTMapper:       mapper,
+		Namespace:        fakeNamespace,
+		RuntimeExecutor:  &mocks.ContainerRuntimeExecutor{},
+		taskResultClient: argofake.NewSimpleClientset().ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace),
+	}, dynamicClient
+}
 
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "test.group",
-		Version: "v1",
-		Kind:    "TestKind",
-	})
-	assert.Equal(t, "apis/test.group/v1/namespaces/test-namespace/testkinds/test-name", inferObjectSelfLink(obj))
+func writeManifest(t *testing.T, manifest string) string {
+	t.Helper()
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(manifest), 0o600))
+	return manifestPath
+}
 
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "test.group",
-		Version: "v1",
-		Kind:    "Duty",
-	})
-	assert.Equal(t, "apis/test.group/v1/namespaces/test-namespace/duties/test-name", inferObjectSelfLink(obj))
+func newJob(name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("batch/v1")
+	obj.SetKind("Job")
+	obj.SetNamespace(fakeNamespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+// optionsRecorder records the options of the last delete and patch
+type optionsRecorder struct {
+	dynamic.ResourceInterface
+	deleteOptions metav1.DeleteOptions
+	patchOptions  metav1.PatchOptions
+}
 
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "test.group",
-		Version: "v1",
-		Kind:    "IngressGateway",
+func (r *optionsRecorder) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	r.deleteOptions = options
+	return r.ResourceInterface.Delete(ctx, name, options, subresources...)
+}
+
+func (r *optionsRecorder) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.patchOptions = options
+	return r.ResourceInterface.Patch(ctx, name, pt, data, options, subresources...)
+}
+
+var jobGVR = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+
+// TestExecResource tests the actions of resource templates against the Kubernetes API
+func TestExecResource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we, dynamicClient := newResourceExecutor(t, &wfv1.ResourceTemplate{})
+	jobs := dynamicClient.Resource(jobGVR).Namespace(fakeNamespace)
+	manifest := writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+  labels:
+    app: foo
+`)
+
+	t.Run("Create", func(t *testing.T) {
+		obj, err := we.ExecResource(ctx, "create", manifest, nil)
+		require.NoError(t, err)
+		assert.Equal(t, fakeNamespace, obj.GetNamespace(), "defaulted to the namespace of the workflow")
+		_, err = jobs.Get(ctx, "my-job", metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("CreateExisting", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "create", manifest, nil)
+		require.EqualError(t, err, `failed to create job.batch/my-job: jobs.batch "my-job" already exists`)
+		assert.True(t, apierr.IsAlreadyExists(errors.Cause(err)))
+	})
+	t.Run("Get", func(t *testing.T) {
+		obj, err := we.ExecResource(ctx, "get", manifest, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "my-job", obj.GetName())
+	})
+	t.Run("Apply", func(t *testing.T) {
+		// the fake client cannot apply unstructured resources, so the request is checked instead
+		dynamicClient.PrependReactor("patch", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patch := action.(k8stesting.PatchAction)
+			if patch.GetPatchType() != types.ApplyPatchType {
+				return false, nil, nil
+			}
+			obj := &unstructured.Unstructured{}
+			return true, obj, obj.UnmarshalJSON(patch.GetPatch())
+		})
+		obj, err := we.ExecResource(ctx, "apply", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+  labels:
+    app: bar
+`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"app": "bar"}, obj.GetLabels())
+	})
+	t.Run("ApplyOverClientSideApply", func(t *testing.T) {
+		job := newJob("csa-job", map[string]string{"app": "foo"})
+		job.SetResourceVersion("1")
+		job.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "batch/v1", FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}}}`)}},
+			// created by an earlier step
+			{Manager: "argo-workflows", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "batch/v1", FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{".":{}}}}`)}},
+			{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "batch/v1", FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{}}`)}},
+		})
+		_, err := jobs.Create(ctx, job, metav1.CreateOptions{})
+		require.NoError(t, err)
+		_, err = we.ExecResource(ctx, "apply", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: csa-job
+  labels:
+    app: bar
+`), nil)
+		require.NoError(t, err)
+		job, err = jobs.Get(ctx, "csa-job", metav1.GetOptions{})
+		require.NoError(t, err)
+		var managers []string
+		for _, entry := range job.GetManagedFields() {
+			managers = append(managers, entry.Manager+"/"+string(entry.Operation))
+		}
+		assert.ElementsMatch(t, []string{"argo-workflows/Apply", "kube-controller-manager/Update"}, managers, "the fields created or applied client-side are migrated to the field manager, so they do not conflict")
+	})
+	t.Run("ApplyConflict", func(t *testing.T) {
+		dynamicClient.PrependReactor("patch", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierr.NewApplyConflict([]metav1.StatusCause{{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl": .metadata.labels.app`}}, `Apply failed with 1 conflict: conflict with "kubectl": .metadata.labels.app`)
+		})
+		defer func() { dynamicClient.ReactionChain = dynamicClient.ReactionChain[1:] }()
+		_, err := we.ExecResource(ctx, "apply", manifest, nil)
+		require.EqualError(t, err, `failed to apply job.batch/my-job: Apply failed with 1 conflict: conflict with "kubectl": .metadata.labels.app`)
+		assert.True(t, apierr.IsConflict(errors.Cause(err)))
+	})
+	t.Run("Patch", func(t *testing.T) {
+		we.Template.Resource.MergeStrategy = "merge"
+		defer func() { we.Template.Resource.MergeStrategy = "" }()
+		obj, err := we.ExecResource(ctx, "patch", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+  labels:
+    team: baz
+`), nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"app": "foo", "team": "baz"}, obj.GetLabels())
+	})
+	t.Run("JSONPatch", func(t *testing.T) {
+		we.Template.Resource.MergeStrategy = "json"
+		defer func() { we.Template.Resource.MergeStrategy = "" }()
+		_, err := we.ExecResource(ctx, "patch", writeManifest(t, `- op: remove
+  path: /metadata/labels/team
+`), nil)
+		require.EqualError(t, err, "json patches do not name the resource to patch, which must be given by flags")
+	})
+	t.Run("Delete", func(t *testing.T) {
+		// the fake client does not record the options of deletes
+		recorder := &optionsRecorder{ResourceInterface: jobs}
+		obj, err := we.doResourceAction(ctx, recorder, "delete", newJob("my-job", nil), nil, &resourceFlags{wait: true})
+		require.NoError(t, err)
+		assert.Nil(t, obj)
+		assert.Equal(t, ptr.To(metav1.DeletePropagationBackground), recorder.deleteOptions.PropagationPolicy)
+		_, err = jobs.Get(ctx, "my-job", metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err))
+	})
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "delete", manifest, nil)
+		require.NoError(t, err)
+	})
+	t.Run("GetNotFound", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "get", manifest, nil)
+		require.Error(t, err)
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
 	})
-	assert.Equal(t, "apis/test.group/v1/namespaces/test-namespace/ingressgateways/test-name", inferObjectSelfLink(obj))
+	t.Run("UnknownKind", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "create", writeManifest(t, `apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: foo
+`), nil)
+		require.ErrorContains(t, err, "failed to find the resource of kind example.com/v1, Kind=Unknown")
+	})
+	t.Run("MissingName", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "apply", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  generateName: my-job-
+`), nil)
+		require.EqualError(t, err, "Kind and name are both required but at least one of them is missing from the manifest")
+	})
+}
 
-	obj.SetNamespace("")
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "",
-		Version: "v1",
-		Kind:    "Namespace",
+// TestExecResourceFlags tests the flags of resource templates, which are mapped onto the Kubernetes API
+func TestExecResourceFlags(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we, dynamicClient := newResourceExecutor(t, &wfv1.ResourceTemplate{},
+		newJob("my-job", map[string]string{"app": "foo"}),
+		newJob("other-job", map[string]string{"app": "foo"}),
+		newJob("kept-job", map[string]string{"app": "bar"}),
+	)
+	jobs := dynamicClient.Resource(jobGVR).Namespace(fakeNamespace)
+	noManifest := writeManifest(t, "")
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		we.Template.Resource.MergeStrategy = "json"
+		defer func() { we.Template.Resource.MergeStrategy = "" }()
+		obj, err := we.ExecResource(ctx, "patch", writeManifest(t, `- op: add
+  path: /metadata/labels/team
+  value: baz
+`), []string{"job", "my-job"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"app": "foo", "team": "baz"}, obj.GetLabels())
+	})
+	t.Run("Get", func(t *testing.T) {
+		obj, err := we.ExecResource(ctx, "get", noManifest, []string{"jobs.batch/my-job", "-n", fakeNamespace})
+		require.NoError(t, err)
+		assert.Equal(t, "my-job", obj.GetName())
+	})
+	t.Run("GetIgnoreNotFound", func(t *testing.T) {
+		obj, err := we.ExecResource(ctx, "get", noManifest, []string{"job/missing", "--ignore-not-found"})
+		require.NoError(t, err)
+		assert.Nil(t, obj)
+		_, err = we.ExecResource(ctx, "get", noManifest, []string{"job/missing"})
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		opts, err := parseResourceFlags("patch", []string{"--validate=false"})
+		require.NoError(t, err)
+		// the fake client does not record the options of patches
+		recorder := &optionsRecorder{ResourceInterface: jobs}
+		_, err = we.doResourceAction(ctx, recorder, "patch", newJob("my-job", nil), []byte(`{}`), opts)
+		require.NoError(t, err)
+		assert.Equal(t, metav1.FieldValidationIgnore, recorder.patchOptions.FieldValidation)
+	})
+	t.Run("DeleteSelected", func(t *testing.T) {
+		obj, err := we.ExecResource(ctx, "delete", noManifest, []string{"jobs", "--selector", "app=foo", "--cascade=foreground"})
+		require.NoError(t, err)
+		assert.Nil(t, obj)
+		list, err := jobs.List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "kept-job", list.Items[0].GetName())
+	})
+	t.Run("NamespaceMismatch", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "create", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+  namespace: other
+`), []string{"-n", fakeNamespace})
+		require.EqualError(t, err, "the namespace of the manifest other does not match the namespace "+fakeNamespace+" of the flags")
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := we.ExecResource(ctx, "create", noManifest, []string{"--record"})
+		require.EqualError(t, err, "flag --record is not supported")
 	})
-	assert.Equal(t, "api/v1/namespaces/test-name", inferObjectSelfLink(obj))
+}
 
+func TestResourceFullName(t *testing.T) {
+	assert.Equal(t, "job.batch/my-job", resourceFullName(newJob("my-job", nil)))
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetGenerateName("my-cm-")
+	assert.Equal(t, "configmap/my-cm-", resourceFullName(cm))
 }
 
 // TestResourceExecRetry tests whether Exec retries transitive errors
 func TestResourceExecRetry(t *testing.T) {
-	we := WorkflowExecutor{
-		PodName:         fakePodName,
-		Template:        wfv1.Template{},
-		ClientSet:       fake.NewSimpleClientset(),
-		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mocks.ContainerRuntimeExecutor{},
-	}
+	we, dynamicClient := newResourceExecutor(t, &wfv1.ResourceTemplate{})
+	attempts := 0
+	dynamicClient.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		attempts++
+		return true, nil, apierr.NewServerTimeout(jobGVR.GroupResource(), "create", 0)
+	})
 
-	_, filename, _, _ := runtime.Caller(0)
-	dirname := path.Dir(filename)
 	duration := retry.DefaultBackoff.Duration
 	defer func() {
 		retry.DefaultBackoff.Duration = duration
 	}()
 	retry.DefaultBackoff.Duration = 0
-	t.Setenv("PATH", dirname+"/testdata")
 	ctx := logging.TestContext(t.Context())
-	_, _, _, err := we.ExecResource(ctx, "", "../../examples/hello-world.yaml", nil)
+	_, err := we.ExecResource(ctx, "create", writeManifest(t, `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: my-job
+`), nil)
 	require.ErrorContains(t, err, "no more retries")
+	assert.True(t, errors.IsCode(errors.CodeTimeout, err))
+	assert.Equal(t, retry.DefaultBackoff.Steps, attempts)
+}
+
+// TestWaitResource tests whether the conditions are evaluated against the resource, or the resource returned by
+// dry runs
+func TestWaitResource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	job := newJob("my-job", nil)
+	require.NoError(t, unstructured.SetNestedField(job.Object, int64(1), "status", "succeeded"))
+
+	t.Run("Succeeded", func(t *testing.T) {
+		we, _ := newResourceExecutor(t, &wfv1.ResourceTemplate{SuccessCondition: "status.succeeded > 0"}, job.DeepCopy())
+		require.NoError(t, we.WaitResource(ctx, newJob("my-job", nil)))
+	})
+	t.Run("Failed", func(t *testing.T) {
+		we, _ := newResourceExecutor(t, &wfv1.ResourceTemplate{SuccessCondition: "status.failed > 0", FailureCondition: "status.succeeded > 0"}, job.DeepCopy())
+		require.EqualError(t, we.WaitResource(ctx, newJob("my-job", nil)), "failure condition '{status.succeeded gt [0]}' evaluated true")
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		we, _ := newResourceExecutor(t, &wfv1.ResourceTemplate{SuccessCondition: "status.succeeded > 0"})
+		require.ErrorContains(t, we.WaitResource(ctx, newJob("my-job", nil)), "The resource has been deleted while its status was still being checked")
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		we, _ := newResourceExecutor(t, &wfv1.ResourceTemplate{SuccessCondition: "status.succeeded > 0", DryRun: true})
+		require.NoError(t, we.WaitResource(ctx, job))
+		require.EqualError(t, we.WaitResource(ctx, newJob("my-job", nil)), "success condition 'status.succeeded > 0' not matched by the dry run of job.batch/my-job")
+	})
+}
+
+// TestSaveResourceParameters tests whether output parameters are taken from the resource
+func TestSaveResourceParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we, _ := newResourceExecutor(t, &wfv1.ResourceTemplate{}, newJob("my-job", map[string]string{"app": "foo"}))
+	we.Template.Outputs.Parameters = []wfv1.Parameter{
+		{Name: "name", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.metadata.name}"}},
+		{Name: "missing", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.status.succeeded}"}},
+		{Name: "labels", ValueFrom: &wfv1.ValueFrom{JQFilter: ".metadata.labels"}},
+	}
+	require.NoError(t, we.SaveResourceParameters(ctx, newJob("my-job", nil)))
+	assert.Equal(t, "my-job", we.Template.Outputs.Parameters[0].Value.String())
+	assert.Empty(t, we.Template.Outputs.Parameters[1].Value.String())
+	assert.Equal(t, `{"app":"foo"}`, we.Template.Outputs.Parameters[2].Value.String())
+
+	we.Template.Outputs.Parameters = []wfv1.Parameter{
+		{Name: "name", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.metadata.name}", Default: wfv1.AnyStringPtr("none")}},
+	}
+	require.NoError(t, we.SaveResourceParameters(ctx, nil))
+	assert.Equal(t, "none", we.Template.Outputs.Parameters[0].Value.String())
 }
 
 func Test_jqFilter(t *testing.T) {
@@ -240,10 +424,3 @@ func Test_jqFilter(t *testing.T) {
 		})
 	}
 }
-
-func Test_runKubectl(t *testing.T) {
-	ctx := logging.TestContext(t.Context())
-	out, err := runKubectl(ctx, "kubectl", "version", "--client=true", "--output", "json")
-	require.NoError(t, err)
-	assert.Contains(t, string(out), "clientVersion")
-}
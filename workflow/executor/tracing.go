@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// tracer uses the global provider, which is configured by the argoexec command (see cmd/argoexec/executor.InitTracing)
+var tracer = otel.Tracer("github.com/argoproj/argo-workflows/v3/workflow/executor")
+
+// startArtifactSpan starts a span for loading or saving a single artifact
+func startArtifactSpan(ctx context.Context, name string, art *wfv1.Artifact) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("argo.artifact.name", art.Name),
+		attribute.String("argo.artifact.path", art.Path),
+	}
+	if key, err := art.GetKey(); err == nil {
+		attrs = append(attrs, attribute.String("argo.artifact.key", key))
+	}
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan ends a span, recording err if there was one
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
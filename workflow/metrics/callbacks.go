@@ -5,4 +5,5 @@ type Callbacks struct {
 	WorkflowPhase     WorkflowPhaseCallback
 	WorkflowCondition WorkflowConditionCallback
 	IsLeader          IsLeaderCallback
+	FairShare         FairShareCallback
 }
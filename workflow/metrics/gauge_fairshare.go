@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// TenantUsage is the usage of a fair-share tenant
+type TenantUsage struct {
+	Running int64
+	Pending int64
+	Share   float64
+}
+
+// FairShareCallback is the function prototype to provide these gauges with the usage of each fair-share tenant
+type FairShareCallback func(ctx context.Context) map[string]TenantUsage
+
+type fairShareGauge struct {
+	callback         FairShareCallback
+	observeUsage     func(ctx context.Context, o metric.Observer, val float64, tenant string)
+	observeWorkflows func(ctx context.Context, o metric.Observer, val int64, tenant string, workflowPhase string)
+}
+
+func addFairShareGauges(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentFairshareUsage)
+	if err != nil {
+		return err
+	}
+	err = m.CreateBuiltinInstrument(telemetry.InstrumentFairshareWorkflows)
+	if err != nil {
+		return err
+	}
+
+	if m.callbacks.FairShare != nil {
+		fsGauge := fairShareGauge{
+			callback:         m.callbacks.FairShare,
+			observeUsage:     m.ObserveFairshareUsage,
+			observeWorkflows: m.ObserveFairshareWorkflows,
+		}
+		err = m.GetInstrument(telemetry.InstrumentFairshareUsage.Name()).RegisterCallback(m.Metrics, fsGauge.updateUsage)
+		if err != nil {
+			return err
+		}
+		return m.GetInstrument(telemetry.InstrumentFairshareWorkflows.Name()).RegisterCallback(m.Metrics, fsGauge.updateWorkflows)
+	}
+	return nil
+}
+
+func (g *fairShareGauge) updateUsage(ctx context.Context, o metric.Observer) error {
+	for tenant, usage := range g.callback(ctx) {
+		g.observeUsage(ctx, o, usage.Share, tenant)
+	}
+	return nil
+}
+
+func (g *fairShareGauge) updateWorkflows(ctx context.Context, o metric.Observer) error {
+	for tenant, usage := range g.callback(ctx) {
+		g.observeWorkflows(ctx, o, usage.Running, tenant, string(WorkflowRunning))
+		g.observeWorkflows(ctx, o, usage.Pending, tenant, string(WorkflowPending))
+	}
+	return nil
+}
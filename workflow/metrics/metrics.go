@@ -57,6 +57,7 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addLogCounter,
 		addK8sRequests,
 		addWorkflowConditionGauge,
+		addFairShareGauges,
 		addWorkQueueMetrics,
 	)
 	if err != nil {
@@ -2,6 +2,8 @@ package progress
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -10,7 +12,7 @@ import (
 // UpdateProgress ensures the workflow's progress is updated with the individual node progress.
 // This func can perform any repair work needed
 func UpdateProgress(ctx context.Context, wf *wfv1.Workflow) {
-	wf.Status.Progress = wfv1.ProgressZero
+	var total progressSum
 	// We loop over all executable nodes first, otherwise sum will be wrong.
 	for nodeID, node := range wf.Status.Nodes {
 		if !executable(node.Type) {
@@ -28,12 +30,24 @@ func UpdateProgress(ctx context.Context, wf *wfv1.Workflow) {
 		// if the node has finished successfully, then we can just set progress complete
 		switch node.Phase {
 		case wfv1.NodeSucceeded, wfv1.NodeSkipped, wfv1.NodeOmitted:
+			if node.ProgressEstimated {
+				// an estimate completes in the units of the default progress
+				node.Progress = wfv1.ProgressDefault
+				node.ProgressEstimated = false
+			}
 			node.Progress = node.Progress.Complete()
 			wf.Status.Nodes.Set(ctx, nodeID, node)
+		case wfv1.NodeRunning:
+			if progress, ok := estimatedProgress(node); ok && progress != node.Progress {
+				node.Progress = progress
+				node.ProgressEstimated = true
+				wf.Status.Nodes.Set(ctx, nodeID, node)
+			}
 		}
 		// the total should only contain node that are valid
-		wf.Status.Progress = wf.Status.Progress.Add(node.Progress)
+		total.add(node)
 	}
+	wf.Status.Progress = total.progress()
 	// For non-executable nodes, we sum up the children.
 	// It is quite possible for a succeeded node to contain failed children (e.g. continues-on failed flag is set)
 	// so it is possible for the sum progress to be "1/2" (for example)
@@ -41,7 +55,7 @@ func UpdateProgress(ctx context.Context, wf *wfv1.Workflow) {
 		if executable(node.Type) {
 			continue
 		}
-		progress := sumProgress(ctx, wf, node, make(map[string]bool))
+		progress := sumProgress(ctx, wf, node, make(map[string]bool)).progress()
 		if progress.IsValid() {
 			node.Progress = progress
 			wf.Status.Nodes.Set(ctx, nodeID, node)
@@ -55,16 +69,68 @@ func UpdateProgress(ctx context.Context, wf *wfv1.Workflow) {
 // It maybe that this type of node never gets progress.
 func executable(nodeType wfv1.NodeType) bool {
 	switch nodeType {
-	case wfv1.NodeTypePod, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin, wfv1.NodeTypeContainer, wfv1.NodeTypeSuspend:
+	case wfv1.NodeTypePod, wfv1.NodeTypeHTTP, wfv1.NodeTypePlugin, wfv1.NodeTypeContainer, wfv1.NodeTypeSuspend, wfv1.NodeTypeChildWorkflow:
 		return true
 	default:
 		return false
 	}
 }
 
-func sumProgress(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[string]bool) wfv1.Progress {
+// estimatedProgress returns the progress of a running node from the time elapsed against its estimated duration,
+// counted in seconds. Progress reported by the node itself (e.g. by the executor) is never overwritten.
+func estimatedProgress(node wfv1.NodeStatus) (wfv1.Progress, bool) {
+	estimated := int64(node.EstimatedDuration)
+	if estimated < 1 || node.StartedAt.IsZero() {
+		return "", false
+	}
+	if node.Progress != wfv1.ProgressDefault && !node.ProgressEstimated {
+		return "", false
+	}
+	elapsed := int64(time.Since(node.StartedAt.Time).Seconds())
+	// never report complete until the node actually completes
+	return wfv1.NewProgress(min(max(elapsed, 0), estimated-1), estimated)
+}
+
+// estimateResolution is how many parts of a unit of the default progress the fraction of an estimate is counted in
+const estimateResolution = 100
+
+// progressSum sums the progress of nodes. An estimate is counted in seconds, so it is counted as its fraction of a
+// unit of the default progress, and a node with an estimated duration does not outweigh the others. To keep that
+// fraction, the sum is kept in hundredths of units.
+type progressSum struct {
+	n, m int64
+}
+
+func (s *progressSum) add(node wfv1.NodeStatus) {
+	if !node.Progress.IsValid() {
+		return
+	}
+	if node.ProgressEstimated {
+		s.n += node.Progress.N() * estimateResolution * wfv1.ProgressDefault.M() / node.Progress.M()
+		s.m += estimateResolution * wfv1.ProgressDefault.M()
+		return
+	}
+	s.n += node.Progress.N() * estimateResolution
+	s.m += node.Progress.M() * estimateResolution
+}
+
+// progress returns the sum, in the largest units it can be, so that a sum without estimates is in the units of the
+// progress of its nodes
+func (s progressSum) progress() wfv1.Progress {
+	unit := gcd(gcd(s.n, s.m), estimateResolution)
+	return wfv1.Progress(fmt.Sprintf("%v/%v", s.n/unit, s.m/unit))
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func sumProgress(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[string]bool) progressSum {
 	logger := logging.RequireLoggerFromContext(ctx)
-	progress := wfv1.ProgressZero
+	var sum progressSum
 	for _, childNodeID := range node.Children {
 		if visited[childNodeID] {
 			continue
@@ -76,13 +142,12 @@ func sumProgress(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, v
 			logger.WithField("childNodeID", childNodeID).Warn(ctx, "Couldn't obtain child, panicking")
 			continue
 		}
-		progress = progress.Add(sumProgress(ctx, wf, *child, visited))
+		childSum := sumProgress(ctx, wf, *child, visited)
+		sum.n += childSum.n
+		sum.m += childSum.m
 		if executable(child.Type) {
-			v := child.Progress
-			if v.IsValid() {
-				progress = progress.Add(v)
-			}
+			sum.add(*child)
 		}
 	}
-	return progress
+	return sum
 }
@@ -2,6 +2,7 @@ package progress
 
 import (
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
@@ -50,3 +51,82 @@ func Test_executes(t *testing.T) {
 	assert.True(t, executable(wfv1.NodeTypeHTTP))
 	assert.True(t, executable(wfv1.NodeTypePlugin))
 }
+
+func TestUpdaterEstimatedProgress(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	started := metav1.NewTime(time.Now().Add(-time.Hour))
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"overdue":   wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 100},
+				"reported":  wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 100, Progress: wfv1.Progress("3/4")},
+				"succeeded": wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 100, Progress: wfv1.Progress("99/100"), ProgressEstimated: true},
+				"pending":   wfv1.NodeStatus{Phase: wfv1.NodePending, Type: wfv1.NodeTypePod, EstimatedDuration: 100},
+				"seconds":   wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 100, Progress: wfv1.Progress("30/100")},
+			},
+		},
+	}
+
+	UpdateProgress(ctx, wf)
+
+	nodes := wf.Status.Nodes
+	assert.Equal(t, wfv1.Progress("99/100"), nodes["overdue"].Progress, "running pod is estimated, but never complete")
+	assert.True(t, nodes["overdue"].ProgressEstimated)
+	assert.Equal(t, wfv1.Progress("3/4"), nodes["reported"].Progress, "reported progress is unchanged")
+	assert.False(t, nodes["reported"].ProgressEstimated)
+	assert.Equal(t, wfv1.Progress("1/1"), nodes["succeeded"].Progress, "succeeded pod is completed in the units of the default progress")
+	assert.False(t, nodes["succeeded"].ProgressEstimated)
+	assert.Equal(t, wfv1.Progress("0/1"), nodes["pending"].Progress, "pending pod is not estimated")
+	assert.Equal(t, wfv1.Progress("30/100"), nodes["seconds"].Progress, "reported progress in as many units as the estimate is unchanged")
+	assert.False(t, nodes["seconds"].ProgressEstimated)
+}
+
+func TestUpdaterMixedEstimatedProgress(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	started := metav1.NewTime(time.Now().Add(-time.Minute))
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"completed": wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod, Progress: wfv1.ProgressDefault},
+				"estimated": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 120},
+				"finished":  wfv1.NodeStatus{Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 120, Progress: wfv1.Progress("119/120"), ProgressEstimated: true},
+				"reported":  wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, Progress: wfv1.Progress("1/2")},
+				"dag":       wfv1.NodeStatus{Children: []string{"completed", "estimated", "finished", "reported"}},
+			},
+		},
+	}
+
+	UpdateProgress(ctx, wf)
+
+	nodes := wf.Status.Nodes
+	assert.Equal(t, wfv1.Progress("1/1"), nodes["completed"].Progress)
+	assert.Equal(t, int64(120), nodes["estimated"].Progress.M(), "running pod is estimated in seconds")
+	assert.Equal(t, wfv1.Progress("1/1"), nodes["finished"].Progress)
+	assert.Equal(t, wfv1.Progress("7/10"), nodes["dag"].Progress, "half of the estimate is counted in the units of the default progress")
+	assert.Equal(t, wfv1.Progress("7/10"), wf.Status.Progress)
+}
+
+func TestUpdaterHalfEstimatedProgress(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	started := metav1.NewTime(time.Now().Add(-30 * time.Minute))
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"estimated": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Type: wfv1.NodeTypePod, StartedAt: started, EstimatedDuration: 3600},
+				"pending":   wfv1.NodeStatus{Phase: wfv1.NodePending, Type: wfv1.NodeTypePod},
+				"dag":       wfv1.NodeStatus{Children: []string{"estimated", "pending"}},
+			},
+		},
+	}
+
+	UpdateProgress(ctx, wf)
+
+	nodes := wf.Status.Nodes
+	assert.Equal(t, int64(3600), nodes["estimated"].Progress.M())
+	assert.True(t, nodes["estimated"].ProgressEstimated)
+	assert.Equal(t, wfv1.Progress("1/4"), nodes["dag"].Progress, "half of the estimated pod is counted")
+	assert.Equal(t, wfv1.Progress("1/4"), wf.Status.Progress)
+}
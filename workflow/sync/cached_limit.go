@@ -2,20 +2,28 @@ package sync
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
 type limitProvider interface {
 	get(ctx context.Context, key string) (int, bool, error)
+	// refresh fetches the limit if it has expired, without needing the lock of the manager
+	refresh(ctx context.Context, key string) error
+	// cached returns the limit without fetching it, and whether it has changed since it was last got
+	cached() (int, bool)
 }
 
 var _ limitProvider = &cachedLimit{}
 
 type cachedLimit struct {
+	mutex          sync.Mutex
 	limit          int
 	limitTimestamp time.Time
 	TTL            time.Duration
 	getter         GetSyncLimit
+	// changed is whether the limit has changed since it was last got
+	changed bool
 }
 
 func newCachedLimit(getter GetSyncLimit, TTL time.Duration) *cachedLimit {
@@ -28,17 +36,42 @@ func newCachedLimit(getter GetSyncLimit, TTL time.Duration) *cachedLimit {
 }
 
 func (c *cachedLimit) get(ctx context.Context, key string) (int, bool, error) {
-	changed := false
-	if nowFn().Sub(c.limitTimestamp) >= c.TTL {
-		limit, err := c.getter(ctx, key)
-		if err != nil {
-			return c.limit, false, err
-		}
-		if limit != c.limit {
-			c.limit = limit
-			changed = true
-		}
-		c.limitTimestamp = nowFn()
+	if err := c.refresh(ctx, key); err != nil {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		return c.limit, false, err
 	}
-	return c.limit, changed, nil
+	limit, changed := c.cached()
+	return limit, changed, nil
+}
+
+func (c *cachedLimit) cached() (int, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	changed := c.changed
+	c.changed = false
+	return c.limit, changed
+}
+
+// refresh fetches the limit, e.g. from its ConfigMap, once the cached limit has expired. Only the cached limit is
+// guarded, so the limit can be fetched before taking the lock of the manager, rather than while holding it.
+func (c *cachedLimit) refresh(ctx context.Context, key string) error {
+	c.mutex.Lock()
+	expired := nowFn().Sub(c.limitTimestamp) >= c.TTL
+	c.mutex.Unlock()
+	if !expired {
+		return nil
+	}
+	limit, err := c.getter(ctx, key)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if limit != c.limit {
+		c.limit = limit
+		c.changed = true
+	}
+	c.limitTimestamp = nowFn()
+	return nil
 }
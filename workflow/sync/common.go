@@ -17,8 +17,13 @@ type semaphore interface {
 	getName() string
 	getLimit(ctx context.Context) int // Testing only
 	probeWaiting(ctx context.Context)
+	// refreshLimit fetches the limit if it has expired, and is called without holding the lock of the manager
+	refreshLimit(ctx context.Context)
 	lock(ctx context.Context) bool
 	unlock(ctx context.Context)
+	// preempt marks the holder as preempted, returning false if the lock does not support preemption
+	// or the holder of a previous preemption has not yet released it
+	preempt(ctx context.Context, holderKey string) bool
 }
 
 // expose for overriding in tests
@@ -88,6 +88,12 @@ func (s *databaseSemaphore) getLimit(ctx context.Context) int {
 	return limit
 }
 
+func (s *databaseSemaphore) refreshLimit(ctx context.Context) {
+	if err := s.limitGetter.refresh(ctx, s.shortDBKey); err != nil {
+		s.logger(ctx).WithField("name", s.name).WithError(err).Error(ctx, "Failed to get limit")
+	}
+}
+
 func (s *databaseSemaphore) currentState(ctx context.Context, session db.Session, held bool) ([]string, error) {
 	logger := s.logger(ctx)
 	states, err := s.queries.GetCurrentState(ctx, session, s.longDBKey(), held)
@@ -426,3 +432,9 @@ func (s *databaseSemaphore) probeWaiting(ctx context.Context) {
 	s.notifyWaiters(ctx)
 	s.expireLocks(ctx)
 }
+
+// preempt is not supported, as the holders may belong to other controllers, and preemption policies on database locks
+// are rejected by validation
+func (s *databaseSemaphore) preempt(_ context.Context, _ string) bool {
+	return false
+}
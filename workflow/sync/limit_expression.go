@@ -0,0 +1,76 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/expr-lang/expr"
+)
+
+// LimitFacts are the controller-known facts a semaphore limit expression can refer to
+type LimitFacts struct {
+	// Running returns the number of Running workflows in a namespace
+	Running func(namespace string) (int, error)
+	// ConfigMapValue returns the value of a key in a ConfigMap
+	ConfigMapValue func(ctx context.Context, namespace, name, key string) (string, error)
+}
+
+// LimitExpressionPrefix marks a semaphore limit as an expression rather than an integer
+const LimitExpressionPrefix = "expr:"
+
+// ParseSyncLimit parses the value configured for a semaphore limit in the namespace of the semaphore.
+// Values prefixed with LimitExpressionPrefix are evaluated as an expression that must return a number, any other
+// value must be an integer.
+func ParseSyncLimit(ctx context.Context, value, namespace string, facts LimitFacts) (int, error) {
+	expression, isExpression := strings.CutPrefix(value, LimitExpressionPrefix)
+	if !isExpression {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid semaphore limit '%s', it must be an integer or an expression prefixed with '%s': %w", value, LimitExpressionPrefix, err)
+		}
+		return limit, nil
+	}
+	now := nowFn()
+	env := map[string]interface{}{
+		"now":       now,
+		"hour":      now.Hour(),
+		"minute":    now.Minute(),
+		"weekday":   now.Weekday().String(),
+		"namespace": namespace,
+		"running": func(namespace string) (int, error) {
+			if facts.Running == nil {
+				return 0, fmt.Errorf("running workflow count is not available")
+			}
+			return facts.Running(namespace)
+		},
+		"configMap": func(name, key string) (string, error) {
+			if facts.ConfigMapValue == nil {
+				return "", fmt.Errorf("ConfigMap values are not available")
+			}
+			return facts.ConfigMapValue(ctx, namespace, name, key)
+		},
+	}
+	program, err := expr.Compile(strings.TrimSpace(expression), expr.Env(env))
+	if err != nil {
+		return 0, fmt.Errorf("invalid semaphore limit expression '%s': %w", value, err)
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return 0, fmt.Errorf("unable to evaluate semaphore limit expression '%s': %w", value, err)
+	}
+	var limit float64
+	switch v := result.(type) {
+	case int:
+		limit = float64(v)
+	case int64:
+		limit = float64(v)
+	case float64:
+		limit = v
+	default:
+		return 0, fmt.Errorf("semaphore limit expression '%s' returned %v, not a number", value, result)
+	}
+	return int(max(math.Floor(limit), 0)), nil
+}
@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestParseSyncLimit(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// a Tuesday
+	previousNow := mockNow
+	t.Cleanup(func() { mockNow = previousNow })
+	mockNow = time.Date(2025, 1, 7, 10, 30, 0, 0, time.UTC)
+	facts := LimitFacts{
+		Running: func(namespace string) (int, error) {
+			if namespace == "other" {
+				return 0, fmt.Errorf("no such namespace")
+			}
+			return 3, nil
+		},
+		ConfigMapValue: func(_ context.Context, namespace, name, key string) (string, error) {
+			return fmt.Sprintf("%d", len(namespace+name+key)), nil
+		},
+	}
+	tests := []struct {
+		name       string
+		value      string
+		expected   int
+		errMessage string
+	}{
+		{"Integer", "5", 5, ""},
+		{"Typo", "1O", 0, "invalid semaphore limit '1O', it must be an integer or an expression prefixed with 'expr:'"},
+		{"UnmarkedExpression", `hour >= 9 ? 2 : 10`, 0, "invalid semaphore limit"},
+		{"BusinessHours", `expr: hour >= 9 && hour < 17 ? 2 : 10`, 2, ""},
+		{"Weekday", `expr:weekday in ["Saturday", "Sunday"] ? 10 : 1`, 1, ""},
+		{"WorkingHours", "expr: weekday not in ['Saturday', 'Sunday'] && hour >= 9 && hour < 17 ? 2 : 10", 2, ""},
+		{"Now", `expr: now.Minute()`, 30, ""},
+		{"Running", `expr: max(10 - running(namespace), 1)`, 7, ""},
+		{"ConfigMap", `expr: int(configMap("cm", "key")) * 2`, 20, ""},
+		{"Float", `expr: 7 / 2`, 3, ""},
+		{"Negative", `expr: hour - 20`, 0, ""},
+		{"Invalid", `expr: hour >=`, 0, "invalid semaphore limit expression"},
+		{"NotNumber", `expr: "five"`, 0, "not a number"},
+		{"FactError", `expr: running("other")`, 0, "no such namespace"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, err := ParseSyncLimit(ctx, tt.value, "my-ns", facts)
+			if tt.errMessage != "" {
+				require.ErrorContains(t, err, tt.errMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, limit)
+		})
+	}
+	t.Run("MissingFacts", func(t *testing.T) {
+		_, err := ParseSyncLimit(ctx, `expr: running(namespace)`, "my-ns", LimitFacts{})
+		require.ErrorContains(t, err, "not available")
+	})
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	"time"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/sync"
 	mock "github.com/stretchr/testify/mock"
@@ -273,6 +274,98 @@ func (_c *Throttler_ResetNamespaceParallelism_Call) RunAndReturn(run func(namesp
 	return _c
 }
 
+// TenantUsage provides a mock function for the type Throttler
+func (_mock *Throttler) TenantUsage() map[string]sync.TenantUsage {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantUsage")
+	}
+
+	var r0 map[string]sync.TenantUsage
+	if returnFunc, ok := ret.Get(0).(func() map[string]sync.TenantUsage); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]sync.TenantUsage)
+		}
+	}
+	return r0
+}
+
+// Throttler_TenantUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantUsage'
+type Throttler_TenantUsage_Call struct {
+	*mock.Call
+}
+
+// TenantUsage is a helper method to define mock.On call
+func (_e *Throttler_Expecter) TenantUsage() *Throttler_TenantUsage_Call {
+	return &Throttler_TenantUsage_Call{Call: _e.mock.On("TenantUsage")}
+}
+
+func (_c *Throttler_TenantUsage_Call) Run(run func()) *Throttler_TenantUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Throttler_TenantUsage_Call) Return(stringToTenantUsage map[string]sync.TenantUsage) *Throttler_TenantUsage_Call {
+	_c.Call.Return(stringToTenantUsage)
+	return _c
+}
+
+func (_c *Throttler_TenantUsage_Call) RunAndReturn(run func() map[string]sync.TenantUsage) *Throttler_TenantUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFairShare provides a mock function for the type Throttler
+func (_mock *Throttler) UpdateFairShare(fairShare *config.FairShareConfig, labels sync.LabelsFunc) {
+	_mock.Called(fairShare, labels)
+	return
+}
+
+// Throttler_UpdateFairShare_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFairShare'
+type Throttler_UpdateFairShare_Call struct {
+	*mock.Call
+}
+
+// UpdateFairShare is a helper method to define mock.On call
+//   - fairShare *config.FairShareConfig
+//   - labels sync.LabelsFunc
+func (_e *Throttler_Expecter) UpdateFairShare(fairShare interface{}, labels interface{}) *Throttler_UpdateFairShare_Call {
+	return &Throttler_UpdateFairShare_Call{Call: _e.mock.On("UpdateFairShare", fairShare, labels)}
+}
+
+func (_c *Throttler_UpdateFairShare_Call) Run(run func(fairShare *config.FairShareConfig, labels sync.LabelsFunc)) *Throttler_UpdateFairShare_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *config.FairShareConfig
+		if args[0] != nil {
+			arg0 = args[0].(*config.FairShareConfig)
+		}
+		var arg1 sync.LabelsFunc
+		if args[1] != nil {
+			arg1 = args[1].(sync.LabelsFunc)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Throttler_UpdateFairShare_Call) Return() *Throttler_UpdateFairShare_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Throttler_UpdateFairShare_Call) RunAndReturn(run func(fairShare *config.FairShareConfig, labels sync.LabelsFunc)) *Throttler_UpdateFairShare_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateNamespaceParallelism provides a mock function for the type Throttler
 func (_mock *Throttler) UpdateNamespaceParallelism(namespace string, limit int) {
 	_mock.Called(namespace, limit)
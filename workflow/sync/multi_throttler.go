@@ -7,6 +7,7 @@ import (
 
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -27,11 +28,28 @@ type Throttler interface {
 	UpdateNamespaceParallelism(namespace string, limit int)
 	// ResetNamespaceParallelism sets the namespace parallelism to the default value
 	ResetNamespaceParallelism(namespace string)
+	// UpdateFairShare enables weighted fair-share admission between tenants, or disables it if not enabled.
+	// The labels func is used to find the tenant of a workflow from its labels.
+	UpdateFairShare(fairShare *config.FairShareConfig, labels LabelsFunc)
+	// TenantUsage returns the usage of each tenant, it is empty unless fair-share is enabled
+	TenantUsage() map[string]TenantUsage
 }
 
 type Key = string
 type QueueFunc func(Key)
 
+// LabelsFunc returns the labels of the workflow, or nil if it is not found
+type LabelsFunc func(Key) map[string]string
+
+// TenantUsage is the usage of a fair-share tenant
+type TenantUsage struct {
+	Running int
+	Pending int
+	// Share is the fraction of the running workflows the tenant has, divided by the fraction its weight entitles it to,
+	// so 1 is a fair share, less than 1 is under its share, and more than 1 is over its share
+	Share float64
+}
+
 // NewMultiThrottler creates a new multi throttler for throttling both namespace and global parallelism, a parallelism value of zero disables throttling
 func NewMultiThrottler(parallelism int, namespaceParallelismLimit int, queue QueueFunc) Throttler {
 	namespaceParallelism := make(map[string]int)
@@ -42,6 +60,7 @@ func NewMultiThrottler(parallelism int, namespaceParallelismLimit int, queue Que
 		totalParallelism:            parallelism,
 		running:                     make(map[Key]bool),
 		pending:                     make(map[string]*priorityQueue),
+		tenants:                     make(map[Key]string),
 		lock:                        &sync.Mutex{},
 	}
 }
@@ -53,7 +72,11 @@ type multiThrottler struct {
 	totalParallelism            int
 	running                     map[Key]bool
 	pending                     map[string]*priorityQueue
-	lock                        *sync.Mutex
+	fairShare                   *config.FairShareConfig
+	labels                      LabelsFunc
+	// tenants of the running and pending items, only populated if fair-share is enabled
+	tenants map[Key]string
+	lock    *sync.Mutex
 }
 
 func (m *multiThrottler) Init(wfs []wfv1.Workflow) error {
@@ -70,6 +93,9 @@ func (m *multiThrottler) Init(wfs []wfv1.Workflow) error {
 			return err
 		}
 		keys = append(keys, key)
+		if m.fairShare.IsEnabled() {
+			m.tenants[key] = m.tenantOf(wf.Namespace, wf.Labels)
+		}
 	}
 
 	for _, key := range keys {
@@ -78,6 +104,35 @@ func (m *multiThrottler) Init(wfs []wfv1.Workflow) error {
 	return nil
 }
 
+func (m *multiThrottler) tenantOf(namespace string, labels map[string]string) string {
+	if m.fairShare.TenantLabel != "" {
+		if tenant := labels[m.fairShare.TenantLabel]; tenant != "" {
+			return tenant
+		}
+	}
+	return namespace
+}
+
+func (m *multiThrottler) updateTenant(key Key) {
+	if !m.fairShare.IsEnabled() {
+		return
+	}
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	var labels map[string]string
+	if m.labels != nil {
+		labels = m.labels(key)
+	}
+	m.tenants[key] = m.tenantOf(namespace, labels)
+}
+
+func (m *multiThrottler) tenant(key Key) string {
+	if tenant, ok := m.tenants[key]; ok {
+		return tenant
+	}
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	return namespace
+}
+
 func (m *multiThrottler) namespaceCount(namespace string) (int, int) {
 	setLimit, has := m.namespaceParallelism[namespace]
 	if !has {
@@ -122,6 +177,7 @@ func (m *multiThrottler) Add(key Key, priority int32, creationTime time.Time) {
 	}
 
 	m.pending[namespace].add(key, priority, creationTime)
+	m.updateTenant(key)
 	m.queueThrottled()
 }
 
@@ -143,6 +199,7 @@ func (m *multiThrottler) Remove(key Key) {
 
 	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
 	delete(m.running, key)
+	delete(m.tenants, key)
 	_, ok := m.pending[namespace]
 	if ok {
 		m.pending[namespace].remove(key)
@@ -170,11 +227,96 @@ func (m *multiThrottler) ResetNamespaceParallelism(namespace string) {
 	delete(m.namespaceParallelism, namespace)
 }
 
+func (m *multiThrottler) UpdateFairShare(fairShare *config.FairShareConfig, labels LabelsFunc) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.fairShare = fairShare
+	m.labels = labels
+	m.tenants = make(map[Key]string)
+	for key := range m.running {
+		m.updateTenant(key)
+	}
+	for _, pq := range m.pending {
+		for key := range pq.itemByKey {
+			m.updateTenant(key)
+		}
+	}
+	m.queueThrottled()
+}
+
+func (m *multiThrottler) TenantUsage() map[string]TenantUsage {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	usage := make(map[string]TenantUsage)
+	if !m.fairShare.IsEnabled() {
+		return usage
+	}
+	for key := range m.running {
+		u := usage[m.tenant(key)]
+		u.Running++
+		usage[m.tenant(key)] = u
+	}
+	for _, pq := range m.pending {
+		for key := range pq.itemByKey {
+			u := usage[m.tenant(key)]
+			u.Pending++
+			usage[m.tenant(key)] = u
+		}
+	}
+	totalWeight := 0
+	for tenant := range usage {
+		totalWeight += m.fairShare.GetWeight(tenant)
+	}
+	for tenant, u := range usage {
+		if len(m.running) > 0 {
+			entitled := float64(m.fairShare.GetWeight(tenant)) / float64(totalWeight)
+			u.Share = float64(u.Running) / float64(len(m.running)) / entitled
+		}
+		usage[tenant] = u
+	}
+	return usage
+}
+
+// queueFairShare admits the pending item of the tenant with the lowest running count for its weight,
+// breaking ties by priority and then creation time
+func (m *multiThrottler) queueFairShare() {
+	tenantRunning := make(map[string]int)
+	for key := range m.running {
+		tenantRunning[m.tenant(key)]++
+	}
+	var best *item
+	var bestUsage float64
+	for namespace, pq := range m.pending {
+		if len(pq.items) == 0 || !m.namespaceAllows(namespace) {
+			continue
+		}
+		for _, currItem := range pq.items {
+			tenant := m.tenant(currItem.key)
+			usage := float64(tenantRunning[tenant]) / float64(m.fairShare.GetWeight(tenant))
+			if best == nil || usage < bestUsage || (usage == bestUsage && currItem.before(best)) {
+				best = currItem
+				bestUsage = usage
+			}
+		}
+	}
+	if best != nil {
+		bestNamespace, _, _ := cache.SplitMetaNamespaceKey(best.key)
+		m.pending[bestNamespace].remove(best.key)
+		m.running[best.key] = true
+		m.queue(best.key)
+	}
+}
+
 func (m *multiThrottler) queueThrottled() {
 	if m.totalParallelism != 0 && len(m.running) >= m.totalParallelism {
 		return
 	}
 
+	if m.fairShare.IsEnabled() {
+		m.queueFairShare()
+		return
+	}
+
 	minPq := &priorityQueue{itemByKey: make(map[string]*item)}
 
 	for _, pq := range m.pending {
@@ -209,6 +351,14 @@ type item struct {
 	index        int
 }
 
+// before returns true if the item should be processed before the other item
+func (i *item) before(other *item) bool {
+	if i.priority == other.priority {
+		return i.creationTime.Before(other.creationTime)
+	}
+	return i.priority > other.priority
+}
+
 type priorityQueue struct {
 	items     []*item
 	itemByKey map[string]*item
@@ -243,10 +393,7 @@ func (pq *priorityQueue) remove(key Key) {
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
-	if pq.items[i].priority == pq.items[j].priority {
-		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
-	}
-	return pq.items[i].priority > pq.items[j].priority
+	return pq.items[i].before(pq.items[j])
 }
 
 func (pq priorityQueue) Swap(i, j int) {
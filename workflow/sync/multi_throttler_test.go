@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 )
@@ -235,3 +236,65 @@ func TestNamespaceParallelismUpdate(t *testing.T) {
 	assert.True(throttler.Admit("argo/a"))
 	assert.False(throttler.Admit("argo/b"))
 }
+
+func TestFairShareWeights(t *testing.T) {
+	assert := assert.New(t)
+	throttler := NewMultiThrottler(3, 0, func(Key) {})
+	throttler.UpdateFairShare(&config.FairShareConfig{Enabled: true, Weights: map[string]int{"a": 2}}, nil)
+	now := time.Now()
+	for i, key := range []Key{"a/0", "a/1", "a/2", "a/3", "a/4", "b/0", "b/1"} {
+		throttler.Add(key, 0, now.Add(time.Duration(i)*time.Second))
+	}
+	assert.True(throttler.Admit("a/0"))
+	assert.True(throttler.Admit("a/1"))
+	assert.True(throttler.Admit("a/2"))
+	assert.False(throttler.Admit("b/0"))
+
+	throttler.Remove("a/0")
+	assert.True(throttler.Admit("b/0"), "b is using none of its share")
+	assert.False(throttler.Admit("a/3"))
+
+	throttler.Remove("a/1")
+	assert.True(throttler.Admit("a/3"), "a is using half of its share, b all of it")
+	assert.False(throttler.Admit("b/1"))
+
+	throttler.Remove("b/0")
+	assert.True(throttler.Admit("b/1"))
+	assert.False(throttler.Admit("a/4"))
+
+	usage := throttler.TenantUsage()
+	assert.Equal(TenantUsage{Running: 2, Pending: 1, Share: 1}, usage["a"])
+	assert.Equal(TenantUsage{Running: 1, Share: 1}, usage["b"])
+}
+
+func TestFairShareTenantLabel(t *testing.T) {
+	assert := assert.New(t)
+	throttler := NewMultiThrottler(2, 0, func(Key) {})
+	tenants := map[Key]string{"a/0": "x", "a/1": "x", "b/0": "x", "b/1": "y"}
+	throttler.UpdateFairShare(&config.FairShareConfig{Enabled: true, TenantLabel: "tenant"}, func(key Key) map[string]string {
+		return map[string]string{"tenant": tenants[key]}
+	})
+	now := time.Now()
+	throttler.Add("a/0", 0, now)
+	throttler.Add("a/1", 1, now)
+	throttler.Add("b/0", 0, now)
+	throttler.Add("b/1", 0, now.Add(time.Second))
+	assert.True(throttler.Admit("a/0"))
+	assert.True(throttler.Admit("a/1"))
+
+	throttler.Remove("a/0")
+	assert.True(throttler.Admit("b/1"), "tenant y is using none of its share")
+	assert.False(throttler.Admit("b/0"))
+
+	throttler.Remove("b/1")
+	assert.True(throttler.Admit("b/0"))
+	assert.Equal(TenantUsage{Running: 2, Share: 1}, throttler.TenantUsage()["x"])
+}
+
+func TestFairShareDisabled(t *testing.T) {
+	throttler := NewMultiThrottler(1, 0, func(Key) {})
+	throttler.UpdateFairShare(&config.FairShareConfig{}, nil)
+	throttler.Add("a/0", 0, time.Now())
+	assert.True(t, throttler.Admit("a/0"))
+	assert.Empty(t, throttler.TenantUsage())
+}
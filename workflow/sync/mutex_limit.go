@@ -9,3 +9,11 @@ var _ limitProvider = &mutexLimit{}
 func (*mutexLimit) get(_ context.Context, _ string) (int, bool, error) {
 	return 1, false, nil
 }
+
+func (*mutexLimit) refresh(_ context.Context, _ string) error {
+	return nil
+}
+
+func (*mutexLimit) cached() (int, bool) {
+	return 1, false
+}
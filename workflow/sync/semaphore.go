@@ -17,6 +17,7 @@ type prioritySemaphore struct {
 	pending      *priorityQueue
 	semaphore    *sema.Weighted
 	lockHolder   map[string]bool
+	preempted    map[string]bool
 	nextWorkflow NextWorkflow
 	logger       loggerFn
 }
@@ -34,6 +35,7 @@ func newInternalSemaphore(ctx context.Context, name string, nextWorkflow NextWor
 		pending:      &priorityQueue{itemByKey: make(map[string]*item)},
 		semaphore:    sema.NewWeighted(int64(0)),
 		lockHolder:   make(map[string]bool),
+		preempted:    make(map[string]bool),
 		nextWorkflow: nextWorkflow,
 		logger:       logger.get,
 	}
@@ -61,6 +63,12 @@ func (s *prioritySemaphore) getLimit(ctx context.Context) int {
 	return limit
 }
 
+func (s *prioritySemaphore) refreshLimit(ctx context.Context) {
+	if err := s.limitGetter.refresh(ctx, s.name); err != nil {
+		s.logger(ctx).WithError(err).WithField("name", s.name).Error(ctx, "failed to get limit for semaphore")
+	}
+}
+
 func (s *prioritySemaphore) lock(_ context.Context) bool {
 	return true
 }
@@ -104,6 +112,7 @@ func (s *prioritySemaphore) release(ctx context.Context, key string) bool {
 	limit := s.getLimit(ctx)
 	if _, ok := s.lockHolder[key]; ok {
 		delete(s.lockHolder, key)
+		delete(s.preempted, key)
 		// When semaphore resized downward
 		// Remove the excess holders from map once the done.
 		if len(s.lockHolder) >= limit {
@@ -126,7 +135,11 @@ func (s *prioritySemaphore) release(ctx context.Context, key string) bool {
 // notifyWaiters enqueues the next N workflows who are waiting for the semaphore to the workqueue,
 // where N is the availability of the semaphore. If semaphore is out of capacity, this does nothing.
 func (s *prioritySemaphore) notifyWaiters(ctx context.Context) {
-	triggerCount := min(s.pending.Len(), s.getLimit(ctx)-len(s.lockHolder))
+	s.notifyWaitersWithLimit(ctx, s.getLimit(ctx))
+}
+
+func (s *prioritySemaphore) notifyWaitersWithLimit(ctx context.Context, limit int) {
+	triggerCount := min(s.pending.Len(), limit-len(s.lockHolder))
 	for idx := 0; idx < triggerCount; idx++ {
 		item := s.pending.items[idx]
 		wfKey := workflowKey(item.key)
@@ -259,4 +272,24 @@ func (s *prioritySemaphore) tryAcquire(ctx context.Context, holderKey string, tx
 	return false, msg
 }
 
-func (s *prioritySemaphore) probeWaiting(_ context.Context) {}
+// probeWaiting re-checks the limit while workflows are waiting, so they are notified when the limit grows,
+// e.g. when the value in the ConfigMap is changed or a limit expression evaluates differently.
+// The limit was fetched by refreshLimit, before the lock of the manager was taken, so it is not fetched again.
+func (s *prioritySemaphore) probeWaiting(ctx context.Context) {
+	limit, changed := s.limitGetter.cached()
+	if changed {
+		s.resize(ctx, limit)
+	}
+	if s.pending.Len() > 0 {
+		s.notifyWaitersWithLimit(ctx, limit)
+	}
+}
+
+func (s *prioritySemaphore) preempt(ctx context.Context, holderKey string) bool {
+	if len(s.preempted) > 0 || !s.lockHolder[holderKey] {
+		return false
+	}
+	s.preempted[holderKey] = true
+	s.logger(ctx).WithField("holderKey", holderKey).Info(ctx, "preempted")
+	return true
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/upper/db/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -23,6 +25,8 @@ type (
 	NextWorkflow      func(string)
 	GetSyncLimit      func(context.Context, string) (int, error)
 	IsWorkflowDeleted func(string) bool
+	// PreemptWorkflow takes the preemption action on the workflow of the preempted lock holder
+	PreemptWorkflow func(ctx context.Context, preemptor *wfv1.Workflow, workflowKey string, record wfv1.PreemptionRecord)
 )
 
 type Manager struct {
@@ -35,6 +39,10 @@ type Manager struct {
 	dbInfo            syncdb.DBInfo
 	queries           syncdb.SyncQueries
 	log               logging.Logger
+	preemptWorkflow   PreemptWorkflow
+	// priorities of the workflows using locks, keyed by workflow key
+	priorities   map[string]int32
+	priorityLock *sync.Mutex
 }
 
 type lockTypeName string
@@ -70,17 +78,55 @@ func createLockManager(ctx context.Context, dbSession db.Session, config *config
 		dbInfo:            dbInfo,
 		queries:           syncdb.NewSyncQueries(dbSession, dbInfo.Config),
 		log:               log,
+		priorities:        make(map[string]int32),
+		priorityLock:      &sync.Mutex{},
 	}
 	log.WithField("dbConfigured", sm.dbInfo.Session != nil).Info(ctx, "Sync manager initialized")
 	sm.dbInfo.Migrate(ctx)
 
+	var pollSeconds *int
+	if config != nil {
+		pollSeconds = config.PollSeconds
+	}
+	sm.backgroundNotifier(ctx, pollSeconds)
 	if sm.dbInfo.Session != nil {
-		sm.backgroundNotifier(ctx, config.PollSeconds)
 		sm.dbControllerHeartbeat(ctx, config.HeartbeatSeconds)
 	}
 	return sm
 }
 
+// SetPreemptWorkflow sets the function called when a lock holder is preempted, preemption is disabled until this is set
+func (sm *Manager) SetPreemptWorkflow(preemptWorkflow PreemptWorkflow) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.preemptWorkflow = preemptWorkflow
+}
+
+func workflowPriority(wf *wfv1.Workflow) int32 {
+	if wf.Spec.Priority != nil {
+		return *wf.Spec.Priority
+	}
+	return 0
+}
+
+func (sm *Manager) setPriority(wf *wfv1.Workflow) {
+	sm.priorityLock.Lock()
+	defer sm.priorityLock.Unlock()
+	sm.priorities[getHolderKey(wf, "")] = workflowPriority(wf)
+}
+
+func (sm *Manager) getPriority(workflowKey string) int32 {
+	sm.priorityLock.Lock()
+	defer sm.priorityLock.Unlock()
+	return sm.priorities[workflowKey]
+}
+
+func (sm *Manager) deletePriority(wf *wfv1.Workflow) {
+	sm.priorityLock.Lock()
+	defer sm.priorityLock.Unlock()
+	delete(sm.priorities, getHolderKey(wf, ""))
+}
+
 func (sm *Manager) getWorkflowKey(key string) (string, error) {
 	if key == "" {
 		return "", fmt.Errorf("holderkey is empty")
@@ -208,10 +254,14 @@ func getWorkflowSyncLevelByName(ctx context.Context, wf *wfv1.Workflow, lockName
 }
 
 func (sm *Manager) Initialize(ctx context.Context, wfs []wfv1.Workflow) {
+	// the background notifier may already be probing the locks
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
 	for _, wf := range wfs {
 		if wf.Status.Synchronization == nil {
 			continue
 		}
+		sm.setPriority(&wf)
 
 		if wf.Status.Synchronization.Semaphore != nil {
 			for _, holding := range wf.Status.Synchronization.Semaphore.Holding {
@@ -265,6 +315,18 @@ func (sm *Manager) Initialize(ctx context.Context, wfs []wfv1.Workflow) {
 			}
 		}
 	}
+	// which holders have been preempted is only held in memory, so it is rebuilt from the records of the workflows that
+	// preempted them, once all the holders have been acquired again
+	for _, wf := range wfs {
+		if wf.Status.Synchronization == nil {
+			continue
+		}
+		for _, record := range wf.Status.Synchronization.Preemptions {
+			if lock := sm.syncLockMap[record.Lock]; lock != nil && lock.preempt(ctx, record.Holder) {
+				sm.log.WithFields(logging.Fields{"lockKey": record.Lock, "holderKey": record.Holder}).Info(ctx, "Lock holder preempted before restart")
+			}
+		}
+	}
 	sm.log.Info(ctx, "Sync manager initialized successfully")
 }
 
@@ -379,14 +441,10 @@ func (sm *Manager) prepAcquire(ctx context.Context, wf *wfv1.Workflow, holderKey
 			sm.syncLockMap[lockKey] = lock
 		}
 
-		var priority int32
-		if wf.Spec.Priority != nil {
-			priority = *wf.Spec.Priority
-		} else {
-			priority = 0
-		}
+		priority := workflowPriority(wf)
 		creationTime := wf.CreationTimestamp
 		ensureInit(wf, syncItems[i].getType())
+		sm.setPriority(wf)
 		if err := lock.addToQueue(ctx, holderKey, priority, creationTime.Time); err != nil {
 			return false, fmt.Sprintf("Failed to add to queue: %v", err), lockKey, err
 		}
@@ -452,10 +510,75 @@ func (sm *Manager) tryAcquireImpl(ctx context.Context, wf *wfv1.Workflow, tx *tr
 				updated = true
 			}
 		}
+		if sm.preempt(ctx, wf, holderKey, syncItems, lockKeys) {
+			updated = true
+		}
 		return false, updated, msg, failedLockName, nil
 	}
 }
 
+// preempt preempts the lowest priority holder of each full lock with a preemption policy, if the waiting holder is at the
+// front of the queue for the lock and its priority is at least the priority gap above that of the holder
+func (sm *Manager) preempt(ctx context.Context, wf *wfv1.Workflow, holderKey string, syncItems []*syncItem, lockKeys []string) bool {
+	if sm.preemptWorkflow == nil {
+		return false
+	}
+	updated := false
+	priority := workflowPriority(wf)
+	for i, lockKey := range lockKeys {
+		preemption := syncItems[i].getPreemption()
+		if preemption == nil {
+			continue
+		}
+		lock := sm.syncLockMap[lockKey]
+		pending, err := lock.getCurrentPending(ctx)
+		if err != nil || len(pending) == 0 || pending[0] != holderKey {
+			continue
+		}
+		holders, err := lock.getCurrentHolders(ctx)
+		if err != nil || len(holders) < lock.getLimit(ctx) {
+			continue
+		}
+		slices.Sort(holders)
+		action := preemption.GetAction()
+		victim, victimWorkflowKey, victimPriority := "", "", int32(0)
+		for _, holder := range holders {
+			workflowKey, err := sm.getWorkflowKey(holder)
+			if err != nil || workflowKey == getHolderKey(wf, "") {
+				continue
+			}
+			// a suspended workflow never releases its workflow level locks, so they cannot be preempted by suspending
+			if action == wfv1.PreemptionActionSuspend && holder == workflowKey {
+				continue
+			}
+			holderPriority := sm.getPriority(workflowKey)
+			if victim == "" || holderPriority < victimPriority {
+				victim, victimWorkflowKey, victimPriority = holder, workflowKey, holderPriority
+			}
+		}
+		if victim == "" || priority-victimPriority < preemption.GetPriorityGap() || !lock.preempt(ctx, victim) {
+			continue
+		}
+		record := wfv1.PreemptionRecord{
+			Lock:      lockKey,
+			Holder:    victim,
+			Preemptor: holderKey,
+			Action:    action,
+			Time:      metav1.Time{Time: nowFn()},
+		}
+		sm.log.WithFields(logging.Fields{
+			"lockKey":   lockKey,
+			"holderKey": victim,
+			"preemptor": holderKey,
+			"action":    record.Action,
+		}).Info(ctx, "Preempting lock holder")
+		wf.Status.Synchronization.Preemptions = append(wf.Status.Synchronization.Preemptions, record)
+		sm.preemptWorkflow(ctx, wf, victimWorkflowKey, record)
+		updated = true
+	}
+	return updated
+}
+
 func (sm *Manager) unlockAll(ctx context.Context, lockKeys []string) {
 	for _, lockKey := range lockKeys {
 		lock := sm.syncLockMap[lockKey]
@@ -499,6 +622,7 @@ func (sm *Manager) ReleaseAll(ctx context.Context, wf *wfv1.Workflow) bool {
 	sm.lock.RLock()
 	defer sm.lock.RUnlock()
 
+	sm.deletePriority(wf)
 	if wf.Status.Synchronization == nil {
 		return true
 	}
@@ -572,6 +696,8 @@ func (sm *Manager) ReleaseAll(ctx context.Context, wf *wfv1.Workflow) bool {
 		}
 	}
 
+	// nothing is held any more, including the record of the holders this workflow preempted, so that the locks of a
+	// completed workflow are not released again every time it is persisted
 	wf.Status.Synchronization = nil
 	return true
 }
@@ -645,17 +771,30 @@ func (sm *Manager) initializeMutex(ctx context.Context, mutexName string) (semap
 func (sm *Manager) backgroundNotifier(ctx context.Context, period *int) {
 	sm.log.WithField("pollInterval", syncdb.SecondsToDurationWithDefault(period, syncdb.DefaultDBHeartbeatSeconds)).
 		Info(ctx, "Starting background notification for sync locks")
-	go wait.UntilWithContext(ctx, func(_ context.Context) {
-		sm.lock.Lock()
-		for _, lock := range sm.syncLockMap {
-			lock.probeWaiting(ctx)
-		}
-		sm.lock.Unlock()
-	},
+	go wait.UntilWithContext(ctx, sm.probeWaiting,
 		syncdb.SecondsToDurationWithDefault(period, syncdb.DefaultDBPollSeconds),
 	)
 }
 
+// probeWaiting notifies the workflows waiting for locks whose limits have grown. The limits are fetched, e.g. from
+// their ConfigMaps, before taking the lock, so acquiring and releasing locks does not wait for them.
+func (sm *Manager) probeWaiting(ctx context.Context) {
+	sm.lock.RLock()
+	locks := make([]semaphore, 0, len(sm.syncLockMap))
+	for _, lock := range sm.syncLockMap {
+		locks = append(locks, lock)
+	}
+	sm.lock.RUnlock()
+	for _, lock := range locks {
+		lock.refreshLimit(ctx)
+	}
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	for _, lock := range sm.syncLockMap {
+		lock.probeWaiting(ctx)
+	}
+}
+
 // dbControllerHeartbeat does periodic deadmans switch updates to the controller state
 func (sm *Manager) dbControllerHeartbeat(ctx context.Context, period *int) {
 	// This doesn't need be be transactional, if someone else has the same controller name as us
@@ -1820,3 +1820,182 @@ func TestUnconfiguredSemaphores(t *testing.T) {
 		}
 	})
 }
+
+func TestPreemption(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	kube := fake.NewSimpleClientset()
+	newWorkflow := func(name string, priority int32, preemption *wfv1.Preemption) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(mutexWf)
+		wf.Name = name
+		wf.Spec.Priority = ptr.To(priority)
+		wf.Spec.Synchronization.Mutexes[0].Preemption = preemption
+		return wf
+	}
+	type preempted struct {
+		workflowKey string
+		record      wfv1.PreemptionRecord
+	}
+	setup := func(t *testing.T) (*Manager, *[]preempted) {
+		var calls []preempted
+		syncManager := NewLockManager(ctx, kube, "", nil, GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+		syncManager.SetPreemptWorkflow(func(_ context.Context, _ *wfv1.Workflow, workflowKey string, record wfv1.PreemptionRecord) {
+			calls = append(calls, preempted{workflowKey, record})
+		})
+		return syncManager, &calls
+	}
+	preemption := &wfv1.Preemption{PriorityGap: ptr.To(int32(2)), Action: wfv1.PreemptionActionStop}
+	suspend := &wfv1.Preemption{PriorityGap: ptr.To(int32(2)), Action: wfv1.PreemptionActionSuspend}
+
+	t.Run("Preempt", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, preemption)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		high := newWorkflow("high", 2, preemption)
+		acquired, updated, _, _, err := syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.True(t, updated)
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "default/low", (*calls)[0].workflowKey)
+		record := (*calls)[0].record
+		assert.Equal(t, "default/Mutex/test", record.Lock)
+		assert.Equal(t, "default/low", record.Holder)
+		assert.Equal(t, "default/high", record.Preemptor)
+		assert.Equal(t, wfv1.PreemptionActionStop, record.Action)
+		require.Len(t, high.Status.Synchronization.Preemptions, 1)
+
+		// the holder is only preempted once
+		_, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.Len(t, *calls, 1)
+
+		syncManager.ReleaseAll(ctx, low)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		assert.True(t, syncManager.ReleaseAll(ctx, high))
+		assert.Nil(t, high.Status.Synchronization, "nothing is left to release once the workflow completes")
+	})
+	t.Run("Restart", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, preemption)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+		high := newWorkflow("high", 2, preemption)
+		_, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		require.Len(t, *calls, 1)
+
+		syncManager, calls = setup(t)
+		syncManager.Initialize(ctx, []wfv1.Workflow{*low, *high})
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Empty(t, *calls, "the holder preempted before the restart is not preempted again")
+	})
+	t.Run("SuspendWorkflowLevelHolder", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, suspend)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		high := newWorkflow("high", 2, suspend)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Empty(t, *calls, "a suspended workflow would never release its workflow level lock")
+	})
+	t.Run("SuspendTemplateLevelHolder", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, suspend)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "low-node", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		high := newWorkflow("high", 2, suspend)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "high-node", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "default/low", (*calls)[0].workflowKey)
+		assert.Equal(t, "default/low/low-node", (*calls)[0].record.Holder)
+		assert.Equal(t, wfv1.PreemptionActionSuspend, (*calls)[0].record.Action)
+
+		// the preempted holder releases the lock as its node completes
+		syncManager.Release(ctx, low, "low-node", low.Spec.Synchronization)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "high-node", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+	t.Run("PriorityGapNotReached", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, preemption)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		high := newWorkflow("high", 1, preemption)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Empty(t, *calls)
+	})
+	t.Run("NoPreemption", func(t *testing.T) {
+		syncManager, calls := setup(t)
+		low := newWorkflow("low", 0, nil)
+		acquired, _, _, _, err := syncManager.TryAcquire(ctx, low, "", low.Spec.Synchronization)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		high := newWorkflow("high", 10, nil)
+		acquired, _, _, _, err = syncManager.TryAcquire(ctx, high, "", high.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		assert.Empty(t, *calls)
+	})
+}
+
+func TestProbeWaiting(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	limit := 1
+	probing := false
+	lockedWhileFetching := false
+	var syncManager *Manager
+	var nextKey string
+	syncManager = NewLockManager(ctx, fake.NewSimpleClientset(), "", nil, func(context.Context, string) (int, error) {
+		if probing {
+			if syncManager.lock.TryLock() {
+				syncManager.lock.Unlock()
+			} else {
+				lockedWhileFetching = true
+			}
+		}
+		return limit, nil
+	}, func(key string) {
+		nextKey = key
+	}, WorkflowExistenceFunc)
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+	acquired, _, _, _, err := syncManager.TryAcquire(ctx, wf, "", wf.Spec.Synchronization)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	waiting := wf.DeepCopy()
+	waiting.Name = "two"
+	acquired, _, _, _, err = syncManager.TryAcquire(ctx, waiting, "", waiting.Spec.Synchronization)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	limit = 2
+	probing = true
+	syncManager.probeWaiting(ctx)
+	assert.False(t, lockedWhileFetching, "the limit is fetched without holding the lock")
+	assert.Equal(t, "default/two", nextKey, "the waiting workflow is notified of the grown limit")
+	acquired, _, _, _, err = syncManager.TryAcquire(ctx, waiting, "", waiting.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -34,6 +34,17 @@ func checkDuplicates(items []*syncItem) error {
 	return nil
 }
 
+func (i *syncItem) getPreemption() *v1alpha1.Preemption {
+	switch {
+	case i.semaphore != nil:
+		return i.semaphore.Preemption
+	case i.mutex != nil:
+		return i.mutex.Preemption
+	default:
+		return nil
+	}
+}
+
 func (i *syncItem) getType() v1alpha1.SynchronizationType {
 	switch {
 	case i.semaphore != nil:
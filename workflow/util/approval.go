@@ -0,0 +1,77 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+)
+
+// decideApproval records the caller's approval or rejection of a suspend node with an approval gate. The node
+// succeeds once the gate's required number of distinct approvers have approved it, and fails if it is rejected.
+func decideApproval(ctx context.Context, node *wfv1.NodeStatus, approved bool) error {
+	approval := node.Approval
+	// in the server auth mode every caller has the claims of the server's own service account
+	if auth.GetMode(ctx) == auth.Server {
+		return errors.Errorf(errors.CodeForbidden, "node %s requires approval by an identified user, which the server auth mode does not identify, use SSO or client auth", node.DisplayName)
+	}
+	claims := auth.GetClaims(ctx)
+	if claims == nil || claims.Subject == "" {
+		return errors.Errorf(errors.CodeForbidden, "node %s requires approval by an identified user", node.DisplayName)
+	}
+	// an unverified email can be claimed by anyone who signs up with it
+	email := ""
+	if claims.EmailVerified {
+		email = claims.Email
+	}
+	if !isAllowedApprover(approval.Gate, claims.Subject, email, claims.Groups) {
+		return errors.Errorf(errors.CodeForbidden, "%s is not allowed to approve node %s", claims.Subject, node.DisplayName)
+	}
+	if !approved && !approval.Gate.AllowReject {
+		return errors.Errorf(errors.CodeBadRequest, "node %s cannot be rejected", node.DisplayName)
+	}
+	if approval.GetDecision(claims.Subject) != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s has already decided on node %s", claims.Subject, node.DisplayName)
+	}
+
+	now := time.Now().UTC()
+	approval.Decisions = append(approval.Decisions, wfv1.ApprovalDecision{User: claims.Subject, Approved: approved, Time: metav1.Time{Time: now}})
+	switch {
+	case !approved:
+		node.Phase = wfv1.NodeFailed
+		node.Message = fmt.Sprintf("Rejected by %s", claims.Subject)
+	case approval.Approvals() >= approval.Gate.GetRequired():
+		node.Phase = wfv1.NodeSucceeded
+		node.Message = fmt.Sprintf("Approved by %d of %d required approvers", approval.Approvals(), approval.Gate.GetRequired())
+	default:
+		node.Message = fmt.Sprintf("Approved by %d of %d required approvers", approval.Approvals(), approval.Gate.GetRequired())
+		return nil
+	}
+	node.FinishedAt = metav1.Time{Time: now}
+	return nil
+}
+
+// isAllowedApprover returns whether a user, identified by their subject or verified email, is allowed to approve the
+// gate. The preferred username is not matched, as users can often change it to anything, including another user's.
+func isAllowedApprover(gate wfv1.SuspendApproval, subject, email string, groups []string) bool {
+	if len(gate.Users) == 0 && len(gate.Groups) == 0 {
+		return true
+	}
+	for _, user := range gate.Users {
+		if user != "" && (user == subject || user == email) {
+			return true
+		}
+	}
+	for _, group := range gate.Groups {
+		if slices.Contains(groups, group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+)
+
+func createApprovalWf(ctx context.Context, t *testing.T, gate wfv1.SuspendApproval) v1alpha1.WorkflowInterface {
+	t.Helper()
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	wf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+	node := wf.Status.Nodes["suspend-template-xjsg2-1771269240"]
+	node.Approval = &wfv1.NodeApproval{Gate: gate}
+	wf.Status.Nodes["suspend-template-xjsg2-1771269240"] = node
+	_, err := wfIf.Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	return wfIf
+}
+
+func withClaims(ctx context.Context, subject, email string, groups ...string) context.Context {
+	return context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: subject}, Email: email, EmailVerified: true, Groups: groups})
+}
+
+func getApprovalNode(ctx context.Context, t *testing.T, wfIf v1alpha1.WorkflowInterface) *wfv1.NodeStatus {
+	t.Helper()
+	wf, err := wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	return wf.Status.Nodes.FindByDisplayName("approve")
+}
+
+func TestResumeWorkflowApproval(t *testing.T) {
+	gate := wfv1.SuspendApproval{Users: []string{"alice@example.com"}, Groups: []string{"release"}, Required: ptr.To(int32(2))}
+
+	t.Run("Unidentified", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+		err := ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "")
+		require.EqualError(t, err, "node approve requires approval by an identified user")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+		err := ResumeWorkflow(withClaims(ctx, "mallory", "mallory@example.com", "dev"), wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+		require.EqualError(t, err, "mallory is not allowed to approve node approve")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("UnverifiedEmail", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+		claims := &types.Claims{Claims: jwt.Claims{Subject: "alice"}, Email: "alice@example.com"}
+		err := ResumeWorkflow(context.WithValue(ctx, auth.ClaimsKey, claims), wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+		require.EqualError(t, err, "alice is not allowed to approve node approve")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("PreferredUsername", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, wfv1.SuspendApproval{Users: []string{"alice"}})
+		claims := &types.Claims{Claims: jwt.Claims{Subject: "mallory"}, PreferredUsername: "alice"}
+		err := ResumeWorkflow(context.WithValue(ctx, auth.ClaimsKey, claims), wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+		require.EqualError(t, err, "mallory is not allowed to approve node approve")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("Quorum", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+
+		require.NoError(t, ResumeWorkflow(withClaims(ctx, "alice", "alice@example.com"), wfIf, hydratorfake.Noop, "suspend", ""))
+		node := getApprovalNode(ctx, t, wfIf)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, "Approved by 1 of 2 required approvers", node.Message)
+
+		err := ResumeWorkflow(withClaims(ctx, "alice", "alice@example.com"), wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+		require.EqualError(t, err, "alice has already decided on node approve")
+
+		require.NoError(t, ResumeWorkflow(withClaims(ctx, "bob", "bob@example.com", "release"), wfIf, hydratorfake.Noop, "suspend", "displayName=approve"))
+		node = getApprovalNode(ctx, t, wfIf)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "Approved by 2 of 2 required approvers", node.Message)
+		assert.False(t, node.FinishedAt.IsZero())
+		require.Len(t, node.Approval.Decisions, 2)
+		assert.Equal(t, "alice", node.Approval.Decisions[0].User)
+		assert.True(t, node.Approval.Decisions[0].Approved)
+		assert.False(t, node.Approval.Decisions[0].Time.IsZero())
+		assert.Equal(t, "bob", node.Approval.Decisions[1].User)
+	})
+}
+
+func TestResumeWorkflowSkipsApproval(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	wf := wfv1.MustUnmarshalWorkflow(suspendedWf)
+	wf.Spec.Suspend = ptr.To(true)
+	node := wf.Status.Nodes["suspend-template-xjsg2-1771269240"]
+	wait := node.DeepCopy()
+	wait.ID, wait.Name, wait.DisplayName = "suspend-template-xjsg2-1", "suspend-template-xjsg2[0].wait", "wait"
+	wf.Status.Nodes[wait.ID] = *wait
+	node.Approval = &wfv1.NodeApproval{Gate: wfv1.SuspendApproval{Users: []string{"alice"}}}
+	wf.Status.Nodes["suspend-template-xjsg2-1771269240"] = node
+	_, err := wfIf.Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, ResumeWorkflow(withClaims(ctx, "mallory", ""), wfIf, hydratorfake.Noop, "suspend", ""))
+	wf, err = wfIf.Get(ctx, "suspend", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, wf.Spec.Suspend)
+	assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes.FindByDisplayName("wait").Phase)
+	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes.FindByDisplayName("approve").Phase, "the approval gate the caller cannot decide stays suspended")
+
+	err = ResumeWorkflow(withClaims(ctx, "mallory", ""), wfIf, hydratorfake.Noop, "suspend", "")
+	require.EqualError(t, err, "mallory is not allowed to approve node approve", "the caller is told when nothing could be resumed")
+}
+
+func TestDecideApprovalAuthMode(t *testing.T) {
+	gate := wfv1.SuspendApproval{}
+
+	t.Run("Server", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+		ctx = context.WithValue(withClaims(ctx, "system:serviceaccount:argo:argo-server", ""), auth.ModeKey, auth.Server)
+		err := ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve")
+		require.EqualError(t, err, "node approve requires approval by an identified user, which the server auth mode does not identify, use SSO or client auth")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("Client", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, gate)
+		ctx = context.WithValue(withClaims(ctx, "system:serviceaccount:argo:alice", ""), auth.ModeKey, auth.Client)
+		require.NoError(t, ResumeWorkflow(ctx, wfIf, hydratorfake.Noop, "suspend", "displayName=approve"))
+		assert.Equal(t, wfv1.NodeSucceeded, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+}
+
+func TestStopWorkflowApproval(t *testing.T) {
+	t.Run("RejectNotAllowed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, wfv1.SuspendApproval{})
+		err := StopWorkflow(withClaims(ctx, "alice", ""), wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "no")
+		require.EqualError(t, err, "node approve cannot be rejected")
+		assert.Equal(t, wfv1.NodeRunning, getApprovalNode(ctx, t, wfIf).Phase)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wfIf := createApprovalWf(ctx, t, wfv1.SuspendApproval{AllowReject: true})
+		require.NoError(t, StopWorkflow(withClaims(ctx, "alice", ""), wfIf, hydratorfake.Noop, "suspend", "displayName=approve", "no"))
+		node := getApprovalNode(ctx, t, wfIf)
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "Rejected by alice", node.Message)
+		require.Len(t, node.Approval.Decisions, 1)
+		assert.False(t, node.Approval.Decisions[0].Approved)
+	})
+}
+
+func TestSetWorkflowApproval(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfIf := createApprovalWf(ctx, t, wfv1.SuspendApproval{})
+	err := SetWorkflow(withClaims(ctx, "alice", ""), wfIf, hydratorfake.Noop, "suspend", "displayName=approve", SetOperationValues{Phase: wfv1.NodeRunning})
+	require.EqualError(t, err, "node approve can only be approved or rejected")
+	require.NoError(t, SetWorkflow(withClaims(ctx, "alice", ""), wfIf, hydratorfake.Noop, "suspend", "displayName=approve", SetOperationValues{Phase: wfv1.NodeSucceeded, Message: "lgtm"}))
+	node := getApprovalNode(ctx, t, wfIf)
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	assert.Equal(t, "Approved by 1 of 1 required approvers", node.Message)
+}
+
+func TestIsAllowedApprover(t *testing.T) {
+	assert.True(t, isAllowedApprover(wfv1.SuspendApproval{}, "alice", "", nil))
+	gate := wfv1.SuspendApproval{Users: []string{"alice", "carol@example.com"}, Groups: []string{"release"}}
+	assert.True(t, isAllowedApprover(gate, "alice", "", nil))
+	assert.True(t, isAllowedApprover(gate, "1234", "carol@example.com", nil))
+	assert.True(t, isAllowedApprover(gate, "bob", "", []string{"dev", "release"}))
+	assert.False(t, isAllowedApprover(gate, "bob", "", []string{"dev"}))
+	assert.False(t, isAllowedApprover(gate, "bob", "bob@example.com", nil))
+}
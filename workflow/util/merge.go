@@ -2,11 +2,14 @@ package util
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 // MergeTo will merge one workflow (the "patch" workflow) into another (the "target" workflow.
@@ -131,9 +134,39 @@ func JoinWorkflowSpec(wfSpec, wftSpec, wfDefaultSpec *wfv1.WorkflowSpec) (*wfv1.
 			// If none of the above conditions are met, we can safely assume that the parameter is set from the wfDefaultSpec.
 		}
 	}
+	if err := coerceTemplateTypedParameters(targetWf.Spec.Arguments.Parameters, wftSpec); err != nil {
+		return nil, err
+	}
 	return &targetWf, nil
 }
 
+// coerceTemplateTypedParameters types the parameters of the workflow that do not declare a type as the parameters of
+// the same name of its template, and coerces their values to the type, as the values passed to a workflow template,
+// e.g. with `argo submit --from workflowtemplate/my-wft -p flag=True`, cannot be coerced until the template is known
+func coerceTemplateTypedParameters(params []wfv1.Parameter, wftSpec *wfv1.WorkflowSpec) error {
+	if wftSpec == nil {
+		return nil
+	}
+	for i := range params {
+		param := &params[i]
+		declared := wftSpec.Arguments.GetParameterByName(param.Name)
+		if param.IsTyped() || declared == nil || !declared.IsTyped() {
+			continue
+		}
+		param.Type = declared.Type
+		param.Schema = declared.Schema
+		if param.Value == nil || strings.Contains(param.Value.String(), "{{") {
+			continue
+		}
+		value, err := common.CoerceParameterValue(*param, param.Value.String())
+		if err != nil {
+			return fmt.Errorf("arguments.parameters.%s: %w", param.Name, err)
+		}
+		param.Value = wfv1.AnyStringPtr(value)
+	}
+	return nil
+}
+
 func parametersToMapByName(spec *wfv1.WorkflowSpec) map[string]wfv1.Parameter {
 	parameterMap := make(map[string]wfv1.Parameter)
 	if spec != nil {
@@ -387,6 +387,32 @@ func TestJoinWfSpecArguments(t *testing.T) {
 	assert.Equal(result.Spec.Arguments, targetWf.Spec.Arguments)
 }
 
+func TestJoinWfSpecTypedArguments(t *testing.T) {
+	wftSpec := &wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+		{Name: "flag", Type: wfv1.ParameterTypeBool, Value: wfv1.AnyStringPtr("false")},
+		{Name: "count", Type: wfv1.ParameterTypeInt},
+		{Name: "message", Value: wfv1.AnyStringPtr("hello")},
+	}}}
+	t.Run("Coerced", func(t *testing.T) {
+		wfSpec := &wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+			{Name: "flag", Value: wfv1.AnyStringPtr("True")},
+			{Name: "count", Value: wfv1.AnyStringPtr(" 3")},
+			{Name: "message", Value: wfv1.AnyStringPtr("True")},
+		}}}
+		targetWf, err := JoinWorkflowSpec(wfSpec, wftSpec, nil)
+		require.NoError(t, err)
+		args := targetWf.Spec.Arguments
+		assert.Equal(t, wfv1.Parameter{Name: "flag", Type: wfv1.ParameterTypeBool, Value: wfv1.AnyStringPtr("true")}, *args.GetParameterByName("flag"))
+		assert.Equal(t, wfv1.Parameter{Name: "count", Type: wfv1.ParameterTypeInt, Value: wfv1.AnyStringPtr("3")}, *args.GetParameterByName("count"))
+		assert.Equal(t, "True", args.GetParameterByName("message").Value.String(), "untyped parameters are left as they are")
+	})
+	t.Run("NotOfType", func(t *testing.T) {
+		wfSpec := &wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "flag", Value: wfv1.AnyStringPtr("maybe")}}}}
+		_, err := JoinWorkflowSpec(wfSpec, wftSpec, nil)
+		require.EqualError(t, err, `arguments.parameters.flag: value "maybe" is not a bool`)
+	})
+}
+
 func TestJoinWfSpecArgumentsWithNil(t *testing.T) {
 	assert := assert.New(t)
 	wf := wfv1.MustUnmarshalWorkflow(wfArguments)
@@ -311,6 +311,18 @@ func overrideParameters(wf *wfv1.Workflow, parameters []string) error {
 				return fmt.Errorf("expected parameter of the form: NAME=VALUE. Received: %s", paramStr)
 			}
 			param := wfv1.Parameter{Name: parts[0], Value: wfv1.AnyStringPtr(parts[1])}
+			// values are coerced to the type of the parameter they override, e.g. "True" to "true" for a bool
+			for _, existing := range wf.Spec.Arguments.Parameters {
+				if existing.Name == param.Name && existing.IsTyped() {
+					param.Type = existing.Type
+					param.Schema = existing.Schema
+					value, err := common.CoerceParameterValue(param, parts[1])
+					if err != nil {
+						return fmt.Errorf("parameter %s: %w", param.Name, err)
+					}
+					param.Value = wfv1.AnyStringPtr(value)
+				}
+			}
 			newParams = append(newParams, param)
 			passedParams[param.Name] = true
 		}
@@ -432,8 +444,26 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 			}
 
 			// To resume a workflow with a suspended node we simply mark the node as Successful
+			var approvalErr error
 			for nodeID, node := range wf.Status.Nodes {
 				if node.IsActiveSuspendNode() {
+					if node.Approval != nil {
+						// approval gates the caller cannot decide stay suspended, but do not stop everything else resuming
+						if err := decideApproval(ctx, &node, true); err != nil {
+							if approvalErr == nil {
+								approvalErr = err
+							}
+							continue
+						}
+						if node.Phase == wfv1.NodeSucceeded {
+							if err := OverrideOutputParametersWithDefault(node.Outputs); err != nil {
+								return false, err
+							}
+						}
+						wf.Status.Nodes.Set(ctx, nodeID, node)
+						workflowUpdated = true
+						continue
+					}
 					if err := OverrideOutputParametersWithDefault(node.Outputs); err != nil {
 						return false, err
 					}
@@ -447,6 +477,9 @@ func ResumeWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrat
 					workflowUpdated = true
 				}
 			}
+			if !workflowUpdated && approvalErr != nil {
+				return true, approvalErr
+			}
 
 			if workflowUpdated {
 				err := hydrator.Dehydrate(ctx, wf)
@@ -550,8 +583,16 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 			if node.IsActiveSuspendNode() {
 				if SelectorMatchesNode(selector, node) {
 
-					// Update phase
-					if values.Phase != "" {
+					// Update phase, which for an approval gate approves or rejects the node
+					if values.Phase != "" && node.Approval != nil {
+						if values.Phase != wfv1.NodeSucceeded && !values.Phase.FailedOrError() {
+							return true, errors.Errorf(errors.CodeBadRequest, "node %s can only be approved or rejected", node.DisplayName)
+						}
+						if err := decideApproval(ctx, &node, values.Phase == wfv1.NodeSucceeded); err != nil {
+							return true, err
+						}
+						nodeUpdated = true
+					} else if values.Phase != "" {
 						node.Phase = values.Phase
 						if values.Phase.Fulfilled(node.TaskResultSynced) {
 							node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
@@ -560,7 +601,7 @@ func updateSuspendedNode(ctx context.Context, wfIf v1alpha1.WorkflowInterface, h
 					}
 
 					// Update message
-					if values.Message != "" {
+					if values.Message != "" && (node.Approval == nil || values.Phase == "") {
 						node.Message = values.Message
 						nodeUpdated = true
 					}
@@ -609,6 +609,18 @@ func TestApplySubmitOpts(t *testing.T) {
 		assert.Equal(t, "a", parameters[0].Name)
 		assert.Equal(t, "81861780812", parameters[0].Value.String())
 	})
+	t.Run("TypedParameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("false"), Type: wfv1.ParameterTypeBool}},
+				},
+			},
+		}
+		require.NoError(t, ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=True"}}))
+		assert.Equal(t, []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("true"), Type: wfv1.ParameterTypeBool}}, wf.Spec.Arguments.Parameters)
+		require.EqualError(t, ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=maybe"}}), `parameter a: value "maybe" is not a bool`)
+	})
 	t.Run("PodPriorityClassName", func(t *testing.T) {
 		wf := &wfv1.Workflow{}
 		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{PodPriorityClassName: "abc"})
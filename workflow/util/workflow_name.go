@@ -1,9 +1,15 @@
 package util
 
 import (
+	"fmt"
+	"hash/fnv"
 	"strings"
+	"time"
 )
 
+// maxWorkflowNameLength is the maximum length of a workflow name, as it is used as a label value
+const maxWorkflowNameLength = 63
+
 // GenerateBackfillWorkflowPrefix return a backfill workflow prefix
 func GenerateBackfillWorkflowPrefix(cronWorkflowName, ops string) string {
 	prefix := cronWorkflowName + "-backfill-" + strings.ToLower(ops)
@@ -18,3 +24,26 @@ func ensureWorkflowNamePrefixLength(prefix string) string {
 
 	return prefix
 }
+
+// GenerateBackfillWorkflowName returns the name of the workflow a backfill runs for a scheduled time, which is
+// different for each rerun of the scheduled time. Names that would be too long are truncated, and end with a hash of
+// the full name to keep them unique.
+func GenerateBackfillWorkflowName(cronWorkflowName, backfillName string, scheduledTime time.Time, rerun int32) string {
+	name := fmt.Sprintf("%s-%d", GenerateBackfillWorkflowPrefix(cronWorkflowName, backfillName), scheduledTime.Unix())
+	if rerun > 0 {
+		name = fmt.Sprintf("%s-%d", name, rerun)
+	}
+	return TruncateWorkflowName(name)
+}
+
+// TruncateWorkflowName returns the name if it is short enough to be a workflow name, or otherwise truncates it, ending
+// it with a hash of the full name to keep it unique
+func TruncateWorkflowName(name string) string {
+	if len(name) <= maxWorkflowNameLength {
+		return name
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", h.Sum32())
+	return strings.TrimRight(name[:maxWorkflowNameLength-len(suffix)], "-") + suffix
+}
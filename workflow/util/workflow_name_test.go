@@ -0,0 +1,26 @@
+package util
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateBackfillWorkflowName(t *testing.T) {
+	scheduledTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "my-cron-backfill-january-1735689600", GenerateBackfillWorkflowName("my-cron", "january", scheduledTime, 0))
+	assert.Equal(t, "my-cron-backfill-january-1735689600-2", GenerateBackfillWorkflowName("my-cron", "january", scheduledTime, 2))
+
+	t.Run("LongName", func(t *testing.T) {
+		cronName := strings.Repeat("c", 52)
+		backfillName := strings.Repeat("b", 63)
+		name := GenerateBackfillWorkflowName(cronName, backfillName, scheduledTime, 0)
+		assert.Len(t, name, maxWorkflowNameLength)
+		assert.True(t, strings.HasPrefix(name, cronName))
+		assert.Equal(t, name, GenerateBackfillWorkflowName(cronName, backfillName, scheduledTime, 0), "the name is deterministic")
+		assert.NotEqual(t, name, GenerateBackfillWorkflowName(cronName, backfillName, scheduledTime, 1), "reruns have different names")
+		assert.NotEqual(t, name, GenerateBackfillWorkflowName(cronName, backfillName, scheduledTime.Add(time.Hour), 0), "scheduled times have different names")
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/robfig/cron/v3"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sintstr "k8s.io/apimachinery/pkg/util/intstr"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/yaml"
 
@@ -178,6 +179,7 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 
 	if hasWorkflowTemplateRef {
 		wfArgs.Parameters = util.MergeParameters(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
+		inheritParameterTypes(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
 		wfArgs.Artifacts = util.MergeArtifacts(wfArgs.Artifacts, wfSpecHolder.GetWorkflowSpec().Arguments.Artifacts)
 	}
 	if err != nil {
@@ -192,6 +194,14 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 	if err != nil {
 		return err
 	}
+	templates := wf.Spec.Templates
+	if hasWorkflowTemplateRef {
+		templates = append(slices.Clone(templates), wfSpecHolder.GetWorkflowSpec().Templates...)
+	}
+	err = validateWorkflowOutputs(wf.Spec.Outputs, templates)
+	if err != nil {
+		return err
+	}
 	if len(wfArgs.Parameters) > 0 {
 		tctx.globalParams[common.GlobalVarWorkflowParameters] = placeholderGenerator.NextPlaceholder()
 		tctx.globalParams[common.GlobalVarWorkflowParametersJSON] = placeholderGenerator.NextPlaceholder()
@@ -288,6 +298,16 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 	if err != nil {
 		return err
 	}
+	err = validateSynchronization("spec.synchronization", wf.Spec.Synchronization, true)
+	if err != nil {
+		return err
+	}
+	if hasWorkflowTemplateRef {
+		err = validateSynchronization("spec.synchronization", wfSpecHolder.GetWorkflowSpec().Synchronization, true)
+		if err != nil {
+			return err
+		}
+	}
 
 	if !wf.Spec.PodGC.GetStrategy().IsValid() {
 		return errors.Errorf(errors.CodeBadRequest, "podGC.strategy unknown strategy '%s'", wf.Spec.PodGC.Strategy)
@@ -317,6 +337,38 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 	return nil
 }
 
+// validateSynchronization rejects preemption of database locks, which may be held by other controllers, and suspending
+// preempted workflow level lock holders, as a suspended workflow never releases its workflow level locks
+func validateSynchronization(path string, synchronization *wfv1.Synchronization, workflowLevel bool) error {
+	if synchronization == nil {
+		return nil
+	}
+	for i, semaphore := range synchronization.Semaphores {
+		if err := validatePreemption(fmt.Sprintf("%s.semaphores[%d]", path, i), semaphore.Preemption, semaphore.Database != nil, workflowLevel); err != nil {
+			return err
+		}
+	}
+	for i, mutex := range synchronization.Mutexes {
+		if err := validatePreemption(fmt.Sprintf("%s.mutexes[%d]", path, i), mutex.Preemption, mutex.Database, workflowLevel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePreemption(path string, preemption *wfv1.Preemption, database, workflowLevel bool) error {
+	if preemption == nil {
+		return nil
+	}
+	if database {
+		return errors.Errorf(errors.CodeBadRequest, "%s.preemption is not supported for database locks", path)
+	}
+	if workflowLevel && preemption.GetAction() == wfv1.PreemptionActionSuspend {
+		return errors.Errorf(errors.CodeBadRequest, "%s.preemption.action %s is not supported for workflow level synchronization", path, wfv1.PreemptionActionSuspend)
+	}
+	return nil
+}
+
 // construct a Set of unique keys
 func getUniqueKeys(labelSources ...[]string) map[string]struct{} {
 	uniqueKeys := make(map[string]struct{})
@@ -401,6 +453,37 @@ func ValidateCronWorkflow(ctx context.Context, wftmplGetter templateresolution.W
 		return errors.Errorf(errors.CodeBadRequest, "startingDeadlineSeconds must be positive")
 	}
 
+	switch cronWf.Spec.CatchUpPolicy {
+	case wfv1.CatchUpNone, wfv1.CatchUpLatest, wfv1.CatchUpAll, "":
+		// Do nothing
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "'%s' is not a valid catchUpPolicy", cronWf.Spec.CatchUpPolicy)
+	}
+
+	if cronWf.Spec.CatchUpLimit != nil && *cronWf.Spec.CatchUpLimit < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "catchUpLimit must be at least 1")
+	}
+
+	// each missed execution would be forbidden by, or would replace, the one before it
+	if cronWf.Spec.CatchUpPolicy == wfv1.CatchUpAll && (cronWf.Spec.ConcurrencyPolicy == wfv1.ForbidConcurrent || cronWf.Spec.ConcurrencyPolicy == wfv1.ReplaceConcurrent) {
+		return errors.Errorf(errors.CodeBadRequest, "catchUpPolicy %s cannot be used with concurrencyPolicy %s", wfv1.CatchUpAll, cronWf.Spec.ConcurrencyPolicy)
+	}
+
+	if dependencies := cronWf.Spec.Dependencies; dependencies != nil {
+		for _, name := range dependencies.CronWorkflows {
+			if name == "" || name == cronWf.Name {
+				return errors.Errorf(errors.CodeBadRequest, "dependencies.cronWorkflows must be the names of other CronWorkflows")
+			}
+		}
+		if dependencies.DeadlineSeconds != nil && *dependencies.DeadlineSeconds < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "dependencies.deadlineSeconds must be positive")
+		}
+	}
+
+	if err := validateCronWorkflowBackfills(cronWf); err != nil {
+		return err
+	}
+
 	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
 
 	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, wfDefaults, ValidateOpts{})
@@ -410,6 +493,32 @@ func ValidateCronWorkflow(ctx context.Context, wftmplGetter templateresolution.W
 	return nil
 }
 
+func validateCronWorkflowBackfills(cronWf *wfv1.CronWorkflow) error {
+	names := make(map[string]bool)
+	for _, backfill := range cronWf.Spec.Backfills {
+		if errs := apivalidation.IsDNS1123Label(backfill.Name); len(errs) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "backfill name %q is invalid: %s", backfill.Name, strings.Join(errs, ";"))
+		}
+		if names[backfill.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "backfill name %q is not unique", backfill.Name)
+		}
+		names[backfill.Name] = true
+		if backfill.End.Before(&backfill.Start) {
+			return errors.Errorf(errors.CodeBadRequest, "backfill %q must not end before it starts", backfill.Name)
+		}
+		if backfill.Parallelism != nil && *backfill.Parallelism < 1 {
+			return errors.Errorf(errors.CodeBadRequest, "backfill %q parallelism must be at least 1", backfill.Name)
+		}
+		if backfill.RerunFailed < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "backfill %q rerunFailed must not be negative", backfill.Name)
+		}
+		if _, err := common.GetBackfillScheduledTimes(&cronWf.Spec, backfill); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s", err)
+		}
+	}
+	return nil
+}
+
 func (tctx *templateValidationCtx) validateInitContainers(containers []wfv1.UserContainer) error {
 	for _, container := range containers {
 		if len(container.Name) == 0 {
@@ -433,6 +542,10 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 		return err
 	}
 
+	if err := validateSynchronization(fmt.Sprintf("templates.%s.synchronization", tmpl.Name), tmpl.Synchronization, false); err != nil {
+		return err
+	}
+
 	localParams := make(map[string]string)
 	if tmpl.IsPodType() {
 		localParams[common.LocalVarPodName] = placeholderGenerator.NextPlaceholder()
@@ -514,6 +627,12 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 			return err
 		}
 	}
+	if newTmpl.Suspend != nil && newTmpl.Suspend.Approval != nil {
+		err = validateSuspendApproval(newTmpl.Name, newTmpl.Suspend.Approval)
+		if err != nil {
+			return err
+		}
+	}
 	if newTmpl.Metrics != nil {
 		for _, metric := range newTmpl.Metrics.Prometheus {
 			if !metrics.IsValidMetricName(metric.Name) {
@@ -604,7 +723,7 @@ func (tctx *templateValidationCtx) validateTemplateHolder(ctx context.Context, t
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.Plugin} {
+	for _, tmplType := range []interface{}{tmpl.Container, tmpl.ContainerSet, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.Data, tmpl.HTTP, tmpl.Plugin, tmpl.ChildWorkflow} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -632,6 +751,9 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
+		if err := common.ValidateParameterType(param); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.parameters.%s %s", tmpl.Name, param.Name, err)
+		}
 	}
 	if len(tmpl.Inputs.Parameters) > 0 {
 		scope["inputs.parameters"] = true
@@ -663,6 +785,16 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	return scope, nil
 }
 
+func validateSuspendApproval(tmplName string, approval *wfv1.SuspendApproval) error {
+	if approval.Required != nil && *approval.Required < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.approval.required must be at least 1", tmplName)
+	}
+	if len(approval.Groups) == 0 && len(approval.Users) > 0 && approval.GetRequired() > len(approval.Users) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.suspend.approval.required cannot be more than the number of users", tmplName)
+	}
+	return nil
+}
+
 func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error {
 	if art.Git != nil {
 		if art.Git.Repo == "" {
@@ -776,6 +908,14 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			}
 		}
 	}
+	if tmpl.ChildWorkflow != nil {
+		if tmpl.ChildWorkflow.WorkflowTemplateRef.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.childWorkflow.workflowTemplateRef.name is required", tmpl.Name)
+		}
+		if err := validateWorkflowFieldNames(tmpl.ChildWorkflow.Arguments.Parameters); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.childWorkflow.arguments.parameters%s", tmpl.Name, err.Error())
+		}
+	}
 	if tmpl.ContainerSet != nil {
 		err = tmpl.ContainerSet.Validate()
 		if err != nil {
@@ -847,6 +987,11 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			}
 		}
 	}
+	if tmpl.HTTP != nil {
+		if err := validateHTTP(tmpl); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s", tmpl.Name, err.Error())
+		}
+	}
 	// we don't validate tmpl.Plugin, because this is done by Plugin.UnmarshallJSON
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
@@ -862,6 +1007,38 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 	return nil
 }
 
+// validateHTTP validates the retry, pagination and response artifact of an HTTP template. The response artifact is
+// its only output artifact.
+func validateHTTP(tmpl *wfv1.Template) error {
+	for _, art := range tmpl.Outputs.Artifacts {
+		if art.Name != tmpl.HTTP.ResponseArtifact {
+			return fmt.Errorf("outputs.artifacts.%s must be the http.responseArtifact", art.Name)
+		}
+	}
+	if tmpl.HTTP.ResponseArtifact != "" && tmpl.Outputs.GetArtifactByName(tmpl.HTTP.ResponseArtifact) == nil {
+		return fmt.Errorf("http.responseArtifact '%s' must be an output artifact", tmpl.HTTP.ResponseArtifact)
+	}
+	if retry := tmpl.HTTP.Retry; retry != nil {
+		if retry.Limit < 0 {
+			return fmt.Errorf("http.retry.limit must be non-negative")
+		}
+		if backoff := retry.Backoff; backoff != nil {
+			for _, field := range [][2]string{{"duration", backoff.Duration}, {"cap", backoff.Cap}, {"maxDuration", backoff.MaxDuration}} {
+				if field[1] == "" || placeholderGenerator.IsPlaceholder(field[1]) {
+					continue
+				}
+				if _, err := wfv1.ParseStringToDuration(field[1]); err != nil {
+					return fmt.Errorf("http.retry.backoff.%s '%s' is not a duration", field[0], field[1])
+				}
+			}
+		}
+	}
+	if tmpl.HTTP.Pagination != nil && tmpl.HTTP.Pagination.MaxPages < 0 {
+		return fmt.Errorf("http.pagination.maxPages must be non-negative")
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -870,6 +1047,40 @@ func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues
 	return validateArgumentsValues(prefix, arguments, allowEmptyValues)
 }
 
+// inheritParameterTypes sets the types of the parameters that do not declare one to those of the template's parameters
+// of the same name, as the parameters passed to a template usually only have a value
+func inheritParameterTypes(params []wfv1.Parameter, tmplParams []wfv1.Parameter) {
+	for i := range params {
+		if params[i].IsTyped() {
+			continue
+		}
+		for _, tmplParam := range tmplParams {
+			if tmplParam.Name == params[i].Name {
+				params[i].Type = tmplParam.Type
+				params[i].Schema = tmplParam.Schema
+				break
+			}
+		}
+	}
+}
+
+// validateTypedArgument ensures that the value and default of a typed parameter are of its type, unless they are
+// only known at runtime
+func validateTypedArgument(param wfv1.Parameter) error {
+	if err := common.ValidateParameterType(param); err != nil {
+		return err
+	}
+	for _, value := range []*wfv1.AnyString{param.Value, param.Default} {
+		if value == nil || strings.Contains(value.String(), "{{") {
+			continue
+		}
+		if _, err := common.GetTypedParameterValue(param, value.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateArgumentsFieldNames(prefix string, arguments wfv1.Arguments) error {
 	fieldToSlices := map[string]interface{}{
 		"parameters": arguments.Parameters,
@@ -904,6 +1115,11 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 				return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only allows: default, configMapKeyRef and supplied", prefix, param.Name)
 			}
 		}
+		if param.IsTyped() {
+			if err := validateTypedArgument(param); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s %s", prefix, param.Name, err)
+			}
+		}
 		// validate enum
 		if param.Enum != nil {
 			if len(param.Enum) == 0 {
@@ -953,7 +1169,7 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 			stepNames[step.Name] = true
 			prefix := fmt.Sprintf("steps.%s", step.Name)
 			scope[fmt.Sprintf("%s.status", prefix)] = true
-			err := addItemsToScope(step.WithItems, step.WithParam, step.WithSequence, scope)
+			err := addItemsToScope(step.WithItems, step.WithParam, step.WithSequence, step.BatchSize, scope)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
@@ -998,6 +1214,13 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 			aggregate := len(step.WithItems) > 0 || step.WithParam != ""
 
 			tctx.addOutputsToScope(ctx, resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, aggregate, false)
+			if step.FanOut != nil {
+				err = validateFanOut(step.FanOut, step.ShouldExpand())
+				if err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
+				addFanOutToScope(prefix, scope)
+			}
 
 			// Validate the template again with actual arguments.
 			_, err = tctx.validateTemplateHolder(ctx, &step, tmplCtx, &step.Arguments, workflowTemplateValidation)
@@ -1009,7 +1232,7 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 	return nil
 }
 
-func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
+func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, batchSize *int64, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
 		defined++
@@ -1023,6 +1246,20 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 	if defined > 1 {
 		return fmt.Errorf("only one of withItems, withParam, withSequence can be specified")
 	}
+	if withSequence != nil && withSequence.Count != nil && withSequence.End != nil {
+		return errors.New(errors.CodeBadRequest, "only one of count or end can be defined in withSequence")
+	}
+	if batchSize != nil {
+		if defined == 0 {
+			return fmt.Errorf("batchSize can only be used with withItems, withParam or withSequence")
+		}
+		if *batchSize < 1 {
+			return fmt.Errorf("batchSize must be greater than zero")
+		}
+		// {{item}} is the JSON list of the items of a batch, whatever their type
+		scope["item"] = true
+		return nil
+	}
 	if len(withItems) > 0 {
 		for i := range withItems {
 			val := withItems[i]
@@ -1047,9 +1284,6 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 		// when considering if all variables are resolveable.
 		scope[anyItemMagicValue] = true
 	} else if withSequence != nil {
-		if withSequence.Count != nil && withSequence.End != nil {
-			return errors.New(errors.CodeBadRequest, "only one of count or end can be defined in withSequence")
-		}
 		scope["item"] = true
 	}
 	return nil
@@ -1113,6 +1347,38 @@ func (tctx *templateValidationCtx) addOutputsToScope(ctx context.Context, tmpl *
 	}
 }
 
+// validateFanOut validates the fan-out policy of a task, or step, expanded by withItems, withParam or withSequence
+func validateFanOut(policy *wfv1.FanOutPolicy, expanded bool) error {
+	if !expanded {
+		return fmt.Errorf("fanOut can only be used with withItems, withParam or withSequence")
+	}
+	if policy.MinSucceeded == nil && policy.MaxFailed == nil {
+		return fmt.Errorf("fanOut must have minSucceeded or maxFailed")
+	}
+	if err := validateFanOutValue("minSucceeded", policy.MinSucceeded); err != nil {
+		return err
+	}
+	return validateFanOutValue("maxFailed", policy.MaxFailed)
+}
+
+func validateFanOutValue(name string, value *k8sintstr.IntOrString) error {
+	if value == nil {
+		return nil
+	}
+	scaled, err := k8sintstr.GetScaledValueFromIntOrPercent(value, 100, false)
+	if err != nil || scaled < 0 || (value.Type == k8sintstr.String && scaled > 100) {
+		return fmt.Errorf("fanOut.%s '%s' must be a non-negative number or a percentage", name, value.String())
+	}
+	return nil
+}
+
+// addFanOutToScope adds the counts of the fan-out of a task, or step, to the scope
+func addFanOutToScope(prefix string, scope map[string]interface{}) {
+	scope[fmt.Sprintf("%s.fanOut.total", prefix)] = true
+	scope[fmt.Sprintf("%s.fanOut.succeeded", prefix)] = true
+	scope[fmt.Sprintf("%s.fanOut.failed", prefix)] = true
+}
+
 func validateOutputs(scope map[string]interface{}, globalParams map[string]string, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
 	err := validateWorkflowFieldNames(tmpl.Outputs.Parameters)
 	if err != nil {
@@ -1133,7 +1399,8 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
-		if tmpl.IsLeaf() {
+		// the output artifact of an HTTP template is its response, rather than a file
+		if tmpl.IsLeaf() && tmpl.HTTP == nil {
 			err = art.CleanPath()
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s: %s", tmpl.Name, artRef, err.Error())
@@ -1152,9 +1419,16 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
-		err = validateOutputParameter(paramRef, &param)
-		if err != nil {
-			return err
+		if tmpl.ChildWorkflow != nil {
+			// the value of a child workflow's output parameter is the child workflow's output of the same name
+			if param.Value != nil || (param.ValueFrom != nil && *param.ValueFrom != (wfv1.ValueFrom{Default: param.ValueFrom.Default})) {
+				return errors.Errorf(errors.CodeBadRequest, "%s can only have valueFrom.default for childWorkflow templates", paramRef)
+			}
+		} else {
+			err = validateOutputParameter(paramRef, &param)
+			if err != nil {
+				return err
+			}
 		}
 		if param.ValueFrom != nil {
 			tmplType := tmpl.GetType()
@@ -1186,6 +1460,58 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 	return nil
 }
 
+// validateWorkflowOutputs validates the outputs declared by a workflow, which are resolved from the entrypoint's
+// steps or tasks when it completes, so cannot refer to anything else. They are recorded in the workflow's global
+// outputs, so cannot have the same names as the global outputs exported by the templates with globalName
+func validateWorkflowOutputs(outputs *wfv1.Outputs, templates []wfv1.Template) error {
+	if outputs == nil {
+		return nil
+	}
+	if err := validateWorkflowFieldNames(outputs.Parameters); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.outputs.parameters%s", err.Error())
+	}
+	if err := validateWorkflowFieldNames(outputs.Artifacts); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.outputs.artifacts%s", err.Error())
+	}
+	if outputs.Result != nil || outputs.ExitCode != nil {
+		return errors.New(errors.CodeBadRequest, "spec.outputs can only have parameters and artifacts")
+	}
+	for _, param := range outputs.Parameters {
+		paramRef := fmt.Sprintf("spec.outputs.parameters.%s", param.Name)
+		if param.ValueFrom == nil || (param.ValueFrom.Parameter == "") == (param.ValueFrom.Expression == "") {
+			return errors.Errorf(errors.CodeBadRequest, "%s must have one of valueFrom.parameter or valueFrom.expression", paramRef)
+		}
+		if param.Value != nil || param.GlobalName != "" || param.ValueFrom.Path != "" || param.ValueFrom.JSONPath != "" || param.ValueFrom.JQFilter != "" || param.ValueFrom.Supplied != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s can only have valueFrom.parameter, valueFrom.expression or valueFrom.default", paramRef)
+		}
+		if err := common.ValidateParameterType(param); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", paramRef, err)
+		}
+	}
+	for _, art := range outputs.Artifacts {
+		artRef := fmt.Sprintf("spec.outputs.artifacts.%s", art.Name)
+		if (art.From == "") == (art.FromExpression == "") {
+			return errors.Errorf(errors.CodeBadRequest, "%s must have one of from or fromExpression", artRef)
+		}
+		if art.Path != "" || art.GlobalName != "" || art.HasLocation() {
+			return errors.Errorf(errors.CodeBadRequest, "%s can only have from, fromExpression, subPath and optional", artRef)
+		}
+	}
+	for _, tmpl := range templates {
+		for _, param := range tmpl.Outputs.Parameters {
+			if param.GlobalName != "" && outputs.GetParameterByName(param.GlobalName) != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s globalName %s conflicts with spec.outputs.parameters.%s", tmpl.Name, param.Name, param.GlobalName, param.GlobalName)
+			}
+		}
+		for _, art := range tmpl.Outputs.Artifacts {
+			if art.GlobalName != "" && outputs.GetArtifactByName(art.GlobalName) != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts.%s globalName %s conflicts with spec.outputs.artifacts.%s", tmpl.Name, art.Name, art.GlobalName, art.GlobalName)
+			}
+		}
+	}
+	return nil
+}
+
 // validateOutputParameter verifies that only one of valueFrom is defined in an output
 func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	if param.ValueFrom != nil && param.Value != nil {
@@ -1360,9 +1686,23 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 
 		resolvedTemplates[task.Name] = resolvedTmpl
 
+		if task.Expand != nil {
+			err = validateDAGTaskExpand(&task, resolvedTmpl)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+		}
+
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
 		aggregate := len(task.WithItems) > 0 || task.WithParam != ""
 		tctx.addOutputsToScope(ctx, resolvedTmpl, prefix, scope, aggregate, false)
+		if task.FanOut != nil {
+			err = validateFanOut(task.FanOut, task.ShouldExpand())
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+			addFanOutToScope(prefix, scope)
+		}
 
 		err = common.ValidateTaskResults(&task)
 		if err != nil {
@@ -1419,6 +1759,9 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			ancestorPrefix := fmt.Sprintf("tasks.%s", ancestor)
 			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != ""
 			tctx.addOutputsToScope(ctx, resolvedTmpl, ancestorPrefix, taskScope, aggregate, true)
+			if ancestorTask.FanOut != nil {
+				addFanOutToScope(ancestorPrefix, taskScope)
+			}
 		}
 		if i := task.Inline; i != nil {
 			for _, p := range i.Inputs.Parameters {
@@ -1426,7 +1769,7 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			}
 		}
 
-		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, taskScope)
+		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, task.BatchSize, taskScope)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
@@ -1452,6 +1795,109 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 	return nil
 }
 
+// validateDAGTaskExpand validates that a task expanding its DAG emits the DAG tasks as one of its template's outputs
+func validateDAGTaskExpand(task *wfv1.DAGTask, resolvedTmpl *wfv1.Template) error {
+	if task.ShouldExpand() {
+		return fmt.Errorf("expand cannot be used with withItems, withParam or withSequence")
+	}
+	if task.Expand.Parameter == "" {
+		return fmt.Errorf("expand.parameter is required")
+	}
+	if resolvedTmpl != nil && resolvedTmpl.Outputs.GetParameterByName(task.Expand.Parameter) == nil {
+		return fmt.Errorf("expand.parameter '%s' is not an output parameter of the template", task.Expand.Parameter)
+	}
+	return nil
+}
+
+// ValidateDAGExpansion validates the DAG tasks emitted by the expanding task of a DAG before they are spliced into it.
+// The emitted tasks are produced by a pod at runtime, so they can only run the templates of the workflow, by name, which
+// were validated, and checked against the controller's templateReferencing restrictions, when it was submitted. They may
+// only depend on the expanding task and on each other.
+func ValidateDAGExpansion(ctx context.Context, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, tasks []wfv1.DAGTask, expandingTask string, expansion []wfv1.DAGTask) error {
+	err := validateWorkflowFieldNames(expansion)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "tasks%s", err.Error())
+	}
+	nameToTask := make(map[string]wfv1.DAGTask)
+	for _, task := range tasks {
+		nameToTask[task.Name] = task
+	}
+	expanded := make(map[string]bool)
+	for _, task := range expansion {
+		if _, ok := nameToTask[task.Name]; ok {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s is already defined", task.Name)
+		}
+		nameToTask[task.Name] = task
+		expanded[task.Name] = true
+	}
+	dagValidationCtx := &dagValidationContext{
+		tasks:        nameToTask,
+		dependencies: make(map[string]map[string]common.DependencyType),
+	}
+	for _, task := range expansion {
+		if '0' <= task.Name[0] && task.Name[0] <= '9' {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s name cannot begin with a digit", task.Name)
+		}
+		if task.Expand != nil {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s cannot expand the DAG again", task.Name)
+		}
+		if task.TemplateRef != nil || task.Inline != nil {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s cannot use templateRef or inline, only the name of a template of the workflow", task.Name)
+		}
+		if err = validateDAGExpansionTemplate(ctx, tmplCtx, task.Template); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s %s", task.Name, err.Error())
+		}
+		if task.OnExit != "" {
+			if err = validateDAGExpansionTemplate(ctx, tmplCtx, task.OnExit); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "tasks.%s.onExit %s", task.Name, err.Error())
+			}
+		}
+		for event, hook := range task.Hooks {
+			if hook.TemplateRef != nil {
+				return errors.Errorf(errors.CodeBadRequest, "tasks.%s.hooks.%s cannot use templateRef, only the name of a template of the workflow", task.Name, event)
+			}
+			if err = validateDAGExpansionTemplate(ctx, tmplCtx, hook.Template); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "tasks.%s.hooks.%s %s", task.Name, event, err.Error())
+			}
+			if err = validateArguments(fmt.Sprintf("tasks.%s.hooks.%s.arguments.", task.Name, event), hook.Arguments, false); err != nil {
+				return err
+			}
+		}
+		if err = validateArguments(fmt.Sprintf("tasks.%s.arguments.", task.Name), task.Arguments, false); err != nil {
+			return err
+		}
+		if task.FanOut != nil {
+			if err = validateFanOut(task.FanOut, task.ShouldExpand()); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "tasks.%s %s", task.Name, err.Error())
+			}
+		}
+		if err = common.ValidateTaskResults(&task); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "tasks.%s %s", task.Name, err.Error())
+		}
+		for depName := range dagValidationCtx.GetTaskDependenciesWithDependencyTypes(ctx, task.Name) {
+			if depName != expandingTask && !expanded[depName] {
+				return errors.Errorf(errors.CodeBadRequest, "tasks.%s dependency '%s' is neither '%s' nor one of the tasks it emitted", task.Name, depName, expandingTask)
+			}
+		}
+	}
+	return verifyNoCycles(ctx, &wfv1.Template{Name: tmpl.Name, DAG: &wfv1.DAGTemplate{Tasks: expansion}}, dagValidationCtx)
+}
+
+// validateDAGExpansionTemplate validates that an emitted task refers to a template of the workflow
+func validateDAGExpansionTemplate(ctx context.Context, tmplCtx *templateresolution.TemplateContext, name string) error {
+	if name == "" {
+		return errors.New(errors.CodeBadRequest, "must have a template")
+	}
+	_, err := tmplCtx.GetTemplateByName(ctx, name)
+	if err != nil {
+		if argoerr, ok := err.(errors.ArgoError); ok && argoerr.Code() == errors.CodeNotFound {
+			return errors.Errorf(errors.CodeBadRequest, "template name '%s' undefined", name)
+		}
+		return err
+	}
+	return nil
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
@@ -2,16 +2,20 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -3435,3 +3439,500 @@ func TestDynamicWorkflowTemplateRef(t *testing.T) {
 	_ = deleteWorkflowTemplate(ctx, wftmplA.Name)
 	_ = deleteWorkflowTemplate(ctx, wftmplB.Name)
 }
+
+func TestValidateCronWorkflowBackfills(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC))
+	newCronWf := func(backfills ...wfv1.CronWorkflowBackfill) *wfv1.CronWorkflow {
+		return &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{Schedules: []string{"0 * * * *"}, Backfills: backfills}}
+	}
+	backfill := wfv1.CronWorkflowBackfill{Name: "b", Start: start, End: metav1.NewTime(start.Add(time.Hour))}
+	require.NoError(t, validateCronWorkflowBackfills(newCronWf(backfill)))
+
+	err := validateCronWorkflowBackfills(newCronWf(backfill, backfill))
+	require.EqualError(t, err, `backfill name "b" is not unique`)
+
+	invalid := backfill
+	invalid.End = metav1.NewTime(start.Add(-time.Hour))
+	err = validateCronWorkflowBackfills(newCronWf(invalid))
+	require.EqualError(t, err, `backfill "b" must not end before it starts`)
+
+	invalid = backfill
+	invalid.Parallelism = ptr.To(int32(0))
+	err = validateCronWorkflowBackfills(newCronWf(invalid))
+	require.EqualError(t, err, `backfill "b" parallelism must be at least 1`)
+
+	invalid = backfill
+	invalid.End = metav1.NewTime(start.AddDate(1, 0, 0))
+	err = validateCronWorkflowBackfills(newCronWf(invalid))
+	require.EqualError(t, err, `backfill "b" has more than 1000 scheduled times`)
+}
+
+func TestValidateCronWorkflowCatchUpPolicy(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "hourly"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedules:     []string{"0 * * * *"},
+			CatchUpPolicy: "Some",
+		},
+	}
+	err := ValidateCronWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "'Some' is not a valid catchUpPolicy")
+
+	cwf.Spec.CatchUpPolicy = wfv1.CatchUpAll
+	cwf.Spec.CatchUpLimit = ptr.To(int32(0))
+	err = ValidateCronWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "catchUpLimit must be at least 1")
+
+	cwf.Spec.CatchUpLimit = nil
+	for _, policy := range []wfv1.ConcurrencyPolicy{wfv1.ForbidConcurrent, wfv1.ReplaceConcurrent} {
+		cwf.Spec.ConcurrencyPolicy = policy
+		err = ValidateCronWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, cwf, nil)
+		require.EqualError(t, err, fmt.Sprintf("catchUpPolicy All cannot be used with concurrencyPolicy %s", policy))
+	}
+}
+
+func TestValidateCronWorkflowDependencies(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "report"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedules:    []string{"0 * * * *"},
+			Dependencies: &wfv1.CronWorkflowDependencies{CronWorkflows: []string{"report"}},
+		},
+	}
+	err := ValidateCronWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "dependencies.cronWorkflows must be the names of other CronWorkflows")
+
+	cwf.Spec.Dependencies = &wfv1.CronWorkflowDependencies{CronWorkflows: []string{"nightly"}, DeadlineSeconds: ptr.To(int64(-1))}
+	err = ValidateCronWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, cwf, nil)
+	require.EqualError(t, err, "dependencies.deadlineSeconds must be positive")
+}
+
+func TestValidateSuspendApproval(t *testing.T) {
+	require.NoError(t, validateSuspendApproval("approve", &wfv1.SuspendApproval{Users: []string{"alice", "bob"}, Required: ptr.To(int32(2))}))
+	require.NoError(t, validateSuspendApproval("approve", &wfv1.SuspendApproval{Groups: []string{"release"}, Required: ptr.To(int32(3))}))
+
+	err := validateSuspendApproval("approve", &wfv1.SuspendApproval{Required: ptr.To(int32(0))})
+	require.EqualError(t, err, "templates.approve.suspend.approval.required must be at least 1")
+
+	err = validateSuspendApproval("approve", &wfv1.SuspendApproval{Users: []string{"alice"}, Required: ptr.To(int32(2))})
+	require.EqualError(t, err, "templates.approve.suspend.approval.required cannot be more than the number of users")
+}
+
+var typedParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: typed-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: replicas
+      type: int
+      value: "%s"
+  templates:
+  - name: main
+    steps:
+    - - name: scale
+        template: scale
+        arguments:
+          parameters:
+          - name: replicas
+            value: "{{workflow.parameters.replicas}}"
+          - name: debug
+            value: "%s"
+  - name: scale
+    inputs:
+      parameters:
+      - name: replicas
+        type: int
+        schema: '{"minimum": 1}'
+      - name: debug
+        type: bool
+    container:
+      image: alpine
+      args: ["{{=inputs.parameters.replicas * 2}}", "{{inputs.parameters.debug}}"]
+`
+
+func TestValidateTypedParameters(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(typedParameters, "3", "true")))
+
+	err := validate(ctx, fmt.Sprintf(typedParameters, "three", "true"))
+	require.EqualError(t, err, `spec.arguments.replicas value "three" is not an int`)
+
+	err = validate(ctx, fmt.Sprintf(typedParameters, "3", "yes"))
+	require.EqualError(t, err, `templates.main.steps[0].scale templates.scale inputs.parameters.debug: value "yes" is not a bool`)
+
+	err = validate(ctx, fmt.Sprintf(typedParameters, "0", "true"))
+	require.EqualError(t, err, `templates.main.steps[0].scale templates.scale inputs.parameters.replicas: value does not conform to the schema: (root): Must be greater than or equal to 1`)
+
+	err = validate(ctx, strings.Replace(fmt.Sprintf(typedParameters, "3", "true"), "type: bool", "type: boolean", 1))
+	require.EqualError(t, err, `templates.main.steps[0].scale templates.scale.inputs.parameters.debug type "boolean" is not one of: string, int, float, bool, json`)
+}
+
+var childWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+    - - name: deploy
+        template: deploy
+        arguments:
+          parameters:
+          - name: version
+            value: "{{steps.build.outputs.parameters.version}}"
+  - name: build
+    childWorkflow:
+      workflowTemplateRef:
+        name: %s
+    outputs:
+      parameters:
+      - name: version
+%s
+  - name: deploy
+    inputs:
+      parameters:
+      - name: version
+    container:
+      image: alpine
+      args: ["{{inputs.parameters.version}}"]
+`
+
+func TestValidateChildWorkflow(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(childWorkflow, "build", "")))
+	require.NoError(t, validate(ctx, fmt.Sprintf(childWorkflow, "build", "        valueFrom:\n          default: latest")))
+
+	err := validate(ctx, fmt.Sprintf(childWorkflow, "build", "        value: latest"))
+	require.EqualError(t, err, "templates.main.steps[0].build templates.build.outputs.parameters.version can only have valueFrom.default for childWorkflow templates")
+
+	err = validate(ctx, fmt.Sprintf(childWorkflow, `""`, ""))
+	require.EqualError(t, err, "templates.main.steps[0].build templates.build.childWorkflow.workflowTemplateRef.name is required")
+}
+
+var dagExpansion = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-expansion-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: discover
+        template: discover
+        expand:
+          parameter: %s
+      - name: report
+        template: work
+        depends: discover
+  - name: discover
+    container:
+      image: alpine
+    outputs:
+      parameters:
+      - name: tasks
+        valueFrom:
+          path: /tmp/tasks.json
+  - name: work
+    container:
+      image: alpine
+`
+
+func TestValidateDAGTaskExpand(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(dagExpansion, "tasks")))
+
+	err := validate(ctx, fmt.Sprintf(dagExpansion, "partitions"))
+	require.EqualError(t, err, "templates.main.tasks.discover expand.parameter 'partitions' is not an output parameter of the template")
+
+	err = validate(ctx, fmt.Sprintf(dagExpansion, `""`))
+	require.EqualError(t, err, "templates.main.tasks.discover expand.parameter is required")
+}
+
+func TestValidateDAGExpansion(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(fmt.Sprintf(dagExpansion, "tasks"))
+	tmplCtx := templateresolution.NewContext(wftmplGetter, cwftmplGetter, wf, wf, logging.RequireLoggerFromContext(ctx))
+	tmpl := wf.GetTemplateByName("main")
+	tasks := tmpl.DAG.Tasks
+
+	require.NoError(t, ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{
+		{Name: "a", Template: "work", Depends: "discover"},
+		{Name: "b", Template: "work", Depends: "a"},
+	}))
+
+	err := ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "report", Template: "work", Depends: "discover"}})
+	require.EqualError(t, err, "tasks.report is already defined")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Depends: "discover"}})
+	require.EqualError(t, err, "tasks.a must have a template")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Template: "inject", Depends: "discover"}})
+	require.EqualError(t, err, "tasks.a template name 'inject' undefined")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Depends: "discover", Inline: &wfv1.Template{Container: &corev1.Container{Image: "attacker"}}}})
+	require.EqualError(t, err, "tasks.a cannot use templateRef or inline, only the name of a template of the workflow")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Depends: "discover", TemplateRef: &wfv1.TemplateRef{Name: "other", Template: "inject"}}})
+	require.EqualError(t, err, "tasks.a cannot use templateRef or inline, only the name of a template of the workflow")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Template: "work", Depends: "discover", Hooks: wfv1.LifecycleHooks{
+		wfv1.ExitLifecycleEvent: {TemplateRef: &wfv1.TemplateRef{Name: "other", Template: "inject"}},
+	}}})
+	require.EqualError(t, err, "tasks.a.hooks.exit cannot use templateRef, only the name of a template of the workflow")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{{Name: "a", Template: "work", Depends: "report"}})
+	require.EqualError(t, err, "tasks.a dependency 'report' is neither 'discover' nor one of the tasks it emitted")
+
+	err = ValidateDAGExpansion(ctx, tmplCtx, tmpl, tasks, "discover", []wfv1.DAGTask{
+		{Name: "a", Template: "work", Depends: "b"},
+		{Name: "b", Template: "work", Depends: "a"},
+	})
+	require.ErrorContains(t, err, "templates.main.tasks dependency cycle detected")
+}
+
+var fanOut = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: fan-out-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: shards
+        template: work
+%s
+        fanOut:
+          %s
+      - name: report
+        template: report
+        depends: shards
+        arguments:
+          parameters:
+          - name: failed
+            value: "{{tasks.shards.fanOut.failed}}"
+  - name: work
+    container:
+      image: alpine
+  - name: report
+    inputs:
+      parameters:
+      - name: failed
+    container:
+      image: alpine
+`
+
+func TestValidateFanOut(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	withItems := "        withItems: [a, b]"
+	require.NoError(t, validate(ctx, fmt.Sprintf(fanOut, withItems, `minSucceeded: "98%"`)))
+	require.NoError(t, validate(ctx, fmt.Sprintf(fanOut, withItems, `maxFailed: 10`)))
+
+	err := validate(ctx, fmt.Sprintf(fanOut, "", `maxFailed: 10`))
+	require.EqualError(t, err, "templates.main.tasks.shards fanOut can only be used with withItems, withParam or withSequence")
+
+	err = validate(ctx, fmt.Sprintf(fanOut, withItems, `{}`))
+	require.EqualError(t, err, "templates.main.tasks.shards fanOut must have minSucceeded or maxFailed")
+
+	err = validate(ctx, fmt.Sprintf(fanOut, withItems, `maxFailed: "120%"`))
+	require.EqualError(t, err, "templates.main.tasks.shards fanOut.maxFailed '120%' must be a non-negative number or a percentage")
+
+	err = validate(ctx, fmt.Sprintf(fanOut, withItems, `minSucceeded: -1`))
+	require.EqualError(t, err, "templates.main.tasks.shards fanOut.minSucceeded '-1' must be a non-negative number or a percentage")
+}
+
+var batchSize = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: batch-size-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: shards
+        template: work
+        arguments:
+          parameters:
+          - name: items
+            value: "%s"
+%s
+        batchSize: %s
+  - name: work
+    inputs:
+      parameters:
+      - name: items
+    container:
+      image: alpine
+`
+
+func TestValidateBatchSize(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	withItems := "        withItems: [{os: debian}, {os: ubuntu}]"
+	require.NoError(t, validate(ctx, fmt.Sprintf(batchSize, "{{item}}", withItems, "100")))
+	require.NoError(t, validate(ctx, fmt.Sprintf(batchSize, "{{item}}", `        withParam: "[1, 2]"`, "100")))
+
+	err := validate(ctx, fmt.Sprintf(batchSize, "{{item.os}}", withItems, "100"))
+	require.EqualError(t, err, "templates.main.steps failed to resolve {{item.os}}")
+
+	err = validate(ctx, fmt.Sprintf(batchSize, "{{item}}", "", "100"))
+	require.EqualError(t, err, "templates.main.steps[0].shards batchSize can only be used with withItems, withParam or withSequence")
+
+	err = validate(ctx, fmt.Sprintf(batchSize, "{{item}}", withItems, "0"))
+	require.EqualError(t, err, "templates.main.steps[0].shards batchSize must be greater than zero")
+}
+
+var httpTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-template-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: export
+        template: http
+    - - name: print
+        template: print
+        arguments:
+          artifacts:
+          - name: export
+            from: "{{steps.export.outputs.artifacts.export}}"
+  - name: http
+    http:
+      url: https://example.com/export
+%s
+    outputs:
+      artifacts:
+      - name: export
+  - name: print
+    inputs:
+      artifacts:
+      - name: export
+        path: /tmp/export
+    container:
+      image: alpine
+`
+
+func TestValidateHTTPTemplate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(httpTemplate, `      responseArtifact: export
+      retry: {limit: 3, backoff: {duration: 1s, factor: 2, cap: 1m}}
+      pagination: {maxPages: 10}`)))
+
+	err := validate(ctx, fmt.Sprintf(httpTemplate, ""))
+	require.EqualError(t, err, "templates.main.steps[0].export templates.http.outputs.artifacts.export must be the http.responseArtifact")
+
+	err = validate(ctx, fmt.Sprintf(httpTemplate, `      responseArtifact: response`))
+	require.EqualError(t, err, "templates.main.steps[0].export templates.http.outputs.artifacts.export must be the http.responseArtifact")
+
+	err = validate(ctx, fmt.Sprintf(httpTemplate, `      responseArtifact: export
+      retry: {limit: 3, backoff: {duration: soon}}`))
+	require.EqualError(t, err, "templates.main.steps[0].export templates.http.http.retry.backoff.duration 'soon' is not a duration")
+}
+
+var preemptionWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: preemption-
+spec:
+  entrypoint: main
+  synchronization:
+    mutexes:
+    - name: deploy
+      preemption:
+        action: %s
+  templates:
+  - name: main
+    container:
+      image: alpine
+`
+
+func TestValidatePreemption(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(preemptionWorkflow, "Stop")))
+
+	err := validate(ctx, fmt.Sprintf(preemptionWorkflow, "Suspend"))
+	require.EqualError(t, err, "spec.synchronization.mutexes[0].preemption.action Suspend is not supported for workflow level synchronization")
+}
+
+var databasePreemptionWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: preemption-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    synchronization:
+      semaphores:
+      - database:
+          key: deploy
+        preemption: {}
+    container:
+      image: alpine
+`
+
+func TestValidateDatabasePreemption(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, databasePreemptionWorkflow)
+	require.EqualError(t, err, "templates.main.synchronization.semaphores[0].preemption is not supported for database locks")
+
+	err = validate(ctx, strings.Replace(fmt.Sprintf(preemptionWorkflow, "Stop"), "- name: deploy", "- name: deploy\n      database: true", 1))
+	require.EqualError(t, err, "spec.synchronization.mutexes[0].preemption is not supported for database locks")
+}
+
+var workflowOutputsGlobalName = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: workflow-outputs-
+spec:
+  entrypoint: main
+  outputs:
+    parameters:
+    - name: version
+      valueFrom:
+        parameter: "{{steps.build.outputs.parameters.version}}"
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+  - name: build
+    container:
+      image: alpine
+    outputs:
+      parameters:
+      - name: version
+        globalName: %s
+        valueFrom:
+          path: /tmp/version
+`
+
+func TestValidateWorkflowOutputsGlobalName(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(workflowOutputsGlobalName, "build-version")))
+
+	err := validate(ctx, fmt.Sprintf(workflowOutputsGlobalName, "version"))
+	require.EqualError(t, err, "templates.build.outputs.parameters.version globalName version conflicts with spec.outputs.parameters.version")
+}